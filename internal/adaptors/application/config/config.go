@@ -13,6 +13,9 @@ import (
 
 type OSLayer interface {
 	Args() []string
+	Environ() []string
+	UserConfigDir() (string, error)
+	ReadFile(filePath string) ([]byte, error)
 	ReadBuildInfo() (info *debug.BuildInfo, ok bool)
 }
 
@@ -24,11 +27,31 @@ type Config struct {
 	useSingleMATLABSession           bool
 	logLevel                         entities.LogLevel
 	preferredLocalMATLABRoot         string
+	preferredMATLABVersion           string
 	preferredMATLABStartingDirectory string
 	baseDirectory                    string
 	watchdogMode                     bool
 	serverInstanceID                 string
 	initializeMATLABOnStartup        bool
+	sharedMATLABSessionFolder        string
+	remoteMATLABHost                 string
+	remoteMATLABPort                 string
+	remoteMATLABAPIKeyFile           string
+	remoteMATLABCertificateFile      string
+	matlabSessionPoolSize            int
+	additionalMATLABRoots            []string
+	allowedRoots                     []string
+	codeSafetyAction                 entities.CodeSafetyAction
+	codeSafetyPatterns               []string
+	enabledTools                     []string
+	disabledTools                    []string
+	readOnly                         bool
+	toolsRequiringApproval           []string
+	auditLogFile                     string
+	codingGuidelines                 []string
+	replaceCodingGuidelines          bool
+	promptFiles                      []string
+	configFile                       string
 }
 
 func New(
@@ -77,6 +100,10 @@ func (c *Config) PreferredLocalMATLABRoot() string {
 	return c.preferredLocalMATLABRoot
 }
 
+func (c *Config) PreferredMATLABVersion() string {
+	return c.preferredMATLABVersion
+}
+
 func (c *Config) PreferredMATLABStartingDirectory() string {
 	return c.preferredMATLABStartingDirectory
 }
@@ -97,12 +124,106 @@ func (c *Config) InitializeMATLABOnStartup() bool {
 	return c.initializeMATLABOnStartup
 }
 
+func (c *Config) SharedMATLABSessionFolder() string {
+	return c.sharedMATLABSessionFolder
+}
+
+func (c *Config) RemoteMATLABHost() string {
+	return c.remoteMATLABHost
+}
+
+func (c *Config) RemoteMATLABPort() string {
+	return c.remoteMATLABPort
+}
+
+func (c *Config) RemoteMATLABAPIKeyFile() string {
+	return c.remoteMATLABAPIKeyFile
+}
+
+func (c *Config) RemoteMATLABCertificateFile() string {
+	return c.remoteMATLABCertificateFile
+}
+
+func (c *Config) MATLABSessionPoolSize() int {
+	return c.matlabSessionPoolSize
+}
+
+func (c *Config) AdditionalMATLABRoots() []string {
+	return c.additionalMATLABRoots
+}
+
+func (c *Config) AllowedRoots() []string {
+	return c.allowedRoots
+}
+
+func (c *Config) CodeSafetyAction() entities.CodeSafetyAction {
+	return c.codeSafetyAction
+}
+
+func (c *Config) CodeSafetyPatterns() []string {
+	return c.codeSafetyPatterns
+}
+
+func (c *Config) EnabledTools() []string {
+	return c.enabledTools
+}
+
+func (c *Config) DisabledTools() []string {
+	return c.disabledTools
+}
+
+func (c *Config) ReadOnly() bool {
+	return c.readOnly
+}
+
+func (c *Config) ToolsRequiringApproval() []string {
+	return c.toolsRequiringApproval
+}
+
+func (c *Config) AuditLogFile() string {
+	return c.auditLogFile
+}
+
+func (c *Config) CodingGuidelines() []string {
+	return c.codingGuidelines
+}
+
+func (c *Config) ReplaceCodingGuidelines() bool {
+	return c.replaceCodingGuidelines
+}
+
+func (c *Config) PromptFiles() []string {
+	return c.promptFiles
+}
+
+func (c *Config) ConfigFile() string {
+	return c.configFile
+}
+
 func (c *Config) RecordToLogger(logger entities.Logger) {
 	logger.
 		With(flags.DisableTelemetry, c.disableTelemetry).
 		With(flags.UseSingleMATLABSession, c.useSingleMATLABSession).
 		With(flags.LogLevel, c.logLevel).
 		With(flags.PreferredLocalMATLABRoot, c.preferredLocalMATLABRoot).
+		With(flags.PreferredMATLABVersion, c.preferredMATLABVersion).
 		With(flags.PreferredMATLABStartingDirectory, c.preferredMATLABStartingDirectory).
+		With(flags.SharedMATLABSessionFolder, c.sharedMATLABSessionFolder).
+		With(flags.RemoteMATLABHost, c.remoteMATLABHost).
+		With(flags.RemoteMATLABPort, c.remoteMATLABPort).
+		With(flags.MATLABSessionPoolSize, c.matlabSessionPoolSize).
+		With(flags.AdditionalMATLABRoots, c.additionalMATLABRoots).
+		With(flags.AllowedRoots, c.allowedRoots).
+		With(flags.CodeSafetyAction, c.codeSafetyAction).
+		With(flags.CodeSafetyPatterns, c.codeSafetyPatterns).
+		With(flags.EnabledTools, c.enabledTools).
+		With(flags.DisabledTools, c.disabledTools).
+		With(flags.ReadOnly, c.readOnly).
+		With(flags.RequireApproval, c.toolsRequiringApproval).
+		With(flags.AuditLogFile, c.auditLogFile).
+		With(flags.CodingGuidelines, c.codingGuidelines).
+		With(flags.ReplaceCodingGuidelines, c.replaceCodingGuidelines).
+		With(flags.PromptFiles, c.promptFiles).
+		With(flags.ConfigFile, c.configFile).
 		Info("Configuration state")
 }
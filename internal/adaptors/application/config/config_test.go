@@ -3,6 +3,8 @@
 package config_test
 
 import (
+	"fmt"
+	"os"
 	"path/filepath"
 	"runtime/debug"
 	"testing"
@@ -26,6 +28,28 @@ type expectedConfig struct {
 	watchdogMode                     bool
 	serverInstanceID                 string
 	initializeMATLABOnStartup        bool
+	sharedMATLABSessionFolder        string
+}
+
+const userConfigDir = "userconfig"
+
+var defaultConfigFile = filepath.Join(userConfigDir, "matlab-mcp-core-server", "config.yaml")
+
+func expectNoEnvironmentVariablesAndNoConfigFile(mockOSLayer *configmocks.MockOSLayer) {
+	mockOSLayer.EXPECT().
+		Environ().
+		Return([]string{"PATH=/usr/bin"}).
+		Once()
+
+	mockOSLayer.EXPECT().
+		UserConfigDir().
+		Return(userConfigDir, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(defaultConfigFile).
+		Return(nil, os.ErrNotExist).
+		Once()
 }
 
 func TestNew_HappyPath(t *testing.T) {
@@ -63,6 +87,7 @@ func TestNew_HappyPath(t *testing.T) {
 				"--watchdog=true",
 				"--server-instance-id=1337",
 				"--initialize-matlab-on-startup=false",
+				"--shared-matlab-session-folder=" + filepath.Join("tmp", "shared"),
 			},
 			expected: expectedConfig{
 				versionMode:                      true,
@@ -75,6 +100,7 @@ func TestNew_HappyPath(t *testing.T) {
 				watchdogMode:                     true,
 				serverInstanceID:                 "1337",
 				initializeMATLABOnStartup:        false,
+				sharedMATLABSessionFolder:        "",
 			},
 		},
 		{
@@ -111,6 +137,8 @@ func TestNew_HappyPath(t *testing.T) {
 				Return(args).
 				Once()
 
+			expectNoEnvironmentVariablesAndNoConfigFile(mockOSLayer)
+
 			// Act
 			cfg, err := config.New(mockOSLayer)
 
@@ -128,6 +156,7 @@ func TestNew_HappyPath(t *testing.T) {
 			assert.Equal(t, testConfig.expected.watchdogMode, cfg.WatchdogMode())
 			assert.Equal(t, testConfig.expected.serverInstanceID, cfg.ServerInstanceID())
 			assert.Equal(t, testConfig.expected.initializeMATLABOnStartup, cfg.InitializeMATLABOnStartup())
+			assert.Equal(t, testConfig.expected.sharedMATLABSessionFolder, cfg.SharedMATLABSessionFolder())
 		})
 	}
 }
@@ -150,6 +179,8 @@ func TestConfig_Version_VersionSetByLDFLAGS(t *testing.T) {
 		Return([]string{programName}).
 		Once()
 
+	expectNoEnvironmentVariablesAndNoConfigFile(mockOSLayer)
+
 	mockOSLayer.EXPECT().
 		ReadBuildInfo().
 		Return(&debug.BuildInfo{
@@ -185,6 +216,8 @@ func TestConfig_Version_VersionUnsetByLDFLAGS(t *testing.T) {
 		Return([]string{programName}).
 		Once()
 
+	expectNoEnvironmentVariablesAndNoConfigFile(mockOSLayer)
+
 	mockOSLayer.EXPECT().
 		ReadBuildInfo().
 		Return(&debug.BuildInfo{
@@ -220,6 +253,8 @@ func TestConfig_Version_VersionUnsetByLDFLAGS_ButReadBuildInfoNotOK(t *testing.T
 		Return([]string{programName}).
 		Once()
 
+	expectNoEnvironmentVariablesAndNoConfigFile(mockOSLayer)
+
 	mockOSLayer.EXPECT().
 		ReadBuildInfo().
 		Return(&debug.BuildInfo{
@@ -282,6 +317,8 @@ func TestConfig_DisableTelemetry_HappyPath(t *testing.T) {
 				Return(args).
 				Once()
 
+			expectNoEnvironmentVariablesAndNoConfigFile(mockOSLayer)
+
 			cfg, err := config.New(mockOSLayer)
 			require.NoError(t, err)
 
@@ -331,6 +368,8 @@ func TestConfig_UseSingleMATLABSession_HappyPath(t *testing.T) {
 				Return(args).
 				Once()
 
+			expectNoEnvironmentVariablesAndNoConfigFile(mockOSLayer)
+
 			cfg, err := config.New(mockOSLayer)
 			require.NoError(t, err)
 
@@ -375,6 +414,8 @@ func TestConfig_PreferredLocalMATLABRoot_HappyPath(t *testing.T) {
 				Return(args).
 				Once()
 
+			expectNoEnvironmentVariablesAndNoConfigFile(mockOSLayer)
+
 			cfg, err := config.New(mockOSLayer)
 			require.NoError(t, err)
 
@@ -419,6 +460,8 @@ func TestConfig_PreferredMATLABStartingDirectory_HappyPath(t *testing.T) {
 				Return(args).
 				Once()
 
+			expectNoEnvironmentVariablesAndNoConfigFile(mockOSLayer)
+
 			cfg, err := config.New(mockOSLayer)
 			require.NoError(t, err)
 
@@ -431,7 +474,7 @@ func TestConfig_PreferredMATLABStartingDirectory_HappyPath(t *testing.T) {
 	}
 }
 
-func TestConfig_LogDirectory_HappyPath(t *testing.T) {
+func TestConfig_SharedMATLABSessionFolder_HappyPath(t *testing.T) {
 	testConfigs := []struct {
 		name     string
 		args     []string
@@ -443,9 +486,17 @@ func TestConfig_LogDirectory_HappyPath(t *testing.T) {
 			expected: "",
 		},
 		{
-			name:     "Supplied log directory",
-			args:     []string{"--log-folder=" + filepath.Join("tmp", "logs")},
-			expected: filepath.Join("tmp", "logs"),
+			name:     "custom folder",
+			args:     []string{"--shared-matlab-session-folder=" + filepath.Join("path", "to", "shared")},
+			expected: filepath.Join("path", "to", "shared"),
+		},
+		{
+			name: "ignored when single session is disabled",
+			args: []string{
+				"--use-single-matlab-session=false",
+				"--shared-matlab-session-folder=" + filepath.Join("path", "to", "shared"),
+			},
+			expected: "",
 		},
 	}
 
@@ -463,11 +514,13 @@ func TestConfig_LogDirectory_HappyPath(t *testing.T) {
 				Return(args).
 				Once()
 
+			expectNoEnvironmentVariablesAndNoConfigFile(mockOSLayer)
+
 			cfg, err := config.New(mockOSLayer)
 			require.NoError(t, err)
 
 			// Act
-			result := cfg.BaseDir()
+			result := cfg.SharedMATLABSessionFolder()
 
 			// Assert
 			assert.Equal(t, testConfig.expected, result)
@@ -475,36 +528,155 @@ func TestConfig_LogDirectory_HappyPath(t *testing.T) {
 	}
 }
 
-func TestConfig_LogLevel_HappyPath(t *testing.T) {
+func TestConfig_RemoteMATLAB_HappyPath(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	expectedHost := "matlab.example.com"
+	expectedPort := "31515"
+	expectedAPIKeyFile := filepath.Join("path", "to", "apikey")
+	expectedCertificateFile := filepath.Join("path", "to", "cert.pem")
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return([]string{
+			"testprocess",
+			"--remote-matlab-host=" + expectedHost,
+			"--remote-matlab-port=" + expectedPort,
+			"--remote-matlab-api-key-file=" + expectedAPIKeyFile,
+			"--remote-matlab-certificate-file=" + expectedCertificateFile,
+		}).
+		Once()
+
+	expectNoEnvironmentVariablesAndNoConfigFile(mockOSLayer)
+
+	// Act
+	cfg, err := config.New(mockOSLayer)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedHost, cfg.RemoteMATLABHost())
+	assert.Equal(t, expectedPort, cfg.RemoteMATLABPort())
+	assert.Equal(t, expectedAPIKeyFile, cfg.RemoteMATLABAPIKeyFile())
+	assert.Equal(t, expectedCertificateFile, cfg.RemoteMATLABCertificateFile())
+}
+
+func TestConfig_RemoteMATLAB_IgnoredWhenSingleSessionIsDisabled(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return([]string{
+			"testprocess",
+			"--use-single-matlab-session=false",
+			"--remote-matlab-host=matlab.example.com",
+		}).
+		Once()
+
+	expectNoEnvironmentVariablesAndNoConfigFile(mockOSLayer)
+
+	// Act
+	cfg, err := config.New(mockOSLayer)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, cfg.RemoteMATLABHost())
+}
+
+func TestConfig_RemoteMATLAB_Invalid(t *testing.T) {
 	testConfigs := []struct {
-		name     string
-		args     []string
-		expected entities.LogLevel
+		name          string
+		args          []string
+		expectedError string
 	}{
 		{
-			name:     "default value",
-			args:     []string{},
-			expected: entities.LogLevelInfo,
+			name: "used with shared MATLAB session folder",
+			args: []string{
+				"--remote-matlab-host=matlab.example.com",
+				"--shared-matlab-session-folder=" + filepath.Join("path", "to", "shared"),
+			},
+			expectedError: "remote-matlab-host and shared-matlab-session-folder cannot be used together",
 		},
 		{
-			name:     "debug level",
-			args:     []string{"--log-level=debug"},
-			expected: entities.LogLevelDebug,
+			name: "missing port",
+			args: []string{
+				"--remote-matlab-host=matlab.example.com",
+			},
+			expectedError: `invalid remote-matlab-port: ""`,
 		},
 		{
-			name:     "info level",
-			args:     []string{"--log-level=info"},
-			expected: entities.LogLevelInfo,
+			name: "invalid port",
+			args: []string{
+				"--remote-matlab-host=matlab.example.com",
+				"--remote-matlab-port=notaport",
+			},
+			expectedError: `invalid remote-matlab-port: "notaport"`,
 		},
 		{
-			name:     "warn level",
-			args:     []string{"--log-level=warn"},
-			expected: entities.LogLevelWarn,
+			name: "missing API key file",
+			args: []string{
+				"--remote-matlab-host=matlab.example.com",
+				"--remote-matlab-port=31515",
+			},
+			expectedError: "remote-matlab-api-key-file is required when remote-matlab-host is set",
 		},
 		{
-			name:     "error level",
-			args:     []string{"--log-level=error"},
-			expected: entities.LogLevelError,
+			name: "missing certificate file",
+			args: []string{
+				"--remote-matlab-host=matlab.example.com",
+				"--remote-matlab-port=31515",
+				"--remote-matlab-api-key-file=" + filepath.Join("path", "to", "apikey"),
+			},
+			expectedError: "remote-matlab-certificate-file is required when remote-matlab-host is set",
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockOSLayer := &configmocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockOSLayer.EXPECT().
+				Args().
+				Return(append([]string{"testprocess"}, testConfig.args...)).
+				Once()
+
+			expectNoEnvironmentVariablesAndNoConfigFile(mockOSLayer)
+
+			// Act
+			cfg, err := config.New(mockOSLayer)
+
+			// Assert
+			require.EqualError(t, err, testConfig.expectedError)
+			assert.Nil(t, cfg)
+		})
+	}
+}
+
+func TestConfig_MATLABSessionPoolSize_HappyPath(t *testing.T) {
+	testConfigs := []struct {
+		name     string
+		args     []string
+		expected int
+	}{
+		{
+			name:     "default value",
+			args:     []string{},
+			expected: 0,
+		},
+		{
+			name:     "custom size in multi session mode",
+			args:     []string{"--use-single-matlab-session=false", "--matlab-session-pool-size=2"},
+			expected: 2,
+		},
+		{
+			name:     "ignored in single session mode",
+			args:     []string{"--matlab-session-pool-size=2"},
+			expected: 0,
 		},
 	}
 
@@ -522,97 +694,160 @@ func TestConfig_LogLevel_HappyPath(t *testing.T) {
 				Return(args).
 				Once()
 
+			expectNoEnvironmentVariablesAndNoConfigFile(mockOSLayer)
+
 			cfg, err := config.New(mockOSLayer)
 			require.NoError(t, err)
 
 			// Act
-			result := cfg.LogLevel()
+			result := cfg.MATLABSessionPoolSize()
 
 			// Assert
-			require.NoError(t, err)
 			assert.Equal(t, testConfig.expected, result)
 		})
 	}
 }
 
-func TestConfig_LogLevel_Invalid(t *testing.T) {
+func TestConfig_MATLABSessionPoolSize_Negative(t *testing.T) {
 	// Arrange
 	mockOSLayer := &configmocks.MockOSLayer{}
 	defer mockOSLayer.AssertExpectations(t)
 
-	programName := "testprocess"
-	args := append([]string{programName}, "--log-level=invalid")
-
 	mockOSLayer.EXPECT().
 		Args().
-		Return(args).
+		Return([]string{"testprocess", "--use-single-matlab-session=false", "--matlab-session-pool-size=-1"}).
 		Once()
 
+	expectNoEnvironmentVariablesAndNoConfigFile(mockOSLayer)
+
 	// Act
 	cfg, err := config.New(mockOSLayer)
 
 	// Assert
-	require.Errorf(t, err, "invalid log level")
-	assert.Empty(t, cfg)
+	require.EqualError(t, err, "invalid matlab-session-pool-size: -1")
+	assert.Nil(t, cfg)
 }
 
-func TestConfig_LogLevel_EmptyIsInvalid(t *testing.T) {
-	// Arrange
-	mockOSLayer := &configmocks.MockOSLayer{}
-	defer mockOSLayer.AssertExpectations(t)
+func TestConfig_PreferredMATLABVersion_HappyPath(t *testing.T) {
+	testConfigs := []struct {
+		name     string
+		args     []string
+		expected string
+	}{
+		{
+			name:     "default value",
+			args:     []string{},
+			expected: "",
+		},
+		{
+			name:     "release",
+			args:     []string{"--matlab-version=R2024b"},
+			expected: "R2024b",
+		},
+		{
+			name:     "comparison",
+			args:     []string{"--matlab-version=>=R2023a"},
+			expected: ">=R2023a",
+		},
+		{
+			name:     "latest",
+			args:     []string{"--matlab-version=latest"},
+			expected: "latest",
+		},
+	}
 
-	programName := "testprocess"
-	args := append([]string{programName}, "--log-level=")
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockOSLayer := &configmocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
 
-	mockOSLayer.EXPECT().
-		Args().
-		Return(args).
-		Once()
+			programName := "testprocess"
+			args := append([]string{programName}, testConfig.args...)
 
-	// Act
-	cfg, err := config.New(mockOSLayer)
+			mockOSLayer.EXPECT().
+				Args().
+				Return(args).
+				Once()
 
-	// Assert
-	require.Errorf(t, err, "invalid log level")
-	assert.Empty(t, cfg)
+			expectNoEnvironmentVariablesAndNoConfigFile(mockOSLayer)
+
+			cfg, err := config.New(mockOSLayer)
+			require.NoError(t, err)
+
+			// Act
+			result := cfg.PreferredMATLABVersion()
+
+			// Assert
+			assert.Equal(t, testConfig.expected, result)
+		})
+	}
 }
 
-func TestConfig_Log_HappyPath(t *testing.T) {
+func TestConfig_PreferredMATLABVersion_Invalid(t *testing.T) {
 	testConfigs := []struct {
-		name                string
-		args                []string
-		expectedLogMessage  string
-		expectedConfigField map[string]any
+		name          string
+		args          []string
+		expectedError string
 	}{
 		{
-			name:               "default configuration",
-			args:               []string{},
-			expectedLogMessage: "Configuration state",
-			expectedConfigField: map[string]any{
-				"disable-telemetry":         false,
-				"initial-working-folder":    "",
-				"log-level":                 entities.LogLevelInfo,
-				"matlab-root":               "",
-				"use-single-matlab-session": true,
-			},
+			name:          "invalid version",
+			args:          []string{"--matlab-version=2024b"},
+			expectedError: `invalid matlab-version: "2024b" is not a valid MATLAB version, use a release such as R2024b, a comparison such as >=R2023a, or latest`,
 		},
 		{
-			name: "custom configuration",
-			args: []string{
-				"--disable-telemetry",
-				"--use-single-matlab-session=false",
-				"--log-level=debug",
-				"--initial-working-folder=" + filepath.Join("home", "user"),
-				"--matlab-root=" + filepath.Join("home", "matlab"),
-			},
-			expectedLogMessage: "Configuration state",
-			expectedConfigField: map[string]any{
-				"disable-telemetry":         true,
-				"initial-working-folder":    filepath.Join("home", "user"),
-				"log-level":                 entities.LogLevelDebug,
-				"matlab-root":               filepath.Join("home", "matlab"),
-				"use-single-matlab-session": false,
-			},
+			name:          "used with matlab-root",
+			args:          []string{"--matlab-version=R2024b", "--matlab-root=" + filepath.Join("tmp", "root")},
+			expectedError: "matlab-version and matlab-root cannot be used together",
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockOSLayer := &configmocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockOSLayer.EXPECT().
+				Args().
+				Return(append([]string{"testprocess"}, testConfig.args...)).
+				Once()
+
+			expectNoEnvironmentVariablesAndNoConfigFile(mockOSLayer)
+
+			// Act
+			cfg, err := config.New(mockOSLayer)
+
+			// Assert
+			require.EqualError(t, err, testConfig.expectedError)
+			assert.Nil(t, cfg)
+		})
+	}
+}
+
+func TestConfig_AdditionalMATLABRoots_HappyPath(t *testing.T) {
+	firstMATLABRoot := filepath.Join("tmp", "MATLAB", "R2024b")
+	secondMATLABRoot := filepath.Join("tmp", "MATLAB", "R2025a")
+
+	testConfigs := []struct {
+		name     string
+		args     []string
+		expected []string
+	}{
+		{
+			name:     "default value",
+			args:     []string{},
+			expected: []string{},
+		},
+		{
+			name:     "single MATLAB root",
+			args:     []string{"--additional-matlab-roots=" + firstMATLABRoot},
+			expected: []string{firstMATLABRoot},
+		},
+		{
+			name:     "multiple MATLAB roots",
+			args:     []string{"--additional-matlab-roots=" + firstMATLABRoot + string(os.PathListSeparator) + secondMATLABRoot},
+			expected: []string{firstMATLABRoot, secondMATLABRoot},
 		},
 	}
 
@@ -630,20 +865,714 @@ func TestConfig_Log_HappyPath(t *testing.T) {
 				Return(args).
 				Once()
 
+			expectNoEnvironmentVariablesAndNoConfigFile(mockOSLayer)
+
 			cfg, err := config.New(mockOSLayer)
 			require.NoError(t, err)
 
-			testLogger := testutils.NewInspectableLogger()
-
 			// Act
-			cfg.RecordToLogger(testLogger)
+			result := cfg.AdditionalMATLABRoots()
 
 			// Assert
-			infoLogs := testLogger.InfoLogs()
-			require.Len(t, infoLogs, 1)
-
-			fields, found := infoLogs[testConfig.expectedLogMessage]
-			require.True(t, found, "Expected log message not found")
+			assert.Equal(t, testConfig.expected, result)
+		})
+	}
+}
+
+func TestConfig_AllowedRoots_HappyPath(t *testing.T) {
+	firstAllowedRoot, err := filepath.Abs(filepath.Join("tmp", "projects"))
+	require.NoError(t, err)
+
+	secondAllowedRoot, err := filepath.Abs(filepath.Join("tmp", "scripts"))
+	require.NoError(t, err)
+
+	testConfigs := []struct {
+		name     string
+		args     []string
+		expected []string
+	}{
+		{
+			name:     "default value",
+			args:     []string{},
+			expected: []string{},
+		},
+		{
+			name:     "single allowed root",
+			args:     []string{"--allowed-roots=" + firstAllowedRoot},
+			expected: []string{firstAllowedRoot},
+		},
+		{
+			name:     "multiple allowed roots",
+			args:     []string{"--allowed-roots=" + firstAllowedRoot + string(os.PathListSeparator) + secondAllowedRoot},
+			expected: []string{firstAllowedRoot, secondAllowedRoot},
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockOSLayer := &configmocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			programName := "testprocess"
+			args := append([]string{programName}, testConfig.args...)
+
+			mockOSLayer.EXPECT().
+				Args().
+				Return(args).
+				Once()
+
+			expectNoEnvironmentVariablesAndNoConfigFile(mockOSLayer)
+
+			cfg, err := config.New(mockOSLayer)
+			require.NoError(t, err)
+
+			// Act
+			result := cfg.AllowedRoots()
+
+			// Assert
+			assert.Equal(t, testConfig.expected, result)
+		})
+	}
+}
+
+func TestConfig_AllowedRoots_RelativePath(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	relativeAllowedRoot := filepath.Join("tmp", "projects")
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return([]string{"testprocess", "--allowed-roots=" + relativeAllowedRoot}).
+		Once()
+
+	expectNoEnvironmentVariablesAndNoConfigFile(mockOSLayer)
+
+	// Act
+	cfg, err := config.New(mockOSLayer)
+
+	// Assert
+	require.EqualError(t, err, fmt.Sprintf("invalid allowed-roots: %q is not an absolute path", relativeAllowedRoot))
+	assert.Nil(t, cfg)
+}
+
+func TestConfig_CodeSafety_HappyPath(t *testing.T) {
+	testConfigs := []struct {
+		name             string
+		args             []string
+		expectedAction   entities.CodeSafetyAction
+		expectedPatterns []string
+	}{
+		{
+			name:             "default values",
+			args:             []string{},
+			expectedAction:   entities.CodeSafetyActionAllow,
+			expectedPatterns: []string{"system", "dos", "unix", "!", "delete", "rmdir", "web", "java.lang.Runtime"},
+		},
+		{
+			name:             "block custom patterns",
+			args:             []string{"--code-safety-action=block", "--code-safety-patterns=system,movefile"},
+			expectedAction:   entities.CodeSafetyActionBlock,
+			expectedPatterns: []string{"system", "movefile"},
+		},
+		{
+			name:             "allow",
+			args:             []string{"--code-safety-action=allow"},
+			expectedAction:   entities.CodeSafetyActionAllow,
+			expectedPatterns: []string{"system", "dos", "unix", "!", "delete", "rmdir", "web", "java.lang.Runtime"},
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockOSLayer := &configmocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			programName := "testprocess"
+			args := append([]string{programName}, testConfig.args...)
+
+			mockOSLayer.EXPECT().
+				Args().
+				Return(args).
+				Once()
+
+			expectNoEnvironmentVariablesAndNoConfigFile(mockOSLayer)
+
+			cfg, err := config.New(mockOSLayer)
+			require.NoError(t, err)
+
+			// Act
+			action := cfg.CodeSafetyAction()
+			patterns := cfg.CodeSafetyPatterns()
+
+			// Assert
+			assert.Equal(t, testConfig.expectedAction, action)
+			assert.Equal(t, testConfig.expectedPatterns, patterns)
+		})
+	}
+}
+
+func TestConfig_CodeSafetyAction_Invalid(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return([]string{"testprocess", "--code-safety-action=ignore"}).
+		Once()
+
+	expectNoEnvironmentVariablesAndNoConfigFile(mockOSLayer)
+
+	// Act
+	cfg, err := config.New(mockOSLayer)
+
+	// Assert
+	require.EqualError(t, err, `invalid code-safety-action: "ignore"`)
+	assert.Nil(t, cfg)
+}
+
+func TestConfig_ToolSelection_HappyPath(t *testing.T) {
+	testConfigs := []struct {
+		name                  string
+		args                  []string
+		expectedEnabledTools  []string
+		expectedDisabledTools []string
+		expectedReadOnly      bool
+	}{
+		{
+			name:                  "default values",
+			args:                  []string{},
+			expectedEnabledTools:  []string{},
+			expectedDisabledTools: []string{},
+			expectedReadOnly:      false,
+		},
+		{
+			name:                  "enabled tools",
+			args:                  []string{"--enabled-tools=check_matlab_code,detect_matlab_toolboxes"},
+			expectedEnabledTools:  []string{"check_matlab_code", "detect_matlab_toolboxes"},
+			expectedDisabledTools: []string{},
+			expectedReadOnly:      false,
+		},
+		{
+			name:                  "disabled tools and read-only",
+			args:                  []string{"--disabled-tools=run_matlab_file", "--disabled-tools=evaluate_matlab_code", "--read-only"},
+			expectedEnabledTools:  []string{},
+			expectedDisabledTools: []string{"run_matlab_file", "evaluate_matlab_code"},
+			expectedReadOnly:      true,
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockOSLayer := &configmocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			programName := "testprocess"
+			args := append([]string{programName}, testConfig.args...)
+
+			mockOSLayer.EXPECT().
+				Args().
+				Return(args).
+				Once()
+
+			expectNoEnvironmentVariablesAndNoConfigFile(mockOSLayer)
+
+			// Act
+			cfg, err := config.New(mockOSLayer)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, testConfig.expectedEnabledTools, cfg.EnabledTools())
+			assert.Equal(t, testConfig.expectedDisabledTools, cfg.DisabledTools())
+			assert.Equal(t, testConfig.expectedReadOnly, cfg.ReadOnly())
+		})
+	}
+}
+
+func TestConfig_ToolsRequiringApproval_HappyPath(t *testing.T) {
+	testConfigs := []struct {
+		name     string
+		args     []string
+		expected []string
+	}{
+		{
+			name:     "default value",
+			args:     []string{},
+			expected: []string{},
+		},
+		{
+			name:     "tools requiring approval",
+			args:     []string{"--require-approval=evaluate_matlab_code,run_matlab_file"},
+			expected: []string{"evaluate_matlab_code", "run_matlab_file"},
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockOSLayer := &configmocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			programName := "testprocess"
+			args := append([]string{programName}, testConfig.args...)
+
+			mockOSLayer.EXPECT().
+				Args().
+				Return(args).
+				Once()
+
+			expectNoEnvironmentVariablesAndNoConfigFile(mockOSLayer)
+
+			// Act
+			cfg, err := config.New(mockOSLayer)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, testConfig.expected, cfg.ToolsRequiringApproval())
+		})
+	}
+}
+
+func TestConfig_AuditLogFile_HappyPath(t *testing.T) {
+	testConfigs := []struct {
+		name     string
+		args     []string
+		expected string
+	}{
+		{
+			name:     "default value",
+			args:     []string{},
+			expected: "",
+		},
+		{
+			name:     "audit log file",
+			args:     []string{"--audit-log-file=/var/log/matlab-mcp/audit.jsonl"},
+			expected: "/var/log/matlab-mcp/audit.jsonl",
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockOSLayer := &configmocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			programName := "testprocess"
+			args := append([]string{programName}, testConfig.args...)
+
+			mockOSLayer.EXPECT().
+				Args().
+				Return(args).
+				Once()
+
+			expectNoEnvironmentVariablesAndNoConfigFile(mockOSLayer)
+
+			// Act
+			cfg, err := config.New(mockOSLayer)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, testConfig.expected, cfg.AuditLogFile())
+		})
+	}
+}
+
+func TestConfig_CodingGuidelines_HappyPath(t *testing.T) {
+	teamGuidelines := filepath.Join(string(filepath.Separator), "home", "user", "team-style.md")
+	guidelinesFolder := filepath.Join(string(filepath.Separator), "home", "user", "guidelines")
+
+	testConfigs := []struct {
+		name            string
+		args            []string
+		expected        []string
+		expectedReplace bool
+	}{
+		{
+			name:            "default value",
+			args:            []string{},
+			expected:        []string{},
+			expectedReplace: false,
+		},
+		{
+			name:            "file and folder",
+			args:            []string{"--coding-guidelines=" + teamGuidelines + string(os.PathListSeparator) + guidelinesFolder},
+			expected:        []string{teamGuidelines, guidelinesFolder},
+			expectedReplace: false,
+		},
+		{
+			name:            "replace built-in guidelines",
+			args:            []string{"--coding-guidelines=" + teamGuidelines, "--replace-coding-guidelines"},
+			expected:        []string{teamGuidelines},
+			expectedReplace: true,
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockOSLayer := &configmocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			programName := "testprocess"
+			args := append([]string{programName}, testConfig.args...)
+
+			mockOSLayer.EXPECT().
+				Args().
+				Return(args).
+				Once()
+
+			expectNoEnvironmentVariablesAndNoConfigFile(mockOSLayer)
+
+			// Act
+			cfg, err := config.New(mockOSLayer)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, testConfig.expected, cfg.CodingGuidelines())
+			assert.Equal(t, testConfig.expectedReplace, cfg.ReplaceCodingGuidelines())
+		})
+	}
+}
+
+func TestConfig_CodingGuidelines_Invalid(t *testing.T) {
+	relativeGuidelines := filepath.Join("docs", "style.md")
+
+	testConfigs := []struct {
+		name          string
+		args          []string
+		expectedError string
+	}{
+		{
+			name:          "relative path",
+			args:          []string{"--coding-guidelines=" + relativeGuidelines},
+			expectedError: fmt.Sprintf("invalid coding-guidelines: %q is not an absolute path", relativeGuidelines),
+		},
+		{
+			name:          "replace without guidelines",
+			args:          []string{"--replace-coding-guidelines"},
+			expectedError: "coding-guidelines is required when replace-coding-guidelines is set",
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockOSLayer := &configmocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockOSLayer.EXPECT().
+				Args().
+				Return(append([]string{"testprocess"}, testConfig.args...)).
+				Once()
+
+			expectNoEnvironmentVariablesAndNoConfigFile(mockOSLayer)
+
+			// Act
+			cfg, err := config.New(mockOSLayer)
+
+			// Assert
+			require.EqualError(t, err, testConfig.expectedError)
+			assert.Nil(t, cfg)
+		})
+	}
+}
+
+func TestConfig_PromptFiles_HappyPath(t *testing.T) {
+	teamPrompt := filepath.Join(string(filepath.Separator), "home", "user", "refactor.md")
+	promptsFolder := filepath.Join(string(filepath.Separator), "home", "user", "prompts")
+
+	testConfigs := []struct {
+		name     string
+		args     []string
+		expected []string
+	}{
+		{
+			name:     "default value",
+			args:     []string{},
+			expected: []string{},
+		},
+		{
+			name:     "file and folder",
+			args:     []string{"--prompt-files=" + teamPrompt + string(os.PathListSeparator) + promptsFolder},
+			expected: []string{teamPrompt, promptsFolder},
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockOSLayer := &configmocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockOSLayer.EXPECT().
+				Args().
+				Return(append([]string{"testprocess"}, testConfig.args...)).
+				Once()
+
+			expectNoEnvironmentVariablesAndNoConfigFile(mockOSLayer)
+
+			// Act
+			cfg, err := config.New(mockOSLayer)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, testConfig.expected, cfg.PromptFiles())
+		})
+	}
+}
+
+func TestConfig_PromptFiles_RelativePath(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	relativePrompt := filepath.Join("prompts", "refactor.md")
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return([]string{"testprocess", "--prompt-files=" + relativePrompt}).
+		Once()
+
+	expectNoEnvironmentVariablesAndNoConfigFile(mockOSLayer)
+
+	// Act
+	cfg, err := config.New(mockOSLayer)
+
+	// Assert
+	require.EqualError(t, err, fmt.Sprintf("invalid prompt-files: %q is not an absolute path", relativePrompt))
+	assert.Nil(t, cfg)
+}
+
+func TestConfig_LogDirectory_HappyPath(t *testing.T) {
+	testConfigs := []struct {
+		name     string
+		args     []string
+		expected string
+	}{
+		{
+			name:     "default value",
+			args:     []string{},
+			expected: "",
+		},
+		{
+			name:     "Supplied log directory",
+			args:     []string{"--log-folder=" + filepath.Join("tmp", "logs")},
+			expected: filepath.Join("tmp", "logs"),
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockOSLayer := &configmocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			programName := "testprocess"
+			args := append([]string{programName}, testConfig.args...)
+
+			mockOSLayer.EXPECT().
+				Args().
+				Return(args).
+				Once()
+
+			expectNoEnvironmentVariablesAndNoConfigFile(mockOSLayer)
+
+			cfg, err := config.New(mockOSLayer)
+			require.NoError(t, err)
+
+			// Act
+			result := cfg.BaseDir()
+
+			// Assert
+			assert.Equal(t, testConfig.expected, result)
+		})
+	}
+}
+
+func TestConfig_LogLevel_HappyPath(t *testing.T) {
+	testConfigs := []struct {
+		name     string
+		args     []string
+		expected entities.LogLevel
+	}{
+		{
+			name:     "default value",
+			args:     []string{},
+			expected: entities.LogLevelInfo,
+		},
+		{
+			name:     "debug level",
+			args:     []string{"--log-level=debug"},
+			expected: entities.LogLevelDebug,
+		},
+		{
+			name:     "info level",
+			args:     []string{"--log-level=info"},
+			expected: entities.LogLevelInfo,
+		},
+		{
+			name:     "warn level",
+			args:     []string{"--log-level=warn"},
+			expected: entities.LogLevelWarn,
+		},
+		{
+			name:     "error level",
+			args:     []string{"--log-level=error"},
+			expected: entities.LogLevelError,
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockOSLayer := &configmocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			programName := "testprocess"
+			args := append([]string{programName}, testConfig.args...)
+
+			mockOSLayer.EXPECT().
+				Args().
+				Return(args).
+				Once()
+
+			expectNoEnvironmentVariablesAndNoConfigFile(mockOSLayer)
+
+			cfg, err := config.New(mockOSLayer)
+			require.NoError(t, err)
+
+			// Act
+			result := cfg.LogLevel()
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, testConfig.expected, result)
+		})
+	}
+}
+
+func TestConfig_LogLevel_Invalid(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	programName := "testprocess"
+	args := append([]string{programName}, "--log-level=invalid")
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return(args).
+		Once()
+
+	expectNoEnvironmentVariablesAndNoConfigFile(mockOSLayer)
+
+	// Act
+	cfg, err := config.New(mockOSLayer)
+
+	// Assert
+	require.Errorf(t, err, "invalid log level")
+	assert.Empty(t, cfg)
+}
+
+func TestConfig_LogLevel_EmptyIsInvalid(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	programName := "testprocess"
+	args := append([]string{programName}, "--log-level=")
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return(args).
+		Once()
+
+	expectNoEnvironmentVariablesAndNoConfigFile(mockOSLayer)
+
+	// Act
+	cfg, err := config.New(mockOSLayer)
+
+	// Assert
+	require.Errorf(t, err, "invalid log level")
+	assert.Empty(t, cfg)
+}
+
+func TestConfig_Log_HappyPath(t *testing.T) {
+	testConfigs := []struct {
+		name                string
+		args                []string
+		expectedLogMessage  string
+		expectedConfigField map[string]any
+	}{
+		{
+			name:               "default configuration",
+			args:               []string{},
+			expectedLogMessage: "Configuration state",
+			expectedConfigField: map[string]any{
+				"disable-telemetry":            false,
+				"initial-working-folder":       "",
+				"log-level":                    entities.LogLevelInfo,
+				"matlab-root":                  "",
+				"shared-matlab-session-folder": "",
+				"use-single-matlab-session":    true,
+			},
+		},
+		{
+			name: "custom configuration",
+			args: []string{
+				"--disable-telemetry",
+				"--use-single-matlab-session=false",
+				"--log-level=debug",
+				"--initial-working-folder=" + filepath.Join("home", "user"),
+				"--matlab-root=" + filepath.Join("home", "matlab"),
+			},
+			expectedLogMessage: "Configuration state",
+			expectedConfigField: map[string]any{
+				"disable-telemetry":            true,
+				"initial-working-folder":       filepath.Join("home", "user"),
+				"log-level":                    entities.LogLevelDebug,
+				"matlab-root":                  filepath.Join("home", "matlab"),
+				"shared-matlab-session-folder": "",
+				"use-single-matlab-session":    false,
+			},
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockOSLayer := &configmocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			programName := "testprocess"
+			args := append([]string{programName}, testConfig.args...)
+
+			mockOSLayer.EXPECT().
+				Args().
+				Return(args).
+				Once()
+
+			expectNoEnvironmentVariablesAndNoConfigFile(mockOSLayer)
+
+			cfg, err := config.New(mockOSLayer)
+			require.NoError(t, err)
+
+			testLogger := testutils.NewInspectableLogger()
+
+			// Act
+			cfg.RecordToLogger(testLogger)
+
+			// Assert
+			infoLogs := testLogger.InfoLogs()
+			require.Len(t, infoLogs, 1)
+
+			fields, found := infoLogs[testConfig.expectedLogMessage]
+			require.True(t, found, "Expected log message not found")
 
 			for expectedField, expectedValue := range testConfig.expectedConfigField {
 				actualValue, exists := fields[expectedField]
@@ -653,3 +1582,361 @@ func TestConfig_Log_HappyPath(t *testing.T) {
 		})
 	}
 }
+
+func TestConfig_EnvironmentVariables_HappyPath(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return([]string{"testprocess"}).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Environ().
+		Return([]string{
+			"MATLAB_MCP_LOG_LEVEL=debug",
+			"MATLAB_MCP_DISABLE_TELEMETRY=true",
+			"MATLAB_MCP_MATLAB_ROOT=" + filepath.Join("env", "root"),
+			"MATLAB_MCP_CORE_SERVER_BUILD_DIR=ignored",
+			"MATLAB_MCP_WATCHDOG=true",
+		}).
+		Once()
+
+	mockOSLayer.EXPECT().
+		UserConfigDir().
+		Return(userConfigDir, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(defaultConfigFile).
+		Return(nil, os.ErrNotExist).
+		Once()
+
+	// Act
+	cfg, err := config.New(mockOSLayer)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, entities.LogLevelDebug, cfg.LogLevel())
+	assert.True(t, cfg.DisableTelemetry())
+	assert.Equal(t, filepath.Join("env", "root"), cfg.PreferredLocalMATLABRoot())
+	assert.False(t, cfg.WatchdogMode(), "Hidden arguments should not be set from environment variables")
+	assert.Empty(t, cfg.ConfigFile())
+}
+
+func TestConfig_ConfigFile_HappyPath(t *testing.T) {
+	testConfigs := []struct {
+		name    string
+		file    string
+		content string
+	}{
+		{
+			name: "YAML",
+			file: "config.yaml",
+			content: `
+log-level: debug
+disable-telemetry: true
+matlab-session-pool-size: 2
+use-single-matlab-session: false
+additional-matlab-roots:
+  - first
+  - second
+enabled-tools:
+  - check_matlab_code
+`,
+		},
+		{
+			name: "JSON",
+			file: "config.json",
+			content: `{
+				"log-level": "debug",
+				"disable-telemetry": true,
+				"matlab-session-pool-size": 2,
+				"use-single-matlab-session": false,
+				"additional-matlab-roots": ["first", "second"],
+				"enabled-tools": ["check_matlab_code"]
+			}`,
+		},
+		{
+			name: "TOML",
+			file: "config.toml",
+			content: `
+log-level = "debug"
+disable-telemetry = true
+matlab-session-pool-size = 2
+use-single-matlab-session = false
+additional-matlab-roots = ["first", "second"]
+enabled-tools = ["check_matlab_code"]
+`,
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockOSLayer := &configmocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			configFile := filepath.Join("path", "to", testConfig.file)
+
+			mockOSLayer.EXPECT().
+				Args().
+				Return([]string{"testprocess", "--config=" + configFile}).
+				Once()
+
+			mockOSLayer.EXPECT().
+				Environ().
+				Return([]string{}).
+				Once()
+
+			mockOSLayer.EXPECT().
+				ReadFile(configFile).
+				Return([]byte(testConfig.content), nil).
+				Once()
+
+			// Act
+			cfg, err := config.New(mockOSLayer)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, entities.LogLevelDebug, cfg.LogLevel())
+			assert.True(t, cfg.DisableTelemetry())
+			assert.False(t, cfg.UseSingleMATLABSession())
+			assert.Equal(t, 2, cfg.MATLABSessionPoolSize())
+			assert.Equal(t, []string{"first", "second"}, cfg.AdditionalMATLABRoots())
+			assert.Equal(t, []string{"check_matlab_code"}, cfg.EnabledTools())
+			assert.Equal(t, configFile, cfg.ConfigFile())
+		})
+	}
+}
+
+func TestConfig_ConfigFile_DefaultLocation(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return([]string{"testprocess"}).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Environ().
+		Return([]string{}).
+		Once()
+
+	mockOSLayer.EXPECT().
+		UserConfigDir().
+		Return(userConfigDir, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(defaultConfigFile).
+		Return([]byte("log-level: warn\n"), nil).
+		Once()
+
+	// Act
+	cfg, err := config.New(mockOSLayer)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, entities.LogLevelWarn, cfg.LogLevel())
+	assert.Equal(t, defaultConfigFile, cfg.ConfigFile())
+}
+
+func TestConfig_ConfigFile_NoUserConfigDir(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return([]string{"testprocess"}).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Environ().
+		Return([]string{}).
+		Once()
+
+	mockOSLayer.EXPECT().
+		UserConfigDir().
+		Return("", assert.AnError).
+		Once()
+
+	// Act
+	cfg, err := config.New(mockOSLayer)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, entities.LogLevelInfo, cfg.LogLevel())
+	assert.Empty(t, cfg.ConfigFile())
+}
+
+func TestConfig_Precedence(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	configFile := filepath.Join("path", "to", "config.yaml")
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return([]string{"testprocess", "--log-level=error"}).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Environ().
+		Return([]string{
+			"MATLAB_MCP_CONFIG=" + configFile,
+			"MATLAB_MCP_LOG_LEVEL=warn",
+			"MATLAB_MCP_MATLAB_ROOT=" + filepath.Join("env", "root"),
+		}).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(configFile).
+		Return([]byte(`
+log-level: debug
+matlab-root: file-root
+initial-working-folder: file-folder
+`), nil).
+		Once()
+
+	// Act
+	cfg, err := config.New(mockOSLayer)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, entities.LogLevelError, cfg.LogLevel(), "Flags should take precedence over everything else")
+	assert.Equal(t, filepath.Join("env", "root"), cfg.PreferredLocalMATLABRoot(), "Environment variables should take precedence over the config file")
+	assert.Equal(t, "file-folder", cfg.PreferredMATLABStartingDirectory(), "The config file should take precedence over the defaults")
+	assert.False(t, cfg.DisableTelemetry(), "Defaults should be used when nothing is set")
+	assert.Equal(t, configFile, cfg.ConfigFile())
+}
+
+func TestConfig_ConfigFile_Invalid(t *testing.T) {
+	testConfigs := []struct {
+		name          string
+		file          string
+		content       string
+		readError     error
+		expectedError string
+	}{
+		{
+			name:          "unknown key",
+			file:          "config.yaml",
+			content:       "log-levle: debug\n",
+			expectedError: `unknown key "log-levle" in config file`,
+		},
+		{
+			name:          "hidden key",
+			file:          "config.yaml",
+			content:       "watchdog: true\n",
+			expectedError: `unknown key "watchdog" in config file`,
+		},
+		{
+			name:          "invalid value",
+			file:          "config.json",
+			content:       `{"matlab-session-pool-size": "many"}`,
+			expectedError: `invalid value for key "matlab-session-pool-size" in config file`,
+		},
+		{
+			name:          "nested value",
+			file:          "config.toml",
+			content:       "[log-level]\nvalue = \"debug\"\n",
+			expectedError: `invalid value for key "log-level" in config file`,
+		},
+		{
+			name:          "validation error",
+			file:          "config.yaml",
+			content:       "log-level: verbose\n",
+			expectedError: `invalid log-level: "verbose"`,
+		},
+		{
+			name:          "parse error",
+			file:          "config.json",
+			content:       `{"log-level": }`,
+			expectedError: "failed to parse config file",
+		},
+		{
+			name:          "unsupported format",
+			file:          "config.ini",
+			content:       "log-level=debug",
+			expectedError: `unsupported config file format ".ini"`,
+		},
+		{
+			name:          "missing file",
+			file:          "config.yaml",
+			readError:     os.ErrNotExist,
+			expectedError: "failed to read config file",
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockOSLayer := &configmocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			configFile := filepath.Join("path", "to", testConfig.file)
+
+			mockOSLayer.EXPECT().
+				Args().
+				Return([]string{"testprocess", "--config=" + configFile}).
+				Once()
+
+			mockOSLayer.EXPECT().
+				Environ().
+				Return([]string{}).
+				Once()
+
+			mockOSLayer.EXPECT().
+				ReadFile(configFile).
+				Return([]byte(testConfig.content), testConfig.readError).
+				Once()
+
+			// Act
+			cfg, err := config.New(mockOSLayer)
+
+			// Assert
+			require.ErrorContains(t, err, testConfig.expectedError)
+			assert.Nil(t, cfg)
+		})
+	}
+}
+
+func TestConfig_EnvironmentVariables_Invalid(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return([]string{"testprocess"}).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Environ().
+		Return([]string{"MATLAB_MCP_DISABLE_TELEMETRY=maybe"}).
+		Once()
+
+	mockOSLayer.EXPECT().
+		UserConfigDir().
+		Return(userConfigDir, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(defaultConfigFile).
+		Return(nil, os.ErrNotExist).
+		Once()
+
+	// Act
+	cfg, err := config.New(mockOSLayer)
+
+	// Assert
+	require.ErrorContains(t, err, `invalid value "maybe" for environment variable MATLAB_MCP_DISABLE_TELEMETRY`)
+	assert.Nil(t, cfg)
+}
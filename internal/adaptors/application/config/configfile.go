@@ -0,0 +1,202 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/inputs/flags"
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	environmentVariablePrefix = "MATLAB_MCP_"
+
+	defaultConfigFileFolder = "matlab-mcp-core-server"
+	defaultConfigFileName   = "config.yaml"
+)
+
+// applyEnvironmentAndConfigFile sets the flags which were not set on the command line
+// from the MATLAB_MCP_* environment variables, then from the config file.
+// The precedence order is: flags > environment variables > config file > defaults.
+// It returns the path to the config file which was read, if any.
+func applyEnvironmentAndConfigFile(osLayer OSLayer, flagSet *pflag.FlagSet) (string, error) {
+	environmentValues := environmentVariablesByFlagName(osLayer.Environ())
+
+	configFile, isExplicitConfigFile, err := configFilePath(osLayer, flagSet, environmentValues)
+	if err != nil {
+		return "", err
+	}
+
+	configFileValues, err := readConfigFile(osLayer, configFile, isExplicitConfigFile)
+	if err != nil {
+		return "", err
+	}
+
+	if configFileValues == nil {
+		configFile = ""
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(configFileValues)) {
+		if flag := flagSet.Lookup(key); flag == nil || !isConfigurable(flag) {
+			return "", fmt.Errorf("unknown key %q in config file %s", key, configFile)
+		}
+	}
+
+	var setErr error
+	flagSet.VisitAll(func(flag *pflag.Flag) {
+		if setErr != nil || flag.Changed || !isConfigurable(flag) {
+			return
+		}
+
+		if value, found := environmentValues[flag.Name]; found {
+			if err := flagSet.Set(flag.Name, value); err != nil {
+				setErr = fmt.Errorf("invalid value %q for environment variable %s: %w", value, environmentVariableName(flag.Name), err)
+			}
+			return
+		}
+
+		if value, found := configFileValues[flag.Name]; found {
+			if err := setFlagFromConfigFileValue(flagSet, flag, value); err != nil {
+				setErr = fmt.Errorf("invalid value for key %q in config file %s: %w", flag.Name, configFile, err)
+			}
+		}
+	})
+	if setErr != nil {
+		return "", setErr
+	}
+
+	return configFile, nil
+}
+
+func configFilePath(osLayer OSLayer, flagSet *pflag.FlagSet, environmentValues map[string]string) (string, bool, error) {
+	if flagSet.Changed(flags.ConfigFile) {
+		configFile, err := flagSet.GetString(flags.ConfigFile)
+		return configFile, true, err
+	}
+
+	if configFile, found := environmentValues[flags.ConfigFile]; found {
+		return configFile, true, nil
+	}
+
+	userConfigDir, err := osLayer.UserConfigDir()
+	if err != nil {
+		// No default location for the config file, e.g. when $HOME is not set.
+		return "", false, nil
+	}
+
+	return filepath.Join(userConfigDir, defaultConfigFileFolder, defaultConfigFileName), false, nil
+}
+
+func readConfigFile(osLayer OSLayer, configFile string, isExplicitConfigFile bool) (map[string]any, error) {
+	if configFile == "" {
+		return nil, nil
+	}
+
+	content, err := osLayer.ReadFile(configFile)
+	if err != nil {
+		if !isExplicitConfigFile && errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	values := map[string]any{}
+
+	switch strings.ToLower(filepath.Ext(configFile)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(content, &values)
+	case ".json":
+		err = json.Unmarshal(content, &values)
+	case ".toml":
+		err = toml.Unmarshal(content, &values)
+	default:
+		return nil, fmt.Errorf("unsupported config file format %q, expected .yaml, .yml, .json or .toml: %s", filepath.Ext(configFile), configFile)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", configFile, err)
+	}
+
+	if values == nil {
+		// An empty YAML file decodes to a nil map
+		values = map[string]any{}
+	}
+
+	return values, nil
+}
+
+func setFlagFromConfigFileValue(flagSet *pflag.FlagSet, flag *pflag.Flag, value any) error {
+	list, isList := value.([]any)
+	if !isList {
+		scalar, err := configFileScalarToString(value)
+		if err != nil {
+			return err
+		}
+		return flagSet.Set(flag.Name, scalar)
+	}
+
+	items := make([]string, 0, len(list))
+	for _, item := range list {
+		scalar, err := configFileScalarToString(item)
+		if err != nil {
+			return err
+		}
+		items = append(items, scalar)
+	}
+
+	if sliceValue, ok := flag.Value.(pflag.SliceValue); ok {
+		if err := sliceValue.Replace(items); err != nil {
+			return err
+		}
+		flag.Changed = true
+		return nil
+	}
+
+	// List arguments which are not slices, such as additional-matlab-roots, use the OS path list separator
+	return flagSet.Set(flag.Name, strings.Join(items, string(filepath.ListSeparator)))
+}
+
+func configFileScalarToString(value any) (string, error) {
+	switch typedValue := value.(type) {
+	case string:
+		return typedValue, nil
+	case bool, int, int64, uint64, float64:
+		return fmt.Sprint(typedValue), nil
+	default:
+		return "", fmt.Errorf("unsupported value of type %T", value)
+	}
+}
+
+func environmentVariablesByFlagName(environment []string) map[string]string {
+	values := map[string]string{}
+
+	for _, variable := range environment {
+		name, value, found := strings.Cut(variable, "=")
+		if !found || !strings.HasPrefix(name, environmentVariablePrefix) {
+			continue
+		}
+
+		flagName := strings.ToLower(strings.ReplaceAll(strings.TrimPrefix(name, environmentVariablePrefix), "_", "-"))
+		values[flagName] = value
+	}
+
+	return values
+}
+
+func environmentVariableName(flagName string) string {
+	return environmentVariablePrefix + strings.ToUpper(strings.ReplaceAll(flagName, "-", "_"))
+}
+
+// isConfigurable reports whether a flag can be set from an environment variable or the config file.
+func isConfigurable(flag *pflag.Flag) bool {
+	return !flag.Hidden && flag.Name != flags.VersionMode && flag.Name != flags.ConfigFile
+}
@@ -4,9 +4,13 @@ package config
 
 import (
 	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/inputs/flags"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/matlabversionrule"
 	"github.com/spf13/pflag"
 )
 
@@ -28,7 +32,11 @@ func setupFlags(flagSet *pflag.FlagSet) error {
 	)
 
 	flagSet.String(flags.PreferredLocalMATLABRoot, flags.PreferredLocalMATLABRootDefaultValue,
-		fmt.Sprintf("When %s is true, if this is set, defines which local MATLAB installation to use. If not set, the first MATLAB installation on the PATH will be used.", flags.UseSingleMATLABSession),
+		fmt.Sprintf("When %s is true, if this is set, defines which local MATLAB installation to use. If not set, the most recent MATLAB installation found will be used.", flags.UseSingleMATLABSession),
+	)
+
+	flagSet.String(flags.PreferredMATLABVersion, flags.PreferredMATLABVersionDefaultValue,
+		fmt.Sprintf("When %s is true, if this is set, defines which MATLAB release to use, such as R2024b, >=R2023a, R2024b Update 3 or latest. If several MATLAB installations match, the most recent one will be used. Cannot be used with %s.", flags.UseSingleMATLABSession, flags.PreferredLocalMATLABRoot),
 	)
 
 	flagSet.String(flags.PreferredMATLABStartingDirectory, flags.PreferredMATLABStartingDirectoryDefaultValue,
@@ -43,6 +51,82 @@ func setupFlags(flagSet *pflag.FlagSet) error {
 		flags.InitializeMATLABOnStartupDescription,
 	)
 
+	flagSet.String(flags.SharedMATLABSessionFolder, flags.SharedMATLABSessionFolderDefaultValue,
+		fmt.Sprintf("When %s is true, if this is set, defines the folder used to discover a MATLAB session that is already running. Run matlab_mcp.share() in that MATLAB session to let the server attach to it.", flags.UseSingleMATLABSession),
+	)
+
+	flagSet.String(flags.RemoteMATLABHost, flags.RemoteMATLABHostDefaultValue,
+		fmt.Sprintf("When %s is true, if this is set, defines the host of a MATLAB session running in a container or on another machine, to connect to instead of starting a new MATLAB session. Requires %s, %s and %s.", flags.UseSingleMATLABSession, flags.RemoteMATLABPort, flags.RemoteMATLABAPIKeyFile, flags.RemoteMATLABCertificateFile),
+	)
+
+	flagSet.String(flags.RemoteMATLABPort, flags.RemoteMATLABPortDefaultValue,
+		flags.RemoteMATLABPortDescription,
+	)
+
+	flagSet.String(flags.RemoteMATLABAPIKeyFile, flags.RemoteMATLABAPIKeyFileDefaultValue,
+		flags.RemoteMATLABAPIKeyFileDescription,
+	)
+
+	flagSet.String(flags.RemoteMATLABCertificateFile, flags.RemoteMATLABCertificateFileDefaultValue,
+		flags.RemoteMATLABCertificateFileDescription,
+	)
+
+	flagSet.Int(flags.MATLABSessionPoolSize, flags.MATLABSessionPoolSizeDefaultValue,
+		fmt.Sprintf("When %s is false, defines the number of idle MATLAB sessions to keep started for each MATLAB installation, so that new sessions start instantly. Each idle session uses the same resources as a running MATLAB.", flags.UseSingleMATLABSession),
+	)
+
+	flagSet.String(flags.AdditionalMATLABRoots, flags.AdditionalMATLABRootsDefaultValue,
+		flags.AdditionalMATLABRootsDescription,
+	)
+
+	flagSet.String(flags.AllowedRoots, flags.AllowedRootsDefaultValue,
+		flags.AllowedRootsDescription,
+	)
+
+	flagSet.String(flags.CodeSafetyAction, flags.CodeSafetyActionDefaultValue,
+		flags.CodeSafetyActionDescription,
+	)
+
+	flagSet.StringSlice(flags.CodeSafetyPatterns, strings.Split(flags.CodeSafetyPatternsDefaultValue, ","),
+		flags.CodeSafetyPatternsDescription,
+	)
+
+	flagSet.StringSlice(flags.EnabledTools, nil,
+		flags.EnabledToolsDescription,
+	)
+
+	flagSet.StringSlice(flags.DisabledTools, nil,
+		flags.DisabledToolsDescription,
+	)
+
+	flagSet.Bool(flags.ReadOnly, flags.ReadOnlyDefaultValue,
+		flags.ReadOnlyDescription,
+	)
+
+	flagSet.StringSlice(flags.RequireApproval, nil,
+		flags.RequireApprovalDescription,
+	)
+
+	flagSet.String(flags.AuditLogFile, flags.AuditLogFileDefaultValue,
+		flags.AuditLogFileDescription,
+	)
+
+	flagSet.String(flags.CodingGuidelines, flags.CodingGuidelinesDefaultValue,
+		flags.CodingGuidelinesDescription,
+	)
+
+	flagSet.Bool(flags.ReplaceCodingGuidelines, flags.ReplaceCodingGuidelinesDefaultValue,
+		flags.ReplaceCodingGuidelinesDescription,
+	)
+
+	flagSet.String(flags.PromptFiles, flags.PromptFilesDefaultValue,
+		flags.PromptFilesDescription,
+	)
+
+	flagSet.String(flags.ConfigFile, flags.ConfigFileDefaultValue,
+		flags.ConfigFileDescription,
+	)
+
 	// Hidden flags, for internal use only
 	flagSet.Bool(flags.WatchdogMode, flags.WatchdogModeDefaultValue,
 		flags.WatchdogModeDescription,
@@ -69,6 +153,11 @@ func createConfigWithFlagValues(osLayer OSLayer, flagSet *pflag.FlagSet, args []
 		return nil, err
 	}
 
+	configFile, err := applyEnvironmentAndConfigFile(osLayer, flagSet)
+	if err != nil {
+		return nil, err
+	}
+
 	versionMode, err := flagSet.GetBool(flags.VersionMode)
 	if err != nil {
 		return nil, err
@@ -93,7 +182,7 @@ func createConfigWithFlagValues(osLayer OSLayer, flagSet *pflag.FlagSet, args []
 	case string(entities.LogLevelDebug), string(entities.LogLevelInfo), string(entities.LogLevelWarn), string(entities.LogLevelError):
 		break
 	default:
-		return nil, fmt.Errorf("invalid log level: %s", logLevel)
+		return nil, fmt.Errorf("invalid %s: %q", flags.LogLevel, logLevel)
 	}
 
 	preferredLocalMATLABRoot, err := flagSet.GetString(flags.PreferredLocalMATLABRoot)
@@ -101,6 +190,21 @@ func createConfigWithFlagValues(osLayer OSLayer, flagSet *pflag.FlagSet, args []
 		return nil, err
 	}
 
+	preferredMATLABVersion, err := flagSet.GetString(flags.PreferredMATLABVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	if preferredMATLABVersion != "" {
+		if preferredLocalMATLABRoot != "" {
+			return nil, fmt.Errorf("%s and %s cannot be used together", flags.PreferredMATLABVersion, flags.PreferredLocalMATLABRoot)
+		}
+
+		if _, err := matlabversionrule.Parse(preferredMATLABVersion); err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", flags.PreferredMATLABVersion, err)
+		}
+	}
+
 	preferredMATLABStartingDirectory, err := flagSet.GetString(flags.PreferredMATLABStartingDirectory)
 	if err != nil {
 		return nil, err
@@ -126,8 +230,153 @@ func createConfigWithFlagValues(osLayer OSLayer, flagSet *pflag.FlagSet, args []
 		return nil, err
 	}
 
-	if !useSingleMATLABSession {
+	sharedMATLABSessionFolder, err := flagSet.GetString(flags.SharedMATLABSessionFolder)
+	if err != nil {
+		return nil, err
+	}
+
+	remoteMATLABHost, err := flagSet.GetString(flags.RemoteMATLABHost)
+	if err != nil {
+		return nil, err
+	}
+
+	remoteMATLABPort, err := flagSet.GetString(flags.RemoteMATLABPort)
+	if err != nil {
+		return nil, err
+	}
+
+	remoteMATLABAPIKeyFile, err := flagSet.GetString(flags.RemoteMATLABAPIKeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	remoteMATLABCertificateFile, err := flagSet.GetString(flags.RemoteMATLABCertificateFile)
+	if err != nil {
+		return nil, err
+	}
+
+	matlabSessionPoolSize, err := flagSet.GetInt(flags.MATLABSessionPoolSize)
+	if err != nil {
+		return nil, err
+	}
+
+	if matlabSessionPoolSize < 0 {
+		return nil, fmt.Errorf("invalid %s: %d", flags.MATLABSessionPoolSize, matlabSessionPoolSize)
+	}
+
+	additionalMATLABRoots, err := flagSet.GetString(flags.AdditionalMATLABRoots)
+	if err != nil {
+		return nil, err
+	}
+
+	allowedRoots, err := flagSet.GetString(flags.AllowedRoots)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, allowedRoot := range filepath.SplitList(allowedRoots) {
+		if !filepath.IsAbs(allowedRoot) {
+			return nil, fmt.Errorf("invalid %s: %q is not an absolute path", flags.AllowedRoots, allowedRoot)
+		}
+	}
+
+	codeSafetyAction, err := flagSet.GetString(flags.CodeSafetyAction)
+	if err != nil {
+		return nil, err
+	}
+
+	switch codeSafetyAction {
+	case string(entities.CodeSafetyActionAllow), string(entities.CodeSafetyActionConfirm), string(entities.CodeSafetyActionBlock):
+		break
+	default:
+		return nil, fmt.Errorf("invalid %s: %q", flags.CodeSafetyAction, codeSafetyAction)
+	}
+
+	codeSafetyPatterns, err := flagSet.GetStringSlice(flags.CodeSafetyPatterns)
+	if err != nil {
+		return nil, err
+	}
+
+	enabledTools, err := flagSet.GetStringSlice(flags.EnabledTools)
+	if err != nil {
+		return nil, err
+	}
+
+	disabledTools, err := flagSet.GetStringSlice(flags.DisabledTools)
+	if err != nil {
+		return nil, err
+	}
+
+	readOnly, err := flagSet.GetBool(flags.ReadOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	toolsRequiringApproval, err := flagSet.GetStringSlice(flags.RequireApproval)
+	if err != nil {
+		return nil, err
+	}
+
+	auditLogFile, err := flagSet.GetString(flags.AuditLogFile)
+	if err != nil {
+		return nil, err
+	}
+
+	codingGuidelines, err := flagSet.GetString(flags.CodingGuidelines)
+	if err != nil {
+		return nil, err
+	}
+
+	replaceCodingGuidelines, err := flagSet.GetBool(flags.ReplaceCodingGuidelines)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, codingGuidelinesPath := range filepath.SplitList(codingGuidelines) {
+		if !filepath.IsAbs(codingGuidelinesPath) {
+			return nil, fmt.Errorf("invalid %s: %q is not an absolute path", flags.CodingGuidelines, codingGuidelinesPath)
+		}
+	}
+
+	if replaceCodingGuidelines && codingGuidelines == "" {
+		return nil, fmt.Errorf("%s is required when %s is set", flags.CodingGuidelines, flags.ReplaceCodingGuidelines)
+	}
+
+	promptFiles, err := flagSet.GetString(flags.PromptFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, promptFilesPath := range filepath.SplitList(promptFiles) {
+		if !filepath.IsAbs(promptFilesPath) {
+			return nil, fmt.Errorf("invalid %s: %q is not an absolute path", flags.PromptFiles, promptFilesPath)
+		}
+	}
+
+	if useSingleMATLABSession {
+		matlabSessionPoolSize = 0
+	} else {
 		initializeMATLABOnStartup = false
+		sharedMATLABSessionFolder = ""
+		remoteMATLABHost = ""
+	}
+
+	if remoteMATLABHost != "" {
+		if sharedMATLABSessionFolder != "" {
+			return nil, fmt.Errorf("%s and %s cannot be used together", flags.RemoteMATLABHost, flags.SharedMATLABSessionFolder)
+		}
+
+		if _, err := strconv.ParseUint(remoteMATLABPort, 10, 16); err != nil {
+			return nil, fmt.Errorf("invalid %s: %q", flags.RemoteMATLABPort, remoteMATLABPort)
+		}
+
+		if remoteMATLABAPIKeyFile == "" {
+			return nil, fmt.Errorf("%s is required when %s is set", flags.RemoteMATLABAPIKeyFile, flags.RemoteMATLABHost)
+		}
+
+		if remoteMATLABCertificateFile == "" {
+			return nil, fmt.Errorf("%s is required when %s is set", flags.RemoteMATLABCertificateFile, flags.RemoteMATLABHost)
+		}
 	}
 
 	return &Config{
@@ -138,10 +387,30 @@ func createConfigWithFlagValues(osLayer OSLayer, flagSet *pflag.FlagSet, args []
 		useSingleMATLABSession:           useSingleMATLABSession,
 		logLevel:                         entities.LogLevel(logLevel),
 		preferredLocalMATLABRoot:         preferredLocalMATLABRoot,
+		preferredMATLABVersion:           preferredMATLABVersion,
 		preferredMATLABStartingDirectory: preferredMATLABStartingDirectory,
 		baseDirectory:                    baseDir,
 		watchdogMode:                     watchdogMode,
 		serverInstanceID:                 serverInstanceID,
 		initializeMATLABOnStartup:        initializeMATLABOnStartup,
+		sharedMATLABSessionFolder:        sharedMATLABSessionFolder,
+		remoteMATLABHost:                 remoteMATLABHost,
+		remoteMATLABPort:                 remoteMATLABPort,
+		remoteMATLABAPIKeyFile:           remoteMATLABAPIKeyFile,
+		remoteMATLABCertificateFile:      remoteMATLABCertificateFile,
+		matlabSessionPoolSize:            matlabSessionPoolSize,
+		additionalMATLABRoots:            filepath.SplitList(additionalMATLABRoots),
+		allowedRoots:                     filepath.SplitList(allowedRoots),
+		codeSafetyAction:                 entities.CodeSafetyAction(codeSafetyAction),
+		codeSafetyPatterns:               codeSafetyPatterns,
+		enabledTools:                     enabledTools,
+		disabledTools:                    disabledTools,
+		readOnly:                         readOnly,
+		toolsRequiringApproval:           toolsRequiringApproval,
+		auditLogFile:                     auditLogFile,
+		codingGuidelines:                 filepath.SplitList(codingGuidelines),
+		replaceCodingGuidelines:          replaceCodingGuidelines,
+		promptFiles:                      filepath.SplitList(promptFiles),
+		configFile:                       configFile,
 	}, nil
 }
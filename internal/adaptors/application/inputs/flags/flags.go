@@ -19,6 +19,10 @@ const (
 	PreferredLocalMATLABRootDefaultValue = ""
 	PreferredLocalMATLABRootDescription  = "The path to the MATLAB installation to use. If not specified, the server will use the first MATLAB installation it finds."
 
+	PreferredMATLABVersion             = "matlab-version"
+	PreferredMATLABVersionDefaultValue = ""
+	PreferredMATLABVersionDescription  = "The MATLAB release to use, such as R2024b, >=R2023a, R2024b Update 3 or latest. If several MATLAB installations match, the server uses the most recent one."
+
 	PreferredMATLABStartingDirectory             = "initial-working-folder"
 	PreferredMATLABStartingDirectoryDefaultValue = ""
 	PreferredMATLABStartingDirectoryDescription  = "The directory to use as the initial working directory for MATLAB sessions. If not specified, the server will use the current working directory."
@@ -35,6 +39,79 @@ const (
 	InitializeMATLABOnStartupDefaultValue = false
 	InitializeMATLABOnStartupDescription  = "To initialize MATLAB as soon as you start the server, set this argument to true. By default, MATLAB only starts when the first tool is called."
 
+	SharedMATLABSessionFolder             = "shared-matlab-session-folder"
+	SharedMATLABSessionFolderDefaultValue = ""
+	SharedMATLABSessionFolderDescription  = "The folder used to discover a MATLAB session that is already running. If set, the server attaches to the MATLAB session shared from this folder with matlab_mcp.share(), instead of starting a new MATLAB session."
+
+	RemoteMATLABHost             = "remote-matlab-host"
+	RemoteMATLABHostDefaultValue = ""
+	RemoteMATLABHostDescription  = "The host of a MATLAB session running in a container or on another machine. If set, the server connects to that MATLAB session instead of starting a new MATLAB session."
+
+	RemoteMATLABPort             = "remote-matlab-port"
+	RemoteMATLABPortDefaultValue = ""
+	RemoteMATLABPortDescription  = "The secure port of the MATLAB embedded connector of the remote MATLAB session."
+
+	RemoteMATLABAPIKeyFile             = "remote-matlab-api-key-file"
+	RemoteMATLABAPIKeyFileDefaultValue = ""
+	RemoteMATLABAPIKeyFileDescription  = "The path to a file containing the API key of the remote MATLAB session."
+
+	RemoteMATLABCertificateFile             = "remote-matlab-certificate-file"
+	RemoteMATLABCertificateFileDefaultValue = ""
+	RemoteMATLABCertificateFileDescription  = "The path to the PEM certificate used by the embedded connector of the remote MATLAB session."
+
+	MATLABSessionPoolSize             = "matlab-session-pool-size"
+	MATLABSessionPoolSizeDefaultValue = 0
+	MATLABSessionPoolSizeDescription  = "The number of idle MATLAB sessions to keep started for each MATLAB installation, so that new sessions start instantly."
+
+	AdditionalMATLABRoots             = "additional-matlab-roots"
+	AdditionalMATLABRootsDefaultValue = ""
+	AdditionalMATLABRootsDescription  = "A list of MATLAB installation folders to search, in addition to the PATH, the MATLAB_ROOTS environment variable and the standard install locations. Separate folders with the OS path list separator."
+
+	AllowedRoots             = "allowed-roots"
+	AllowedRootsDefaultValue = ""
+	AllowedRootsDescription  = "A list of folders which contain the MATLAB files and project folders the tools can access. The roots provided by the MCP client are also allowed. If neither is specified, the tools can access any folder. Separate folders with the OS path list separator."
+
+	CodeSafetyAction             = "code-safety-action"
+	CodeSafetyActionDefaultValue = "allow"
+	CodeSafetyActionDescription  = "The action to take when MATLAB code to evaluate uses one of the code-safety-patterns: allow, confirm or block. With confirm, the server asks the user to confirm through the MCP client, and blocks the code if the client does not support it."
+
+	CodeSafetyPatterns             = "code-safety-patterns"
+	CodeSafetyPatternsDefaultValue = "system,dos,unix,!,delete,rmdir,web,java.lang.Runtime"
+	CodeSafetyPatternsDescription  = "A comma-separated list of the function and class names, or ! for shell escapes, which MATLAB code to evaluate must not use without applying the code-safety-action."
+
+	EnabledTools            = "enabled-tools"
+	EnabledToolsDescription = "A comma-separated list of the names of the tools to expose. If not specified, the server exposes all the tools of the current mode."
+
+	DisabledTools            = "disabled-tools"
+	DisabledToolsDescription = "A comma-separated list of the names of the tools not to expose."
+
+	ReadOnly             = "read-only"
+	ReadOnlyDefaultValue = false
+	ReadOnlyDescription  = "To only expose the tools which inspect and analyze MATLAB code, without executing it, set this argument to true."
+
+	RequireApproval            = "require-approval"
+	RequireApprovalDescription = "A comma-separated list of the names of the tools whose calls the user must approve, through MCP elicitation, before they run."
+
+	AuditLogFile             = "audit-log-file"
+	AuditLogFileDefaultValue = ""
+	AuditLogFileDescription  = "The path to the JSON lines file to which the server appends a record of every tool call. If not specified, the server uses audit.jsonl in the log-folder, or in the matlab-mcp-core-server folder of the user configuration folder when no log-folder is specified."
+
+	CodingGuidelines             = "coding-guidelines"
+	CodingGuidelinesDefaultValue = ""
+	CodingGuidelinesDescription  = "A list of Markdown files, or of folders of Markdown files, with coding guidelines to expose as resources in addition to the built-in MATLAB coding guidelines. Separate paths with the OS path list separator."
+
+	ReplaceCodingGuidelines             = "replace-coding-guidelines"
+	ReplaceCodingGuidelinesDefaultValue = false
+	ReplaceCodingGuidelinesDescription  = "To only expose the coding guidelines from coding-guidelines, instead of adding them to the built-in MATLAB coding guidelines, set this argument to true."
+
+	PromptFiles             = "prompt-files"
+	PromptFilesDefaultValue = ""
+	PromptFilesDescription  = "A list of Markdown files, or of folders of Markdown files, to expose as prompts in addition to the built-in prompts. Write {{argument}} in a file to declare a prompt argument. Separate paths with the OS path list separator."
+
+	ConfigFile             = "config"
+	ConfigFileDefaultValue = ""
+	ConfigFileDescription  = "The path to a YAML, JSON or TOML file defining the values of the other arguments, using the argument names as keys. If not specified, the server reads matlab-mcp-core-server/config.yaml in the user configuration folder, if it exists."
+
 	// Hidden
 
 	WatchdogMode             = "watchdog"
@@ -45,6 +45,10 @@ type Directory interface {
 	RecordToLogger(logger entities.Logger)
 }
 
+type MATLABManager interface {
+	StartSessionPool(logger entities.Logger)
+}
+
 // Orchestrator
 type Orchestrator struct {
 	lifecycleSignaler LifecycleSignaler
@@ -55,6 +59,7 @@ type Orchestrator struct {
 	osSignaler        OSSignaler
 	globalMATLAB      GlobalMATLAB
 	directory         Directory
+	matlabManager     MATLABManager
 }
 
 func New(
@@ -66,6 +71,7 @@ func New(
 	osSignaler OSSignaler,
 	globalMATLAB GlobalMATLAB,
 	directory Directory,
+	matlabManager MATLABManager,
 ) *Orchestrator {
 	orchestrator := &Orchestrator{
 		lifecycleSignaler: lifecycleSignaler,
@@ -76,6 +82,7 @@ func New(
 		osSignaler:        osSignaler,
 		globalMATLAB:      globalMATLAB,
 		directory:         directory,
+		matlabManager:     matlabManager,
 	}
 	return orchestrator
 }
@@ -115,11 +122,15 @@ func (o *Orchestrator) StartAndWaitForCompletion(ctx context.Context) error {
 		serverErrC <- o.server.Run()
 	}()
 
-	if o.config.UseSingleMATLABSession() && o.config.InitializeMATLABOnStartup() {
-		_, err := o.globalMATLAB.Client(ctx, o.loggerFactory.GetGlobalLogger())
-		if err != nil {
-			logger.WithError(err).Warn("MATLAB global initialization failed")
+	if o.config.UseSingleMATLABSession() {
+		if o.config.InitializeMATLABOnStartup() {
+			_, err := o.globalMATLAB.Client(ctx, o.loggerFactory.GetGlobalLogger())
+			if err != nil {
+				logger.WithError(err).Warn("MATLAB global initialization failed")
+			}
 		}
+	} else {
+		o.matlabManager.StartSessionPool(logger)
 	}
 
 	logger.Info("MATLAB MCP Core Server application startup complete")
@@ -40,6 +40,9 @@ func TestNew_HappyPath(t *testing.T) {
 	mockDirectory := &orchestratormocks.MockDirectory{}
 	defer mockDirectory.AssertExpectations(t)
 
+	mockMATLABManager := &orchestratormocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
 	//Act
 	orchestratorInstance := orchestrator.New(
 		mockLifecycleSignaler,
@@ -50,6 +53,7 @@ func TestNew_HappyPath(t *testing.T) {
 		mockSignalLayer,
 		mockGlobalMATLABManager,
 		mockDirectory,
+		mockMATLABManager,
 	)
 
 	// Assert
@@ -82,6 +86,9 @@ func TestOrchestrator_StartAndWaitForCompletion_HappyPath(t *testing.T) {
 	mockDirectory := &orchestratormocks.MockDirectory{}
 	defer mockDirectory.AssertExpectations(t)
 
+	mockMATLABManager := &orchestratormocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 	ctx := t.Context()
 	interruptC := getInterruptChannel()
@@ -163,6 +170,7 @@ func TestOrchestrator_StartAndWaitForCompletion_HappyPath(t *testing.T) {
 		mockSignalLayer,
 		mockGlobalMATLABManager,
 		mockDirectory,
+		mockMATLABManager,
 	)
 
 	// Act
@@ -207,6 +215,9 @@ func TestOrchestrator_StartAndWaitForCompletion_InitializeMATLABOnStartup_False(
 	mockDirectory := &orchestratormocks.MockDirectory{}
 	defer mockDirectory.AssertExpectations(t)
 
+	mockMATLABManager := &orchestratormocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
 	ctx := t.Context()
 	interruptC := getInterruptChannel()
 
@@ -282,6 +293,7 @@ func TestOrchestrator_StartAndWaitForCompletion_InitializeMATLABOnStartup_False(
 		mockSignalLayer,
 		mockGlobalMATLABManager,
 		mockDirectory,
+		mockMATLABManager,
 	)
 
 	// Act
@@ -323,6 +335,9 @@ func TestOrchestrator_StartAndWaitForCompletion_ServerError(t *testing.T) {
 	mockDirectory := &orchestratormocks.MockDirectory{}
 	defer mockDirectory.AssertExpectations(t)
 
+	mockMATLABManager := &orchestratormocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 	ctx := t.Context()
 	interruptC := getInterruptChannel()
@@ -397,6 +412,7 @@ func TestOrchestrator_StartAndWaitForCompletion_ServerError(t *testing.T) {
 		mockSignalLayer,
 		mockGlobalMATLABManager,
 		mockDirectory,
+		mockMATLABManager,
 	)
 
 	// Act
@@ -432,6 +448,9 @@ func TestOrchestrator_StartAndWaitForCompletion_InitializeMATLABErrorDoesNotTrig
 	mockDirectory := &orchestratormocks.MockDirectory{}
 	defer mockDirectory.AssertExpectations(t)
 
+	mockMATLABManager := &orchestratormocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 	ctx := t.Context()
 	expectedError := assert.AnError
@@ -513,6 +532,7 @@ func TestOrchestrator_StartAndWaitForCompletion_InitializeMATLABErrorDoesNotTrig
 		mockSignalLayer,
 		mockGlobalMATLABManager,
 		mockDirectory,
+		mockMATLABManager,
 	)
 
 	// Act
@@ -559,6 +579,9 @@ func TestOrchestrator_StartAndWaitForCompletion_WaitForShutdownToCompleteError(t
 	mockDirectory := &orchestratormocks.MockDirectory{}
 	defer mockDirectory.AssertExpectations(t)
 
+	mockMATLABManager := &orchestratormocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 	ctx := t.Context()
 	interruptC := getInterruptChannel()
@@ -633,6 +656,7 @@ func TestOrchestrator_StartAndWaitForCompletion_WaitForShutdownToCompleteError(t
 		mockSignalLayer,
 		mockGlobalMATLABManager,
 		mockDirectory,
+		mockMATLABManager,
 	)
 
 	// Act
@@ -686,6 +710,9 @@ func TestOrchestrator_StartAndWaitForCompletion_WatchdogStopError(t *testing.T)
 	mockDirectory := &orchestratormocks.MockDirectory{}
 	defer mockDirectory.AssertExpectations(t)
 
+	mockMATLABManager := &orchestratormocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
 	ctx := t.Context()
 	interruptC := getInterruptChannel()
 	expectedError := assert.AnError
@@ -763,7 +790,7 @@ func TestOrchestrator_StartAndWaitForCompletion_WatchdogStopError(t *testing.T)
 
 	orchestratorInstance := orchestrator.New(
 		mockLifecycleSignaler, mockConfig, mockServer, mockWatchdogClient,
-		mockLoggerFactory, mockSignalLayer, mockGlobalMATLABManager, mockDirectory,
+		mockLoggerFactory, mockSignalLayer, mockGlobalMATLABManager, mockDirectory, mockMATLABManager,
 	)
 
 	// Act
@@ -812,6 +839,9 @@ func TestOrchestrator_runMATLABMCPServerMain_MultipleSession_HappyPath(t *testin
 	mockDirectory := &orchestratormocks.MockDirectory{}
 	defer mockDirectory.AssertExpectations(t)
 
+	mockMATLABManager := &orchestratormocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 	ctx := t.Context()
 	interruptC := getInterruptChannel()
@@ -846,6 +876,11 @@ func TestOrchestrator_runMATLABMCPServerMain_MultipleSession_HappyPath(t *testin
 		Return(false).
 		Once()
 
+	mockMATLABManager.EXPECT().
+		StartSessionPool(mockLogger.AsMockArg()).
+		Return().
+		Once()
+
 	mockSignalLayer.EXPECT().
 		InterruptSignalChan().
 		Return(interruptC).
@@ -875,6 +910,7 @@ func TestOrchestrator_runMATLABMCPServerMain_MultipleSession_HappyPath(t *testin
 		mockSignalLayer,
 		mockGlobalMATLABManager,
 		mockDirectory,
+		mockMATLABManager,
 	)
 
 	// Act
@@ -12,6 +12,7 @@ import (
 type MATLABManager interface {
 	StartMATLABSession(ctx context.Context, sessionLogger entities.Logger, startRequest entities.SessionDetails) (entities.SessionID, error)
 	StopMATLABSession(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID) error
+	RestartMATLABSession(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID) error
 	GetMATLABSessionClient(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID) (entities.MATLABSessionClient, error)
 }
 
@@ -23,15 +24,25 @@ type MATLABStartingDirSelector interface {
 	SelectMatlabStartingDir() (string, error)
 }
 
+type Config interface {
+	SharedMATLABSessionFolder() string
+	RemoteMATLABHost() string
+	RemoteMATLABPort() string
+	RemoteMATLABAPIKeyFile() string
+	RemoteMATLABCertificateFile() string
+}
+
 type GlobalMATLAB struct {
 	matlabManager             MATLABManager
 	matlabRootSelector        MATLABRootSelector
 	matlabStartingDirSelector MATLABStartingDirSelector
+	config                    Config
 
 	lock              *sync.Mutex
 	initializeOnce    *sync.Once
 	matlabRoot        string
 	matlabStartingDir string
+	sessionDetails    entities.SessionDetails
 	sessionID         entities.SessionID
 	cachedStartupErr  error
 }
@@ -40,11 +51,13 @@ func New(
 	matlabManager MATLABManager,
 	matlabRootSelector MATLABRootSelector,
 	matlabStartingDirSelector MATLABStartingDirSelector,
+	config Config,
 ) *GlobalMATLAB {
 	return &GlobalMATLAB{
 		matlabManager:             matlabManager,
 		matlabRootSelector:        matlabRootSelector,
 		matlabStartingDirSelector: matlabStartingDirSelector,
+		config:                    config,
 
 		lock:           &sync.Mutex{},
 		initializeOnce: &sync.Once{},
@@ -55,6 +68,75 @@ func (g *GlobalMATLAB) Client(ctx context.Context, logger entities.Logger) (enti
 	g.lock.Lock()
 	defer g.lock.Unlock()
 
+	if err := g.initialize(ctx, logger); err != nil {
+		return nil, err
+	}
+
+	return g.getOrCreateClient(ctx, logger)
+}
+
+// RunningClient returns the client of the global MATLAB session only if it is already running, without starting one.
+func (g *GlobalMATLAB) RunningClient(ctx context.Context, logger entities.Logger) (entities.MATLABSessionClient, bool) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	var sessionIDZeroValue entities.SessionID
+
+	if g.sessionID == sessionIDZeroValue {
+		return nil, false
+	}
+
+	client, err := g.matlabManager.GetMATLABSessionClient(ctx, logger, g.sessionID)
+	if err != nil {
+		return nil, false
+	}
+
+	return client, true
+}
+
+// RunningSessionID returns the ID of the global MATLAB session only if one was started, without starting one.
+func (g *GlobalMATLAB) RunningSessionID() (entities.SessionID, bool) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	var sessionIDZeroValue entities.SessionID
+
+	return g.sessionID, g.sessionID != sessionIDZeroValue
+}
+
+// Restart stops the global MATLAB session, if any, and starts a new one with the same MATLAB root and starting directory.
+// Shared and remote MATLAB sessions cannot be restarted, as the server does not own them.
+func (g *GlobalMATLAB) Restart(ctx context.Context, logger entities.Logger) error {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	if err := g.initialize(ctx, logger); err != nil {
+		return err
+	}
+
+	if g.sessionDetails != nil {
+		return entities.ErrSessionNotOwned
+	}
+
+	var sessionIDZeroValue entities.SessionID
+
+	if g.sessionID == sessionIDZeroValue {
+		if err := g.startNewSession(ctx, logger); err != nil {
+			g.cachedStartupErr = err
+			return err
+		}
+		return nil
+	}
+
+	if err := g.matlabManager.RestartMATLABSession(ctx, logger, g.sessionID); err != nil {
+		g.sessionID = sessionIDZeroValue
+		return err
+	}
+
+	return nil
+}
+
+func (g *GlobalMATLAB) initialize(ctx context.Context, logger entities.Logger) error {
 	g.initializeOnce.Do(func() {
 		err := g.initializeStartupConfig(ctx, logger)
 		if err != nil {
@@ -62,11 +144,7 @@ func (g *GlobalMATLAB) Client(ctx context.Context, logger entities.Logger) (enti
 		}
 	})
 
-	if g.cachedStartupErr != nil {
-		return nil, g.cachedStartupErr
-	}
-
-	return g.getOrCreateClient(ctx, logger)
+	return g.cachedStartupErr
 }
 
 func (g *GlobalMATLAB) getOrCreateClient(ctx context.Context, logger entities.Logger) (entities.MATLABSessionClient, error) {
@@ -100,12 +178,17 @@ func (g *GlobalMATLAB) getOrCreateClient(ctx context.Context, logger entities.Lo
 }
 
 func (g *GlobalMATLAB) startNewSession(ctx context.Context, logger entities.Logger) error {
-	sessionID, err := g.matlabManager.StartMATLABSession(ctx, logger, entities.LocalSessionDetails{
-		MATLABRoot:             g.matlabRoot,
-		IsStartingDirectorySet: g.matlabStartingDir != "",
-		StartingDirectory:      g.matlabStartingDir,
-		ShowMATLABDesktop:      true,
-	})
+	sessionDetails := g.sessionDetails
+	if sessionDetails == nil {
+		sessionDetails = entities.LocalSessionDetails{
+			MATLABRoot:             g.matlabRoot,
+			IsStartingDirectorySet: g.matlabStartingDir != "",
+			StartingDirectory:      g.matlabStartingDir,
+			ShowMATLABDesktop:      true,
+		}
+	}
+
+	sessionID, err := g.matlabManager.StartMATLABSession(ctx, logger, sessionDetails)
 	if err != nil {
 		return err
 	}
@@ -115,6 +198,24 @@ func (g *GlobalMATLAB) startNewSession(ctx context.Context, logger entities.Logg
 }
 
 func (g *GlobalMATLAB) initializeStartupConfig(ctx context.Context, logger entities.Logger) error {
+	// Shared and remote MATLAB sessions are already running, so there is no MATLAB root or starting directory to select
+	if sharedMATLABSessionFolder := g.config.SharedMATLABSessionFolder(); sharedMATLABSessionFolder != "" {
+		g.sessionDetails = entities.SharedSessionDetails{
+			DiscoveryFolder: sharedMATLABSessionFolder,
+		}
+		return nil
+	}
+
+	if remoteMATLABHost := g.config.RemoteMATLABHost(); remoteMATLABHost != "" {
+		g.sessionDetails = entities.RemoteSessionDetails{
+			Host:            remoteMATLABHost,
+			Port:            g.config.RemoteMATLABPort(),
+			APIKeyFile:      g.config.RemoteMATLABAPIKeyFile(),
+			CertificateFile: g.config.RemoteMATLABCertificateFile(),
+		}
+		return nil
+	}
+
 	matlabRoot, err := g.matlabRootSelector.SelectMATLABRoot(ctx, logger)
 	if err != nil {
 		return err
@@ -31,6 +31,9 @@ func TestGlobalMATLAB_Client_HappyPath(t *testing.T) {
 	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
 	defer mockMATLABStartingDirSelector.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	expectedSessionClient := &entitiesmocks.MockMATLABSessionClient{}
 
 	ctx := t.Context()
@@ -45,6 +48,16 @@ func TestGlobalMATLAB_Client_HappyPath(t *testing.T) {
 		ShowMATLABDesktop:      true,
 	}
 
+	mockConfig.EXPECT().
+		SharedMATLABSessionFolder().
+		Return("").
+		Once()
+
+	mockConfig.EXPECT().
+		RemoteMATLABHost().
+		Return("").
+		Once()
+
 	mockMATLABRootSelector.EXPECT().
 		SelectMATLABRoot(ctx, mockLogger.AsMockArg()).
 		Return(expectedMATLABRoot, nil).
@@ -69,6 +82,7 @@ func TestGlobalMATLAB_Client_HappyPath(t *testing.T) {
 		mockMATLABManager,
 		mockMATLABRootSelector,
 		mockMATLABStartingDirSelector,
+		mockConfig,
 	)
 
 	require.NotNil(t, globalMATLABSession)
@@ -81,6 +95,140 @@ func TestGlobalMATLAB_Client_HappyPath(t *testing.T) {
 	assert.Equal(t, expectedSessionClient, client)
 }
 
+func TestGlobalMATLAB_Client_SharedMATLABSession(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManager := &mocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
+	defer mockMATLABRootSelector.AssertExpectations(t)
+
+	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
+	defer mockMATLABStartingDirSelector.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	expectedSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+
+	ctx := t.Context()
+	expectedSessionID := entities.SessionID(123)
+	expectedDiscoveryFolder := filepath.Join("some", "shared", "folder")
+
+	expectedSharedSessionDetails := entities.SharedSessionDetails{
+		DiscoveryFolder: expectedDiscoveryFolder,
+	}
+
+	mockConfig.EXPECT().
+		SharedMATLABSessionFolder().
+		Return(expectedDiscoveryFolder).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		StartMATLABSession(mock.Anything, mockLogger.AsMockArg(), expectedSharedSessionDetails).
+		Return(expectedSessionID, nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), expectedSessionID).
+		Return(expectedSessionClient, nil).
+		Once()
+
+	globalMATLABSession := globalmatlab.New(
+		mockMATLABManager,
+		mockMATLABRootSelector,
+		mockMATLABStartingDirSelector,
+		mockConfig,
+	)
+
+	// Act
+	client, err := globalMATLABSession.Client(ctx, mockLogger)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedSessionClient, client)
+}
+
+func TestGlobalMATLAB_Client_RemoteMATLABSession(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManager := &mocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
+	defer mockMATLABRootSelector.AssertExpectations(t)
+
+	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
+	defer mockMATLABStartingDirSelector.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	expectedSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+
+	ctx := t.Context()
+	expectedSessionID := entities.SessionID(123)
+
+	expectedRemoteSessionDetails := entities.RemoteSessionDetails{
+		Host:            "matlab.example.com",
+		Port:            "31515",
+		APIKeyFile:      filepath.Join("path", "to", "apikey"),
+		CertificateFile: filepath.Join("path", "to", "cert.pem"),
+	}
+
+	mockConfig.EXPECT().
+		SharedMATLABSessionFolder().
+		Return("").
+		Once()
+
+	mockConfig.EXPECT().
+		RemoteMATLABHost().
+		Return(expectedRemoteSessionDetails.Host).
+		Once()
+
+	mockConfig.EXPECT().
+		RemoteMATLABPort().
+		Return(expectedRemoteSessionDetails.Port).
+		Once()
+
+	mockConfig.EXPECT().
+		RemoteMATLABAPIKeyFile().
+		Return(expectedRemoteSessionDetails.APIKeyFile).
+		Once()
+
+	mockConfig.EXPECT().
+		RemoteMATLABCertificateFile().
+		Return(expectedRemoteSessionDetails.CertificateFile).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		StartMATLABSession(mock.Anything, mockLogger.AsMockArg(), expectedRemoteSessionDetails).
+		Return(expectedSessionID, nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), expectedSessionID).
+		Return(expectedSessionClient, nil).
+		Once()
+
+	globalMATLABSession := globalmatlab.New(
+		mockMATLABManager,
+		mockMATLABRootSelector,
+		mockMATLABStartingDirSelector,
+		mockConfig,
+	)
+
+	// Act
+	client, err := globalMATLABSession.Client(ctx, mockLogger)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedSessionClient, client)
+}
+
 func TestGlobalMATLAB_Client_StartingDirectorySet(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
@@ -94,6 +242,9 @@ func TestGlobalMATLAB_Client_StartingDirectorySet(t *testing.T) {
 	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
 	defer mockMATLABStartingDirSelector.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	expectedSessionClient := &entitiesmocks.MockMATLABSessionClient{}
 
 	ctx := t.Context()
@@ -108,6 +259,16 @@ func TestGlobalMATLAB_Client_StartingDirectorySet(t *testing.T) {
 		ShowMATLABDesktop:      true,
 	}
 
+	mockConfig.EXPECT().
+		SharedMATLABSessionFolder().
+		Return("").
+		Once()
+
+	mockConfig.EXPECT().
+		RemoteMATLABHost().
+		Return("").
+		Once()
+
 	mockMATLABRootSelector.EXPECT().
 		SelectMATLABRoot(ctx, mockLogger.AsMockArg()).
 		Return(expectedMATLABRoot, nil).
@@ -132,6 +293,7 @@ func TestGlobalMATLAB_Client_StartingDirectorySet(t *testing.T) {
 		mockMATLABManager,
 		mockMATLABRootSelector,
 		mockMATLABStartingDirSelector,
+		mockConfig,
 	)
 
 	require.NotNil(t, globalMATLABSession)
@@ -157,9 +319,22 @@ func TestGlobalMATLAB_Client_SelectMATLABRootError(t *testing.T) {
 	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
 	defer mockMATLABStartingDirSelector.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	ctx := t.Context()
 	expectedError := assert.AnError
 
+	mockConfig.EXPECT().
+		SharedMATLABSessionFolder().
+		Return("").
+		Once()
+
+	mockConfig.EXPECT().
+		RemoteMATLABHost().
+		Return("").
+		Once()
+
 	mockMATLABRootSelector.EXPECT().
 		SelectMATLABRoot(ctx, mockLogger.AsMockArg()).
 		Return("", expectedError).
@@ -169,6 +344,7 @@ func TestGlobalMATLAB_Client_SelectMATLABRootError(t *testing.T) {
 		mockMATLABManager,
 		mockMATLABRootSelector,
 		mockMATLABStartingDirSelector,
+		mockConfig,
 	)
 
 	// Act
@@ -192,6 +368,9 @@ func TestGlobalMATLAB_Client_MATLABStartingDirSelectionError(t *testing.T) {
 	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
 	defer mockMATLABStartingDirSelector.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	expectedSessionClient := &entitiesmocks.MockMATLABSessionClient{}
 
 	ctx := t.Context()
@@ -204,6 +383,16 @@ func TestGlobalMATLAB_Client_MATLABStartingDirSelectionError(t *testing.T) {
 		ShowMATLABDesktop:      true,
 	}
 
+	mockConfig.EXPECT().
+		SharedMATLABSessionFolder().
+		Return("").
+		Once()
+
+	mockConfig.EXPECT().
+		RemoteMATLABHost().
+		Return("").
+		Once()
+
 	mockMATLABRootSelector.EXPECT().
 		SelectMATLABRoot(ctx, mockLogger.AsMockArg()).
 		Return(expectedMATLABRoot, nil).
@@ -228,6 +417,7 @@ func TestGlobalMATLAB_Client_MATLABStartingDirSelectionError(t *testing.T) {
 		mockMATLABManager,
 		mockMATLABRootSelector,
 		mockMATLABStartingDirSelector,
+		mockConfig,
 	)
 
 	require.NotNil(t, globalMATLABSession)
@@ -253,6 +443,9 @@ func TestGlobalMATLAB_Client_StartMATLABSessionError(t *testing.T) {
 	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
 	defer mockMATLABStartingDirSelector.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	ctx := t.Context()
 	expectedMATLABRoot := filepath.Join("some", "matlab", "root")
 	expectedMATLABStartingDir := filepath.Join("some", "starting", "dir")
@@ -265,6 +458,16 @@ func TestGlobalMATLAB_Client_StartMATLABSessionError(t *testing.T) {
 		ShowMATLABDesktop:      true,
 	}
 
+	mockConfig.EXPECT().
+		SharedMATLABSessionFolder().
+		Return("").
+		Once()
+
+	mockConfig.EXPECT().
+		RemoteMATLABHost().
+		Return("").
+		Once()
+
 	mockMATLABRootSelector.EXPECT().
 		SelectMATLABRoot(ctx, mockLogger.AsMockArg()).
 		Return(expectedMATLABRoot, nil).
@@ -284,6 +487,7 @@ func TestGlobalMATLAB_Client_StartMATLABSessionError(t *testing.T) {
 		mockMATLABManager,
 		mockMATLABRootSelector,
 		mockMATLABStartingDirSelector,
+		mockConfig,
 	)
 
 	// Act
@@ -307,6 +511,9 @@ func TestGlobalMATLAB_Client_GetMATLABSessionClientError(t *testing.T) {
 	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
 	defer mockMATLABStartingDirSelector.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	ctx := t.Context()
 	expectedSessionID := entities.SessionID(123)
 	expectedNewSessionID := entities.SessionID(456)
@@ -321,6 +528,16 @@ func TestGlobalMATLAB_Client_GetMATLABSessionClientError(t *testing.T) {
 		ShowMATLABDesktop:      true,
 	}
 
+	mockConfig.EXPECT().
+		SharedMATLABSessionFolder().
+		Return("").
+		Once()
+
+	mockConfig.EXPECT().
+		RemoteMATLABHost().
+		Return("").
+		Once()
+
 	mockMATLABRootSelector.EXPECT().
 		SelectMATLABRoot(ctx, mockLogger.AsMockArg()).
 		Return(expectedMATLABRoot, nil).
@@ -360,6 +577,7 @@ func TestGlobalMATLAB_Client_GetMATLABSessionClientError(t *testing.T) {
 		mockMATLABManager,
 		mockMATLABRootSelector,
 		mockMATLABStartingDirSelector,
+		mockConfig,
 	)
 
 	// Act
@@ -383,6 +601,9 @@ func TestGlobalMATLAB_Client_GetMATLABSessionClientError_RetrySucceeds(t *testin
 	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
 	defer mockMATLABStartingDirSelector.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	expectedSessionClient := &entitiesmocks.MockMATLABSessionClient{}
 
 	ctx := t.Context()
@@ -398,6 +619,16 @@ func TestGlobalMATLAB_Client_GetMATLABSessionClientError_RetrySucceeds(t *testin
 		ShowMATLABDesktop:      true,
 	}
 
+	mockConfig.EXPECT().
+		SharedMATLABSessionFolder().
+		Return("").
+		Once()
+
+	mockConfig.EXPECT().
+		RemoteMATLABHost().
+		Return("").
+		Once()
+
 	mockMATLABRootSelector.EXPECT().
 		SelectMATLABRoot(ctx, mockLogger.AsMockArg()).
 		Return(expectedMATLABRoot, nil).
@@ -437,6 +668,7 @@ func TestGlobalMATLAB_Client_GetMATLABSessionClientError_RetrySucceeds(t *testin
 		mockMATLABManager,
 		mockMATLABRootSelector,
 		mockMATLABStartingDirSelector,
+		mockConfig,
 	)
 
 	// Act
@@ -460,6 +692,9 @@ func TestGlobalMATLAB_Client_ReturnsInitializeCachedErrorOnSubsequentClientCalls
 	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
 	defer mockMATLABStartingDirSelector.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	ctx := t.Context()
 	expectedError := assert.AnError
 
@@ -467,8 +702,19 @@ func TestGlobalMATLAB_Client_ReturnsInitializeCachedErrorOnSubsequentClientCalls
 		mockMATLABManager,
 		mockMATLABRootSelector,
 		mockMATLABStartingDirSelector,
+		mockConfig,
 	)
 
+	mockConfig.EXPECT().
+		SharedMATLABSessionFolder().
+		Return("").
+		Once()
+
+	mockConfig.EXPECT().
+		RemoteMATLABHost().
+		Return("").
+		Once()
+
 	mockMATLABRootSelector.EXPECT().
 		SelectMATLABRoot(ctx, mockLogger.AsMockArg()).
 		Return("", expectedError).
@@ -499,6 +745,9 @@ func TestGlobalMATLAB_Client_ReturnsMATLABStartupCachedErrorOnSubsequentClientCa
 	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
 	defer mockMATLABStartingDirSelector.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	ctx := t.Context()
 	expectedMATLABRoot := filepath.Join("some", "matlab", "root")
 	expectedMATLABStartingDir := filepath.Join("some", "starting", "dir")
@@ -511,6 +760,16 @@ func TestGlobalMATLAB_Client_ReturnsMATLABStartupCachedErrorOnSubsequentClientCa
 		ShowMATLABDesktop:      true,
 	}
 
+	mockConfig.EXPECT().
+		SharedMATLABSessionFolder().
+		Return("").
+		Once()
+
+	mockConfig.EXPECT().
+		RemoteMATLABHost().
+		Return("").
+		Once()
+
 	mockMATLABRootSelector.EXPECT().
 		SelectMATLABRoot(ctx, mockLogger.AsMockArg()).
 		Return(expectedMATLABRoot, nil).
@@ -530,6 +789,7 @@ func TestGlobalMATLAB_Client_ReturnsMATLABStartupCachedErrorOnSubsequentClientCa
 		mockMATLABManager,
 		mockMATLABRootSelector,
 		mockMATLABStartingDirSelector,
+		mockConfig,
 	)
 
 	// Act
@@ -557,6 +817,9 @@ func TestGlobalMATLAB_Client_ConcurrentCallsWaitForCompletion(t *testing.T) {
 	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
 	defer mockMATLABStartingDirSelector.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	expectedSessionClient := &entitiesmocks.MockMATLABSessionClient{}
 
 	ctx := t.Context()
@@ -582,6 +845,16 @@ func TestGlobalMATLAB_Client_ConcurrentCallsWaitForCompletion(t *testing.T) {
 	firstCallCompleted := make(chan clientResult)
 	secondCallCompleted := make(chan clientResult)
 
+	mockConfig.EXPECT().
+		SharedMATLABSessionFolder().
+		Return("").
+		Once()
+
+	mockConfig.EXPECT().
+		RemoteMATLABHost().
+		Return("").
+		Once()
+
 	mockMATLABRootSelector.EXPECT().
 		SelectMATLABRoot(ctx, mockLogger.AsMockArg()).
 		Return(expectedMATLABRoot, nil).
@@ -615,6 +888,7 @@ func TestGlobalMATLAB_Client_ConcurrentCallsWaitForCompletion(t *testing.T) {
 		mockMATLABManager,
 		mockMATLABRootSelector,
 		mockMATLABStartingDirSelector,
+		mockConfig,
 	)
 
 	// Act
@@ -662,6 +936,9 @@ func TestGlobalMATLAB_Client_RestartOnGetClientFailure(t *testing.T) {
 	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
 	defer mockMATLABStartingDirSelector.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	expectedSessionClient := &entitiesmocks.MockMATLABSessionClient{}
 
 	ctx := t.Context()
@@ -677,6 +954,16 @@ func TestGlobalMATLAB_Client_RestartOnGetClientFailure(t *testing.T) {
 		ShowMATLABDesktop:      true,
 	}
 
+	mockConfig.EXPECT().
+		SharedMATLABSessionFolder().
+		Return("").
+		Once()
+
+	mockConfig.EXPECT().
+		RemoteMATLABHost().
+		Return("").
+		Once()
+
 	mockMATLABRootSelector.EXPECT().
 		SelectMATLABRoot(ctx, mockLogger.AsMockArg()).
 		Return(expectedMATLABRoot, nil).
@@ -717,7 +1004,7 @@ func TestGlobalMATLAB_Client_RestartOnGetClientFailure(t *testing.T) {
 		Return(expectedSessionClient, nil).
 		Once()
 
-	globalMATLABSession := globalmatlab.New(mockMATLABManager, mockMATLABRootSelector, mockMATLABStartingDirSelector)
+	globalMATLABSession := globalmatlab.New(mockMATLABManager, mockMATLABRootSelector, mockMATLABStartingDirSelector, mockConfig)
 
 	// Act
 	client1, err1 := globalMATLABSession.Client(ctx, mockLogger)
@@ -744,6 +1031,9 @@ func TestGlobalMATLAB_Client_DoesNotErrorIfStopSessionError(t *testing.T) {
 	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
 	defer mockMATLABStartingDirSelector.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	expectedSessionClient := &entitiesmocks.MockMATLABSessionClient{}
 
 	ctx := t.Context()
@@ -759,6 +1049,16 @@ func TestGlobalMATLAB_Client_DoesNotErrorIfStopSessionError(t *testing.T) {
 		ShowMATLABDesktop:      true,
 	}
 
+	mockConfig.EXPECT().
+		SharedMATLABSessionFolder().
+		Return("").
+		Once()
+
+	mockConfig.EXPECT().
+		RemoteMATLABHost().
+		Return("").
+		Once()
+
 	mockMATLABRootSelector.EXPECT().
 		SelectMATLABRoot(ctx, mockLogger.AsMockArg()).
 		Return(expectedMATLABRoot, nil).
@@ -799,7 +1099,7 @@ func TestGlobalMATLAB_Client_DoesNotErrorIfStopSessionError(t *testing.T) {
 		Return(expectedSessionClient, nil).
 		Once()
 
-	globalMATLABSession := globalmatlab.New(mockMATLABManager, mockMATLABRootSelector, mockMATLABStartingDirSelector)
+	globalMATLABSession := globalmatlab.New(mockMATLABManager, mockMATLABRootSelector, mockMATLABStartingDirSelector, mockConfig)
 
 	// Act
 	client1, err1 := globalMATLABSession.Client(ctx, mockLogger)
@@ -826,6 +1126,9 @@ func TestGlobalMATLAB_Client_RestartFailure_OnExistingSession(t *testing.T) {
 	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
 	defer mockMATLABStartingDirSelector.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	expectedSessionClient := &entitiesmocks.MockMATLABSessionClient{}
 
 	ctx := t.Context()
@@ -841,6 +1144,16 @@ func TestGlobalMATLAB_Client_RestartFailure_OnExistingSession(t *testing.T) {
 		ShowMATLABDesktop:      true,
 	}
 
+	mockConfig.EXPECT().
+		SharedMATLABSessionFolder().
+		Return("").
+		Once()
+
+	mockConfig.EXPECT().
+		RemoteMATLABHost().
+		Return("").
+		Once()
+
 	mockMATLABRootSelector.EXPECT().
 		SelectMATLABRoot(ctx, mockLogger.AsMockArg()).
 		Return(expectedMATLABRoot, nil).
@@ -876,7 +1189,7 @@ func TestGlobalMATLAB_Client_RestartFailure_OnExistingSession(t *testing.T) {
 		Return(entities.SessionID(0), expectedError).
 		Once()
 
-	globalMATLABSession := globalmatlab.New(mockMATLABManager, mockMATLABRootSelector, mockMATLABStartingDirSelector)
+	globalMATLABSession := globalmatlab.New(mockMATLABManager, mockMATLABRootSelector, mockMATLABStartingDirSelector, mockConfig)
 
 	// Act
 	client1, err1 := globalMATLABSession.Client(ctx, mockLogger)
@@ -0,0 +1,376 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package globalmatlab_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/globalmatlab"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/globalmatlab"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGlobalMATLAB_Restart_NoExistingSessionStartsNewSession(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManager := &mocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
+	defer mockMATLABRootSelector.AssertExpectations(t)
+
+	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
+	defer mockMATLABStartingDirSelector.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	ctx := t.Context()
+	expectedSessionID := entities.SessionID(123)
+	expectedMATLABRoot := filepath.Join("some", "matlab", "root")
+	expectedMATLABStartingDir := filepath.Join("some", "starting", "dir")
+
+	expectedLocalSessionDetails := entities.LocalSessionDetails{
+		MATLABRoot:             expectedMATLABRoot,
+		IsStartingDirectorySet: true,
+		StartingDirectory:      expectedMATLABStartingDir,
+		ShowMATLABDesktop:      true,
+	}
+
+	mockConfig.EXPECT().
+		SharedMATLABSessionFolder().
+		Return("").
+		Once()
+
+	mockConfig.EXPECT().
+		RemoteMATLABHost().
+		Return("").
+		Once()
+
+	mockMATLABRootSelector.EXPECT().
+		SelectMATLABRoot(ctx, mockLogger.AsMockArg()).
+		Return(expectedMATLABRoot, nil).
+		Once()
+
+	mockMATLABStartingDirSelector.EXPECT().
+		SelectMatlabStartingDir().
+		Return(expectedMATLABStartingDir, nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		StartMATLABSession(ctx, mockLogger.AsMockArg(), expectedLocalSessionDetails).
+		Return(expectedSessionID, nil).
+		Once()
+
+	globalMATLABSession := globalmatlab.New(
+		mockMATLABManager,
+		mockMATLABRootSelector,
+		mockMATLABStartingDirSelector,
+		mockConfig,
+	)
+
+	// Act
+	err := globalMATLABSession.Restart(ctx, mockLogger)
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestGlobalMATLAB_Restart_ExistingSessionIsRestarted(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManager := &mocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
+	defer mockMATLABRootSelector.AssertExpectations(t)
+
+	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
+	defer mockMATLABStartingDirSelector.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	expectedSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+
+	ctx := t.Context()
+	expectedSessionID := entities.SessionID(123)
+	expectedMATLABRoot := filepath.Join("some", "matlab", "root")
+
+	mockConfig.EXPECT().
+		SharedMATLABSessionFolder().
+		Return("").
+		Once()
+
+	mockConfig.EXPECT().
+		RemoteMATLABHost().
+		Return("").
+		Once()
+
+	mockMATLABRootSelector.EXPECT().
+		SelectMATLABRoot(ctx, mockLogger.AsMockArg()).
+		Return(expectedMATLABRoot, nil).
+		Once()
+
+	mockMATLABStartingDirSelector.EXPECT().
+		SelectMatlabStartingDir().
+		Return("", nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		StartMATLABSession(ctx, mockLogger.AsMockArg(), mock.Anything).
+		Return(expectedSessionID, nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), expectedSessionID).
+		Return(expectedSessionClient, nil).
+		Twice()
+
+	mockMATLABManager.EXPECT().
+		RestartMATLABSession(ctx, mockLogger.AsMockArg(), expectedSessionID).
+		Return(nil).
+		Once()
+
+	globalMATLABSession := globalmatlab.New(
+		mockMATLABManager,
+		mockMATLABRootSelector,
+		mockMATLABStartingDirSelector,
+		mockConfig,
+	)
+
+	_, err := globalMATLABSession.Client(ctx, mockLogger)
+	require.NoError(t, err)
+
+	// Act
+	err = globalMATLABSession.Restart(ctx, mockLogger)
+
+	// Assert
+	require.NoError(t, err)
+
+	client, err := globalMATLABSession.Client(ctx, mockLogger)
+	require.NoError(t, err)
+	assert.Equal(t, expectedSessionClient, client)
+}
+
+func TestGlobalMATLAB_Restart_RestartErrorStartsNewSessionOnNextClient(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManager := &mocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
+	defer mockMATLABRootSelector.AssertExpectations(t)
+
+	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
+	defer mockMATLABStartingDirSelector.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	expectedSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+
+	ctx := t.Context()
+	firstSessionID := entities.SessionID(123)
+	secondSessionID := entities.SessionID(456)
+	expectedMATLABRoot := filepath.Join("some", "matlab", "root")
+	expectedError := assert.AnError
+
+	mockConfig.EXPECT().
+		SharedMATLABSessionFolder().
+		Return("").
+		Once()
+
+	mockConfig.EXPECT().
+		RemoteMATLABHost().
+		Return("").
+		Once()
+
+	mockMATLABRootSelector.EXPECT().
+		SelectMATLABRoot(ctx, mockLogger.AsMockArg()).
+		Return(expectedMATLABRoot, nil).
+		Once()
+
+	mockMATLABStartingDirSelector.EXPECT().
+		SelectMatlabStartingDir().
+		Return("", nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		StartMATLABSession(ctx, mockLogger.AsMockArg(), mock.Anything).
+		Return(firstSessionID, nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), firstSessionID).
+		Return(expectedSessionClient, nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		RestartMATLABSession(ctx, mockLogger.AsMockArg(), firstSessionID).
+		Return(expectedError).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		StartMATLABSession(ctx, mockLogger.AsMockArg(), mock.Anything).
+		Return(secondSessionID, nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), secondSessionID).
+		Return(expectedSessionClient, nil).
+		Once()
+
+	globalMATLABSession := globalmatlab.New(
+		mockMATLABManager,
+		mockMATLABRootSelector,
+		mockMATLABStartingDirSelector,
+		mockConfig,
+	)
+
+	_, err := globalMATLABSession.Client(ctx, mockLogger)
+	require.NoError(t, err)
+
+	// Act
+	err = globalMATLABSession.Restart(ctx, mockLogger)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+
+	client, err := globalMATLABSession.Client(ctx, mockLogger)
+	require.NoError(t, err)
+	assert.Equal(t, expectedSessionClient, client)
+}
+
+func TestGlobalMATLAB_Restart_InitializationError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManager := &mocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
+	defer mockMATLABRootSelector.AssertExpectations(t)
+
+	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
+	defer mockMATLABStartingDirSelector.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	ctx := t.Context()
+	expectedError := assert.AnError
+
+	mockConfig.EXPECT().
+		SharedMATLABSessionFolder().
+		Return("").
+		Once()
+
+	mockConfig.EXPECT().
+		RemoteMATLABHost().
+		Return("").
+		Once()
+
+	mockMATLABRootSelector.EXPECT().
+		SelectMATLABRoot(ctx, mockLogger.AsMockArg()).
+		Return("", expectedError).
+		Once()
+
+	globalMATLABSession := globalmatlab.New(
+		mockMATLABManager,
+		mockMATLABRootSelector,
+		mockMATLABStartingDirSelector,
+		mockConfig,
+	)
+
+	// Act
+	err := globalMATLABSession.Restart(ctx, mockLogger)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestGlobalMATLAB_Restart_SessionNotOwned(t *testing.T) {
+	testCases := []struct {
+		name                      string
+		sharedMATLABSessionFolder string
+		remoteMATLABHost          string
+	}{
+		{
+			name:                      "shared session",
+			sharedMATLABSessionFolder: filepath.Join("some", "shared", "folder"),
+		},
+		{
+			name:             "remote session",
+			remoteMATLABHost: "matlab.example.com",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockMATLABManager := &mocks.MockMATLABManager{}
+			defer mockMATLABManager.AssertExpectations(t)
+
+			mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
+			defer mockMATLABRootSelector.AssertExpectations(t)
+
+			mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
+			defer mockMATLABStartingDirSelector.AssertExpectations(t)
+
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockConfig.EXPECT().
+				SharedMATLABSessionFolder().
+				Return(tc.sharedMATLABSessionFolder).
+				Once()
+
+			if tc.remoteMATLABHost != "" {
+				mockConfig.EXPECT().
+					RemoteMATLABHost().
+					Return(tc.remoteMATLABHost).
+					Once()
+
+				mockConfig.EXPECT().
+					RemoteMATLABPort().
+					Return("31515").
+					Once()
+
+				mockConfig.EXPECT().
+					RemoteMATLABAPIKeyFile().
+					Return("").
+					Once()
+
+				mockConfig.EXPECT().
+					RemoteMATLABCertificateFile().
+					Return("").
+					Once()
+			}
+
+			globalMATLABSession := globalmatlab.New(
+				mockMATLABManager,
+				mockMATLABRootSelector,
+				mockMATLABStartingDirSelector,
+				mockConfig,
+			)
+
+			// Act
+			err := globalMATLABSession.Restart(t.Context(), mockLogger)
+
+			// Assert
+			require.ErrorIs(t, err, entities.ErrSessionNotOwned)
+		})
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package globalmatlab_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/globalmatlab"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/globalmatlab"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGlobalMATLAB_RunningClient_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManager := &mocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
+	defer mockMATLABRootSelector.AssertExpectations(t)
+
+	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
+	defer mockMATLABStartingDirSelector.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	expectedSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+
+	ctx := t.Context()
+	expectedSessionID := entities.SessionID(123)
+	expectedDiscoveryFolder := filepath.Join("some", "shared", "folder")
+
+	mockConfig.EXPECT().
+		SharedMATLABSessionFolder().
+		Return(expectedDiscoveryFolder).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		StartMATLABSession(mock.Anything, mockLogger.AsMockArg(), entities.SharedSessionDetails{DiscoveryFolder: expectedDiscoveryFolder}).
+		Return(expectedSessionID, nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), expectedSessionID).
+		Return(expectedSessionClient, nil).
+		Twice()
+
+	globalMATLABSession := globalmatlab.New(
+		mockMATLABManager,
+		mockMATLABRootSelector,
+		mockMATLABStartingDirSelector,
+		mockConfig,
+	)
+
+	_, err := globalMATLABSession.Client(ctx, mockLogger)
+	require.NoError(t, err)
+
+	// Act
+	client, ok := globalMATLABSession.RunningClient(ctx, mockLogger)
+
+	// Assert
+	require.True(t, ok)
+	assert.Equal(t, expectedSessionClient, client)
+}
+
+func TestGlobalMATLAB_RunningClient_DoesNotStartMATLAB(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManager := &mocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
+	defer mockMATLABRootSelector.AssertExpectations(t)
+
+	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
+	defer mockMATLABStartingDirSelector.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	globalMATLABSession := globalmatlab.New(
+		mockMATLABManager,
+		mockMATLABRootSelector,
+		mockMATLABStartingDirSelector,
+		mockConfig,
+	)
+
+	// Act
+	client, ok := globalMATLABSession.RunningClient(t.Context(), mockLogger)
+
+	// Assert
+	assert.False(t, ok)
+	assert.Nil(t, client)
+}
+
+func TestGlobalMATLAB_RunningClient_GetMATLABSessionClientError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManager := &mocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
+	defer mockMATLABRootSelector.AssertExpectations(t)
+
+	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
+	defer mockMATLABStartingDirSelector.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	expectedSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+
+	ctx := t.Context()
+	expectedSessionID := entities.SessionID(123)
+	expectedDiscoveryFolder := filepath.Join("some", "shared", "folder")
+
+	mockConfig.EXPECT().
+		SharedMATLABSessionFolder().
+		Return(expectedDiscoveryFolder).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		StartMATLABSession(mock.Anything, mockLogger.AsMockArg(), entities.SharedSessionDetails{DiscoveryFolder: expectedDiscoveryFolder}).
+		Return(expectedSessionID, nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), expectedSessionID).
+		Return(expectedSessionClient, nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), expectedSessionID).
+		Return(nil, assert.AnError).
+		Once()
+
+	globalMATLABSession := globalmatlab.New(
+		mockMATLABManager,
+		mockMATLABRootSelector,
+		mockMATLABStartingDirSelector,
+		mockConfig,
+	)
+
+	_, err := globalMATLABSession.Client(ctx, mockLogger)
+	require.NoError(t, err)
+
+	// Act
+	client, ok := globalMATLABSession.RunningClient(ctx, mockLogger)
+
+	// Assert
+	assert.False(t, ok)
+	assert.Nil(t, client)
+}
@@ -0,0 +1,98 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package globalmatlab_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/globalmatlab"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/globalmatlab"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGlobalMATLAB_RunningSessionID_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManager := &mocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
+	defer mockMATLABRootSelector.AssertExpectations(t)
+
+	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
+	defer mockMATLABStartingDirSelector.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	ctx := t.Context()
+	expectedSessionID := entities.SessionID(123)
+	expectedDiscoveryFolder := filepath.Join("some", "shared", "folder")
+
+	mockConfig.EXPECT().
+		SharedMATLABSessionFolder().
+		Return(expectedDiscoveryFolder).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		StartMATLABSession(mock.Anything, mockLogger.AsMockArg(), entities.SharedSessionDetails{DiscoveryFolder: expectedDiscoveryFolder}).
+		Return(expectedSessionID, nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), expectedSessionID).
+		Return(&entitiesmocks.MockMATLABSessionClient{}, nil).
+		Once()
+
+	globalMATLABSession := globalmatlab.New(
+		mockMATLABManager,
+		mockMATLABRootSelector,
+		mockMATLABStartingDirSelector,
+		mockConfig,
+	)
+
+	_, err := globalMATLABSession.Client(ctx, mockLogger)
+	require.NoError(t, err)
+
+	// Act
+	sessionID, ok := globalMATLABSession.RunningSessionID()
+
+	// Assert
+	require.True(t, ok)
+	assert.Equal(t, expectedSessionID, sessionID)
+}
+
+func TestGlobalMATLAB_RunningSessionID_NotStarted(t *testing.T) {
+	// Arrange
+	mockMATLABManager := &mocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
+	defer mockMATLABRootSelector.AssertExpectations(t)
+
+	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
+	defer mockMATLABStartingDirSelector.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	globalMATLABSession := globalmatlab.New(
+		mockMATLABManager,
+		mockMATLABRootSelector,
+		mockMATLABStartingDirSelector,
+		mockConfig,
+	)
+
+	// Act
+	_, ok := globalMATLABSession.RunningSessionID()
+
+	// Assert
+	assert.False(t, ok)
+}
@@ -21,11 +21,15 @@ func TestNew_HappyPath(t *testing.T) {
 	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
 	defer mockMATLABStartingDirSelector.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	// Act
 	globalMATLABSession := globalmatlab.New(
 		mockMATLABManager,
 		mockMATLABRootSelector,
 		mockMATLABStartingDirSelector,
+		mockConfig,
 	)
 
 	// Assert
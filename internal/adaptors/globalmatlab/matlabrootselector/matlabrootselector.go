@@ -5,12 +5,15 @@ package matlabrootselector
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/matlabversionrule"
 )
 
 type Config interface {
 	PreferredLocalMATLABRoot() string
+	PreferredMATLABVersion() string
 }
 
 type MATLABManager interface {
@@ -42,5 +45,37 @@ func (m *MATLABRootSelector) SelectMATLABRoot(ctx context.Context, logger entiti
 		return "", fmt.Errorf("no valid MATLAB environments found")
 	}
 
-	return environments[0].MATLABRoot, nil
+	preferredMATLABVersion := m.config.PreferredMATLABVersion()
+	if preferredMATLABVersion == "" {
+		return environments[0].MATLABRoot, nil
+	}
+
+	rule, err := matlabversionrule.Parse(preferredMATLABVersion)
+	if err != nil {
+		return "", err
+	}
+
+	environment, found := rule.Select(environments)
+	if !found {
+		return "", fmt.Errorf("no MATLAB environment matches version %s, found: %s", rule, describeEnvironments(environments))
+	}
+
+	logger.
+		With("matlab_version", rule.String()).
+		With("matlab_root", environment.MATLABRoot).
+		Debug("Selected MATLAB environment matching the preferred version")
+
+	return environment.MATLABRoot, nil
+}
+
+func describeEnvironments(environments []entities.EnvironmentInfo) string {
+	descriptions := make([]string, 0, len(environments))
+	for _, environment := range environments {
+		description := environment.Version
+		if environment.UpdateLevel > 0 {
+			description = fmt.Sprintf("%s Update %d", description, environment.UpdateLevel)
+		}
+		descriptions = append(descriptions, fmt.Sprintf("%s (%s)", description, environment.MATLABRoot))
+	}
+	return strings.Join(descriptions, ", ")
 }
@@ -98,6 +98,11 @@ func TestMATLABRootSelector_SelectMATLABRoot_HappyPath(t *testing.T) {
 				Return("").
 				Once()
 
+			mockConfig.EXPECT().
+				PreferredMATLABVersion().
+				Return("").
+				Once()
+
 			mockMATLABManager.EXPECT().
 				ListEnvironments(ctx, mockLogger.AsMockArg()).
 				Return(tc.environments).
@@ -193,3 +198,143 @@ func TestMATLABRootSelector_SelectMATLABRoot_ListEnvironmentsEmpty(t *testing.T)
 		})
 	}
 }
+
+func TestMATLABRootSelector_SelectMATLABRoot_PreferredMATLABVersion_HappyPath(t *testing.T) {
+	r2023bRoot := filepath.Join("usr", "local", "MATLAB", "R2023b")
+	r2024aRoot := filepath.Join("usr", "local", "MATLAB", "R2024a")
+	r2024bRoot := filepath.Join("usr", "local", "MATLAB", "R2024b")
+	r2024bUpdateRoot := filepath.Join("opt", "MATLAB", "R2024b")
+	r2025aPrereleaseRoot := filepath.Join("opt", "MATLAB", "R2025a")
+
+	environments := []entities.EnvironmentInfo{
+		{MATLABRoot: r2023bRoot, Version: "R2023b", UpdateLevel: 5},
+		{MATLABRoot: r2024aRoot, Version: "R2024a", UpdateLevel: 1},
+		{MATLABRoot: r2024bRoot, Version: "R2024b", UpdateLevel: 0},
+		{MATLABRoot: r2024bUpdateRoot, Version: "R2024b", UpdateLevel: 3},
+		{MATLABRoot: r2025aPrereleaseRoot, Version: "R2025a", IsPrerelease: true, UpdateLevel: 0},
+	}
+
+	testCases := []struct {
+		name                   string
+		preferredMATLABVersion string
+		expected               string
+	}{
+		{
+			name:                   "exact release selects the latest update",
+			preferredMATLABVersion: "R2024b",
+			expected:               r2024bUpdateRoot,
+		},
+		{
+			name:                   "exact release and update",
+			preferredMATLABVersion: "R2024b Update 0",
+			expected:               r2024bRoot,
+		},
+		{
+			name:                   "lower case release",
+			preferredMATLABVersion: "r2024a",
+			expected:               r2024aRoot,
+		},
+		{
+			name:                   "minimum release prefers a general release over a prerelease",
+			preferredMATLABVersion: ">=R2024a",
+			expected:               r2024bUpdateRoot,
+		},
+		{
+			name:                   "prerelease when no general release matches",
+			preferredMATLABVersion: "R2025a",
+			expected:               r2025aPrereleaseRoot,
+		},
+		{
+			name:                   "maximum release",
+			preferredMATLABVersion: "<R2024b",
+			expected:               r2024aRoot,
+		},
+		{
+			name:                   "latest prefers a general release over a prerelease",
+			preferredMATLABVersion: "latest",
+			expected:               r2024bUpdateRoot,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockMATLABManager := &mocks.MockMATLABManager{}
+			defer mockMATLABManager.AssertExpectations(t)
+
+			ctx := t.Context()
+
+			mockConfig.EXPECT().
+				PreferredLocalMATLABRoot().
+				Return("").
+				Once()
+
+			mockConfig.EXPECT().
+				PreferredMATLABVersion().
+				Return(tc.preferredMATLABVersion).
+				Once()
+
+			mockMATLABManager.EXPECT().
+				ListEnvironments(ctx, mockLogger.AsMockArg()).
+				Return(environments).
+				Once()
+
+			selector := matlabrootselector.New(mockConfig, mockMATLABManager)
+
+			// Act
+			result, err := selector.SelectMATLABRoot(ctx, mockLogger)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestMATLABRootSelector_SelectMATLABRoot_PreferredMATLABVersionNoMatch(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockMATLABManager := &mocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	r2023bRoot := filepath.Join("usr", "local", "MATLAB", "R2023b")
+	r2024aRoot := filepath.Join("usr", "local", "MATLAB", "R2024a")
+
+	mockConfig.EXPECT().
+		PreferredLocalMATLABRoot().
+		Return("").
+		Once()
+
+	mockConfig.EXPECT().
+		PreferredMATLABVersion().
+		Return(">=R2024b").
+		Once()
+
+	mockMATLABManager.EXPECT().
+		ListEnvironments(ctx, mockLogger.AsMockArg()).
+		Return([]entities.EnvironmentInfo{
+			{MATLABRoot: r2024aRoot, Version: "R2024a", UpdateLevel: 2},
+			{MATLABRoot: r2023bRoot, Version: "R2023b", UpdateLevel: 0},
+		}).
+		Once()
+
+	selector := matlabrootselector.New(mockConfig, mockMATLABManager)
+
+	// Act
+	result, err := selector.SelectMATLABRoot(ctx, mockLogger)
+
+	// Assert
+	require.EqualError(t, err, "no MATLAB environment matches version >=R2024b, found: R2024a Update 2 ("+r2024aRoot+"), R2023b ("+r2023bRoot+")")
+	assert.Empty(t, result)
+}
@@ -27,6 +27,9 @@ func TestMATLABManager_GetMATLABSessionClient_HappyPath(t *testing.T) {
 	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
 	defer mockClientFactory.AssertExpectations(t)
 
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
 	mockSessionClient := &sessionstoremocks.MockMATLABSessionClientWithCleanup{}
 
 	expectedSessionID := entities.SessionID(123)
@@ -42,7 +45,7 @@ func TestMATLABManager_GetMATLABSessionClient_HappyPath(t *testing.T) {
 		Return(entities.PingResponse{IsAlive: true}).
 		Once()
 
-	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory)
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionPool)
 
 	// Act
 	client, err := manager.GetMATLABSessionClient(ctx, mockLogger, expectedSessionID)
@@ -65,6 +68,9 @@ func TestMATLABManager_GetMATLABSessionClient_PingFailure(t *testing.T) {
 	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
 	defer mockClientFactory.AssertExpectations(t)
 
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
 	mockSessionClient := &sessionstoremocks.MockMATLABSessionClientWithCleanup{}
 
 	sessionID := entities.SessionID(123)
@@ -80,7 +86,7 @@ func TestMATLABManager_GetMATLABSessionClient_PingFailure(t *testing.T) {
 		Return(entities.PingResponse{IsAlive: false}).
 		Once()
 
-	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory)
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionPool)
 
 	// Act
 	client, err := manager.GetMATLABSessionClient(ctx, mockLogger, sessionID)
@@ -104,6 +110,9 @@ func TestMATLABManager_GetMATLABSessionClient_SessionStoreError(t *testing.T) {
 	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
 	defer mockClientFactory.AssertExpectations(t)
 
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
 	expectedSessionID := entities.SessionID(123)
 	ctx := t.Context()
 	expectedError := assert.AnError
@@ -113,7 +122,7 @@ func TestMATLABManager_GetMATLABSessionClient_SessionStoreError(t *testing.T) {
 		Return(nil, expectedError).
 		Once()
 
-	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory)
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionPool)
 
 	// Act
 	client, err := manager.GetMATLABSessionClient(ctx, mockLogger, expectedSessionID)
@@ -0,0 +1,26 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabmanager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// GetMATLABSessionDirectory returns the session directory of a MATLAB session started by the server.
+// Unlike GetMATLABSessionClient, it does not check that MATLAB responds, so that the files of a busy or crashed MATLAB session can still be read.
+func (m *MATLABManager) GetMATLABSessionDirectory(_ context.Context, _ entities.Logger, sessionID entities.SessionID) (string, error) {
+	client, err := m.sessionStore.Get(sessionID)
+	if err != nil {
+		return "", err
+	}
+
+	sessionDir := client.SessionDir()
+	if sessionDir == "" {
+		return "", fmt.Errorf("MATLAB session %v was not started by the server, so it has no session directory", sessionID)
+	}
+
+	return sessionDir, nil
+}
@@ -0,0 +1,132 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabmanager_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager"
+	sessionstoremocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager/matlabsessionstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMATLABManager_GetMATLABSessionDirectory_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockSessionClient := &sessionstoremocks.MockMATLABSessionClientWithCleanup{}
+	defer mockSessionClient.AssertExpectations(t)
+
+	sessionID := entities.SessionID(123)
+	expectedSessionDir := filepath.Join("tmp", "session")
+
+	mockSessionStore.EXPECT().
+		Get(sessionID).
+		Return(mockSessionClient, nil).
+		Once()
+
+	mockSessionClient.EXPECT().
+		SessionDir().
+		Return(expectedSessionDir).
+		Once()
+
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionPool)
+
+	// Act
+	sessionDir, err := manager.GetMATLABSessionDirectory(t.Context(), mockLogger, sessionID)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedSessionDir, sessionDir)
+}
+
+func TestMATLABManager_GetMATLABSessionDirectory_NotStartedByServer(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockSessionClient := &sessionstoremocks.MockMATLABSessionClientWithCleanup{}
+	defer mockSessionClient.AssertExpectations(t)
+
+	sessionID := entities.SessionID(123)
+
+	mockSessionStore.EXPECT().
+		Get(sessionID).
+		Return(mockSessionClient, nil).
+		Once()
+
+	mockSessionClient.EXPECT().
+		SessionDir().
+		Return("").
+		Once()
+
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionPool)
+
+	// Act
+	sessionDir, err := manager.GetMATLABSessionDirectory(t.Context(), mockLogger, sessionID)
+
+	// Assert
+	require.EqualError(t, err, "MATLAB session 123 was not started by the server, so it has no session directory")
+	assert.Empty(t, sessionDir)
+}
+
+func TestMATLABManager_GetMATLABSessionDirectory_SessionNotFound(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	sessionID := entities.SessionID(123)
+
+	mockSessionStore.EXPECT().
+		Get(sessionID).
+		Return(nil, assert.AnError).
+		Once()
+
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionPool)
+
+	// Act
+	sessionDir, err := manager.GetMATLABSessionDirectory(t.Context(), mockLogger, sessionID)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, sessionDir)
+}
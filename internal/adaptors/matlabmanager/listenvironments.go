@@ -5,6 +5,7 @@ package matlabmanager
 import (
 	"context"
 
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 )
 
@@ -17,9 +18,21 @@ func (m *MATLABManager) ListEnvironments(_ context.Context, sessionLogger entiti
 
 	info := make([]entities.EnvironmentInfo, 0, len(matlabInfos.MatlabInfo))
 	for _, matlabInfo := range matlabInfos.MatlabInfo {
+		products := make([]entities.ProductInfo, 0, len(matlabInfo.Installation.Products))
+		for _, product := range matlabInfo.Installation.Products {
+			products = append(products, entities.ProductInfo{
+				Name:    product.Name,
+				Version: product.Version,
+			})
+		}
+
 		info = append(info, entities.EnvironmentInfo{
-			MATLABRoot: matlabInfo.Location,
-			Version:    matlabInfo.Version.ReleaseFamily,
+			MATLABRoot:   matlabInfo.Location,
+			Version:      matlabInfo.Version.ReleaseFamily,
+			UpdateLevel:  matlabInfo.Version.UpdateLevel,
+			IsPrerelease: matlabInfo.Version.ReleasePhase == datatypes.ReleasePhasePrerelease,
+			Architecture: matlabInfo.Installation.Architecture,
+			Products:     products,
 		})
 	}
 
@@ -8,6 +8,7 @@ import (
 
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
 	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager"
 	"github.com/stretchr/testify/assert"
@@ -27,6 +28,9 @@ func TestMATLABManager_ListEnvironments_HappyPath(t *testing.T) {
 	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
 	defer mockClientFactory.AssertExpectations(t)
 
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
 	expectedMatlabInfos := []datatypes.MatlabInfo{{
 		Location: filepath.Join("path", "to", "matlab", "R2023a"),
 		Version: datatypes.MatlabVersionInfo{
@@ -38,9 +42,16 @@ func TestMATLABManager_ListEnvironments_HappyPath(t *testing.T) {
 		Location: filepath.Join("path", "to", "matlab", "R2022b"),
 		Version: datatypes.MatlabVersionInfo{
 			ReleaseFamily: "R2022b",
-			ReleasePhase:  "release",
+			ReleasePhase:  datatypes.ReleasePhasePrerelease,
 			UpdateLevel:   1,
 		},
+		Installation: datatypes.MatlabInstallationInfo{
+			Architecture: "glnxa64",
+			Products: []datatypes.MatlabProductInfo{
+				{Name: "MATLAB", Version: "9.13"},
+				{Name: "Simulink", Version: "10.6"},
+			},
+		},
 	},
 	}
 
@@ -52,7 +63,7 @@ func TestMATLABManager_ListEnvironments_HappyPath(t *testing.T) {
 		Return(mockResponse).
 		Once()
 
-	manager := matlabmanager.New(mockMATLABManager, mockSessionStore, mockClientFactory)
+	manager := matlabmanager.New(mockMATLABManager, mockSessionStore, mockClientFactory, mockSessionPool)
 	ctx := t.Context()
 
 	// Act
@@ -65,7 +76,18 @@ func TestMATLABManager_ListEnvironments_HappyPath(t *testing.T) {
 	for i := range expectedMatlabInfos {
 		assert.Equal(t, expectedMatlabInfos[i].Location, result[i].MATLABRoot, "Output MATLAB root does not match input dummy data")
 		assert.Equal(t, expectedMatlabInfos[i].Version.ReleaseFamily, result[i].Version, "Output MATLAB version does not match input dummy data")
+		assert.Equal(t, expectedMatlabInfos[i].Version.UpdateLevel, result[i].UpdateLevel, "Output MATLAB update level does not match input dummy data")
+		assert.Equal(t, expectedMatlabInfos[i].Installation.Architecture, result[i].Architecture, "Output MATLAB architecture does not match input dummy data")
 	}
+
+	assert.False(t, result[0].IsPrerelease)
+	assert.Empty(t, result[0].Products)
+
+	assert.True(t, result[1].IsPrerelease)
+	assert.Equal(t, []entities.ProductInfo{
+		{Name: "MATLAB", Version: "9.13"},
+		{Name: "Simulink", Version: "10.6"},
+	}, result[1].Products)
 }
 
 func TestMATLABManager_ListEnvironments_EmptyList(t *testing.T) {
@@ -81,6 +103,9 @@ func TestMATLABManager_ListEnvironments_EmptyList(t *testing.T) {
 	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
 	defer mockClientFactory.AssertExpectations(t)
 
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
 	mockResponse := datatypes.ListMatlabInfo{
 		MatlabInfo: []datatypes.MatlabInfo{},
 	}
@@ -89,7 +114,7 @@ func TestMATLABManager_ListEnvironments_EmptyList(t *testing.T) {
 		Return(mockResponse).
 		Once()
 
-	manager := matlabmanager.New(mockMATLABManager, mockSessionStore, mockClientFactory)
+	manager := matlabmanager.New(mockMATLABManager, mockSessionStore, mockClientFactory, mockSessionPool)
 	ctx := t.Context()
 
 	// Act
@@ -3,20 +3,27 @@
 package matlabmanager
 
 import (
+	"context"
+
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionpool"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionstore"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 )
 
 type MATLABServices interface {
 	ListDiscoveredMatlabInfo(logger entities.Logger) datatypes.ListMatlabInfo
-	StartLocalMATLABSession(logger entities.Logger, request datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, func() error, error)
+	StartLocalMATLABSession(logger entities.Logger, request datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, string, func(killMATLAB bool) error, error)
+	AttachToSharedMATLABSession(logger entities.Logger, request datatypes.SharedSessionDetails) (embeddedconnector.ConnectionDetails, func(killMATLAB bool) error, error)
+	ConnectToRemoteMATLABSession(logger entities.Logger, request datatypes.RemoteSessionDetails) (embeddedconnector.ConnectionDetails, func(killMATLAB bool) error, error)
+	InstallMATLABPackage(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient) error
 }
 
 type MATLABSessionStore interface {
 	Add(client matlabsessionstore.MATLABSessionClientWithCleanup) entities.SessionID
 	Get(sessionID entities.SessionID) (matlabsessionstore.MATLABSessionClientWithCleanup, error)
+	Replace(sessionID entities.SessionID, client matlabsessionstore.MATLABSessionClientWithCleanup) error
 	Remove(sessionID entities.SessionID)
 }
 
@@ -24,10 +31,16 @@ type MATLABSessionClientFactory interface {
 	New(endpoint embeddedconnector.ConnectionDetails) (entities.MATLABSessionClient, error)
 }
 
+type MATLABSessionPool interface {
+	Start(logger entities.Logger, starter matlabsessionpool.SessionStarter, sessionDetails []entities.LocalSessionDetails)
+	Take(ctx context.Context, logger entities.Logger, sessionDetails entities.LocalSessionDetails) (matlabsessionstore.MATLABSessionClientWithCleanup, bool)
+}
+
 type MATLABManager struct {
 	matlabServices MATLABServices
 	sessionStore   MATLABSessionStore
 	clientFactory  MATLABSessionClientFactory
+	sessionPool    MATLABSessionPool
 }
 
 var _ entities.MATLABManager = (*MATLABManager)(nil)
@@ -36,10 +49,12 @@ func New(
 	matlabServices MATLABServices,
 	sessionStore MATLABSessionStore,
 	clientFactory MATLABSessionClientFactory,
+	sessionPool MATLABSessionPool,
 ) *MATLABManager {
 	return &MATLABManager{
 		matlabServices: matlabServices,
 		sessionStore:   sessionStore,
 		clientFactory:  clientFactory,
+		sessionPool:    sessionPool,
 	}
 }
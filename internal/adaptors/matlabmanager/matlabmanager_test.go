@@ -21,8 +21,11 @@ func TestNew_HappyPath(t *testing.T) {
 	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
 	defer mockClientFactory.AssertExpectations(t)
 
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
 	// Act
-	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory)
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionPool)
 
 	// Assert
 	assert.NotNil(t, manager, "MATLABManager should not be nil")
@@ -5,3 +5,13 @@ package config
 const (
 	MATLABExeName = "matlab"
 )
+
+// MATLABRootSearchPatterns are the glob patterns of the standard MATLAB install locations
+var MATLABRootSearchPatterns = []string{
+	"/Applications/MATLAB_R*.app",
+}
+
+// UserMATLABRootSearchPatterns are the glob patterns of MATLAB install locations, relative to the user home folder
+var UserMATLABRootSearchPatterns = []string{
+	"Applications/MATLAB_R*.app",
+}
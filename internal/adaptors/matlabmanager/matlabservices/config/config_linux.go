@@ -5,3 +5,16 @@ package config
 const (
 	MATLABExeName = "matlab"
 )
+
+// MATLABRootSearchPatterns are the glob patterns of the standard MATLAB install locations
+var MATLABRootSearchPatterns = []string{
+	"/usr/local/MATLAB/R*",
+	"/opt/MATLAB",
+	"/opt/MATLAB/R*",
+}
+
+// UserMATLABRootSearchPatterns are the glob patterns of MATLAB install locations, relative to the user home folder
+var UserMATLABRootSearchPatterns = []string{
+	"MATLAB",
+	"MATLAB/R*",
+}
@@ -5,3 +5,11 @@ package config
 const (
 	MATLABExeName = "matlab.exe"
 )
+
+// MATLABRootSearchPatterns are the glob patterns of the standard MATLAB install locations
+var MATLABRootSearchPatterns = []string{
+	`C:\Program Files\MATLAB\R*`,
+}
+
+// UserMATLABRootSearchPatterns are the glob patterns of MATLAB install locations, relative to the user home folder
+var UserMATLABRootSearchPatterns = []string{}
@@ -2,15 +2,31 @@
 
 package datatypes
 
+const (
+	ReleasePhaseRelease    = "Release"
+	ReleasePhasePrerelease = "Prerelease"
+)
+
 type MatlabVersionInfo struct {
 	ReleaseFamily string
 	ReleasePhase  string
 	UpdateLevel   int
 }
 
+type MatlabProductInfo struct {
+	Name    string
+	Version string
+}
+
+type MatlabInstallationInfo struct {
+	Architecture string
+	Products     []MatlabProductInfo
+}
+
 type MatlabInfo struct {
-	Version  MatlabVersionInfo
-	Location string
+	Version      MatlabVersionInfo
+	Installation MatlabInstallationInfo
+	Location     string
 }
 
 type ListMatlabInfo struct {
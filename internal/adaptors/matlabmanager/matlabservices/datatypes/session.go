@@ -10,3 +10,14 @@ type LocalSessionDetails struct {
 	StartingDirectory      string
 	ShowMATLABDesktop      bool
 }
+
+type SharedSessionDetails struct {
+	DiscoveryFolder string
+}
+
+type RemoteSessionDetails struct {
+	Host            string
+	Port            string
+	APIKeyFile      string
+	CertificateFile string
+}
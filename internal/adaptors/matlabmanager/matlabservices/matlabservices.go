@@ -3,6 +3,8 @@
 package matlabservices
 
 import (
+	"context"
+
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
@@ -13,20 +15,35 @@ type MATLABLocator interface {
 }
 
 type LocalMATLABSessionLauncher interface {
-	StartLocalMATLABSession(logger entities.Logger, request datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, func() error, error)
+	StartLocalMATLABSession(logger entities.Logger, request datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, string, func(killMATLAB bool) error, error)
+}
+
+type SharedMATLABSessionAttacher interface {
+	AttachToSharedMATLABSession(logger entities.Logger, request datatypes.SharedSessionDetails) (embeddedconnector.ConnectionDetails, func(killMATLAB bool) error, error)
+}
+
+type RemoteMATLABSessionConnector interface {
+	ConnectToRemoteMATLABSession(logger entities.Logger, request datatypes.RemoteSessionDetails) (embeddedconnector.ConnectionDetails, func(killMATLAB bool) error, error)
+	InstallMATLABPackage(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient) error
 }
 
 type MATLABServices struct {
 	MATLABLocator
 	LocalMATLABSessionLauncher
+	SharedMATLABSessionAttacher
+	RemoteMATLABSessionConnector
 }
 
 func New(
 	matlabLocator MATLABLocator,
 	localMATLABSessionLauncher LocalMATLABSessionLauncher,
+	sharedMATLABSessionAttacher SharedMATLABSessionAttacher,
+	remoteMATLABSessionConnector RemoteMATLABSessionConnector,
 ) *MATLABServices {
 	return &MATLABServices{
-		MATLABLocator:              matlabLocator,
-		LocalMATLABSessionLauncher: localMATLABSessionLauncher,
+		MATLABLocator:                matlabLocator,
+		LocalMATLABSessionLauncher:   localMATLABSessionLauncher,
+		SharedMATLABSessionAttacher:  sharedMATLABSessionAttacher,
+		RemoteMATLABSessionConnector: remoteMATLABSessionConnector,
 	}
 }
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
@@ -17,6 +19,7 @@ type ApplicationDirectory interface {
 
 type OSLayer interface {
 	Mkdir(name string, perm os.FileMode) error
+	MkdirAll(path string, perm os.FileMode) error
 	RemoveAll(path string) error
 
 	Stat(name string) (osfacade.FileInfo, error)
@@ -24,6 +27,10 @@ type OSLayer interface {
 	WriteFile(name string, data []byte, perm os.FileMode) error
 }
 
+type OSWrapper interface {
+	FindProcess(processPid int) osfacade.Process
+}
+
 type MATLABFiles interface {
 	GetAll() map[string][]byte
 }
@@ -33,22 +40,26 @@ type Directory interface {
 	CertificateFile() string
 	CertificateKeyFile() string
 	GetEmbeddedConnectorDetails() (string, []byte, error)
+	APIKey() (string, error)
 	Cleanup() error
 }
 
 type DirectoryFactory struct {
 	osLayer              OSLayer
+	osWrapper            OSWrapper
 	applicationDirectory ApplicationDirectory
 	matlabFiles          MATLABFiles
 }
 
 func NewFactory(
 	osLayer OSLayer,
+	osWrapper OSWrapper,
 	applicationDirectory ApplicationDirectory,
 	matlabFiles MATLABFiles,
 ) *DirectoryFactory {
 	return &DirectoryFactory{
 		osLayer:              osLayer,
+		osWrapper:            osWrapper,
 		applicationDirectory: applicationDirectory,
 		matlabFiles:          matlabFiles,
 	}
@@ -60,19 +71,66 @@ func (f *DirectoryFactory) Create(logger entities.Logger) (Directory, error) {
 		return nil, fmt.Errorf("failed to create temporary session directory: %w", err)
 	}
 
-	matlabMCPPackagePath := filepath.Join(sessionDir, "+matlab_mcp")
+	err = f.osLayer.Mkdir(filepath.Join(sessionDir, matlabMCPPackage), 0o700)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create package directory: %w", err)
+	}
+
+	if err := f.writeMATLABFiles(sessionDir); err != nil {
+		return nil, err
+	}
+
+	return newDirectoryManager(sessionDir, f.osLayer), nil
+}
 
-	err = f.osLayer.Mkdir(matlabMCPPackagePath, 0o700)
+// Open uses an existing, user provided, directory as the session directory.
+// The directory is created if it does not exist, and is never deleted by the server.
+func (f *DirectoryFactory) Open(logger entities.Logger, sessionDir string) (Directory, error) {
+	err := f.osLayer.MkdirAll(filepath.Join(sessionDir, matlabMCPPackage), 0o700)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create package directory: %w", err)
 	}
 
+	if err := f.removeStaleEmbeddedConnectorDetails(logger, sessionDir); err != nil {
+		return nil, err
+	}
+
+	if err := f.writeMATLABFiles(sessionDir); err != nil {
+		return nil, err
+	}
+
+	return newDirectoryManager(sessionDir, f.osLayer), nil
+}
+
+// removeStaleEmbeddedConnectorDetails removes the secure port of a MATLAB session shared earlier, unless that MATLAB is still running,
+// so that the server waits for MATLAB to be shared again, rather than connecting to a port nothing listens on.
+func (f *DirectoryFactory) removeStaleEmbeddedConnectorDetails(logger entities.Logger, sessionDir string) error {
+	processID, err := f.osLayer.ReadFile(filepath.Join(sessionDir, matlabProcessIDFile))
+	if err == nil {
+		if pid, err := strconv.Atoi(strings.TrimSpace(string(processID))); err == nil && f.osWrapper.FindProcess(pid) != nil {
+			return nil
+		}
+		logger.With("pid", string(processID)).Debug("The MATLAB session shared earlier is no longer running")
+	}
+
+	for _, fileName := range []string{securePortFile, matlabProcessIDFile} {
+		if err := f.osLayer.RemoveAll(filepath.Join(sessionDir, fileName)); err != nil {
+			return fmt.Errorf("failed to remove stale %s file: %w", fileName, err)
+		}
+	}
+
+	return nil
+}
+
+func (f *DirectoryFactory) writeMATLABFiles(sessionDir string) error {
+	matlabMCPPackagePath := filepath.Join(sessionDir, matlabMCPPackage)
+
 	for fileName, fileContent := range f.matlabFiles.GetAll() {
 		filePath := filepath.Join(matlabMCPPackagePath, fileName)
 		if err := f.osLayer.WriteFile(filePath, fileContent, 0o600); err != nil {
-			return nil, fmt.Errorf("failed to create %s file: %w", fileName, err)
+			return fmt.Errorf("failed to create %s file: %w", fileName, err)
 		}
 	}
 
-	return newDirectoryManager(sessionDir, f.osLayer), nil
+	return nil
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession/directorymanager"
 	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
 	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager/matlabservices/services/localmatlabsession/directorymanager"
+	osfacademocks "github.com/matlab/matlab-mcp-core-server/mocks/facades/osfacade"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -20,6 +21,9 @@ func TestNewFactory_HappyPath(t *testing.T) {
 	mockOSLayer := &mocks.MockOSLayer{}
 	defer mockOSLayer.AssertExpectations(t)
 
+	mockOSWrapper := &mocks.MockOSWrapper{}
+	defer mockOSWrapper.AssertExpectations(t)
+
 	mockApplicationDirectory := &mocks.MockApplicationDirectory{}
 	defer mockApplicationDirectory.AssertExpectations(t)
 
@@ -27,7 +31,7 @@ func TestNewFactory_HappyPath(t *testing.T) {
 	defer mockMATLABFiles.AssertExpectations(t)
 
 	// Act
-	factory := directorymanager.NewFactory(mockOSLayer, mockApplicationDirectory, mockMATLABFiles)
+	factory := directorymanager.NewFactory(mockOSLayer, mockOSWrapper, mockApplicationDirectory, mockMATLABFiles)
 
 	// Assert
 	assert.NotNil(t, factory)
@@ -38,6 +42,9 @@ func TestDirectoryFactory_Create_HappyPath(t *testing.T) {
 	mockOSLayer := &mocks.MockOSLayer{}
 	defer mockOSLayer.AssertExpectations(t)
 
+	mockOSWrapper := &mocks.MockOSWrapper{}
+	defer mockOSWrapper.AssertExpectations(t)
+
 	mockApplicationDirectory := &mocks.MockApplicationDirectory{}
 	defer mockApplicationDirectory.AssertExpectations(t)
 
@@ -78,7 +85,7 @@ func TestDirectoryFactory_Create_HappyPath(t *testing.T) {
 			Once()
 	}
 
-	factory := directorymanager.NewFactory(mockOSLayer, mockApplicationDirectory, mockMATLABFiles)
+	factory := directorymanager.NewFactory(mockOSLayer, mockOSWrapper, mockApplicationDirectory, mockMATLABFiles)
 
 	// Act
 	directory, err := factory.Create(mockLogger)
@@ -96,6 +103,9 @@ func TestDirectoryFactory_Create_MkdirTempError(t *testing.T) {
 	mockOSLayer := &mocks.MockOSLayer{}
 	defer mockOSLayer.AssertExpectations(t)
 
+	mockOSWrapper := &mocks.MockOSWrapper{}
+	defer mockOSWrapper.AssertExpectations(t)
+
 	mockApplicationDirectory := &mocks.MockApplicationDirectory{}
 	defer mockApplicationDirectory.AssertExpectations(t)
 
@@ -111,7 +121,7 @@ func TestDirectoryFactory_Create_MkdirTempError(t *testing.T) {
 		Return("", expectedError).
 		Once()
 
-	factory := directorymanager.NewFactory(mockOSLayer, mockApplicationDirectory, mockMATLABFiles)
+	factory := directorymanager.NewFactory(mockOSLayer, mockOSWrapper, mockApplicationDirectory, mockMATLABFiles)
 
 	// Act
 	directory, err := factory.Create(mockLogger)
@@ -126,6 +136,9 @@ func TestDirectoryFactory_Create_PackageDirectoryMkdirError(t *testing.T) {
 	mockOSLayer := &mocks.MockOSLayer{}
 	defer mockOSLayer.AssertExpectations(t)
 
+	mockOSWrapper := &mocks.MockOSWrapper{}
+	defer mockOSWrapper.AssertExpectations(t)
+
 	mockApplicationDirectory := &mocks.MockApplicationDirectory{}
 	defer mockApplicationDirectory.AssertExpectations(t)
 
@@ -148,7 +161,7 @@ func TestDirectoryFactory_Create_PackageDirectoryMkdirError(t *testing.T) {
 		Return(expectedError).
 		Once()
 
-	factory := directorymanager.NewFactory(mockOSLayer, mockApplicationDirectory, mockMATLABFiles)
+	factory := directorymanager.NewFactory(mockOSLayer, mockOSWrapper, mockApplicationDirectory, mockMATLABFiles)
 
 	// Act
 	directory, err := factory.Create(mockLogger)
@@ -163,6 +176,9 @@ func TestDirectoryFactory_Create_WriteFileError(t *testing.T) {
 	mockOSLayer := &mocks.MockOSLayer{}
 	defer mockOSLayer.AssertExpectations(t)
 
+	mockOSWrapper := &mocks.MockOSWrapper{}
+	defer mockOSWrapper.AssertExpectations(t)
+
 	mockApplicationDirectory := &mocks.MockApplicationDirectory{}
 	defer mockApplicationDirectory.AssertExpectations(t)
 
@@ -201,7 +217,7 @@ func TestDirectoryFactory_Create_WriteFileError(t *testing.T) {
 		Return(expectedError).
 		Once()
 
-	factory := directorymanager.NewFactory(mockOSLayer, mockApplicationDirectory, mockMATLABFiles)
+	factory := directorymanager.NewFactory(mockOSLayer, mockOSWrapper, mockApplicationDirectory, mockMATLABFiles)
 
 	// Act
 	directory, err := factory.Create(mockLogger)
@@ -210,3 +226,287 @@ func TestDirectoryFactory_Create_WriteFileError(t *testing.T) {
 	require.ErrorIs(t, err, expectedError)
 	assert.Nil(t, directory)
 }
+
+func TestDirectoryFactory_Open_HappyPath(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockOSWrapper := &mocks.MockOSWrapper{}
+	defer mockOSWrapper.AssertExpectations(t)
+
+	mockApplicationDirectory := &mocks.MockApplicationDirectory{}
+	defer mockApplicationDirectory.AssertExpectations(t)
+
+	mockMATLABFiles := &mocks.MockMATLABFiles{}
+	defer mockMATLABFiles.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	expectedSessionDir := filepath.Join("home", "user", "shared-matlab")
+	packageDir := filepath.Join(expectedSessionDir, "+matlab_mcp")
+	expectedMATLABFiles := map[string][]byte{
+		"share.m": []byte("some content"),
+		"eval.m":  []byte("some other content"),
+	}
+
+	mockOSLayer.EXPECT().
+		MkdirAll(packageDir, os.FileMode(0o700)).
+		Return(nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(filepath.Join(expectedSessionDir, "matlab.pid")).
+		Return([]byte("4242"), nil).
+		Once()
+
+	mockOSWrapper.EXPECT().
+		FindProcess(4242).
+		Return(&osfacademocks.MockProcess{}).
+		Once()
+
+	mockMATLABFiles.EXPECT().
+		GetAll().
+		Return(expectedMATLABFiles).
+		Once()
+
+	for fileName, fileContent := range expectedMATLABFiles {
+		filePath := filepath.Join(packageDir, fileName)
+		mockOSLayer.EXPECT().
+			WriteFile(filePath, fileContent, os.FileMode(0o600)).
+			Return(nil).
+			Once()
+	}
+
+	factory := directorymanager.NewFactory(mockOSLayer, mockOSWrapper, mockApplicationDirectory, mockMATLABFiles)
+
+	// Act
+	directory, err := factory.Open(mockLogger, expectedSessionDir)
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotNil(t, directory)
+	assert.Equal(t, expectedSessionDir, directory.Path())
+	assert.Equal(t, filepath.Join(expectedSessionDir, "cert.pem"), directory.CertificateFile())
+}
+
+func TestDirectoryFactory_Open_MkdirAllError(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockOSWrapper := &mocks.MockOSWrapper{}
+	defer mockOSWrapper.AssertExpectations(t)
+
+	mockApplicationDirectory := &mocks.MockApplicationDirectory{}
+	defer mockApplicationDirectory.AssertExpectations(t)
+
+	mockMATLABFiles := &mocks.MockMATLABFiles{}
+	defer mockMATLABFiles.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	sessionDir := filepath.Join("home", "user", "shared-matlab")
+	expectedError := assert.AnError
+
+	mockOSLayer.EXPECT().
+		MkdirAll(filepath.Join(sessionDir, "+matlab_mcp"), os.FileMode(0o700)).
+		Return(expectedError).
+		Once()
+
+	factory := directorymanager.NewFactory(mockOSLayer, mockOSWrapper, mockApplicationDirectory, mockMATLABFiles)
+
+	// Act
+	directory, err := factory.Open(mockLogger, sessionDir)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Nil(t, directory)
+}
+
+func TestDirectoryFactory_Open_WriteFileError(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockOSWrapper := &mocks.MockOSWrapper{}
+	defer mockOSWrapper.AssertExpectations(t)
+
+	mockApplicationDirectory := &mocks.MockApplicationDirectory{}
+	defer mockApplicationDirectory.AssertExpectations(t)
+
+	mockMATLABFiles := &mocks.MockMATLABFiles{}
+	defer mockMATLABFiles.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	sessionDir := filepath.Join("home", "user", "shared-matlab")
+	packageDir := filepath.Join(sessionDir, "+matlab_mcp")
+	expectedError := assert.AnError
+	expectedMATLABFiles := map[string][]byte{
+		"share.m": []byte("some content"),
+	}
+
+	mockOSLayer.EXPECT().
+		MkdirAll(packageDir, os.FileMode(0o700)).
+		Return(nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(filepath.Join(sessionDir, "matlab.pid")).
+		Return(nil, os.ErrNotExist).
+		Once()
+
+	mockOSLayer.EXPECT().
+		RemoveAll(mock.AnythingOfType("string")).
+		Return(nil).
+		Times(2)
+
+	mockMATLABFiles.EXPECT().
+		GetAll().
+		Return(expectedMATLABFiles).
+		Once()
+
+	mockOSLayer.EXPECT().
+		WriteFile(filepath.Join(packageDir, "share.m"), expectedMATLABFiles["share.m"], os.FileMode(0o600)).
+		Return(expectedError).
+		Once()
+
+	factory := directorymanager.NewFactory(mockOSLayer, mockOSWrapper, mockApplicationDirectory, mockMATLABFiles)
+
+	// Act
+	directory, err := factory.Open(mockLogger, sessionDir)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Nil(t, directory)
+}
+
+func TestDirectoryFactory_Open_RemovesStaleEmbeddedConnectorDetails(t *testing.T) {
+	testCases := []struct {
+		name            string
+		processIDFile   []byte
+		readErr         error
+		expectedProcess int
+	}{
+		{
+			name:    "never shared",
+			readErr: os.ErrNotExist,
+		},
+		{
+			name:            "MATLAB exited",
+			processIDFile:   []byte("4242"),
+			expectedProcess: 4242,
+		},
+		{
+			name:          "invalid process ID",
+			processIDFile: []byte("not a pid"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockOSLayer := &mocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockOSWrapper := &mocks.MockOSWrapper{}
+			defer mockOSWrapper.AssertExpectations(t)
+
+			mockApplicationDirectory := &mocks.MockApplicationDirectory{}
+			defer mockApplicationDirectory.AssertExpectations(t)
+
+			mockMATLABFiles := &mocks.MockMATLABFiles{}
+			defer mockMATLABFiles.AssertExpectations(t)
+
+			mockLogger := testutils.NewInspectableLogger()
+
+			sessionDir := filepath.Join("home", "user", "shared-matlab")
+
+			mockOSLayer.EXPECT().
+				MkdirAll(filepath.Join(sessionDir, "+matlab_mcp"), os.FileMode(0o700)).
+				Return(nil).
+				Once()
+
+			mockOSLayer.EXPECT().
+				ReadFile(filepath.Join(sessionDir, "matlab.pid")).
+				Return(testCase.processIDFile, testCase.readErr).
+				Once()
+
+			if testCase.expectedProcess != 0 {
+				mockOSWrapper.EXPECT().
+					FindProcess(testCase.expectedProcess).
+					Return(nil).
+					Once()
+			}
+
+			mockOSLayer.EXPECT().
+				RemoveAll(filepath.Join(sessionDir, "connector.securePort")).
+				Return(nil).
+				Once()
+
+			mockOSLayer.EXPECT().
+				RemoveAll(filepath.Join(sessionDir, "matlab.pid")).
+				Return(nil).
+				Once()
+
+			mockMATLABFiles.EXPECT().
+				GetAll().
+				Return(map[string][]byte{}).
+				Once()
+
+			factory := directorymanager.NewFactory(mockOSLayer, mockOSWrapper, mockApplicationDirectory, mockMATLABFiles)
+
+			// Act
+			directory, err := factory.Open(mockLogger, sessionDir)
+
+			// Assert
+			require.NoError(t, err)
+			assert.NotNil(t, directory)
+		})
+	}
+}
+
+func TestDirectoryFactory_Open_RemoveStaleEmbeddedConnectorDetailsError(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockOSWrapper := &mocks.MockOSWrapper{}
+	defer mockOSWrapper.AssertExpectations(t)
+
+	mockApplicationDirectory := &mocks.MockApplicationDirectory{}
+	defer mockApplicationDirectory.AssertExpectations(t)
+
+	mockMATLABFiles := &mocks.MockMATLABFiles{}
+	defer mockMATLABFiles.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	sessionDir := filepath.Join("home", "user", "shared-matlab")
+	expectedError := assert.AnError
+
+	mockOSLayer.EXPECT().
+		MkdirAll(filepath.Join(sessionDir, "+matlab_mcp"), os.FileMode(0o700)).
+		Return(nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(filepath.Join(sessionDir, "matlab.pid")).
+		Return(nil, os.ErrNotExist).
+		Once()
+
+	mockOSLayer.EXPECT().
+		RemoveAll(filepath.Join(sessionDir, "connector.securePort")).
+		Return(expectedError).
+		Once()
+
+	factory := directorymanager.NewFactory(mockOSLayer, mockOSWrapper, mockApplicationDirectory, mockMATLABFiles)
+
+	// Act
+	directory, err := factory.Open(mockLogger, sessionDir)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Nil(t, directory)
+}
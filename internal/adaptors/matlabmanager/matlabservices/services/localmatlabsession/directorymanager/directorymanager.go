@@ -14,7 +14,11 @@ const defaultEmbeddedConnectorDetailsRetry = 500 * time.Millisecond
 const defaultCleanupTimeout = 2 * time.Minute
 const defaultCleanupRetry = 500 * time.Millisecond
 
+const matlabMCPPackage = "+matlab_mcp"
+
 const securePortFile = "connector.securePort"
+const apiKeyFile = "connector.apiKey"
+const matlabProcessIDFile = "matlab.pid"
 const certificateFile = "cert.pem"
 const certificateKeyFile = "cert.key"
 
@@ -91,6 +95,19 @@ func (m *directoryManager) GetEmbeddedConnectorDetails() (string, []byte, error)
 	}
 }
 
+func (m *directoryManager) APIKey() (string, error) {
+	apiKey, err := m.osLayer.ReadFile(filepath.Join(m.sessionDir, apiKeyFile))
+	if err != nil {
+		return "", fmt.Errorf("failed to read API key file: %w", err)
+	}
+
+	if len(apiKey) == 0 {
+		return "", fmt.Errorf("API key file is empty")
+	}
+
+	return string(apiKey), nil
+}
+
 func (m *directoryManager) Cleanup() error {
 	if m.sessionDir == "" {
 		return nil
@@ -0,0 +1,81 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package directorymanager_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession/directorymanager"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager/matlabservices/services/localmatlabsession/directorymanager"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDirectoryManager_APIKey_HappyPath(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	sessionDir := filepath.Join("home", "user", "shared-matlab")
+	expectedAPIKey := "test-api-key-12345"
+
+	mockOSLayer.EXPECT().
+		ReadFile(filepath.Join(sessionDir, "connector.apiKey")).
+		Return([]byte(expectedAPIKey), nil).
+		Once()
+
+	directoryManager := directorymanager.NewDirectoryManager(sessionDir, mockOSLayer)
+
+	// Act
+	apiKey, err := directoryManager.APIKey()
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedAPIKey, apiKey)
+}
+
+func TestDirectoryManager_APIKey_ReadFileError(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	sessionDir := filepath.Join("home", "user", "shared-matlab")
+	expectedError := assert.AnError
+
+	mockOSLayer.EXPECT().
+		ReadFile(filepath.Join(sessionDir, "connector.apiKey")).
+		Return(nil, expectedError).
+		Once()
+
+	directoryManager := directorymanager.NewDirectoryManager(sessionDir, mockOSLayer)
+
+	// Act
+	apiKey, err := directoryManager.APIKey()
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, apiKey)
+}
+
+func TestDirectoryManager_APIKey_EmptyFile(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	sessionDir := filepath.Join("home", "user", "shared-matlab")
+
+	mockOSLayer.EXPECT().
+		ReadFile(filepath.Join(sessionDir, "connector.apiKey")).
+		Return([]byte{}, nil).
+		Once()
+
+	directoryManager := directorymanager.NewDirectoryManager(sessionDir, mockOSLayer)
+
+	// Act
+	apiKey, err := directoryManager.APIKey()
+
+	// Assert
+	require.Error(t, err)
+	assert.Empty(t, apiKey)
+}
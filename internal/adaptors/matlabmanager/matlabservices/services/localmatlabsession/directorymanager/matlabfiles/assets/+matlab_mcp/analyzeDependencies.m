@@ -0,0 +1,40 @@
+function dependenciesJSON = analyzeDependencies(pathToAnalyze)
+    % analyzeDependencies returns the files and the products required to run
+    % the MATLAB code in a file or a folder as JSON. Each required product is
+    % flagged with whether it is installed in the current MATLAB session.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    if isfolder(pathToAnalyze)
+        filesToAnalyze = {};
+        for pattern = ["*.m", "*.mlx", "*.mlapp"]
+            listing = dir(fullfile(pathToAnalyze, "**", pattern));
+            filesToAnalyze = [filesToAnalyze, fullfile({listing.folder}, {listing.name})]; %#ok<AGROW>
+        end
+    else
+        filesToAnalyze = {char(pathToAnalyze)};
+    end
+
+    if isempty(filesToAnalyze)
+        dependenciesJSON = jsonencode(struct("files", {{}}, "products", {{}}));
+        return
+    end
+
+    [requiredFiles, requiredProducts] = matlab.codetools.requiredFilesAndProducts(filesToAnalyze);
+
+    installedProducts = ver();
+    installedProductNames = string({installedProducts.Name});
+
+    productList = {};
+    for idx = 1:numel(requiredProducts)
+        productList{end+1} = struct( ...
+            "name", string(requiredProducts(idx).Name), ...
+            "version", string(requiredProducts(idx).Version), ...
+            "product_number", requiredProducts(idx).ProductNumber, ...
+            "certain", logical(requiredProducts(idx).Certain), ...
+            "installed", any(installedProductNames == string(requiredProducts(idx).Name))); %#ok<AGROW>
+    end
+
+    % The cell arrays keep a single file or product encoded as a JSON array
+    dependenciesJSON = jsonencode(struct("files", {cellstr(requiredFiles)}, "products", {productList}));
+end
@@ -0,0 +1,78 @@
+function benchmarkJSON = benchmarkCode(setupCode, code, warmupRuns, runs, useTimeit)
+    % benchmarkCode times the code in the base workspace, after running the
+    % setup code, if any, and the warmup runs. The code is either a
+    % snippet, or an expression returning a function handle taking no
+    % inputs, such as @() myFunction(x).
+    % Returns, as JSON, the time of each run, the timeit estimate when
+    % requested, the change in the memory MATLAB uses across a run, where
+    % MATLAB can measure it, and the error thrown, if any.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    setupCode = string(setupCode);
+    code = string(code);
+    warmupRuns = str2double(warmupRuns);
+    runs = str2double(runs);
+    useTimeit = string(useTimeit) == "true";
+
+    times = {};
+    timeitTime = 0;
+    memoryAvailable = ispc;
+    memoryBytes = 0;
+    errorMessage = "";
+
+    try
+        if setupCode ~= ""
+            evalc("evalin(""base"", setupCode)");
+        end
+
+        if startsWith(strtrim(code), "@")
+            benchmarkedFunction = evalin("base", code);
+        else
+            benchmarkedFunction = @() evalin("base", code);
+        end
+
+        % The output of the code is not returned, and would otherwise flood the command window
+        evalc("runTimes = timeRuns(benchmarkedFunction, warmupRuns, runs);");
+        % num2cell keeps a single run encoded as a JSON array
+        times = num2cell(runTimes);
+
+        if useTimeit
+            evalc("timeitTime = timeit(benchmarkedFunction, 0);");
+        end
+
+        if memoryAvailable
+            evalc("memoryBytes = measureMemory(benchmarkedFunction);");
+        end
+    catch ME
+        errorMessage = string(ME.message);
+    end
+
+    benchmarkJSON = jsonencode(struct( ...
+        "error", errorMessage, ...
+        "times", {times}, ...
+        "timeit_time", timeitTime, ...
+        "memory_available", memoryAvailable, ...
+        "memory_bytes", memoryBytes));
+end
+
+function runTimes = timeRuns(benchmarkedFunction, warmupRuns, runs)
+    for idx = 1:warmupRuns
+        benchmarkedFunction();
+    end
+
+    runTimes = zeros(1, runs);
+    for idx = 1:runs
+        startTime = tic;
+        benchmarkedFunction();
+        runTimes(idx) = toc(startTime);
+    end
+end
+
+function memoryBytes = measureMemory(benchmarkedFunction)
+    % The memory function is only available on Windows
+    memoryBefore = memory;
+    benchmarkedFunction();
+    memoryAfter = memory;
+    memoryBytes = memoryAfter.MemUsedMATLAB - memoryBefore.MemUsedMATLAB;
+end
@@ -0,0 +1,42 @@
+function checkJSON = checkSimulinkModel(model, checkIDsJSON, configurationFile)
+    % checkSimulinkModel runs Model Advisor checks on a loaded model, either
+    % the checks with the given IDs, as a JSON array, or the checks of a
+    % Model Advisor configuration file, without displaying the results.
+    % Returns, as JSON, the number of checks which passed, failed, warned or
+    % did not run, the status of each check, and the path to the report.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    model = string(model);
+    configurationFile = string(configurationFile);
+
+    matlab_mcp.getLoadedModel(model);
+
+    if configurationFile ~= ""
+        systemResults = ModelAdvisor.run(char(model), "Configuration", char(configurationFile), "DisplayResults", "None");
+    else
+        checkIDs = cellstr(jsondecode(string(checkIDsJSON)));
+        systemResults = ModelAdvisor.run(char(model), checkIDs, "DisplayResults", "None");
+    end
+
+    systemResult = systemResults{1};
+
+    % A cell array keeps a single check encoded as a JSON array
+    checks = {};
+    for idx = 1:numel(systemResult.CheckResultObjs)
+        checkResult = systemResult.CheckResultObjs(idx);
+        checks{end+1} = struct( ...
+            "id", string(checkResult.checkID), ...
+            "name", string(checkResult.checkName), ...
+            "status", string(checkResult.status)); %#ok<AGROW>
+    end
+
+    checkJSON = jsonencode(struct( ...
+        "system", string(systemResult.system), ...
+        "passed", systemResult.numPass, ...
+        "failed", systemResult.numFail, ...
+        "warnings", systemResult.numWarn, ...
+        "not_run", systemResult.numNotRun, ...
+        "report", string(systemResult.Report), ...
+        "checks", {checks}));
+end
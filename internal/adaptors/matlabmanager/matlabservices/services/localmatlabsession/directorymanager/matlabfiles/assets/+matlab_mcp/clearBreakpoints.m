@@ -0,0 +1,21 @@
+function breakpointsJSON = clearBreakpoints(filePath, line)
+    % clearBreakpoints clears the breakpoint at a line of a file, or all the
+    % breakpoints of the file when there is no line. Without a file, it clears
+    % all the breakpoints, including the one pausing the execution on errors.
+    % Returns the remaining breakpoints, as listBreakpoints does.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    filePath = string(filePath);
+    line = string(line);
+
+    if filePath == ""
+        dbclear all
+    elseif line == ""
+        dbclear("in", filePath);
+    else
+        dbclear("in", filePath, "at", line);
+    end
+
+    breakpointsJSON = matlab_mcp.listBreakpoints();
+end
@@ -0,0 +1,9 @@
+function deleteIfExists(file)
+    % deleteIfExists deletes the file, unless it does not exist.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    if isfile(file)
+        delete(file);
+    end
+end
@@ -0,0 +1,47 @@
+function functionNamesJSON = findFunctions(prefix)
+    % findFunctions returns, as JSON, the sorted names of the functions and
+    % classes on the MATLAB path which start with the prefix. When the prefix
+    % has a package name, e.g. matlab.unittest.Test, the members of the
+    % package are returned instead, with their package name.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    prefix = char(prefix);
+    lastDot = find(prefix == '.', 1, 'last');
+
+    if isempty(lastDot)
+        functionNames = findOnPath(prefix);
+    else
+        functionNames = findInPackage(prefix(1:lastDot-1));
+    end
+
+    functionNames = unique(functionNames(startsWith(functionNames, prefix)));
+
+    % cellstr keeps a single function name encoded as a JSON array
+    functionNamesJSON = jsonencode(cellstr(functionNames));
+end
+
+function functionNames = findOnPath(prefix)
+    folders = [strsplit(path, pathsep), {pwd}];
+    patterns = [prefix + ["*.m", "*.mlx", "*.p", "*." + mexext], "@" + prefix + "*", "+" + prefix + "*"];
+
+    functionNames = {};
+    for idx = 1:numel(folders)
+        for pattern = patterns
+            listing = dir(fullfile(folders{idx}, pattern));
+            functionNames = [functionNames, regexprep({listing.name}, '^[@+]|\.\w+$', '')]; %#ok<AGROW>
+        end
+    end
+end
+
+function functionNames = findInPackage(packageName)
+    functionNames = {};
+
+    package = meta.package.fromName(packageName);
+    if isempty(package)
+        return
+    end
+
+    % The names of the functions are not qualified, unlike the names of the classes and subpackages
+    functionNames = [strcat(packageName, '.', {package.FunctionList.Name}), {package.ClassList.Name}, {package.PackageList.Name}];
+end
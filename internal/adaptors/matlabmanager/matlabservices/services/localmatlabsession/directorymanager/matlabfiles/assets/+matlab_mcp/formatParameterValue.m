@@ -0,0 +1,16 @@
+function formattedValue = formatParameterValue(value)
+    % formatParameterValue returns the value of a block or model parameter as
+    % a string. Most parameters are character vectors already, the numeric
+    % and logical ones are formatted as MATLAB expressions, and only the
+    % class of the others is returned.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    if ischar(value) || isstring(value)
+        formattedValue = string(value);
+    elseif (isnumeric(value) || islogical(value)) && ismatrix(value)
+        formattedValue = string(mat2str(value));
+    else
+        formattedValue = "<" + class(value) + ">";
+    end
+end
@@ -0,0 +1,68 @@
+function stateJSON = getDebugState()
+    % getDebugState returns, as JSON, the frames of the code paused in the
+    % debugger, innermost first, with the name, size, class and a short
+    % display of the value of the variables of the innermost frame.
+    % This relies on how MATLAB queues the requests: while the code runs,
+    % this function only runs once the code is done, so there are no frames,
+    % and while the code is paused, it runs at the debug prompt, so that its
+    % caller workspace is the one of the paused frame.
+    % The variables of the outer frames are not listed, as dbup and dbdown
+    % would move the workspace of this function rather than the one of the
+    % debug prompt.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    stack = dbstack("-completenames");
+    % The first frame is the one of this function
+    stack = stack(2:end);
+
+    % The caller workspace is the one of the innermost frame
+    variables = evalin("caller", "whos");
+    variableList = {};
+    for variableIdx = 1:numel(variables)
+        value = "";
+        if isDisplayed(variables(variableIdx))
+            value = displayValue(evalin("caller", variables(variableIdx).name));
+        end
+
+        variableList{end+1} = struct( ...
+            "name", string(variables(variableIdx).name), ...
+            "size", {num2cell(variables(variableIdx).size)}, ...
+            "class", string(variables(variableIdx).class), ...
+            "value", value); %#ok<AGROW>
+    end
+
+    frames = {};
+    for idx = 1:numel(stack)
+        frameVariables = {};
+        if idx == 1
+            frameVariables = variableList;
+        end
+
+        frames{end+1} = struct( ...
+            "name", string(stack(idx).name), ...
+            "file", string(stack(idx).file), ...
+            "line", stack(idx).line, ...
+            "variables", {frameVariables}); %#ok<AGROW>
+    end
+
+    % The cell arrays keep a single frame, variable or dimension encoded as a JSON array
+    stateJSON = jsonencode(struct("frames", {frames}));
+end
+
+function displayed = isDisplayed(variable)
+    % Only the values of small numeric, logical and text variables are
+    % displayed, to keep the state short
+    displayedClasses = ["double", "single", "logical", "char", "string", ...
+        "int8", "int16", "int32", "int64", "uint8", "uint16", "uint32", "uint64"];
+    displayed = prod(variable.size) <= 10 && any(variable.class == displayedClasses);
+end
+
+function text = displayValue(value)
+    try
+        text = strtrim(string(formattedDisplayText(value)));
+    catch
+        % The value is only informative
+        text = "";
+    end
+end
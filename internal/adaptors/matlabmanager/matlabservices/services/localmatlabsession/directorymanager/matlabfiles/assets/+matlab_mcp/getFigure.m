@@ -0,0 +1,24 @@
+function pngBase64 = getFigure(figureNumber)
+    % getFigure returns a PNG image of the figure with the given number,
+    % encoded as base64. Hidden figures are included.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    figureNumber = str2double(figureNumber);
+
+    fig = findall(groot, "Type", "figure", "Number", figureNumber);
+    if isempty(fig)
+        error("matlab_mcp:figureNotFound", "There is no figure number %d.", figureNumber);
+    end
+
+    pngFile = tempname() + ".png";
+    deletePNGFile = onCleanup(@() delete(pngFile));
+
+    exportgraphics(fig(1), pngFile);
+
+    fileID = fopen(pngFile, "r");
+    pngBytes = fread(fileID, Inf, "*uint8");
+    fclose(fileID);
+
+    pngBase64 = matlab.net.base64encode(pngBytes);
+end
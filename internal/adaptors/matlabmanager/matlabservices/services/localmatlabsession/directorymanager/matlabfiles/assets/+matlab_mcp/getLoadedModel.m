@@ -0,0 +1,13 @@
+function modelName = getLoadedModel(system)
+    % getLoadedModel returns the name of the model of the system, which is
+    % either a model or a block path, and throws an error when the model is
+    % not loaded.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    modelName = extractBefore(string(system) + "/", "/");
+
+    if modelName == "" || ~bdIsLoaded(modelName)
+        error("matlab_mcp:modelNotLoaded", "The model %s is not loaded, load it with the load_simulink_model tool first.", modelName);
+    end
+end
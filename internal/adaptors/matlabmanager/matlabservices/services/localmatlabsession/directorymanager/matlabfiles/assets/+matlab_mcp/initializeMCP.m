@@ -5,6 +5,9 @@ function initializeMCP()
 
     connector.ensureServiceOn();
 
+    % Record the starting directory so the session state can be reset to it later
+    setenv("MW_MCP_STARTING_DIR", pwd);
+
     sessionDir = getenv("MW_MCP_SESSION_DIR");
     securePortFile = fullfile(sessionDir, "connector.securePort");
 
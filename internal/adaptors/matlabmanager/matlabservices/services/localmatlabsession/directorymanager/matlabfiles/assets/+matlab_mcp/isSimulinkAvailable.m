@@ -0,0 +1,10 @@
+function availableJSON = isSimulinkAvailable()
+    % isSimulinkAvailable returns, as JSON, whether Simulink is installed
+    % and licensed. This is much cheaper than listing the installed products.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    available = license("test", "Simulink") && exist("simulink", "file") > 0;
+
+    availableJSON = jsonencode(struct("available", available));
+end
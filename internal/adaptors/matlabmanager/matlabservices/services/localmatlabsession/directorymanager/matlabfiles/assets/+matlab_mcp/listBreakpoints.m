@@ -0,0 +1,26 @@
+function breakpointsJSON = listBreakpoints()
+    % listBreakpoints returns, as JSON, the file, line and condition of the
+    % breakpoints, along with whether the execution pauses on errors.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    status = dbstatus("-completenames");
+
+    % A cell array keeps a single breakpoint encoded as a JSON array
+    breakpoints = {};
+    stopOnError = false;
+    for idx = 1:numel(status)
+        if strcmp(status(idx).cond, "error")
+            stopOnError = true;
+        end
+
+        for lineIdx = 1:numel(status(idx).line)
+            breakpoints{end+1} = struct( ...
+                "file", string(status(idx).file), ...
+                "line", status(idx).line(lineIdx), ...
+                "condition", string(status(idx).expression{lineIdx})); %#ok<AGROW>
+        end
+    end
+
+    breakpointsJSON = jsonencode(struct("breakpoints", {breakpoints}, "stop_on_error", stopOnError));
+end
@@ -0,0 +1,52 @@
+function installedProductsJSON = listInstalledProducts()
+    % listInstalledProducts returns the installed MathWorks products and the
+    % other installed add-ons (e.g. support packages, community add-ons) as JSON.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    products = ver();
+    productNames = string({products.Name});
+
+    try
+        addons = matlab.addons.installedAddons();
+        addonNames = string(addons.Name);
+        addonVersions = string(addons.Version);
+        addonEnabled = logical(addons.Enabled);
+        addonIdentifiers = string(addons.Identifier);
+    catch
+        addonNames = strings(0, 1);
+        addonVersions = strings(0, 1);
+        addonEnabled = false(0, 1);
+        addonIdentifiers = strings(0, 1);
+    end
+
+    productList = {};
+    for idx = 1:numel(products)
+        identifier = addonIdentifiers(addonNames == productNames(idx));
+        if isempty(identifier)
+            identifier = "";
+        end
+
+        productList{end+1} = struct( ...
+            "name", productNames(idx), ...
+            "version", string(products(idx).Version), ...
+            "release", erase(string(products(idx).Release), ["(", ")"]), ...
+            "identifier", identifier(1)); %#ok<AGROW>
+    end
+
+    addonList = {};
+    for idx = 1:numel(addonNames)
+        if any(productNames == addonNames(idx))
+            continue
+        end
+
+        addonList{end+1} = struct( ...
+            "name", addonNames(idx), ...
+            "version", addonVersions(idx), ...
+            "enabled", addonEnabled(idx), ...
+            "identifier", addonIdentifiers(idx)); %#ok<AGROW>
+    end
+
+    % The cell arrays keep a single product or add-on encoded as a JSON array
+    installedProductsJSON = jsonencode(struct("products", {productList}, "addons", {addonList}));
+end
@@ -0,0 +1,62 @@
+function blocksJSON = listSimulinkBlocks(system, searchDepth, includeParameters)
+    % listSimulinkBlocks returns, as JSON, the blocks of the system, which is
+    % either a loaded model or a subsystem of it, looking under the masks and
+    % into the library links. A search depth of 0 lists the blocks of all
+    % the nested subsystems. The dialog parameters of each block are
+    % returned too when requested.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    system = string(system);
+    searchDepth = str2double(searchDepth);
+    includeParameters = string(includeParameters) == "true";
+
+    matlab_mcp.getLoadedModel(system);
+
+    options = {"LookUnderMasks", "all", "FollowLinks", "on"};
+    if searchDepth > 0
+        options = [options, {"SearchDepth", searchDepth}];
+    end
+
+    blockPaths = string(find_system(char(system), options{:}, "Type", "Block"));
+    % A subsystem is found along with its blocks
+    blockPaths(blockPaths == system) = [];
+
+    % Cell arrays keep a single block, or a single parameter, encoded as a JSON array
+    blocks = {};
+    for idx = 1:numel(blockPaths)
+        blockType = string(get_param(blockPaths(idx), "BlockType"));
+
+        block = struct( ...
+            "path", blockPaths(idx), ...
+            "name", string(get_param(blockPaths(idx), "Name")), ...
+            "parent", string(get_param(blockPaths(idx), "Parent")), ...
+            "type", blockType, ...
+            "mask_type", string(get_param(blockPaths(idx), "MaskType")), ...
+            "is_subsystem", blockType == "SubSystem");
+
+        if includeParameters
+            block.parameters = dialogParameters(blockPaths(idx));
+        end
+
+        blocks{end+1} = block; %#ok<AGROW>
+    end
+
+    blocksJSON = jsonencode(struct("blocks", {blocks}));
+end
+
+function parameters = dialogParameters(blockPath)
+    parameters = {};
+
+    dialogParameterNames = get_param(blockPath, "DialogParameters");
+    if isempty(dialogParameterNames)
+        return
+    end
+
+    names = string(fieldnames(dialogParameterNames));
+    for idx = 1:numel(names)
+        parameters{end+1} = struct( ...
+            "name", names(idx), ...
+            "value", matlab_mcp.formatParameterValue(get_param(blockPath, names(idx)))); %#ok<AGROW>
+    end
+end
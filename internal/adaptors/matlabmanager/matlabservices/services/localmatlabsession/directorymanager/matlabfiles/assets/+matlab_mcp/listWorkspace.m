@@ -0,0 +1,24 @@
+function variablesJSON = listWorkspace()
+    % listWorkspace returns, as JSON, the name, size, class and number of
+    % bytes of the variables in the base workspace, along with the numbers of
+    % the open figures.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    variables = evalin("base", "whos");
+
+    variableList = {};
+    for idx = 1:numel(variables)
+        variableList{end+1} = struct( ...
+            "name", string(variables(idx).name), ...
+            "size", {num2cell(variables(idx).size)}, ...
+            "class", string(variables(idx).class), ...
+            "bytes", variables(idx).bytes); %#ok<AGROW>
+    end
+
+    figures = findall(groot, "Type", "figure");
+    figureNumbers = num2cell(sort([figures.Number]));
+
+    % The cell arrays keep a single variable, dimension or figure encoded as a JSON array
+    variablesJSON = jsonencode(struct("variables", {variableList}, "figures", {figureNumbers}));
+end
@@ -0,0 +1,19 @@
+function modelJSON = loadSimulinkModel(modelPath)
+    % loadSimulinkModel loads the model into memory, without opening it, and
+    % returns, as JSON, its name, file, solver, start and stop times, and
+    % number of blocks.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    modelHandle = load_system(string(modelPath));
+
+    blocks = find_system(modelHandle, "LookUnderMasks", "all", "FollowLinks", "on", "Type", "Block");
+
+    modelJSON = jsonencode(struct( ...
+        "name", string(get_param(modelHandle, "Name")), ...
+        "file", string(get_param(modelHandle, "FileName")), ...
+        "solver", string(get_param(modelHandle, "Solver")), ...
+        "start_time", string(get_param(modelHandle, "StartTime")), ...
+        "stop_time", string(get_param(modelHandle, "StopTime")), ...
+        "block_count", numel(blocks)));
+end
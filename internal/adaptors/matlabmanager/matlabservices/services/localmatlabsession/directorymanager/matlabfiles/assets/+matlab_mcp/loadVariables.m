@@ -0,0 +1,20 @@
+function loadVariables(matBase64)
+    % loadVariables loads the variables of a MAT file encoded as base64, as
+    % returned by saveVariables, into the base workspace.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    matFile = tempname() + ".mat";
+    deleteMATFile = onCleanup(@() delete(matFile));
+
+    fileID = fopen(matFile, "w");
+    fwrite(fileID, matlab.net.base64decode(matBase64), "uint8");
+    fclose(fileID);
+
+    variables = load(matFile);
+
+    variableNames = fieldnames(variables);
+    for idx = 1:numel(variableNames)
+        assignin("base", variableNames{idx}, variables.(variableNames{idx}));
+    end
+end
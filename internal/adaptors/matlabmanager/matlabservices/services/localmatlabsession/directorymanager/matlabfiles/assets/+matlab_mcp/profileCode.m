@@ -0,0 +1,75 @@
+function profileJSON = profileCode(code)
+    % profileCode runs the code in the base workspace under the profiler, and
+    % returns, as JSON, its output, its error if any, how long it took, and
+    % the functions the profiler recorded, with their call counts, total
+    % times, the total times of the functions they called, and their slowest
+    % executed lines.
+    % The functions of this package are left out, so that only the code
+    % itself is profiled.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    maxLinesPerFunction = 20;
+
+    output = "";
+    errorMessage = "";
+
+    profile("clear");
+    profile("on");
+    startTime = tic;
+    try
+        output = string(evalc("evalin(""base"", code)"));
+    catch ME
+        errorMessage = string(ME.message);
+    end
+    totalTime = toc(startTime);
+    profile("off");
+
+    info = profile("info");
+    packageFolder = fileparts(mfilename("fullpath"));
+
+    functions = {};
+    for idx = 1:numel(info.FunctionTable)
+        entry = info.FunctionTable(idx);
+        if startsWith(entry.FileName, packageFolder)
+            continue
+        end
+
+        children = {};
+        for childIdx = 1:numel(entry.Children)
+            children{end+1} = struct( ...
+                "index", entry.Children(childIdx).Index, ...
+                "calls", entry.Children(childIdx).NumCalls, ...
+                "total_time", entry.Children(childIdx).TotalTime); %#ok<AGROW>
+        end
+
+        % Each row of the executed lines is the line number, its number of calls and its time
+        executedLines = sortrows(entry.ExecutedLines, -3);
+        lines = {};
+        for lineIdx = 1:min(size(executedLines, 1), maxLinesPerFunction)
+            lines{end+1} = struct( ...
+                "line", executedLines(lineIdx, 1), ...
+                "calls", executedLines(lineIdx, 2), ...
+                "time", executedLines(lineIdx, 3)); %#ok<AGROW>
+        end
+
+        functions{end+1} = struct( ...
+            "index", idx, ...
+            "name", string(entry.FunctionName), ...
+            "file", string(entry.FileName), ...
+            "type", string(entry.Type), ...
+            "calls", entry.NumCalls, ...
+            "total_time", entry.TotalTime, ...
+            "children", {children}, ...
+            "lines", {lines}); %#ok<AGROW>
+    end
+
+    profile("clear");
+
+    % The cell arrays keep a single function, child or line encoded as a JSON array
+    profileJSON = jsonencode(struct( ...
+        "output", output, ...
+        "error", errorMessage, ...
+        "total_time", totalTime, ...
+        "functions", {functions}));
+end
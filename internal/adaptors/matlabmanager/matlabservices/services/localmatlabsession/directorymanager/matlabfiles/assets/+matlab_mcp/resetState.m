@@ -0,0 +1,30 @@
+function resetState(clearVariables, closeFigures, clearFunctions, restoreDefaultPath, changeToStartingDirectory)
+    % resetState resets the state of the MATLAB session without restarting it.
+    % Clearing functions also clears classes, which removes all variables from
+    % the base workspace.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    if closeFigures
+        close("all", "force");
+    end
+
+    if clearVariables
+        evalin("base", "clearvars");
+    end
+
+    if clearFunctions
+        evalin("base", "clear functions");
+        evalin("base", "clear classes");
+    end
+
+    if restoreDefaultPath
+        restoredefaultpath();
+        % The session directory provides the +matlab_mcp package, so it must stay on the path
+        addpath(getenv("MW_MCP_SESSION_DIR"));
+    end
+
+    if changeToStartingDirectory
+        cd(getenv("MW_MCP_STARTING_DIR"));
+    end
+end
@@ -0,0 +1,23 @@
+function matBase64 = saveVariables(varargin)
+    % saveVariables returns a MAT file with the given variables of the base
+    % workspace, encoded as base64, so that they can be loaded into another
+    % MATLAB session with loadVariables.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    variables = struct();
+    for idx = 1:numel(varargin)
+        variables.(varargin{idx}) = evalin("base", varargin{idx});
+    end
+
+    matFile = tempname() + ".mat";
+    deleteMATFile = onCleanup(@() matlab_mcp.deleteIfExists(matFile));
+
+    save(matFile, "-struct", "variables");
+
+    fileID = fopen(matFile, "r");
+    matBytes = fread(fileID, Inf, "*uint8");
+    fclose(fileID);
+
+    matBase64 = matlab.net.base64encode(matBytes);
+end
@@ -0,0 +1,21 @@
+function breakpointsJSON = setBreakpoint(filePath, line, condition)
+    % setBreakpoint pauses the execution at a line of a file, when the
+    % condition, if any, is true. Without a file, it pauses the execution
+    % when an error is thrown, like dbstop if error.
+    % Returns the breakpoints, as listBreakpoints does.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    filePath = string(filePath);
+    condition = string(condition);
+
+    if filePath == ""
+        dbstop if error
+    elseif condition == ""
+        dbstop("in", filePath, "at", string(line));
+    else
+        dbstop("in", filePath, "at", string(line), "if", condition);
+    end
+
+    breakpointsJSON = matlab_mcp.listBreakpoints();
+end
@@ -0,0 +1,30 @@
+function parametersJSON = setSimulinkBlockParameters(blockPath, parametersToSetJSON)
+    % setSimulinkBlockParameters sets the parameters of a block of a loaded
+    % model, given as a JSON array of name and value pairs, without saving
+    % the model. Returns, as JSON, the values of the parameters once set.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    blockPath = string(blockPath);
+
+    matlab_mcp.getLoadedModel(blockPath);
+
+    parametersToSet = jsondecode(string(parametersToSetJSON));
+    if iscell(parametersToSet)
+        parametersToSet = [parametersToSet{:}];
+    end
+
+    for idx = 1:numel(parametersToSet)
+        set_param(blockPath, parametersToSet(idx).name, parametersToSet(idx).value);
+    end
+
+    % A cell array keeps a single parameter encoded as a JSON array
+    parameters = {};
+    for idx = 1:numel(parametersToSet)
+        parameters{end+1} = struct( ...
+            "name", string(parametersToSet(idx).name), ...
+            "value", matlab_mcp.formatParameterValue(get_param(blockPath, parametersToSet(idx).name))); %#ok<AGROW>
+    end
+
+    parametersJSON = jsonencode(struct("parameters", {parameters}));
+end
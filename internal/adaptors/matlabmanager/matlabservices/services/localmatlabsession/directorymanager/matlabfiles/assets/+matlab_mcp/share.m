@@ -0,0 +1,66 @@
+function share()
+    % share makes this MATLAB session available to the MATLAB MCP Core Server.
+    % The connection details are written to the folder containing the
+    % +matlab_mcp package, which the server reads to attach to this session.
+    % Unless MATLAB was started with the MWAPIKEY and MW_CERTFILE environment
+    % variables set, a new API key and certificate are generated, and the
+    % connector is restarted to use them.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    sessionDir = fileparts(fileparts(mfilename("fullpath")));
+    sessionCertificateFile = fullfile(sessionDir, "cert.pem");
+
+    apiKey = getenv("MWAPIKEY");
+    certificateFile = getenv("MW_CERTFILE");
+    if isempty(apiKey) || isempty(certificateFile)
+        apiKey = char(java.util.UUID.randomUUID());
+        certificateFile = sessionCertificateFile;
+        certificateKeyFile = fullfile(sessionDir, "cert.key");
+
+        % The connector creates the certificate and its key when it starts
+        matlab_mcp.deleteIfExists(certificateFile);
+        matlab_mcp.deleteIfExists(certificateKeyFile);
+
+        setenv("MWAPIKEY", apiKey);
+        setenv("MW_CERTFILE", certificateFile);
+        setenv("MW_PKEYFILE", certificateKeyFile);
+
+        % The connector only reads its API key and certificate when it starts
+        connector.ensureServiceOff();
+    end
+
+    connector.ensureServiceOn();
+
+    setenv("MW_MCP_SESSION_DIR", sessionDir);
+
+    % Record the starting directory so the session state can be reset to it later
+    setenv("MW_MCP_STARTING_DIR", pwd);
+
+    if certificateFile ~= sessionCertificateFile
+        copyfile(certificateFile, sessionCertificateFile, "f");
+    end
+
+    apiKeyFile = fullfile(sessionDir, "connector.apiKey");
+    writeUserOnlyFile(apiKeyFile, apiKey);
+
+    % The server checks that this MATLAB is still running before using the port, in case it was shared earlier
+    writeUserOnlyFile(fullfile(sessionDir, "matlab.pid"), sprintf("%d", feature("getpid")));
+
+    % The port is written last, as the MCP server waits for it before reading the other details
+    securePortFileID = fopen(fullfile(sessionDir, "connector.securePort"), "w");
+    closeSecurePortFile = onCleanup(@() fclose(securePortFileID));
+    fprintf(securePortFileID, "%d", connector.securePort());
+end
+
+function writeUserOnlyFile(file, content)
+    % The file is restricted to the current user before writing its content
+    fclose(fopen(file, "w"));
+    if isunix
+        fileattrib(file, "-r -w -x", "go");
+    end
+
+    fileID = fopen(file, "w");
+    closeFile = onCleanup(@() fclose(fileID));
+    fprintf(fileID, "%s", content);
+end
@@ -0,0 +1,107 @@
+function simulationJSON = simulateSimulinkModel(model, stopTime, maxPoints)
+    % simulateSimulinkModel simulates a loaded model, until the stop time if
+    % any, or else until the stop time of the model. Returns, as JSON, the
+    % error of the simulation if any, how long it took, and the signals
+    % logged by signal logging and output logging, each with its time and
+    % the values of its channels, downsampled to at most maxPoints samples.
+    % The elements of the bus signals are returned as separate signals.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    model = string(model);
+    stopTime = string(stopTime);
+    maxPoints = str2double(maxPoints);
+
+    matlab_mcp.getLoadedModel(model);
+
+    options = {"ReturnWorkspaceOutputs", "on", "CaptureErrors", "on"};
+    if stopTime ~= ""
+        options = [options, {"StopTime", char(stopTime)}];
+    end
+
+    simulationTimer = tic;
+    simulationOutput = sim(char(model), options{:});
+    simulationTime = toc(simulationTimer);
+
+    signals = {};
+    datasetNames = unique([ ...
+        string(get_param(model, "SignalLoggingName")), ...
+        string(get_param(model, "OutputSaveName"))]);
+    for datasetName = datasetNames
+        if ~any(string(simulationOutput.who) == datasetName)
+            continue
+        end
+
+        dataset = simulationOutput.get(datasetName);
+        if ~isa(dataset, "Simulink.SimulationData.Dataset")
+            continue
+        end
+
+        for idx = 1:dataset.numElements
+            element = dataset.getElement(idx);
+            if ~isa(element, "Simulink.SimulationData.Signal")
+                continue
+            end
+
+            blockPath = "";
+            if element.BlockPath.getLength() > 0
+                blockPath = string(element.BlockPath.getBlock(element.BlockPath.getLength()));
+            end
+
+            source = struct( ...
+                "source", datasetName, ...
+                "block_path", blockPath, ...
+                "port_index", element.PortIndex);
+
+            signals = [signals, encodeValues(string(element.Name), element.Values, source, maxPoints)]; %#ok<AGROW>
+        end
+    end
+
+    % The cell arrays keep a single signal, channel or sample encoded as a JSON array
+    simulationJSON = jsonencode(struct( ...
+        "error", string(simulationOutput.ErrorMessage), ...
+        "simulation_time", simulationTime, ...
+        "signals", {signals}));
+end
+
+function signals = encodeValues(name, values, source, maxPoints)
+    signals = {};
+
+    if isstruct(values)
+        % The values of a bus signal are a structure of the values of its elements
+        for fieldName = string(fieldnames(values))'
+            signals = [signals, encodeValues(name + "." + fieldName, values.(fieldName), source, maxPoints)]; %#ok<AGROW>
+        end
+        return
+    end
+
+    if ~isa(values, "timeseries")
+        return
+    end
+
+    time = values.Time;
+    sampleCount = numel(time);
+
+    data = double(values.Data);
+    if ~values.IsTimeFirst
+        data = permute(data, [ndims(data), 1:ndims(data) - 1]);
+    end
+    data = reshape(data, sampleCount, []);
+
+    if sampleCount > maxPoints
+        samples = unique(round(linspace(1, sampleCount, maxPoints)));
+        time = time(samples);
+        data = data(samples, :);
+    end
+
+    channels = cell(1, size(data, 2));
+    for channelIdx = 1:size(data, 2)
+        channels{channelIdx} = num2cell(data(:, channelIdx));
+    end
+
+    signal = source;
+    signal.name = name;
+    signal.time = num2cell(time);
+    signal.channels = channels;
+    signals = {signal};
+end
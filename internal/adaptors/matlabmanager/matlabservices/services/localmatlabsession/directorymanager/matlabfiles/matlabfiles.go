@@ -13,6 +13,78 @@ var mcpEval []byte
 //go:embed assets/+matlab_mcp/getOrStashExceptions.m
 var getOrStashExceptions []byte
 
+//go:embed assets/+matlab_mcp/resetState.m
+var resetState []byte
+
+//go:embed assets/+matlab_mcp/saveVariables.m
+var saveVariables []byte
+
+//go:embed assets/+matlab_mcp/loadVariables.m
+var loadVariables []byte
+
+//go:embed assets/+matlab_mcp/share.m
+var share []byte
+
+//go:embed assets/+matlab_mcp/deleteIfExists.m
+var deleteIfExists []byte
+
+//go:embed assets/+matlab_mcp/listInstalledProducts.m
+var listInstalledProducts []byte
+
+//go:embed assets/+matlab_mcp/analyzeDependencies.m
+var analyzeDependencies []byte
+
+//go:embed assets/+matlab_mcp/findFunctions.m
+var findFunctions []byte
+
+//go:embed assets/+matlab_mcp/getFigure.m
+var getFigure []byte
+
+//go:embed assets/+matlab_mcp/listWorkspace.m
+var listWorkspace []byte
+
+//go:embed assets/+matlab_mcp/setBreakpoint.m
+var setBreakpoint []byte
+
+//go:embed assets/+matlab_mcp/clearBreakpoints.m
+var clearBreakpoints []byte
+
+//go:embed assets/+matlab_mcp/listBreakpoints.m
+var listBreakpoints []byte
+
+//go:embed assets/+matlab_mcp/getDebugState.m
+var getDebugState []byte
+
+//go:embed assets/+matlab_mcp/profileCode.m
+var profileCode []byte
+
+//go:embed assets/+matlab_mcp/benchmarkCode.m
+var benchmarkCode []byte
+
+//go:embed assets/+matlab_mcp/isSimulinkAvailable.m
+var isSimulinkAvailable []byte
+
+//go:embed assets/+matlab_mcp/getLoadedModel.m
+var getLoadedModel []byte
+
+//go:embed assets/+matlab_mcp/formatParameterValue.m
+var formatParameterValue []byte
+
+//go:embed assets/+matlab_mcp/loadSimulinkModel.m
+var loadSimulinkModel []byte
+
+//go:embed assets/+matlab_mcp/listSimulinkBlocks.m
+var listSimulinkBlocks []byte
+
+//go:embed assets/+matlab_mcp/setSimulinkBlockParameters.m
+var setSimulinkBlockParameters []byte
+
+//go:embed assets/+matlab_mcp/simulateSimulinkModel.m
+var simulateSimulinkModel []byte
+
+//go:embed assets/+matlab_mcp/checkSimulinkModel.m
+var checkSimulinkModel []byte
+
 type MATLABFiles struct{}
 
 func New() MATLABFiles {
@@ -21,8 +93,32 @@ func New() MATLABFiles {
 
 func (g MATLABFiles) GetAll() map[string][]byte {
 	return map[string][]byte{
-		"initializeMCP.m":        initializeMCP,
-		"mcpEval.m":              mcpEval,
-		"getOrStashExceptions.m": getOrStashExceptions,
+		"initializeMCP.m":              initializeMCP,
+		"mcpEval.m":                    mcpEval,
+		"getOrStashExceptions.m":       getOrStashExceptions,
+		"resetState.m":                 resetState,
+		"saveVariables.m":              saveVariables,
+		"loadVariables.m":              loadVariables,
+		"share.m":                      share,
+		"deleteIfExists.m":             deleteIfExists,
+		"listInstalledProducts.m":      listInstalledProducts,
+		"analyzeDependencies.m":        analyzeDependencies,
+		"findFunctions.m":              findFunctions,
+		"getFigure.m":                  getFigure,
+		"listWorkspace.m":              listWorkspace,
+		"setBreakpoint.m":              setBreakpoint,
+		"clearBreakpoints.m":           clearBreakpoints,
+		"listBreakpoints.m":            listBreakpoints,
+		"getDebugState.m":              getDebugState,
+		"profileCode.m":                profileCode,
+		"benchmarkCode.m":              benchmarkCode,
+		"isSimulinkAvailable.m":        isSimulinkAvailable,
+		"getLoadedModel.m":             getLoadedModel,
+		"formatParameterValue.m":       formatParameterValue,
+		"loadSimulinkModel.m":          loadSimulinkModel,
+		"listSimulinkBlocks.m":         listSimulinkBlocks,
+		"setSimulinkBlockParameters.m": setSimulinkBlockParameters,
+		"simulateSimulinkModel.m":      simulateSimulinkModel,
+		"checkSimulinkModel.m":         checkSimulinkModel,
 	}
 }
@@ -22,7 +22,7 @@ type ProcessDetails interface {
 }
 
 type MATLABProcessLauncher interface {
-	Launch(logger entities.Logger, sessionRoot string, matlabRoot string, workingDir string, args []string, env []string) (int, func(), error)
+	Launch(logger entities.Logger, sessionRoot string, matlabRoot string, workingDir string, args []string, env []string) (int, func(killMATLAB bool), error)
 }
 
 type Watchdog interface {
@@ -50,12 +50,13 @@ func NewStarter(
 	}
 }
 
-func (m *Starter) StartLocalMATLABSession(logger entities.Logger, request datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, func() error, error) {
+// StartLocalMATLABSession starts MATLAB, and returns the details to connect to it and the session directory, in which MATLAB logs its output.
+func (m *Starter) StartLocalMATLABSession(logger entities.Logger, request datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, string, func(killMATLAB bool) error, error) {
 	logger.Debug("Starting a local MATLAB session")
 
 	sessionDir, err := m.directoryFactory.Create(logger)
 	if err != nil {
-		return embeddedconnector.ConnectionDetails{}, nil, err
+		return embeddedconnector.ConnectionDetails{}, "", nil, err
 	}
 
 	sessionDirPath := sessionDir.Path()
@@ -82,7 +83,7 @@ func (m *Starter) StartLocalMATLABSession(logger entities.Logger, request dataty
 
 	processID, processCleanup, err := m.matlabProcessLauncher.Launch(logger, sessionDirPath, request.MATLABRoot, request.StartingDirectory, startupFlags, env)
 	if err != nil {
-		return embeddedconnector.ConnectionDetails{}, nil, err
+		return embeddedconnector.ConnectionDetails{}, "", nil, err
 	}
 
 	if err = m.watchdog.RegisterProcessPIDWithWatchdog(processID); err != nil {
@@ -91,16 +92,16 @@ func (m *Starter) StartLocalMATLABSession(logger entities.Logger, request dataty
 
 	securePort, certificatePEM, err := sessionDir.GetEmbeddedConnectorDetails()
 	if err != nil {
-		return embeddedconnector.ConnectionDetails{}, nil, err
+		return embeddedconnector.ConnectionDetails{}, "", nil, err
 	}
 
 	return embeddedconnector.ConnectionDetails{
-			Host:           "localhost",
-			Port:           securePort,
-			APIKey:         uniqueAPIKey,
-			CertificatePEM: certificatePEM,
-		}, func() error {
-			processCleanup()
-			return sessionDir.Cleanup()
-		}, nil
+		Host:           "localhost",
+		Port:           securePort,
+		APIKey:         uniqueAPIKey,
+		CertificatePEM: certificatePEM,
+	}, sessionDirPath, func(killMATLAB bool) error {
+		processCleanup(killMATLAB)
+		return sessionDir.Cleanup()
+	}, nil
 }
@@ -75,8 +75,10 @@ func TestStarter_StartLocalMATLABSession_HappyPath(t *testing.T) {
 	expectedStartupFlags := []string{"-r", expectedStartupCode}
 	expectedProcessID := 12345
 	processCleanupCalled := false
-	processCleanup := func() {
+	processCleanupKilledMATLAB := false
+	processCleanup := func(killMATLAB bool) {
 		processCleanupCalled = true
+		processCleanupKilledMATLAB = killMATLAB
 	}
 
 	mockDirectoryFactory.EXPECT().
@@ -147,7 +149,7 @@ func TestStarter_StartLocalMATLABSession_HappyPath(t *testing.T) {
 	}
 
 	// Act
-	connectionDetails, cleanup, err := starter.StartLocalMATLABSession(mockLogger, startRequest)
+	connectionDetails, _, cleanup, err := starter.StartLocalMATLABSession(mockLogger, startRequest)
 
 	// Assert
 	require.NoError(t, err)
@@ -158,9 +160,10 @@ func TestStarter_StartLocalMATLABSession_HappyPath(t *testing.T) {
 	assert.Equal(t, expectedCertificatePEM, connectionDetails.CertificatePEM)
 
 	assert.False(t, processCleanupCalled)
-	err = cleanup()
+	err = cleanup(true)
 	require.NoError(t, err)
 	assert.True(t, processCleanupCalled)
+	assert.True(t, processCleanupKilledMATLAB)
 }
 
 func TestStarter_StartLocalMATLABSession_WithStartingDirectory(t *testing.T) {
@@ -195,7 +198,7 @@ func TestStarter_StartLocalMATLABSession_WithStartingDirectory(t *testing.T) {
 	showDesktop := false
 	expectedStartupFlags := []string{"-r", expectedStartupCode}
 	expectedProcessID := 12345
-	processCleanup := func() {}
+	processCleanup := func(_ bool) {}
 
 	mockDirectoryFactory.EXPECT().
 		Create(mockLogger.AsMockArg()).
@@ -263,7 +266,7 @@ func TestStarter_StartLocalMATLABSession_WithStartingDirectory(t *testing.T) {
 	}
 
 	// Act
-	connectionDetails, cleanup, err := starter.StartLocalMATLABSession(mockLogger, startRequest)
+	connectionDetails, _, cleanup, err := starter.StartLocalMATLABSession(mockLogger, startRequest)
 
 	// Assert
 	require.NoError(t, err)
@@ -312,7 +315,7 @@ func TestStarter_StartLocalMATLABSession_DirectoryFactoryCreateError(t *testing.
 	}
 
 	// Act
-	connectionDetails, cleanup, err := starter.StartLocalMATLABSession(mockLogger, startRequest)
+	connectionDetails, _, cleanup, err := starter.StartLocalMATLABSession(mockLogger, startRequest)
 
 	// Assert
 	require.ErrorIs(t, err, expectedError)
@@ -402,7 +405,7 @@ func TestStarter_StartLocalMATLABSession_MATLABProcessLauncherError(t *testing.T
 	}
 
 	// Act
-	connectionDetails, cleanup, err := starter.StartLocalMATLABSession(mockLogger, startRequest)
+	connectionDetails, _, cleanup, err := starter.StartLocalMATLABSession(mockLogger, startRequest)
 
 	// Assert
 	require.ErrorIs(t, err, expectedError)
@@ -440,7 +443,7 @@ func TestStarter_StartLocalMATLABSession_RegisterProcessPIDWithWatchdogError(t *
 	expectedStartupFlags := []string{"-r", expectedStartupCode}
 	expectedError := assert.AnError
 	expectedProcessID := 12345
-	processCleanup := func() {}
+	processCleanup := func(_ bool) {}
 	expectedCertificatePEM := []byte("-----BEGIN CERTIFICATE-----\ntest-cert\n-----END CERTIFICATE-----")
 	expectedSecurePort := "9999"
 	showDesktop := false
@@ -510,7 +513,7 @@ func TestStarter_StartLocalMATLABSession_RegisterProcessPIDWithWatchdogError(t *
 	}
 
 	// Act
-	connectionDetails, cleanup, err := starter.StartLocalMATLABSession(mockLogger, startRequest)
+	connectionDetails, _, cleanup, err := starter.StartLocalMATLABSession(mockLogger, startRequest)
 
 	// Assert
 	require.NoError(t, err)
@@ -562,7 +565,7 @@ func TestStarter_StartLocalMATLABSession_GetEmbeddedConnectorDetailsError(t *tes
 	expectedStartupCode := "sessionPath = '" + expectedSessionDirPath + "';addpath(sessionPath);matlab_mcp.initializeMCP();clear sessionPath;"
 	expectedStartupFlags := []string{"-r", expectedStartupCode}
 	expectedProcessID := 12345
-	processCleanup := func() {}
+	processCleanup := func(_ bool) {}
 	expectedError := assert.AnError
 
 	mockDirectoryFactory.EXPECT().
@@ -628,7 +631,7 @@ func TestStarter_StartLocalMATLABSession_GetEmbeddedConnectorDetailsError(t *tes
 	}
 
 	// Act
-	connectionDetails, cleanup, err := starter.StartLocalMATLABSession(mockLogger, startRequest)
+	connectionDetails, _, cleanup, err := starter.StartLocalMATLABSession(mockLogger, startRequest)
 
 	// Assert
 	require.ErrorIs(t, err, expectedError)
@@ -667,7 +670,7 @@ func TestStarter_StartLocalMATLABSession_CleanupReturnsSessionCleanupError(t *te
 	showDestop := false
 	expectedStartupFlags := []string{"-r", expectedStartupCode}
 	expectedProcessID := 12345
-	processCleanup := func() {}
+	processCleanup := func(_ bool) {}
 	expectedError := assert.AnError
 
 	mockDirectoryFactory.EXPECT().
@@ -737,13 +740,13 @@ func TestStarter_StartLocalMATLABSession_CleanupReturnsSessionCleanupError(t *te
 		IsStartingDirectorySet: false,
 	}
 
-	_, cleanup, err := starter.StartLocalMATLABSession(mockLogger, startRequest)
+	_, _, cleanup, err := starter.StartLocalMATLABSession(mockLogger, startRequest)
 	require.NoError(t, err)
 	require.NotNil(t, cleanup)
 
 	// Act
 
-	err = cleanup()
+	err = cleanup(false)
 
 	// Assert
 	require.ErrorIs(t, err, expectedError)
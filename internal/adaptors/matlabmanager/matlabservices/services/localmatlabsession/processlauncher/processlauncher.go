@@ -19,7 +19,7 @@ func New() *MATLABProcessLauncher {
 	return &MATLABProcessLauncher{}
 }
 
-func (l *MATLABProcessLauncher) Launch(logger entities.Logger, sessionRoot string, matlabRoot string, workingDir string, args []string, env []string) (int, func(), error) {
+func (l *MATLABProcessLauncher) Launch(logger entities.Logger, sessionRoot string, matlabRoot string, workingDir string, args []string, env []string) (int, func(killMATLAB bool), error) {
 	stdIO, stdIOCleanup, err := createLocalStdioForNewProcess(logger, sessionRoot)
 	if err != nil {
 		return 0, nil, err
@@ -30,8 +30,13 @@ func (l *MATLABProcessLauncher) Launch(logger entities.Logger, sessionRoot strin
 		return 0, nil, fmt.Errorf("failed to start MATLAB process: %w", err)
 	}
 
-	return process.Pid, func() {
-		// By the time this is called, we expect MATLAB to be shutting down gracefully
+	return process.Pid, func(killMATLAB bool) {
+		if killMATLAB {
+			logger.Warn("MATLAB did not shut down gracefully, forcefully kill it")
+			killMATLABProcess(logger, process)
+		}
+
+		// Unless killed, by the time this is called, we expect MATLAB to be shutting down gracefully
 		logger.Debug("Waiting for MATLAB process to exit gracefully")
 
 		errC := make(chan error)
@@ -0,0 +1,102 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabinstallation
+
+import (
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/customerrors"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
+	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
+)
+
+// The installer records each installed product in appdata/products, in a file named "<Product Name> <Version> <Architecture>.xml"
+var productFileNameRegexp = regexp.MustCompile(`^(?P<name>.+?) (?P<version>\d+(?:\.\d+)*) (?P<architecture>[a-zA-Z0-9]+)(?: [^/\\]*)?\.xml$`)
+
+var knownArchitectures = []string{"glnxa64", "maca64", "maci64", "win64"}
+
+type OSLayer interface {
+	Stat(name string) (osfacade.FileInfo, error)
+}
+
+type FileLayer interface {
+	Glob(pattern string) ([]string, error)
+}
+
+type Getter struct {
+	osLayer   OSLayer
+	fileLayer FileLayer
+}
+
+func New(
+	osLayer OSLayer,
+	fileLayer FileLayer,
+) *Getter {
+	return &Getter{
+		osLayer:   osLayer,
+		fileLayer: fileLayer,
+	}
+}
+
+// Get reads the installed products and the architecture of a MATLAB installation from its install metadata,
+// without starting MATLAB.
+func (s *Getter) Get(matlabRootLocation string) (datatypes.MatlabInstallationInfo, error) {
+	if matlabRootLocation == "" {
+		return datatypes.MatlabInstallationInfo{}, customerrors.ErrEmptyLocation
+	}
+
+	productFiles, err := s.fileLayer.Glob(filepath.Join(matlabRootLocation, "appdata", "products", "*.xml"))
+	if err != nil {
+		return datatypes.MatlabInstallationInfo{}, err
+	}
+
+	installationInfo := datatypes.MatlabInstallationInfo{
+		Products: make([]datatypes.MatlabProductInfo, 0, len(productFiles)),
+	}
+
+	for _, productFile := range productFiles {
+		match := productFileNameRegexp.FindStringSubmatch(filepath.Base(productFile))
+		if match == nil {
+			continue
+		}
+
+		installationInfo.Products = append(installationInfo.Products, datatypes.MatlabProductInfo{
+			Name:    match[productFileNameRegexp.SubexpIndex("name")],
+			Version: match[productFileNameRegexp.SubexpIndex("version")],
+		})
+
+		architecture := match[productFileNameRegexp.SubexpIndex("architecture")]
+		if installationInfo.Architecture == "" && slices.Contains(knownArchitectures, architecture) {
+			installationInfo.Architecture = architecture
+		}
+	}
+
+	sort.Slice(installationInfo.Products, func(i, j int) bool {
+		return installationInfo.Products[i].Name < installationInfo.Products[j].Name
+	})
+
+	if installationInfo.Architecture == "" {
+		installationInfo.Architecture = s.getArchitectureFromBinFolder(matlabRootLocation)
+	}
+
+	return installationInfo, nil
+}
+
+// getArchitectureFromBinFolder falls back to the architecture specific folder in the bin folder of MATLAB
+func (s *Getter) getArchitectureFromBinFolder(matlabRootLocation string) string {
+	for _, architecture := range knownArchitectures {
+		fileInfo, err := s.osLayer.Stat(filepath.Join(matlabRootLocation, "bin", architecture))
+		if err != nil {
+			continue
+		}
+
+		if fileInfo.IsDir() {
+			return architecture
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,157 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabinstallation_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/customerrors"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/matlablocator/matlabinstallation"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager/matlabservices/services/matlablocator/matlabinstallation"
+	osfacademocks "github.com/matlab/matlab-mcp-core-server/mocks/facades/osfacade"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	// Act
+	getter := matlabinstallation.New(mockOSLayer, mockFileLayer)
+
+	// Assert
+	assert.NotNil(t, getter)
+}
+
+func TestMATLABInstallationGetter_Get_HappyPath(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	matlabRootLocation := filepath.FromSlash("/path/to/matlab/R2024b")
+	productsFolder := filepath.Join(matlabRootLocation, "appdata", "products")
+
+	mockFileLayer.EXPECT().
+		Glob(filepath.Join(productsFolder, "*.xml")).
+		Return([]string{
+			filepath.Join(productsFolder, "Signal Processing Toolbox 24.2 glnxa64.xml"),
+			filepath.Join(productsFolder, "MATLAB 24.2 glnxa64.xml"),
+			filepath.Join(productsFolder, "Simulink 24.2 glnxa64 1234567.xml"),
+			filepath.Join(productsFolder, "not-a-product.xml"),
+		}, nil).
+		Once()
+
+	getter := matlabinstallation.New(mockOSLayer, mockFileLayer)
+
+	// Act
+	result, err := getter.Get(matlabRootLocation)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, datatypes.MatlabInstallationInfo{
+		Architecture: "glnxa64",
+		Products: []datatypes.MatlabProductInfo{
+			{Name: "MATLAB", Version: "24.2"},
+			{Name: "Signal Processing Toolbox", Version: "24.2"},
+			{Name: "Simulink", Version: "24.2"},
+		},
+	}, result)
+}
+
+func TestMATLABInstallationGetter_Get_ArchitectureFromBinFolder(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockFileInfo := &osfacademocks.MockFileInfo{}
+	defer mockFileInfo.AssertExpectations(t)
+
+	matlabRootLocation := filepath.FromSlash("/path/to/matlab/R2024b")
+
+	mockFileLayer.EXPECT().
+		Glob(filepath.Join(matlabRootLocation, "appdata", "products", "*.xml")).
+		Return(nil, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat(filepath.Join(matlabRootLocation, "bin", "glnxa64")).
+		Return(nil, os.ErrNotExist).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat(filepath.Join(matlabRootLocation, "bin", "maca64")).
+		Return(mockFileInfo, nil).
+		Once()
+
+	mockFileInfo.EXPECT().
+		IsDir().
+		Return(true).
+		Once()
+
+	getter := matlabinstallation.New(mockOSLayer, mockFileLayer)
+
+	// Act
+	result, err := getter.Get(matlabRootLocation)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "maca64", result.Architecture)
+	assert.Empty(t, result.Products)
+}
+
+func TestMATLABInstallationGetter_Get_HandlesEmptyLocation(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	getter := matlabinstallation.New(mockOSLayer, mockFileLayer)
+
+	// Act
+	result, err := getter.Get("")
+
+	// Assert
+	require.ErrorIs(t, err, customerrors.ErrEmptyLocation)
+	assert.Empty(t, result)
+}
+
+func TestMATLABInstallationGetter_Get_GlobError(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	matlabRootLocation := filepath.FromSlash("/path/to/matlab/R2024b")
+
+	mockFileLayer.EXPECT().
+		Glob(filepath.Join(matlabRootLocation, "appdata", "products", "*.xml")).
+		Return(nil, assert.AnError).
+		Once()
+
+	getter := matlabinstallation.New(mockOSLayer, mockFileLayer)
+
+	// Act
+	result, err := getter.Get(matlabRootLocation)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
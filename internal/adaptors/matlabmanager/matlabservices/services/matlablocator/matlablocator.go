@@ -15,18 +15,25 @@ type MATLABVersionGetter interface {
 	Get(matlabRootLocation string) (datatypes.MatlabVersionInfo, error)
 }
 
+type MATLABInstallationGetter interface {
+	Get(matlabRootLocation string) (datatypes.MatlabInstallationInfo, error)
+}
+
 type MATLABLocator struct {
-	matlabRootGetter    MATLABRootGetter
-	matlabVersionGetter MATLABVersionGetter
+	matlabRootGetter         MATLABRootGetter
+	matlabVersionGetter      MATLABVersionGetter
+	matlabInstallationGetter MATLABInstallationGetter
 }
 
 func New(
 	matlabRootGetter MATLABRootGetter,
 	matlabVersionGetter MATLABVersionGetter,
+	matlabInstallationGetter MATLABInstallationGetter,
 ) *MATLABLocator {
 	return &MATLABLocator{
-		matlabRootGetter:    matlabRootGetter,
-		matlabVersionGetter: matlabVersionGetter,
+		matlabRootGetter:         matlabRootGetter,
+		matlabVersionGetter:      matlabVersionGetter,
+		matlabInstallationGetter: matlabInstallationGetter,
 	}
 }
 
@@ -35,7 +42,7 @@ func (s *MATLABLocator) ListDiscoveredMatlabInfo(logger entities.Logger) datatyp
 
 	infos := make([]datatypes.MatlabInfo, 0)
 	for _, matlabLocation := range discoveredMatlabLocations {
-		info, err := s.getVerifiedEnvironmentFromLocation(matlabLocation)
+		info, err := s.getVerifiedEnvironmentFromLocation(logger, matlabLocation)
 		if err != nil {
 			logger.With("matlab_root", matlabLocation).WithError(err).Warn("Possible MATLAB location candidate was invalid.")
 			continue
@@ -47,19 +54,27 @@ func (s *MATLABLocator) ListDiscoveredMatlabInfo(logger entities.Logger) datatyp
 		return datatypes.ListMatlabInfo{}
 	}
 
+	// The discovery order is kept, so that the MATLAB on the PATH comes first, as the default MATLAB
 	return datatypes.ListMatlabInfo{
 		MatlabInfo: infos,
 	}
 }
 
-func (s *MATLABLocator) getVerifiedEnvironmentFromLocation(location string) (datatypes.MatlabInfo, error) {
+func (s *MATLABLocator) getVerifiedEnvironmentFromLocation(logger entities.Logger, location string) (datatypes.MatlabInfo, error) {
 	version, err := s.matlabVersionGetter.Get(location)
 	if err != nil {
 		return datatypes.MatlabInfo{}, err
 	}
 
+	// The installed products are informational, so a MATLAB with unreadable install metadata is still listed
+	installation, err := s.matlabInstallationGetter.Get(location)
+	if err != nil {
+		logger.With("matlab_root", location).WithError(err).Warn("Unable to read the installed products of MATLAB")
+	}
+
 	return datatypes.MatlabInfo{
-		Version:  version,
-		Location: location,
+		Version:      version,
+		Installation: installation,
+		Location:     location,
 	}, nil
 }
@@ -24,6 +24,9 @@ func TestService_ListDiscoveredMatlabInfo_SingleMatlab(t *testing.T) {
 	mockMATLABVersionGetter := &mocks.MockMATLABVersionGetter{}
 	defer mockMATLABVersionGetter.AssertExpectations(t)
 
+	mockMATLABInstallationGetter := &mocks.MockMATLABInstallationGetter{}
+	defer mockMATLABInstallationGetter.AssertExpectations(t)
+
 	// Mock discovery service to return MATLAB locations
 	expectedPathToMATLAB := filepath.Join("path", "to", "matlab", "R2023a")
 	mockMATLABRootGetter.EXPECT().
@@ -44,7 +47,20 @@ func TestService_ListDiscoveredMatlabInfo_SingleMatlab(t *testing.T) {
 		Return(expectedMatlabVersionInfo, nil).
 		Once()
 
-	service := matlablocator.New(mockMATLABRootGetter, mockMATLABVersionGetter)
+	// Mock installation service to return the installed products
+	expectedMatlabInstallationInfo := datatypes.MatlabInstallationInfo{
+		Architecture: "glnxa64",
+		Products: []datatypes.MatlabProductInfo{
+			{Name: "MATLAB", Version: "9.14"},
+			{Name: "Signal Processing Toolbox", Version: "9.2"},
+		},
+	}
+	mockMATLABInstallationGetter.EXPECT().
+		Get(expectedPathToMATLAB).
+		Return(expectedMatlabInstallationInfo, nil).
+		Once()
+
+	service := matlablocator.New(mockMATLABRootGetter, mockMATLABVersionGetter, mockMATLABInstallationGetter)
 
 	// Act
 	result := service.ListDiscoveredMatlabInfo(mockLogger)
@@ -56,6 +72,7 @@ func TestService_ListDiscoveredMatlabInfo_SingleMatlab(t *testing.T) {
 	// Verify the MATLAB info
 	assert.Equal(t, expectedPathToMATLAB, result.MatlabInfo[0].Location)
 	assert.Equal(t, expectedMatlabVersionInfo, result.MatlabInfo[0].Version)
+	assert.Equal(t, expectedMatlabInstallationInfo, result.MatlabInfo[0].Installation)
 }
 
 func TestService_ListDiscoveredMatlabInfo_MultipleMatlabs(t *testing.T) {
@@ -68,6 +85,9 @@ func TestService_ListDiscoveredMatlabInfo_MultipleMatlabs(t *testing.T) {
 	mockMATLABVersionGetter := &mocks.MockMATLABVersionGetter{}
 	defer mockMATLABVersionGetter.AssertExpectations(t)
 
+	mockMATLABInstallationGetter := &mocks.MockMATLABInstallationGetter{}
+	defer mockMATLABInstallationGetter.AssertExpectations(t)
+
 	expectedPaths := []string{
 		filepath.Join("Program Files", "MATLAB", "R2023a"),
 		filepath.Join("Program Files", "MATLAB", "R2022b"),
@@ -97,9 +117,14 @@ func TestService_ListDiscoveredMatlabInfo_MultipleMatlabs(t *testing.T) {
 			Get(path).
 			Return(expectedMatlabInfos[path], nil).
 			Once()
+
+		mockMATLABInstallationGetter.EXPECT().
+			Get(path).
+			Return(datatypes.MatlabInstallationInfo{}, nil).
+			Once()
 	}
 
-	service := matlablocator.New(mockMATLABRootGetter, mockMATLABVersionGetter)
+	service := matlablocator.New(mockMATLABRootGetter, mockMATLABVersionGetter, mockMATLABInstallationGetter)
 
 	// Act
 	result := service.ListDiscoveredMatlabInfo(mockLogger)
@@ -119,6 +144,66 @@ func TestService_ListDiscoveredMatlabInfo_MultipleMatlabs(t *testing.T) {
 	}
 }
 
+func TestService_ListDiscoveredMatlabInfo_KeepsDiscoveryOrder(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABRootGetter := &mocks.MockMATLABRootGetter{}
+	defer mockMATLABRootGetter.AssertExpectations(t)
+
+	mockMATLABVersionGetter := &mocks.MockMATLABVersionGetter{}
+	defer mockMATLABVersionGetter.AssertExpectations(t)
+
+	mockMATLABInstallationGetter := &mocks.MockMATLABInstallationGetter{}
+	defer mockMATLABInstallationGetter.AssertExpectations(t)
+
+	r2023bPath := filepath.Join("usr", "local", "MATLAB", "R2023b")
+	r2024bPath := filepath.Join("opt", "MATLAB", "R2024b")
+	r2024bUpdatePath := filepath.Join("home", "user", "MATLAB", "R2024b")
+	r2024aPath := filepath.Join("usr", "local", "MATLAB", "R2024a")
+	r2025aPrereleasePath := filepath.Join("opt", "MATLAB", "R2025a_Prerelease")
+	r2025aPath := filepath.Join("opt", "MATLAB", "R2025a")
+
+	discoveredPaths := []string{r2023bPath, r2025aPrereleasePath, r2024bPath, r2024aPath, r2024bUpdatePath, r2025aPath}
+	matlabVersionInfos := map[string]datatypes.MatlabVersionInfo{
+		r2023bPath:           {ReleaseFamily: "R2023b", ReleasePhase: "release", UpdateLevel: 0},
+		r2024bPath:           {ReleaseFamily: "R2024b", ReleasePhase: "release", UpdateLevel: 0},
+		r2024aPath:           {ReleaseFamily: "R2024a", ReleasePhase: "release", UpdateLevel: 3},
+		r2024bUpdatePath:     {ReleaseFamily: "R2024b", ReleasePhase: "release", UpdateLevel: 2},
+		r2025aPrereleasePath: {ReleaseFamily: "R2025a", ReleasePhase: datatypes.ReleasePhasePrerelease, UpdateLevel: 1},
+		r2025aPath:           {ReleaseFamily: "R2025a", ReleasePhase: datatypes.ReleasePhaseRelease, UpdateLevel: 0},
+	}
+
+	mockMATLABRootGetter.EXPECT().
+		GetAll(mockLogger).
+		Return(discoveredPaths).
+		Once()
+
+	for _, path := range discoveredPaths {
+		mockMATLABVersionGetter.EXPECT().
+			Get(path).
+			Return(matlabVersionInfos[path], nil).
+			Once()
+
+		mockMATLABInstallationGetter.EXPECT().
+			Get(path).
+			Return(datatypes.MatlabInstallationInfo{}, nil).
+			Once()
+	}
+
+	service := matlablocator.New(mockMATLABRootGetter, mockMATLABVersionGetter, mockMATLABInstallationGetter)
+
+	// Act
+	result := service.ListDiscoveredMatlabInfo(mockLogger)
+
+	// Assert
+	expectedPaths := discoveredPaths
+	require.Len(t, result.MatlabInfo, len(expectedPaths))
+	for i, expectedPath := range expectedPaths {
+		assert.Equal(t, expectedPath, result.MatlabInfo[i].Location)
+	}
+}
+
 func TestService_ListDiscoveredMatlabInfo_NoMatlabsFound(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
@@ -129,13 +214,16 @@ func TestService_ListDiscoveredMatlabInfo_NoMatlabsFound(t *testing.T) {
 	mockMATLABVersionGetter := &mocks.MockMATLABVersionGetter{}
 	defer mockMATLABVersionGetter.AssertExpectations(t)
 
+	mockMATLABInstallationGetter := &mocks.MockMATLABInstallationGetter{}
+	defer mockMATLABInstallationGetter.AssertExpectations(t)
+
 	// Mock discovery service to return empty list
 	mockMATLABRootGetter.EXPECT().
 		GetAll(mockLogger).
 		Return([]string{}).
 		Once()
 
-	service := matlablocator.New(mockMATLABRootGetter, mockMATLABVersionGetter)
+	service := matlablocator.New(mockMATLABRootGetter, mockMATLABVersionGetter, mockMATLABInstallationGetter)
 
 	// Act
 	result := service.ListDiscoveredMatlabInfo(mockLogger)
@@ -155,6 +243,9 @@ func TestService_ListDiscoveredMatlabInfo_VersionServiceError(t *testing.T) {
 	mockMATLABVersionGetter := &mocks.MockMATLABVersionGetter{}
 	defer mockMATLABVersionGetter.AssertExpectations(t)
 
+	mockMATLABInstallationGetter := &mocks.MockMATLABInstallationGetter{}
+	defer mockMATLABInstallationGetter.AssertExpectations(t)
+
 	// Mock discovery service to return MATLAB locations
 	mockMATLABRootGetter.EXPECT().
 		GetAll(mockLogger).
@@ -170,7 +261,7 @@ func TestService_ListDiscoveredMatlabInfo_VersionServiceError(t *testing.T) {
 		Return(datatypes.MatlabVersionInfo{}, expectedError).
 		Once()
 
-	service := matlablocator.New(mockMATLABRootGetter, mockMATLABVersionGetter)
+	service := matlablocator.New(mockMATLABRootGetter, mockMATLABVersionGetter, mockMATLABInstallationGetter)
 
 	// Act
 	result := service.ListDiscoveredMatlabInfo(mockLogger)
@@ -179,3 +270,50 @@ func TestService_ListDiscoveredMatlabInfo_VersionServiceError(t *testing.T) {
 	assert.Empty(t, result.MatlabInfo, "Result should be empty when there's an error")
 	assert.Len(t, mockLogger.WarnLogs(), 1, "Should log expected number of warning messages")
 }
+
+func TestService_ListDiscoveredMatlabInfo_InstallationServiceError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABRootGetter := &mocks.MockMATLABRootGetter{}
+	defer mockMATLABRootGetter.AssertExpectations(t)
+
+	mockMATLABVersionGetter := &mocks.MockMATLABVersionGetter{}
+	defer mockMATLABVersionGetter.AssertExpectations(t)
+
+	mockMATLABInstallationGetter := &mocks.MockMATLABInstallationGetter{}
+	defer mockMATLABInstallationGetter.AssertExpectations(t)
+
+	pathToMATLAB := filepath.Join("path", "to", "matlab", "R2023a")
+	expectedMatlabVersionInfo := datatypes.MatlabVersionInfo{
+		ReleaseFamily: "R2023a",
+		ReleasePhase:  "Release",
+	}
+
+	mockMATLABRootGetter.EXPECT().
+		GetAll(mockLogger).
+		Return([]string{pathToMATLAB}).
+		Once()
+
+	mockMATLABVersionGetter.EXPECT().
+		Get(pathToMATLAB).
+		Return(expectedMatlabVersionInfo, nil).
+		Once()
+
+	mockMATLABInstallationGetter.EXPECT().
+		Get(pathToMATLAB).
+		Return(datatypes.MatlabInstallationInfo{}, assert.AnError).
+		Once()
+
+	service := matlablocator.New(mockMATLABRootGetter, mockMATLABVersionGetter, mockMATLABInstallationGetter)
+
+	// Act
+	result := service.ListDiscoveredMatlabInfo(mockLogger)
+
+	// Assert
+	require.Len(t, result.MatlabInfo, 1, "MATLAB should still be listed when its installed products cannot be read")
+	assert.Equal(t, pathToMATLAB, result.MatlabInfo[0].Location)
+	assert.Equal(t, expectedMatlabVersionInfo, result.MatlabInfo[0].Version)
+	assert.Empty(t, result.MatlabInfo[0].Installation.Products)
+	assert.Len(t, mockLogger.WarnLogs(), 1, "Should log expected number of warning messages")
+}
@@ -13,80 +13,174 @@ import (
 	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
 )
 
+const matlabRootsEnvVar = "MATLAB_ROOTS"
+
+type Config interface {
+	AdditionalMATLABRoots() []string
+}
+
 type OSLayer interface {
 	Getenv(key string) string
 	Stat(name string) (osfacade.FileInfo, error)
+	UserHomeDir() (string, error)
 }
 
 type FileLayer interface {
 	EvalSymlinks(path string) (string, error)
+	Glob(pattern string) ([]string, error)
 }
 
 type Getter struct {
+	config    Config
 	osLayer   OSLayer
 	fileLayer FileLayer
 }
 
 func New(
+	config Config,
 	osLayer OSLayer,
 	fileLayer FileLayer,
 ) *Getter {
 	return &Getter{
+		config:    config,
 		osLayer:   osLayer,
 		fileLayer: fileLayer,
 	}
 }
 
+// GetAll returns the MATLAB roots found on the PATH, in the configured locations, in the MATLAB_ROOTS
+// environment variable and in the standard install locations. Each MATLAB root is only returned once.
 func (s *Getter) GetAll(logger entities.Logger) []string {
-	pathList := strings.Split(s.osLayer.Getenv("PATH"), string(os.PathListSeparator))
-
 	matlabLocations := make([]string, 0)
+	foundMATLABRoots := make(map[string]struct{})
 
-	for _, path := range pathList {
-		// Fix path formatting on all platforms as CMD formatting can be strange
-		path = strings.Trim(path, ` "'`)
+	addMATLABRoot := func(matlabRoot string) {
+		if _, found := foundMATLABRoots[matlabRoot]; found {
+			return
+		}
+		foundMATLABRoots[matlabRoot] = struct{}{}
+		matlabLocations = append(matlabLocations, matlabRoot)
+	}
 
-		if path == "" {
-			continue
+	for _, path := range splitPathList(s.osLayer.Getenv("PATH")) {
+		if matlabRoot, found := s.getMATLABRootFromPath(logger, path); found {
+			addMATLABRoot(matlabRoot)
 		}
+	}
 
-		path, err := s.fileLayer.EvalSymlinks(path)
-		if err != nil {
-			logger.With("path", path).WithError(err).Warn("Error evaluating if the path was a symbolic link")
-			continue
+	for _, candidate := range s.getMATLABRootCandidates(logger) {
+		if matlabRoot, found := s.getMATLABRootFromCandidate(logger, candidate); found {
+			addMATLABRoot(matlabRoot)
 		}
+	}
 
-		matlabExePath := filepath.Join(path, config.MATLABExeName)
+	if len(matlabLocations) == 0 {
+		return nil
+	}
+
+	return matlabLocations
+}
+
+func (s *Getter) getMATLABRootFromPath(logger entities.Logger, path string) (string, bool) {
+	path, err := s.fileLayer.EvalSymlinks(path)
+	if err != nil {
+		logger.With("path", path).WithError(err).Warn("Error evaluating if the path was a symbolic link")
+		return "", false
+	}
+
+	matlabExePath := filepath.Join(path, config.MATLABExeName)
 
-		// Check that the MATLAB executable exists and is a file
-		fileInfo, err := s.osLayer.Stat(matlabExePath)
+	if !s.isMATLABExecutable(logger, matlabExePath) {
+		return "", false
+	}
+
+	// Follow the executable symlink to find the MATLAB root directory
+	// If the executable is not a symlink then the raw path will be the same as the original path
+	matlabRawPath, err := s.fileLayer.EvalSymlinks(matlabExePath)
+	if err != nil {
+		logger.With("path", matlabExePath).WithError(err).Warn("Error evaluating if the found executable was a symbolic link")
+		return "", false
+	}
+
+	// The matlab exe is in the bin directory of matlab root and so we need to extract the root directory
+	return filepath.Dir(filepath.Dir(matlabRawPath)), true
+}
+
+func (s *Getter) getMATLABRootFromCandidate(logger entities.Logger, candidate string) (string, bool) {
+	matlabRoot, err := s.fileLayer.EvalSymlinks(candidate)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			logger.With("path", candidate).WithError(err).Warn("Error evaluating if the MATLAB root was a symbolic link")
+		}
+		return "", false
+	}
+
+	if !s.isMATLABExecutable(logger, filepath.Join(matlabRoot, "bin", config.MATLABExeName)) {
+		return "", false
+	}
+
+	return matlabRoot, true
+}
+
+// getMATLABRootCandidates returns the folders that could be a MATLAB root, in order of precedence
+func (s *Getter) getMATLABRootCandidates(logger entities.Logger) []string {
+	candidates := make([]string, 0)
+
+	candidates = append(candidates, s.config.AdditionalMATLABRoots()...)
+	candidates = append(candidates, splitPathList(s.osLayer.Getenv(matlabRootsEnvVar))...)
+
+	patterns := make([]string, 0, len(config.MATLABRootSearchPatterns)+len(config.UserMATLABRootSearchPatterns))
+	patterns = append(patterns, config.MATLABRootSearchPatterns...)
+
+	if len(config.UserMATLABRootSearchPatterns) > 0 {
+		homeDir, err := s.osLayer.UserHomeDir()
 		if err != nil {
-			if !errors.Is(err, os.ErrNotExist) {
-				logger.With("path", matlabExePath).WithError(err).Warn("Unable to evaluate file")
+			logger.WithError(err).Warn("Unable to get the user home folder to search for MATLAB")
+		} else {
+			for _, pattern := range config.UserMATLABRootSearchPatterns {
+				patterns = append(patterns, filepath.Join(homeDir, pattern))
 			}
-			continue
-		}
-		if fileInfo.IsDir() {
-			continue
 		}
+	}
 
-		// Follow the executable symlink to find the MATLAB root directory
-		// If the executable is not a symlink then the raw path will be the same as the original path
-		matlabRawPath, err := s.fileLayer.EvalSymlinks(matlabExePath)
+	for _, pattern := range patterns {
+		matches, err := s.fileLayer.Glob(pattern)
 		if err != nil {
-			logger.With("path", matlabExePath).WithError(err).Warn("Error evaluating if the found executable was a symbolic link")
+			logger.With("pattern", pattern).WithError(err).Warn("Unable to search for MATLAB")
 			continue
 		}
+		candidates = append(candidates, matches...)
+	}
 
-		// The matlab exe is in the bin directory of matlab root and so we need to extract the root directory
-		matlabRoot := filepath.Dir(filepath.Dir(matlabRawPath))
+	return candidates
+}
 
-		matlabLocations = append(matlabLocations, matlabRoot)
+func (s *Getter) isMATLABExecutable(logger entities.Logger, matlabExePath string) bool {
+	// Check that the MATLAB executable exists and is a file
+	fileInfo, err := s.osLayer.Stat(matlabExePath)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			logger.With("path", matlabExePath).WithError(err).Warn("Unable to evaluate file")
+		}
+		return false
 	}
 
-	if len(matlabLocations) == 0 {
-		return nil
+	return !fileInfo.IsDir()
+}
+
+func splitPathList(pathList string) []string {
+	paths := make([]string, 0)
+
+	for _, path := range strings.Split(pathList, string(os.PathListSeparator)) {
+		// Fix path formatting on all platforms as CMD formatting can be strange
+		path = strings.Trim(path, ` "'`)
+
+		if path == "" {
+			continue
+		}
+
+		paths = append(paths, path)
 	}
 
-	return matlabLocations
+	return paths
 }
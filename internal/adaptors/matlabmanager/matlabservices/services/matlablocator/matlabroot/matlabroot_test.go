@@ -20,6 +20,9 @@ import (
 
 func TestMATLABRootGetter_GetAll_Success(t *testing.T) {
 	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockOSLayer := &mocks.MockOSLayer{}
 	defer mockOSLayer.AssertExpectations(t)
 
@@ -38,8 +41,8 @@ func TestMATLABRootGetter_GetAll_Success(t *testing.T) {
 	}()
 
 	inputPaths := []string{
-		"/valid/path1",
-		"/valid/path2",
+		"/valid/root1/bin",
+		"/valid/root2/bin",
 	}
 
 	pathEnv := strings.Join(inputPaths, string(os.PathListSeparator))
@@ -53,7 +56,9 @@ func TestMATLABRootGetter_GetAll_Success(t *testing.T) {
 		addSuccessfulPathCheck(path, mockOSLayer, mockFileLayer, mockFileInfo)
 	}
 
-	service := matlabroot.New(mockOSLayer, mockFileLayer)
+	addNoMATLABRootCandidates(mockConfig, mockOSLayer, mockFileLayer)
+
+	service := matlabroot.New(mockConfig, mockOSLayer, mockFileLayer)
 
 	// Act
 	results := service.GetAll(mockLogger)
@@ -75,6 +80,9 @@ func TestMATLABRootGetter_GetAll_Success(t *testing.T) {
 // that path is skipped and later paths are still checked
 func TestMATLABRootGetter_GetAll_FirstEvalSymlinksError(t *testing.T) {
 	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockOSLayer := &mocks.MockOSLayer{}
 	defer mockOSLayer.AssertExpectations(t)
 
@@ -104,7 +112,9 @@ func TestMATLABRootGetter_GetAll_FirstEvalSymlinksError(t *testing.T) {
 		Return(pathEnv).
 		Once()
 
-	service := matlabroot.New(mockOSLayer, mockFileLayer)
+	addNoMATLABRootCandidates(mockConfig, mockOSLayer, mockFileLayer)
+
+	service := matlabroot.New(mockConfig, mockOSLayer, mockFileLayer)
 
 	// Act
 	result := service.GetAll(mockLogger)
@@ -120,6 +130,9 @@ func TestMATLABRootGetter_GetAll_FirstEvalSymlinksError(t *testing.T) {
 // that path is skipped and later paths are still checked
 func TestMATLABRootGetter_GetAll_StatError(t *testing.T) {
 	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockOSLayer := &mocks.MockOSLayer{}
 	defer mockOSLayer.AssertExpectations(t)
 
@@ -155,7 +168,9 @@ func TestMATLABRootGetter_GetAll_StatError(t *testing.T) {
 		Return(pathEnv).
 		Once()
 
-	service := matlabroot.New(mockOSLayer, mockFileLayer)
+	addNoMATLABRootCandidates(mockConfig, mockOSLayer, mockFileLayer)
+
+	service := matlabroot.New(mockConfig, mockOSLayer, mockFileLayer)
 
 	// Act
 	result := service.GetAll(mockLogger)
@@ -171,6 +186,9 @@ func TestMATLABRootGetter_GetAll_StatError(t *testing.T) {
 // that path is skipped and later paths are still checked
 func TestMATLABRootGetter_GetAll_FindsDirectoryInsteadOfBinary(t *testing.T) {
 	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockOSLayer := &mocks.MockOSLayer{}
 	defer mockOSLayer.AssertExpectations(t)
 
@@ -211,7 +229,9 @@ func TestMATLABRootGetter_GetAll_FindsDirectoryInsteadOfBinary(t *testing.T) {
 		Return(pathEnv).
 		Once()
 
-	service := matlabroot.New(mockOSLayer, mockFileLayer)
+	addNoMATLABRootCandidates(mockConfig, mockOSLayer, mockFileLayer)
+
+	service := matlabroot.New(mockConfig, mockOSLayer, mockFileLayer)
 
 	// Act
 	result := service.GetAll(mockLogger)
@@ -228,6 +248,9 @@ func TestMATLABRootGetter_GetAll_FindsDirectoryInsteadOfBinary(t *testing.T) {
 // that path is skipped and later paths are still checked
 func TestMATLABRootGetter_GetAll_SecondEvalSymlinksError(t *testing.T) {
 	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockOSLayer := &mocks.MockOSLayer{}
 	defer mockOSLayer.AssertExpectations(t)
 
@@ -273,7 +296,9 @@ func TestMATLABRootGetter_GetAll_SecondEvalSymlinksError(t *testing.T) {
 		Return(pathEnv).
 		Once()
 
-	service := matlabroot.New(mockOSLayer, mockFileLayer)
+	addNoMATLABRootCandidates(mockConfig, mockOSLayer, mockFileLayer)
+
+	service := matlabroot.New(mockConfig, mockOSLayer, mockFileLayer)
 
 	// Act
 	result := service.GetAll(mockLogger)
@@ -287,6 +312,9 @@ func TestMATLABRootGetter_GetAll_SecondEvalSymlinksError(t *testing.T) {
 
 func TestMATLABRootGetter_GetAll_EmptyPath(t *testing.T) {
 	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockOSLayer := &mocks.MockOSLayer{}
 	defer mockOSLayer.AssertExpectations(t)
 
@@ -300,7 +328,9 @@ func TestMATLABRootGetter_GetAll_EmptyPath(t *testing.T) {
 		Return("").
 		Once()
 
-	service := matlabroot.New(mockOSLayer, mockFileLayer)
+	addNoMATLABRootCandidates(mockConfig, mockOSLayer, mockFileLayer)
+
+	service := matlabroot.New(mockConfig, mockOSLayer, mockFileLayer)
 
 	// Act
 	result := service.GetAll(mockLogger)
@@ -311,6 +341,9 @@ func TestMATLABRootGetter_GetAll_EmptyPath(t *testing.T) {
 
 func TestMATLABRootGetter_GetAll_FileNotExist(t *testing.T) {
 	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockOSLayer := &mocks.MockOSLayer{}
 	defer mockOSLayer.AssertExpectations(t)
 
@@ -339,7 +372,9 @@ func TestMATLABRootGetter_GetAll_FileNotExist(t *testing.T) {
 		Return(nil, os.ErrNotExist).
 		Once()
 
-	service := matlabroot.New(mockOSLayer, mockFileLayer)
+	addNoMATLABRootCandidates(mockConfig, mockOSLayer, mockFileLayer)
+
+	service := matlabroot.New(mockConfig, mockOSLayer, mockFileLayer)
 
 	// Act
 	result := service.GetAll(mockLogger)
@@ -350,6 +385,246 @@ func TestMATLABRootGetter_GetAll_FileNotExist(t *testing.T) {
 	assert.Len(t, mockLogger.WarnLogs(), 0, "No warning logs should be generated for files not existing") //nolint:testifylint // Len check is consistent with other logger checks
 }
 
+func TestMATLABRootGetter_GetAll_AdditionalLocations(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockFileInfo := &osfacademocks.MockFileInfo{}
+	defer mockFileInfo.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	configuredMATLABRoot := filepath.FromSlash("/configured/MATLAB/R2023b")
+	environmentMATLABRoot := filepath.FromSlash("/environment/MATLAB/R2024a")
+	standardMATLABRoot := filepath.FromSlash("/standard/MATLAB/R2024b")
+
+	mockOSLayer.EXPECT().
+		Getenv("PATH").
+		Return("").
+		Once()
+
+	addMATLABRootCandidates(mockConfig, mockOSLayer, mockFileLayer, []string{configuredMATLABRoot}, environmentMATLABRoot, []string{standardMATLABRoot})
+
+	for _, matlabRoot := range []string{configuredMATLABRoot, environmentMATLABRoot, standardMATLABRoot} {
+		addSuccessfulMATLABRootCheck(matlabRoot, matlabRoot, mockOSLayer, mockFileLayer, mockFileInfo)
+	}
+
+	service := matlabroot.New(mockConfig, mockOSLayer, mockFileLayer)
+
+	// Act
+	result := service.GetAll(mockLogger)
+
+	// Assert
+	assert.Equal(t, []string{configuredMATLABRoot, environmentMATLABRoot, standardMATLABRoot}, result)
+	assert.Empty(t, mockLogger.WarnLogs())
+}
+
+func TestMATLABRootGetter_GetAll_DeduplicatesResolvedMATLABRoots(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockFileInfo := &osfacademocks.MockFileInfo{}
+	defer mockFileInfo.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	binPath := filepath.FromSlash("/usr/local/MATLAB/R2024b/bin")
+	matlabRoot := filepath.Dir(binPath)
+	symlinkedMATLABRoot := filepath.FromSlash("/opt/MATLAB")
+
+	mockOSLayer.EXPECT().
+		Getenv("PATH").
+		Return(binPath).
+		Once()
+
+	addSuccessfulPathCheck(binPath, mockOSLayer, mockFileLayer, mockFileInfo)
+
+	addMATLABRootCandidates(mockConfig, mockOSLayer, mockFileLayer, []string{matlabRoot}, "", []string{symlinkedMATLABRoot})
+
+	addSuccessfulMATLABRootCheck(matlabRoot, matlabRoot, mockOSLayer, mockFileLayer, mockFileInfo)
+	addSuccessfulMATLABRootCheck(symlinkedMATLABRoot, matlabRoot, mockOSLayer, mockFileLayer, mockFileInfo)
+
+	service := matlabroot.New(mockConfig, mockOSLayer, mockFileLayer)
+
+	// Act
+	result := service.GetAll(mockLogger)
+
+	// Assert
+	assert.Equal(t, []string{matlabRoot}, result)
+}
+
+func TestMATLABRootGetter_GetAll_MATLABRootCandidateNotExist(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	missingMATLABRoot := filepath.FromSlash("/missing/MATLAB/R2024b")
+
+	mockOSLayer.EXPECT().
+		Getenv("PATH").
+		Return("").
+		Once()
+
+	addMATLABRootCandidates(mockConfig, mockOSLayer, mockFileLayer, []string{missingMATLABRoot}, "", nil)
+
+	mockFileLayer.EXPECT().
+		EvalSymlinks(missingMATLABRoot).
+		Return("", os.ErrNotExist).
+		Once()
+
+	service := matlabroot.New(mockConfig, mockOSLayer, mockFileLayer)
+
+	// Act
+	result := service.GetAll(mockLogger)
+
+	// Assert
+	assert.Nil(t, result)
+	assert.Empty(t, mockLogger.WarnLogs(), "No warning logs should be generated for MATLAB roots not existing")
+}
+
+func TestMATLABRootGetter_GetAll_MATLABRootCandidateWithoutExecutable(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	notAMATLABRoot := filepath.FromSlash("/opt/MATLAB")
+
+	mockOSLayer.EXPECT().
+		Getenv("PATH").
+		Return("").
+		Once()
+
+	addMATLABRootCandidates(mockConfig, mockOSLayer, mockFileLayer, nil, "", []string{notAMATLABRoot})
+
+	mockFileLayer.EXPECT().
+		EvalSymlinks(notAMATLABRoot).
+		Return(notAMATLABRoot, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat(filepath.Join(notAMATLABRoot, "bin", config.MATLABExeName)).
+		Return(nil, os.ErrNotExist).
+		Once()
+
+	service := matlabroot.New(mockConfig, mockOSLayer, mockFileLayer)
+
+	// Act
+	result := service.GetAll(mockLogger)
+
+	// Assert
+	assert.Nil(t, result)
+	assert.Empty(t, mockLogger.WarnLogs())
+}
+
+func addNoMATLABRootCandidates(
+	mockConfig *mocks.MockConfig,
+	mockOSLayer *mocks.MockOSLayer,
+	mockFileLayer *mocks.MockFileLayer,
+) {
+	addMATLABRootCandidates(mockConfig, mockOSLayer, mockFileLayer, nil, "", nil)
+}
+
+// addMATLABRootCandidates sets up the search of the additional locations, with the standard MATLAB roots
+// returned by the first standard search pattern
+func addMATLABRootCandidates(
+	mockConfig *mocks.MockConfig,
+	mockOSLayer *mocks.MockOSLayer,
+	mockFileLayer *mocks.MockFileLayer,
+	additionalMATLABRoots []string,
+	matlabRootsEnv string,
+	standardMATLABRoots []string,
+) {
+	mockConfig.EXPECT().
+		AdditionalMATLABRoots().
+		Return(additionalMATLABRoots).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Getenv("MATLAB_ROOTS").
+		Return(matlabRootsEnv).
+		Once()
+
+	homeDir := filepath.FromSlash("/home/user")
+	if len(config.UserMATLABRootSearchPatterns) > 0 {
+		mockOSLayer.EXPECT().
+			UserHomeDir().
+			Return(homeDir, nil).
+			Once()
+	}
+
+	for i, pattern := range config.MATLABRootSearchPatterns {
+		var matches []string
+		if i == 0 {
+			matches = standardMATLABRoots
+		}
+
+		mockFileLayer.EXPECT().
+			Glob(pattern).
+			Return(matches, nil).
+			Once()
+	}
+
+	for _, pattern := range config.UserMATLABRootSearchPatterns {
+		mockFileLayer.EXPECT().
+			Glob(filepath.Join(homeDir, pattern)).
+			Return(nil, nil).
+			Once()
+	}
+}
+
+func addSuccessfulMATLABRootCheck(
+	matlabRoot string,
+	resolvedMATLABRoot string,
+	mockOSLayer *mocks.MockOSLayer,
+	mockFileLayer *mocks.MockFileLayer,
+	mockFileInfo *osfacademocks.MockFileInfo,
+) {
+	mockFileLayer.EXPECT().
+		EvalSymlinks(matlabRoot).
+		Return(resolvedMATLABRoot, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat(filepath.Join(resolvedMATLABRoot, "bin", config.MATLABExeName)).
+		Return(mockFileInfo, nil).
+		Once()
+
+	mockFileInfo.EXPECT().
+		IsDir().
+		Return(false).
+		Once()
+}
+
 func addSuccessfulPathCheck(
 	path string,
 	mockOSLayer *mocks.MockOSLayer,
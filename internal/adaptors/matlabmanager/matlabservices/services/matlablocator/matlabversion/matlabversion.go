@@ -90,9 +90,9 @@ func (s *Getter) parseReleasePhase(description string) (string, int) {
 
 	var releasePhase string
 	if strings.Contains(result["phase"], "Pre") {
-		releasePhase = "Prerelease"
+		releasePhase = datatypes.ReleasePhasePrerelease
 	} else {
-		releasePhase = "Release"
+		releasePhase = datatypes.ReleasePhaseRelease
 	}
 
 	updateLevel, err := strconv.Atoi(result["update"])
@@ -0,0 +1,99 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package remotematlabsession
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+type OSLayer interface {
+	ReadFile(filePath string) ([]byte, error)
+}
+
+type MATLABFiles interface {
+	GetAll() map[string][]byte
+}
+
+type Connector struct {
+	osLayer     OSLayer
+	matlabFiles MATLABFiles
+}
+
+func NewConnector(
+	osLayer OSLayer,
+	matlabFiles MATLABFiles,
+) *Connector {
+	return &Connector{
+		osLayer:     osLayer,
+		matlabFiles: matlabFiles,
+	}
+}
+
+func (c *Connector) ConnectToRemoteMATLABSession(logger entities.Logger, request datatypes.RemoteSessionDetails) (embeddedconnector.ConnectionDetails, func(killMATLAB bool) error, error) {
+	logger.Debug("Connecting to a remote MATLAB session")
+
+	apiKey, err := c.osLayer.ReadFile(request.APIKeyFile)
+	if err != nil {
+		return embeddedconnector.ConnectionDetails{}, nil, fmt.Errorf("failed to read API key file: %w", err)
+	}
+
+	trimmedAPIKey := strings.TrimSpace(string(apiKey))
+	if trimmedAPIKey == "" {
+		return embeddedconnector.ConnectionDetails{}, nil, fmt.Errorf("API key file %s is empty", request.APIKeyFile)
+	}
+
+	certificatePEM, err := c.osLayer.ReadFile(request.CertificateFile)
+	if err != nil {
+		return embeddedconnector.ConnectionDetails{}, nil, fmt.Errorf("failed to read certificate file: %w", err)
+	}
+
+	// The remote MATLAB session is managed outside of the server, so there is nothing to clean up.
+	return embeddedconnector.ConnectionDetails{
+		Host:           request.Host,
+		Port:           request.Port,
+		APIKey:         trimmedAPIKey,
+		CertificatePEM: certificatePEM,
+	}, func(_ bool) error {
+		return nil
+	}, nil
+}
+
+// InstallMATLABPackage writes the +matlab_mcp package to a temporary folder of the remote MATLAB session, and adds that folder to its path.
+// The remote MATLAB session was not started by the server, so it does not have the package the tools rely on.
+// The package is written with plain evaluations, which, unlike the other requests of the server, do not need the package.
+func (c *Connector) InstallMATLABPackage(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient) error {
+	logger.Debug("Installing the +matlab_mcp package in the remote MATLAB session")
+
+	var code strings.Builder
+	code.WriteString("matlabMCPPackageDir = fullfile(tempdir, 'matlab_mcp_core_server', '+matlab_mcp');")
+	code.WriteString("if ~isfolder(matlabMCPPackageDir), mkdir(matlabMCPPackageDir); end;")
+
+	files := c.matlabFiles.GetAll()
+	for _, fileName := range slices.Sorted(maps.Keys(files)) {
+		fmt.Fprintf(&code,
+			"matlabMCPFileID = fopen(fullfile(matlabMCPPackageDir, '%s'), 'w');fwrite(matlabMCPFileID, matlab.net.base64decode('%s'));fclose(matlabMCPFileID);",
+			fileName, base64.StdEncoding.EncodeToString(files[fileName]),
+		)
+	}
+
+	// The session directory provides the +matlab_mcp package, and the session state is reset to the starting directory
+	code.WriteString("addpath(fileparts(matlabMCPPackageDir));rehash;")
+	code.WriteString("setenv('MW_MCP_SESSION_DIR', fileparts(matlabMCPPackageDir));")
+	code.WriteString("if isempty(getenv('MW_MCP_STARTING_DIR')), setenv('MW_MCP_STARTING_DIR', pwd); end;")
+	code.WriteString("clear matlabMCPPackageDir matlabMCPFileID;")
+
+	if _, err := client.Eval(ctx, logger, entities.EvalRequest{Code: code.String()}); err != nil {
+		return fmt.Errorf("failed to install the +matlab_mcp package in the remote MATLAB session: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,265 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package remotematlabsession_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/remotematlabsession"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager/matlabservices/services/remotematlabsession"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewConnector_HappyPath(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockMATLABFiles := &mocks.MockMATLABFiles{}
+	defer mockMATLABFiles.AssertExpectations(t)
+
+	// Act
+	connector := remotematlabsession.NewConnector(mockOSLayer, mockMATLABFiles)
+
+	// Assert
+	assert.NotNil(t, connector)
+}
+
+func TestConnector_ConnectToRemoteMATLABSession_HappyPath(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockMATLABFiles := &mocks.MockMATLABFiles{}
+	defer mockMATLABFiles.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	request := datatypes.RemoteSessionDetails{
+		Host:            "matlab.example.com",
+		Port:            "31515",
+		APIKeyFile:      filepath.Join("path", "to", "apikey"),
+		CertificateFile: filepath.Join("path", "to", "cert.pem"),
+	}
+	expectedAPIKey := "test-api-key-12345"
+	expectedCertificatePEM := []byte("-----BEGIN CERTIFICATE-----\ntest-cert\n-----END CERTIFICATE-----")
+
+	mockOSLayer.EXPECT().
+		ReadFile(request.APIKeyFile).
+		Return([]byte(expectedAPIKey+"\n"), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(request.CertificateFile).
+		Return(expectedCertificatePEM, nil).
+		Once()
+
+	connector := remotematlabsession.NewConnector(mockOSLayer, mockMATLABFiles)
+
+	// Act
+	connectionDetails, cleanup, err := connector.ConnectToRemoteMATLABSession(mockLogger, request)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, embeddedconnector.ConnectionDetails{
+		Host:           request.Host,
+		Port:           request.Port,
+		APIKey:         expectedAPIKey,
+		CertificatePEM: expectedCertificatePEM,
+	}, connectionDetails)
+	require.NotNil(t, cleanup)
+	require.NoError(t, cleanup(false))
+}
+
+func TestConnector_ConnectToRemoteMATLABSession_APIKeyFileReadError(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockMATLABFiles := &mocks.MockMATLABFiles{}
+	defer mockMATLABFiles.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	request := datatypes.RemoteSessionDetails{
+		Host:            "matlab.example.com",
+		Port:            "31515",
+		APIKeyFile:      filepath.Join("path", "to", "apikey"),
+		CertificateFile: filepath.Join("path", "to", "cert.pem"),
+	}
+	expectedError := assert.AnError
+
+	mockOSLayer.EXPECT().
+		ReadFile(request.APIKeyFile).
+		Return(nil, expectedError).
+		Once()
+
+	connector := remotematlabsession.NewConnector(mockOSLayer, mockMATLABFiles)
+
+	// Act
+	connectionDetails, cleanup, err := connector.ConnectToRemoteMATLABSession(mockLogger, request)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, connectionDetails)
+	assert.Nil(t, cleanup)
+}
+
+func TestConnector_ConnectToRemoteMATLABSession_EmptyAPIKeyFile(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockMATLABFiles := &mocks.MockMATLABFiles{}
+	defer mockMATLABFiles.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	request := datatypes.RemoteSessionDetails{
+		Host:            "matlab.example.com",
+		Port:            "31515",
+		APIKeyFile:      filepath.Join("path", "to", "apikey"),
+		CertificateFile: filepath.Join("path", "to", "cert.pem"),
+	}
+
+	mockOSLayer.EXPECT().
+		ReadFile(request.APIKeyFile).
+		Return([]byte(" \n"), nil).
+		Once()
+
+	connector := remotematlabsession.NewConnector(mockOSLayer, mockMATLABFiles)
+
+	// Act
+	connectionDetails, cleanup, err := connector.ConnectToRemoteMATLABSession(mockLogger, request)
+
+	// Assert
+	require.Error(t, err)
+	assert.Empty(t, connectionDetails)
+	assert.Nil(t, cleanup)
+}
+
+func TestConnector_ConnectToRemoteMATLABSession_CertificateFileReadError(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockMATLABFiles := &mocks.MockMATLABFiles{}
+	defer mockMATLABFiles.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	request := datatypes.RemoteSessionDetails{
+		Host:            "matlab.example.com",
+		Port:            "31515",
+		APIKeyFile:      filepath.Join("path", "to", "apikey"),
+		CertificateFile: filepath.Join("path", "to", "cert.pem"),
+	}
+	expectedError := assert.AnError
+
+	mockOSLayer.EXPECT().
+		ReadFile(request.APIKeyFile).
+		Return([]byte("test-api-key-12345"), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(request.CertificateFile).
+		Return(nil, expectedError).
+		Once()
+
+	connector := remotematlabsession.NewConnector(mockOSLayer, mockMATLABFiles)
+
+	// Act
+	connectionDetails, cleanup, err := connector.ConnectToRemoteMATLABSession(mockLogger, request)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, connectionDetails)
+	assert.Nil(t, cleanup)
+}
+
+func TestConnector_InstallMATLABPackage_HappyPath(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockMATLABFiles := &mocks.MockMATLABFiles{}
+	defer mockMATLABFiles.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockMATLABFiles.EXPECT().
+		GetAll().
+		Return(map[string][]byte{
+			"mcpEval.m":       []byte("function mcpEval()"),
+			"initializeMCP.m": []byte("function initializeMCP()"),
+		}).
+		Once()
+
+	expectedCode := "matlabMCPPackageDir = fullfile(tempdir, 'matlab_mcp_core_server', '+matlab_mcp');" +
+		"if ~isfolder(matlabMCPPackageDir), mkdir(matlabMCPPackageDir); end;" +
+		"matlabMCPFileID = fopen(fullfile(matlabMCPPackageDir, 'initializeMCP.m'), 'w');fwrite(matlabMCPFileID, matlab.net.base64decode('ZnVuY3Rpb24gaW5pdGlhbGl6ZU1DUCgp'));fclose(matlabMCPFileID);" +
+		"matlabMCPFileID = fopen(fullfile(matlabMCPPackageDir, 'mcpEval.m'), 'w');fwrite(matlabMCPFileID, matlab.net.base64decode('ZnVuY3Rpb24gbWNwRXZhbCgp'));fclose(matlabMCPFileID);" +
+		"addpath(fileparts(matlabMCPPackageDir));rehash;" +
+		"setenv('MW_MCP_SESSION_DIR', fileparts(matlabMCPPackageDir));" +
+		"if isempty(getenv('MW_MCP_STARTING_DIR')), setenv('MW_MCP_STARTING_DIR', pwd); end;" +
+		"clear matlabMCPPackageDir matlabMCPFileID;"
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: expectedCode}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	connector := remotematlabsession.NewConnector(mockOSLayer, mockMATLABFiles)
+
+	// Act
+	err := connector.InstallMATLABPackage(ctx, mockLogger, mockClient)
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestConnector_InstallMATLABPackage_EvalError(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockMATLABFiles := &mocks.MockMATLABFiles{}
+	defer mockMATLABFiles.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockMATLABFiles.EXPECT().
+		GetAll().
+		Return(map[string][]byte{}).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), mock.Anything).
+		Return(entities.EvalResponse{}, assert.AnError).
+		Once()
+
+	connector := remotematlabsession.NewConnector(mockOSLayer, mockMATLABFiles)
+
+	// Act
+	err := connector.InstallMATLABPackage(ctx, mockLogger, mockClient)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.ErrorContains(t, err, "failed to install the +matlab_mcp package")
+}
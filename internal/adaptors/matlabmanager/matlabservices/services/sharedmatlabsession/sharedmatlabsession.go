@@ -0,0 +1,64 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package sharedmatlabsession
+
+import (
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession/directorymanager"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+type SessionDirectoryFactory interface {
+	Open(logger entities.Logger, sessionDir string) (directorymanager.Directory, error)
+}
+
+type Attacher struct {
+	directoryFactory SessionDirectoryFactory
+}
+
+func NewAttacher(
+	directoryFactory SessionDirectoryFactory,
+) *Attacher {
+	return &Attacher{
+		directoryFactory: directoryFactory,
+	}
+}
+
+func (a *Attacher) AttachToSharedMATLABSession(logger entities.Logger, request datatypes.SharedSessionDetails) (embeddedconnector.ConnectionDetails, func(killMATLAB bool) error, error) {
+	logger.Debug("Attaching to a shared MATLAB session")
+
+	sessionDir, err := a.directoryFactory.Open(logger, request.DiscoveryFolder)
+	if err != nil {
+		return embeddedconnector.ConnectionDetails{}, nil, err
+	}
+
+	sessionDirPath := sessionDir.Path()
+
+	logger = logger.With("session_dir", sessionDirPath)
+	logger.
+		With("matlab_code", "addpath('"+strings.ReplaceAll(sessionDirPath, "'", "''")+"');matlab_mcp.share();").
+		Info("Waiting for a MATLAB session to be shared, run the code in MATLAB to share it")
+
+	securePort, certificatePEM, err := sessionDir.GetEmbeddedConnectorDetails()
+	if err != nil {
+		return embeddedconnector.ConnectionDetails{}, nil, err
+	}
+
+	apiKey, err := sessionDir.APIKey()
+	if err != nil {
+		return embeddedconnector.ConnectionDetails{}, nil, err
+	}
+
+	// The discovery folder belongs to the user, and the MATLAB session outlives the server, so there is nothing to clean up.
+	return embeddedconnector.ConnectionDetails{
+		Host:           "localhost",
+		Port:           securePort,
+		APIKey:         apiKey,
+		CertificatePEM: certificatePEM,
+	}, func(_ bool) error {
+		return nil
+	}, nil
+}
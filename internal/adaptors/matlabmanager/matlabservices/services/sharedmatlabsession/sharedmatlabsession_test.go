@@ -0,0 +1,203 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package sharedmatlabsession_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/sharedmatlabsession"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	directorymocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager/matlabservices/services/localmatlabsession/directorymanager"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager/matlabservices/services/sharedmatlabsession"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAttacher_HappyPath(t *testing.T) {
+	// Arrange
+	mockDirectoryFactory := &mocks.MockSessionDirectoryFactory{}
+	defer mockDirectoryFactory.AssertExpectations(t)
+
+	// Act
+	attacher := sharedmatlabsession.NewAttacher(mockDirectoryFactory)
+
+	// Assert
+	assert.NotNil(t, attacher)
+}
+
+func TestAttacher_AttachToSharedMATLABSession_HappyPath(t *testing.T) {
+	// Arrange
+	mockDirectoryFactory := &mocks.MockSessionDirectoryFactory{}
+	defer mockDirectoryFactory.AssertExpectations(t)
+
+	mockDirectory := &directorymocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	expectedDiscoveryFolder := filepath.Join("home", "user", "shared-matlab")
+	expectedSecurePort := "9999"
+	expectedCertificatePEM := []byte("-----BEGIN CERTIFICATE-----\ntest-cert\n-----END CERTIFICATE-----")
+	expectedAPIKey := "test-api-key-12345"
+
+	mockDirectoryFactory.EXPECT().
+		Open(mockLogger.AsMockArg(), expectedDiscoveryFolder).
+		Return(mockDirectory, nil).
+		Once()
+
+	mockDirectory.EXPECT().
+		Path().
+		Return(expectedDiscoveryFolder).
+		Once()
+
+	mockDirectory.EXPECT().
+		GetEmbeddedConnectorDetails().
+		Return(expectedSecurePort, expectedCertificatePEM, nil).
+		Once()
+
+	mockDirectory.EXPECT().
+		APIKey().
+		Return(expectedAPIKey, nil).
+		Once()
+
+	attacher := sharedmatlabsession.NewAttacher(mockDirectoryFactory)
+
+	// Act
+	connectionDetails, cleanup, err := attacher.AttachToSharedMATLABSession(mockLogger, datatypes.SharedSessionDetails{
+		DiscoveryFolder: expectedDiscoveryFolder,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, embeddedconnector.ConnectionDetails{
+		Host:           "localhost",
+		Port:           expectedSecurePort,
+		APIKey:         expectedAPIKey,
+		CertificatePEM: expectedCertificatePEM,
+	}, connectionDetails)
+	require.NotNil(t, cleanup)
+	require.NoError(t, cleanup(false))
+
+	infoLogs := mockLogger.InfoLogs()
+	fields, found := infoLogs["Waiting for a MATLAB session to be shared, run the code in MATLAB to share it"]
+	require.True(t, found)
+	assert.Equal(t, "addpath('"+expectedDiscoveryFolder+"');matlab_mcp.share();", fields["matlab_code"])
+}
+
+func TestAttacher_AttachToSharedMATLABSession_OpenError(t *testing.T) {
+	// Arrange
+	mockDirectoryFactory := &mocks.MockSessionDirectoryFactory{}
+	defer mockDirectoryFactory.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	expectedDiscoveryFolder := filepath.Join("home", "user", "shared-matlab")
+	expectedError := assert.AnError
+
+	mockDirectoryFactory.EXPECT().
+		Open(mockLogger.AsMockArg(), expectedDiscoveryFolder).
+		Return(nil, expectedError).
+		Once()
+
+	attacher := sharedmatlabsession.NewAttacher(mockDirectoryFactory)
+
+	// Act
+	connectionDetails, cleanup, err := attacher.AttachToSharedMATLABSession(mockLogger, datatypes.SharedSessionDetails{
+		DiscoveryFolder: expectedDiscoveryFolder,
+	})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, connectionDetails)
+	assert.Nil(t, cleanup)
+}
+
+func TestAttacher_AttachToSharedMATLABSession_GetEmbeddedConnectorDetailsError(t *testing.T) {
+	// Arrange
+	mockDirectoryFactory := &mocks.MockSessionDirectoryFactory{}
+	defer mockDirectoryFactory.AssertExpectations(t)
+
+	mockDirectory := &directorymocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	expectedDiscoveryFolder := filepath.Join("home", "user", "shared-matlab")
+	expectedError := assert.AnError
+
+	mockDirectoryFactory.EXPECT().
+		Open(mockLogger.AsMockArg(), expectedDiscoveryFolder).
+		Return(mockDirectory, nil).
+		Once()
+
+	mockDirectory.EXPECT().
+		Path().
+		Return(expectedDiscoveryFolder).
+		Once()
+
+	mockDirectory.EXPECT().
+		GetEmbeddedConnectorDetails().
+		Return("", nil, expectedError).
+		Once()
+
+	attacher := sharedmatlabsession.NewAttacher(mockDirectoryFactory)
+
+	// Act
+	connectionDetails, cleanup, err := attacher.AttachToSharedMATLABSession(mockLogger, datatypes.SharedSessionDetails{
+		DiscoveryFolder: expectedDiscoveryFolder,
+	})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, connectionDetails)
+	assert.Nil(t, cleanup)
+}
+
+func TestAttacher_AttachToSharedMATLABSession_APIKeyError(t *testing.T) {
+	// Arrange
+	mockDirectoryFactory := &mocks.MockSessionDirectoryFactory{}
+	defer mockDirectoryFactory.AssertExpectations(t)
+
+	mockDirectory := &directorymocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	expectedDiscoveryFolder := filepath.Join("home", "user", "shared-matlab")
+	expectedError := assert.AnError
+
+	mockDirectoryFactory.EXPECT().
+		Open(mockLogger.AsMockArg(), expectedDiscoveryFolder).
+		Return(mockDirectory, nil).
+		Once()
+
+	mockDirectory.EXPECT().
+		Path().
+		Return(expectedDiscoveryFolder).
+		Once()
+
+	mockDirectory.EXPECT().
+		GetEmbeddedConnectorDetails().
+		Return("9999", []byte("cert"), nil).
+		Once()
+
+	mockDirectory.EXPECT().
+		APIKey().
+		Return("", expectedError).
+		Once()
+
+	attacher := sharedmatlabsession.NewAttacher(mockDirectoryFactory)
+
+	// Act
+	connectionDetails, cleanup, err := attacher.AttachToSharedMATLABSession(mockLogger, datatypes.SharedSessionDetails{
+		DiscoveryFolder: expectedDiscoveryFolder,
+	})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, connectionDetails)
+	assert.Nil(t, cleanup)
+}
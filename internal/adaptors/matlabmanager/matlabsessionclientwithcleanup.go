@@ -10,21 +10,44 @@ import (
 
 type matlabSessionClientWithCleanup struct {
 	entities.MATLABSessionClient
-	sessionCleanup func() error
+	sessionCleanup func(killMATLAB bool) error
+	sessionDetails entities.SessionDetails
+
+	// Only the MATLAB sessions started by the server have a session directory.
+	sessionDir string
+
+	// Shared and remote MATLAB sessions are not owned by the server, so they must be left running when the session is stopped.
+	exitMATLABOnStop bool
 }
 
-func newMATLABSessionClientWithCleanup(matlabSessionClient entities.MATLABSessionClient, sessionCleanup func() error) *matlabSessionClientWithCleanup {
+func newMATLABSessionClientWithCleanup(matlabSessionClient entities.MATLABSessionClient, sessionCleanup func(killMATLAB bool) error, sessionDetails entities.SessionDetails, sessionDir string, exitMATLABOnStop bool) *matlabSessionClientWithCleanup {
 	return &matlabSessionClientWithCleanup{
 		MATLABSessionClient: matlabSessionClient,
 		sessionCleanup:      sessionCleanup,
+		sessionDetails:      sessionDetails,
+		sessionDir:          sessionDir,
+		exitMATLABOnStop:    exitMATLABOnStop,
 	}
 }
 
+func (c *matlabSessionClientWithCleanup) SessionDetails() entities.SessionDetails {
+	return c.sessionDetails
+}
+
+func (c *matlabSessionClientWithCleanup) SessionDir() string {
+	return c.sessionDir
+}
+
 func (c *matlabSessionClientWithCleanup) StopSession(ctx context.Context, sessionLogger entities.Logger) error {
-	_, err := c.Eval(ctx, sessionLogger, entities.EvalRequest{Code: "exit()"})
-	if err != nil {
-		return err
+	if !c.exitMATLABOnStop {
+		return c.sessionCleanup(false)
+	}
+
+	// An unresponsive MATLAB session cannot exit by itself, so its process is killed, rather than left running along with its session directory
+	if _, err := c.Eval(ctx, sessionLogger, entities.EvalRequest{Code: "exit()"}); err != nil {
+		sessionLogger.WithError(err).Warn("failed to exit MATLAB, killing the MATLAB process")
+		return c.sessionCleanup(true)
 	}
 
-	return c.sessionCleanup()
+	return c.sessionCleanup(false)
 }
@@ -0,0 +1,238 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabsessionpool
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionstore"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"golang.org/x/sync/errgroup"
+)
+
+type Config interface {
+	MATLABSessionPoolSize() int
+}
+
+type LoggerFactory interface {
+	GetGlobalLogger() entities.Logger
+}
+
+type LifecycleSignaler interface {
+	AddShutdownFunction(shutdownFcn func() error)
+}
+
+type FileLayer interface {
+	EvalSymlinks(path string) (string, error)
+}
+
+type SessionStarter func(logger entities.Logger, sessionDetails entities.LocalSessionDetails) (matlabsessionstore.MATLABSessionClientWithCleanup, error)
+
+// Pool keeps idle MATLAB sessions started in the background, so that they can be handed out without waiting for MATLAB to start.
+type Pool struct {
+	size      int
+	fileLayer FileLayer
+
+	lock         *sync.Mutex
+	starter      SessionStarter
+	idle         map[entities.LocalSessionDetails][]matlabsessionstore.MATLABSessionClientWithCleanup
+	starting     map[entities.LocalSessionDetails]int
+	shuttingDown bool
+}
+
+func New(
+	config Config,
+	loggerFactory LoggerFactory,
+	lifecycleSignaler LifecycleSignaler,
+	fileLayer FileLayer,
+) *Pool {
+	pool := &Pool{
+		size:      config.MATLABSessionPoolSize(),
+		fileLayer: fileLayer,
+
+		lock:     new(sync.Mutex),
+		idle:     map[entities.LocalSessionDetails][]matlabsessionstore.MATLABSessionClientWithCleanup{},
+		starting: map[entities.LocalSessionDetails]int{},
+	}
+
+	lifecycleSignaler.AddShutdownFunction(func() error {
+		return pool.shutdown(loggerFactory.GetGlobalLogger())
+	})
+
+	return pool
+}
+
+// Start fills the pool for each of the session details, using the starter to start the MATLAB sessions.
+func (p *Pool) Start(logger entities.Logger, starter SessionStarter, sessionDetails []entities.LocalSessionDetails) {
+	if p.size <= 0 {
+		return
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.shuttingDown {
+		return
+	}
+
+	p.starter = starter
+
+	logger.With("pool_size", p.size).With("count", len(sessionDetails)).Info("Starting MATLAB session pool")
+
+	for _, details := range sessionDetails {
+		details = p.normalize(details)
+		if _, exists := p.idle[details]; !exists {
+			p.idle[details] = []matlabsessionstore.MATLABSessionClientWithCleanup{}
+		}
+		p.replenish(logger, details)
+	}
+}
+
+// Take hands out a live idle session matching the session details, if there is one, and replenishes the pool in the background.
+// Idle sessions that no longer respond are stopped and replaced.
+func (p *Pool) Take(ctx context.Context, logger entities.Logger, sessionDetails entities.LocalSessionDetails) (matlabsessionstore.MATLABSessionClientWithCleanup, bool) {
+	sessionDetails = p.normalize(sessionDetails)
+
+	for {
+		client, ok := p.takeIdle(sessionDetails)
+		if !ok {
+			return nil, false
+		}
+
+		isAlive := client.Ping(ctx, logger).IsAlive
+		if !isAlive {
+			logger.With("matlab_root", sessionDetails.MATLABRoot).Warn("Idle MATLAB session from the pool is not responding, replacing it")
+			if err := client.StopSession(context.Background(), logger); err != nil {
+				logger.WithError(err).Warn("Failed to stop unresponsive MATLAB session from the pool")
+			}
+		}
+
+		p.lock.Lock()
+		p.replenish(logger, sessionDetails)
+		p.logStatus(logger, sessionDetails)
+		p.lock.Unlock()
+
+		if isAlive {
+			return client, true
+		}
+	}
+}
+
+func (p *Pool) takeIdle(sessionDetails entities.LocalSessionDetails) (matlabsessionstore.MATLABSessionClientWithCleanup, bool) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	idleClients, exists := p.idle[sessionDetails]
+	if !exists || len(idleClients) == 0 {
+		return nil, false
+	}
+
+	client := idleClients[0]
+	p.idle[sessionDetails] = idleClients[1:]
+
+	return client, true
+}
+
+func (p *Pool) Status() []entities.MATLABSessionPoolStatus {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	status := make([]entities.MATLABSessionPoolStatus, 0, len(p.idle))
+	for details, idleClients := range p.idle {
+		status = append(status, entities.MATLABSessionPoolStatus{
+			MATLABRoot:       details.MATLABRoot,
+			TargetSize:       p.size,
+			IdleSessions:     len(idleClients),
+			StartingSessions: p.starting[details],
+		})
+	}
+
+	sort.Slice(status, func(i, j int) bool {
+		return status[i].MATLABRoot < status[j].MATLABRoot
+	})
+
+	return status
+}
+
+// normalize resolves the MATLAB root, so that requests for the same MATLAB installation match regardless of how its path is spelled.
+func (p *Pool) normalize(sessionDetails entities.LocalSessionDetails) entities.LocalSessionDetails {
+	sessionDetails.MATLABRoot = filepath.Clean(sessionDetails.MATLABRoot)
+	if resolvedRoot, err := p.fileLayer.EvalSymlinks(sessionDetails.MATLABRoot); err == nil {
+		sessionDetails.MATLABRoot = resolvedRoot
+	}
+	return sessionDetails
+}
+
+// replenish must be called while holding the lock.
+func (p *Pool) replenish(logger entities.Logger, sessionDetails entities.LocalSessionDetails) {
+	missing := p.size - len(p.idle[sessionDetails]) - p.starting[sessionDetails]
+	for range missing {
+		p.starting[sessionDetails]++
+		go p.startSession(logger, sessionDetails)
+	}
+}
+
+func (p *Pool) startSession(logger entities.Logger, sessionDetails entities.LocalSessionDetails) {
+	logger = logger.With("matlab_root", sessionDetails.MATLABRoot)
+	logger.Debug("Starting a MATLAB session for the pool")
+
+	client, err := p.starter(logger, sessionDetails)
+
+	p.lock.Lock()
+	p.starting[sessionDetails]--
+
+	if err != nil {
+		p.lock.Unlock()
+		logger.WithError(err).Warn("Failed to start a MATLAB session for the pool")
+		return
+	}
+
+	if p.shuttingDown {
+		p.lock.Unlock()
+		if err := client.StopSession(context.Background(), logger); err != nil {
+			logger.WithError(err).Warn("Failed to stop MATLAB session started during shutdown")
+		}
+		return
+	}
+
+	p.idle[sessionDetails] = append(p.idle[sessionDetails], client)
+	p.logStatus(logger, sessionDetails)
+	p.lock.Unlock()
+}
+
+// logStatus must be called while holding the lock.
+func (p *Pool) logStatus(logger entities.Logger, sessionDetails entities.LocalSessionDetails) {
+	logger.
+		With("matlab_root", sessionDetails.MATLABRoot).
+		With("idle_sessions", len(p.idle[sessionDetails])).
+		With("starting_sessions", p.starting[sessionDetails]).
+		Info("MATLAB session pool status")
+}
+
+func (p *Pool) shutdown(logger entities.Logger) error {
+	p.lock.Lock()
+	p.shuttingDown = true
+	idle := p.idle
+	p.idle = map[entities.LocalSessionDetails][]matlabsessionstore.MATLABSessionClientWithCleanup{}
+	p.lock.Unlock()
+
+	wg := new(errgroup.Group)
+
+	for details, idleClients := range idle {
+		for _, client := range idleClients {
+			wg.Go(func() error {
+				err := client.StopSession(context.Background(), logger)
+				if err != nil {
+					return fmt.Errorf("error stopping pooled session for %s: %w", details.MATLABRoot, err)
+				}
+				return nil
+			})
+		}
+	}
+
+	return wg.Wait()
+}
@@ -0,0 +1,416 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabsessionpool_test
+
+import (
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionpool"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionstore"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager/matlabsessionpool"
+	sessionstoremocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager/matlabsessionstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	waitTimeout  = time.Second
+	waitInterval = 5 * time.Millisecond
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		MATLABSessionPoolSize().
+		Return(2).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	// Act
+	pool := matlabsessionpool.New(mockConfig, mockLoggerFactory, mockLifecycleSignaler, mockFileLayer)
+
+	// Assert
+	assert.NotNil(t, pool)
+	assert.Empty(t, pool.Status())
+}
+
+func TestPool_Start_ZeroSizeDoesNotStartSessions(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+	pool, _ := newPool(t, 0)
+
+	starter := func(entities.Logger, entities.LocalSessionDetails) (matlabsessionstore.MATLABSessionClientWithCleanup, error) {
+		t.Fatal("starter should not be called")
+		return nil, nil
+	}
+
+	// Act
+	pool.Start(mockLogger, starter, []entities.LocalSessionDetails{{MATLABRoot: filepath.Join("path", "to", "matlab")}})
+
+	// Assert
+	assert.Empty(t, pool.Status())
+}
+
+func TestPool_Start_FillsPool(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+	pool, _ := newPool(t, 2)
+
+	sessionDetails := entities.LocalSessionDetails{MATLABRoot: filepath.Join("path", "to", "matlab")}
+	var startedSessions atomic.Int32
+
+	starter := func(_ entities.Logger, requested entities.LocalSessionDetails) (matlabsessionstore.MATLABSessionClientWithCleanup, error) {
+		assert.Equal(t, sessionDetails, requested)
+		startedSessions.Add(1)
+		return &sessionstoremocks.MockMATLABSessionClientWithCleanup{}, nil
+	}
+
+	// Act
+	pool.Start(mockLogger, starter, []entities.LocalSessionDetails{sessionDetails})
+
+	// Assert
+	require.Eventually(t, func() bool {
+		status := pool.Status()
+		return len(status) == 1 && status[0].IdleSessions == 2
+	}, waitTimeout, waitInterval)
+
+	assert.Equal(t, int32(2), startedSessions.Load())
+	assert.Equal(t, []entities.MATLABSessionPoolStatus{
+		{
+			MATLABRoot:       sessionDetails.MATLABRoot,
+			TargetSize:       2,
+			IdleSessions:     2,
+			StartingSessions: 0,
+		},
+	}, pool.Status())
+}
+
+func TestPool_Take_ReturnsIdleSessionAndReplenishes(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+	pool, _ := newPool(t, 1)
+
+	sessionDetails := entities.LocalSessionDetails{MATLABRoot: filepath.Join("path", "to", "matlab")}
+	var startedSessions atomic.Int32
+
+	starter := func(entities.Logger, entities.LocalSessionDetails) (matlabsessionstore.MATLABSessionClientWithCleanup, error) {
+		startedSessions.Add(1)
+		return newLiveClient(), nil
+	}
+
+	pool.Start(mockLogger, starter, []entities.LocalSessionDetails{sessionDetails})
+
+	require.Eventually(t, func() bool {
+		return pool.Status()[0].IdleSessions == 1
+	}, waitTimeout, waitInterval)
+
+	// Act
+	client, ok := pool.Take(t.Context(), mockLogger, sessionDetails)
+
+	// Assert
+	require.True(t, ok)
+	assert.NotNil(t, client)
+
+	require.Eventually(t, func() bool {
+		return pool.Status()[0].IdleSessions == 1
+	}, waitTimeout, waitInterval)
+	assert.Equal(t, int32(2), startedSessions.Load())
+}
+
+func TestPool_Take_UnknownSessionDetails(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+	pool, _ := newPool(t, 1)
+
+	// Act
+	client, ok := pool.Take(t.Context(), mockLogger, entities.LocalSessionDetails{MATLABRoot: filepath.Join("path", "to", "matlab")})
+
+	// Assert
+	assert.False(t, ok)
+	assert.Nil(t, client)
+}
+
+func TestPool_Take_MatchesNormalizedMATLABRoot(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	discoveredRoot := filepath.Join("path", "to", "matlab")
+	requestedRoot := filepath.Join("path", "to", "link") + string(filepath.Separator)
+	resolvedRoot := filepath.Join("path", "to", "resolved")
+
+	mockConfig.EXPECT().
+		MATLABSessionPoolSize().
+		Return(1).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	mockFileLayer.EXPECT().
+		EvalSymlinks(discoveredRoot).
+		Return(resolvedRoot, nil).
+		Once()
+
+	mockFileLayer.EXPECT().
+		EvalSymlinks(filepath.Clean(requestedRoot)).
+		Return(resolvedRoot, nil).
+		Once()
+
+	pool := matlabsessionpool.New(mockConfig, mockLoggerFactory, mockLifecycleSignaler, mockFileLayer)
+
+	var startedSessions atomic.Int32
+	starter := func(_ entities.Logger, requested entities.LocalSessionDetails) (matlabsessionstore.MATLABSessionClientWithCleanup, error) {
+		assert.Equal(t, resolvedRoot, requested.MATLABRoot)
+		if startedSessions.Add(1) > 1 {
+			return nil, assert.AnError
+		}
+		return newLiveClient(), nil
+	}
+
+	pool.Start(mockLogger, starter, []entities.LocalSessionDetails{{MATLABRoot: discoveredRoot}})
+
+	require.Eventually(t, func() bool {
+		return pool.Status()[0].IdleSessions == 1
+	}, waitTimeout, waitInterval)
+
+	// Act
+	client, ok := pool.Take(t.Context(), mockLogger, entities.LocalSessionDetails{MATLABRoot: requestedRoot})
+
+	// Assert
+	require.True(t, ok)
+	assert.NotNil(t, client)
+}
+
+func TestPool_Take_ReplacesUnresponsiveSession(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+	pool, _ := newPool(t, 1)
+
+	sessionDetails := entities.LocalSessionDetails{MATLABRoot: filepath.Join("path", "to", "matlab")}
+
+	deadClient := &sessionstoremocks.MockMATLABSessionClientWithCleanup{}
+	defer deadClient.AssertExpectations(t)
+
+	deadClient.EXPECT().
+		Ping(mock.Anything, mockLogger.AsMockArg()).
+		Return(entities.PingResponse{IsAlive: false}).
+		Once()
+
+	deadClient.EXPECT().
+		StopSession(mock.Anything, mockLogger.AsMockArg()).
+		Return(nil).
+		Once()
+
+	liveClient := newLiveClient()
+	releaseReplacement := make(chan struct{})
+
+	var startedSessions atomic.Int32
+	starter := func(entities.Logger, entities.LocalSessionDetails) (matlabsessionstore.MATLABSessionClientWithCleanup, error) {
+		if startedSessions.Add(1) == 1 {
+			return deadClient, nil
+		}
+		<-releaseReplacement
+		return liveClient, nil
+	}
+
+	pool.Start(mockLogger, starter, []entities.LocalSessionDetails{sessionDetails})
+
+	require.Eventually(t, func() bool {
+		return pool.Status()[0].IdleSessions == 1
+	}, waitTimeout, waitInterval)
+
+	// Act
+	client, ok := pool.Take(t.Context(), mockLogger, sessionDetails)
+
+	// Assert
+	assert.False(t, ok)
+	assert.Nil(t, client)
+
+	close(releaseReplacement)
+
+	require.Eventually(t, func() bool {
+		return pool.Status()[0].IdleSessions == 1
+	}, waitTimeout, waitInterval)
+
+	client, ok = pool.Take(t.Context(), testutils.NewInspectableLogger(), sessionDetails)
+	require.True(t, ok)
+	assert.Same(t, liveClient, client)
+}
+
+func TestPool_Start_StarterErrorLeavesPoolEmpty(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+	pool, _ := newPool(t, 1)
+
+	sessionDetails := entities.LocalSessionDetails{MATLABRoot: filepath.Join("path", "to", "matlab")}
+
+	starter := func(entities.Logger, entities.LocalSessionDetails) (matlabsessionstore.MATLABSessionClientWithCleanup, error) {
+		return nil, assert.AnError
+	}
+
+	// Act
+	pool.Start(mockLogger, starter, []entities.LocalSessionDetails{sessionDetails})
+
+	// Assert
+	require.Eventually(t, func() bool {
+		return pool.Status()[0].StartingSessions == 0
+	}, waitTimeout, waitInterval)
+	assert.Equal(t, 0, pool.Status()[0].IdleSessions)
+
+	client, ok := pool.Take(t.Context(), mockLogger, sessionDetails)
+	assert.False(t, ok)
+	assert.Nil(t, client)
+}
+
+func TestPool_Shutdown_StopsIdleSessions(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+	pool, shutdown := newPoolWithShutdown(t, 1, mockLogger)
+
+	sessionDetails := entities.LocalSessionDetails{MATLABRoot: filepath.Join("path", "to", "matlab")}
+
+	mockClient := &sessionstoremocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient.AssertExpectations(t)
+
+	mockClient.EXPECT().
+		StopSession(mock.Anything, mockLogger.AsMockArg()).
+		Return(nil).
+		Once()
+
+	starter := func(entities.Logger, entities.LocalSessionDetails) (matlabsessionstore.MATLABSessionClientWithCleanup, error) {
+		return mockClient, nil
+	}
+
+	pool.Start(mockLogger, starter, []entities.LocalSessionDetails{sessionDetails})
+
+	require.Eventually(t, func() bool {
+		return pool.Status()[0].IdleSessions == 1
+	}, waitTimeout, waitInterval)
+
+	// Act
+	err := shutdown()
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, pool.Status())
+}
+
+func TestPool_Shutdown_StopSessionError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+	pool, shutdown := newPoolWithShutdown(t, 1, mockLogger)
+
+	sessionDetails := entities.LocalSessionDetails{MATLABRoot: filepath.Join("path", "to", "matlab")}
+
+	mockClient := &sessionstoremocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient.AssertExpectations(t)
+
+	mockClient.EXPECT().
+		StopSession(mock.Anything, mockLogger.AsMockArg()).
+		Return(assert.AnError).
+		Once()
+
+	starter := func(entities.Logger, entities.LocalSessionDetails) (matlabsessionstore.MATLABSessionClientWithCleanup, error) {
+		return mockClient, nil
+	}
+
+	pool.Start(mockLogger, starter, []entities.LocalSessionDetails{sessionDetails})
+
+	require.Eventually(t, func() bool {
+		return pool.Status()[0].IdleSessions == 1
+	}, waitTimeout, waitInterval)
+
+	// Act
+	err := shutdown()
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
+
+func newPool(t *testing.T, size int) (*matlabsessionpool.Pool, func() error) {
+	return newPoolWithShutdown(t, size, testutils.NewInspectableLogger())
+}
+
+func newPoolWithShutdown(t *testing.T, size int, globalLogger entities.Logger) (*matlabsessionpool.Pool, func() error) {
+	t.Helper()
+
+	mockConfig := &mocks.MockConfig{}
+	t.Cleanup(func() { mockConfig.AssertExpectations(t) })
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	t.Cleanup(func() { mockLifecycleSignaler.AssertExpectations(t) })
+
+	mockFileLayer := &mocks.MockFileLayer{}
+
+	var capturedShutdownFunc func() error
+
+	mockConfig.EXPECT().
+		MATLABSessionPoolSize().
+		Return(size).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(shutdownFcn func() error) {
+			capturedShutdownFunc = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(globalLogger)
+
+	mockFileLayer.EXPECT().
+		EvalSymlinks(mock.Anything).
+		RunAndReturn(func(path string) (string, error) { return path, nil })
+
+	return matlabsessionpool.New(mockConfig, mockLoggerFactory, mockLifecycleSignaler, mockFileLayer), capturedShutdownFunc
+}
+
+func newLiveClient() *sessionstoremocks.MockMATLABSessionClientWithCleanup {
+	client := &sessionstoremocks.MockMATLABSessionClientWithCleanup{}
+	client.EXPECT().
+		Ping(mock.Anything, mock.Anything).
+		Return(entities.PingResponse{IsAlive: true}).
+		Maybe()
+	return client
+}
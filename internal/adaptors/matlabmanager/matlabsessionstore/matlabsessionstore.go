@@ -5,6 +5,8 @@ package matlabsessionstore
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
 	"sync"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
@@ -18,6 +20,8 @@ type LoggerFactory interface {
 type MATLABSessionClientWithCleanup interface {
 	entities.MATLABSessionClient
 	StopSession(ctx context.Context, sessionLogger entities.Logger) error
+	SessionDetails() entities.SessionDetails
+	SessionDir() string
 }
 
 type LifecycleSignaler interface {
@@ -85,9 +89,29 @@ func (s *Store) Get(sessionID entities.SessionID) (MATLABSessionClientWithCleanu
 	return client, nil
 }
 
+func (s *Store) Replace(sessionID entities.SessionID, client MATLABSessionClientWithCleanup) error {
+	s.l.Lock()
+	defer s.l.Unlock()
+
+	if _, exists := s.clients[sessionID]; !exists {
+		return fmt.Errorf("session not found: %v", sessionID)
+	}
+
+	s.clients[sessionID] = client
+	return nil
+}
+
 func (s *Store) Remove(sessionID entities.SessionID) {
 	s.l.Lock()
 	defer s.l.Unlock()
 
 	delete(s.clients, sessionID)
 }
+
+// SessionIDs returns the IDs of the sessions in the store, in ascending order.
+func (s *Store) SessionIDs() []entities.SessionID {
+	s.l.RLock()
+	defer s.l.RUnlock()
+
+	return slices.Sorted(maps.Keys(s.clients))
+}
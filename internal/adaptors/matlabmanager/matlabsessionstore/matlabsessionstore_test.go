@@ -406,3 +406,124 @@ func TestStore_AddGetRemove_MultipleClients(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, mockClient3, retrievedClient3)
 }
+
+func TestStore_Replace_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockOldClient := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockOldClient.AssertExpectations(t)
+
+	mockNewClient := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockNewClient.AssertExpectations(t)
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
+	sessionID := store.Add(mockOldClient)
+
+	// Act
+	err := store.Replace(sessionID, mockNewClient)
+
+	// Assert
+	require.NoError(t, err)
+	retrievedClient, err := store.Get(sessionID)
+	require.NoError(t, err)
+	assert.Equal(t, mockNewClient, retrievedClient)
+}
+
+func TestStore_Replace_NonExistentSession_ReturnsError(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
+	nonExistentSessionID := entities.SessionID(999)
+
+	// Act
+	err := store.Replace(nonExistentSessionID, mockClient)
+
+	// Assert
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "session not found")
+
+	retrievedClient, err := store.Get(nonExistentSessionID)
+	require.Error(t, err)
+	assert.Nil(t, retrievedClient)
+}
+
+func TestStore_SessionIDs_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient1 := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient1.AssertExpectations(t)
+
+	mockClient2 := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient2.AssertExpectations(t)
+
+	mockClient3 := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient3.AssertExpectations(t)
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
+
+	firstID := store.Add(mockClient1)
+	secondID := store.Add(mockClient2)
+	thirdID := store.Add(mockClient3)
+	store.Remove(secondID)
+
+	// Act
+	sessionIDs := store.SessionIDs()
+
+	// Assert
+	assert.Equal(t, []entities.SessionID{firstID, thirdID}, sessionIDs)
+}
+
+func TestStore_SessionIDs_EmptyStore(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
+
+	// Act
+	sessionIDs := store.SessionIDs()
+
+	// Assert
+	assert.Empty(t, sessionIDs)
+}
@@ -0,0 +1,37 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabmanager
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// RestartMATLABSession stops the session and starts a new one with the same session details, keeping the session ID.
+// Only the local MATLAB sessions started by the server can be restarted.
+func (m *MATLABManager) RestartMATLABSession(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID) error {
+	client, err := m.sessionStore.Get(sessionID)
+	if err != nil {
+		return err
+	}
+
+	sessionDetails := client.SessionDetails()
+	if _, ok := sessionDetails.(entities.LocalSessionDetails); !ok {
+		return entities.ErrSessionNotOwned
+	}
+
+	sessionLogger = sessionLogger.With("session-id", sessionID)
+
+	if err := client.StopSession(ctx, sessionLogger); err != nil {
+		sessionLogger.WithError(err).Warn("failed to stop MATLAB session, starting a new one anyway")
+	}
+
+	newClient, err := m.startSession(ctx, sessionLogger, sessionDetails)
+	if err != nil {
+		m.sessionStore.Remove(sessionID)
+		return err
+	}
+
+	return m.sessionStore.Replace(sessionID, newClient)
+}
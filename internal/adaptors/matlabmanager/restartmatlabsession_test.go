@@ -0,0 +1,324 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabmanager_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager"
+	sessionstoremocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager/matlabsessionstore"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMATLABManager_RestartMATLABSession_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockOldSessionClient := &sessionstoremocks.MockMATLABSessionClientWithCleanup{}
+	defer mockOldSessionClient.AssertExpectations(t)
+
+	mockNewSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+
+	expectedSessionID := entities.SessionID(123)
+	expectedMATLABRoot := filepath.Join("path", "to", "matlab", "R2023a")
+	expectedStartingDirectory := filepath.Join("path", "to", "project")
+	ctx := t.Context()
+
+	sessionDetails := entities.LocalSessionDetails{
+		MATLABRoot:             expectedMATLABRoot,
+		IsStartingDirectorySet: true,
+		StartingDirectory:      expectedStartingDirectory,
+		ShowMATLABDesktop:      true,
+	}
+
+	connectionDetails := embeddedconnector.ConnectionDetails{
+		Host: "localhost",
+		Port: "1234",
+	}
+
+	mockSessionStore.EXPECT().
+		Get(expectedSessionID).
+		Return(mockOldSessionClient, nil).
+		Once()
+
+	mockOldSessionClient.EXPECT().
+		StopSession(ctx, mock.Anything).
+		Return(nil).
+		Once()
+
+	mockOldSessionClient.EXPECT().
+		SessionDetails().
+		Return(sessionDetails).
+		Once()
+
+	mockMATLABServices.EXPECT().
+		StartLocalMATLABSession(mock.Anything, datatypes.LocalSessionDetails{
+			MATLABRoot:             expectedMATLABRoot,
+			IsStartingDirectorySet: true,
+			StartingDirectory:      expectedStartingDirectory,
+			ShowMATLABDesktop:      true,
+		}).
+		Return(connectionDetails, "", func(_ bool) error { return nil }, nil).
+		Once()
+
+	mockClientFactory.EXPECT().
+		New(connectionDetails).
+		Return(mockNewSessionClient, nil).
+		Once()
+
+	mockSessionStore.EXPECT().
+		Replace(expectedSessionID, mock.AnythingOfType("*matlabmanager.matlabSessionClientWithCleanup")).
+		Return(nil).
+		Once()
+
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionPool)
+
+	// Act
+	err := manager.RestartMATLABSession(ctx, mockLogger, expectedSessionID)
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestMATLABManager_RestartMATLABSession_SessionStoreGetError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	expectedSessionID := entities.SessionID(123)
+	expectedError := assert.AnError
+
+	mockSessionStore.EXPECT().
+		Get(expectedSessionID).
+		Return(nil, expectedError).
+		Once()
+
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionPool)
+
+	// Act
+	err := manager.RestartMATLABSession(t.Context(), mockLogger, expectedSessionID)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestMATLABManager_RestartMATLABSession_StopSessionErrorStillRestarts(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockOldSessionClient := &sessionstoremocks.MockMATLABSessionClientWithCleanup{}
+	defer mockOldSessionClient.AssertExpectations(t)
+
+	mockNewSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+
+	expectedSessionID := entities.SessionID(123)
+	expectedMATLABRoot := filepath.Join("path", "to", "matlab", "R2023a")
+	ctx := t.Context()
+
+	connectionDetails := embeddedconnector.ConnectionDetails{
+		Host: "localhost",
+		Port: "1234",
+	}
+
+	mockSessionStore.EXPECT().
+		Get(expectedSessionID).
+		Return(mockOldSessionClient, nil).
+		Once()
+
+	mockOldSessionClient.EXPECT().
+		StopSession(ctx, mock.Anything).
+		Return(assert.AnError).
+		Once()
+
+	mockOldSessionClient.EXPECT().
+		SessionDetails().
+		Return(entities.LocalSessionDetails{MATLABRoot: expectedMATLABRoot}).
+		Once()
+
+	mockMATLABServices.EXPECT().
+		StartLocalMATLABSession(mock.Anything, datatypes.LocalSessionDetails{MATLABRoot: expectedMATLABRoot}).
+		Return(connectionDetails, "", func(_ bool) error { return nil }, nil).
+		Once()
+
+	mockClientFactory.EXPECT().
+		New(connectionDetails).
+		Return(mockNewSessionClient, nil).
+		Once()
+
+	mockSessionStore.EXPECT().
+		Replace(expectedSessionID, mock.AnythingOfType("*matlabmanager.matlabSessionClientWithCleanup")).
+		Return(nil).
+		Once()
+
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionPool)
+
+	// Act
+	err := manager.RestartMATLABSession(ctx, mockLogger, expectedSessionID)
+
+	// Assert
+	require.NoError(t, err)
+
+	warnLogs := mockLogger.WarnLogs()
+	require.Len(t, warnLogs, 1)
+	assert.Contains(t, warnLogs, "failed to stop MATLAB session, starting a new one anyway")
+}
+
+func TestMATLABManager_RestartMATLABSession_StartErrorRemovesSession(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockOldSessionClient := &sessionstoremocks.MockMATLABSessionClientWithCleanup{}
+	defer mockOldSessionClient.AssertExpectations(t)
+
+	expectedSessionID := entities.SessionID(123)
+	expectedMATLABRoot := filepath.Join("path", "to", "matlab", "R2023a")
+	expectedError := assert.AnError
+	ctx := t.Context()
+
+	mockSessionStore.EXPECT().
+		Get(expectedSessionID).
+		Return(mockOldSessionClient, nil).
+		Once()
+
+	mockOldSessionClient.EXPECT().
+		StopSession(ctx, mock.Anything).
+		Return(nil).
+		Once()
+
+	mockOldSessionClient.EXPECT().
+		SessionDetails().
+		Return(entities.LocalSessionDetails{MATLABRoot: expectedMATLABRoot}).
+		Once()
+
+	mockMATLABServices.EXPECT().
+		StartLocalMATLABSession(mock.Anything, datatypes.LocalSessionDetails{MATLABRoot: expectedMATLABRoot}).
+		Return(embeddedconnector.ConnectionDetails{}, "", nil, expectedError).
+		Once()
+
+	mockSessionStore.EXPECT().
+		Remove(expectedSessionID).
+		Return().
+		Once()
+
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionPool)
+
+	// Act
+	err := manager.RestartMATLABSession(ctx, mockLogger, expectedSessionID)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestMATLABManager_RestartMATLABSession_SessionNotOwned(t *testing.T) {
+	testCases := []struct {
+		name           string
+		sessionDetails entities.SessionDetails
+	}{
+		{
+			name:           "shared session",
+			sessionDetails: entities.SharedSessionDetails{DiscoveryFolder: filepath.Join("path", "to", "shared")},
+		},
+		{
+			name:           "remote session",
+			sessionDetails: entities.RemoteSessionDetails{Host: "matlab.example.com", Port: "31515"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockMATLABServices := &mocks.MockMATLABServices{}
+			defer mockMATLABServices.AssertExpectations(t)
+
+			mockSessionStore := &mocks.MockMATLABSessionStore{}
+			defer mockSessionStore.AssertExpectations(t)
+
+			mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+			defer mockClientFactory.AssertExpectations(t)
+
+			mockSessionPool := &mocks.MockMATLABSessionPool{}
+			defer mockSessionPool.AssertExpectations(t)
+
+			mockSessionClient := &sessionstoremocks.MockMATLABSessionClientWithCleanup{}
+			defer mockSessionClient.AssertExpectations(t)
+
+			expectedSessionID := entities.SessionID(123)
+
+			mockSessionStore.EXPECT().
+				Get(expectedSessionID).
+				Return(mockSessionClient, nil).
+				Once()
+
+			mockSessionClient.EXPECT().
+				SessionDetails().
+				Return(tc.sessionDetails).
+				Once()
+
+			manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionPool)
+
+			// Act
+			err := manager.RestartMATLABSession(t.Context(), mockLogger, expectedSessionID)
+
+			// Assert
+			require.ErrorIs(t, err, entities.ErrSessionNotOwned)
+		})
+	}
+}
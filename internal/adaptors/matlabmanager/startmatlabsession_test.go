@@ -9,9 +9,11 @@ import (
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionstore"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
 	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager"
+	sessionstoremocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager/matlabsessionstore"
 	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -31,6 +33,9 @@ func TestMATLABManager_StartMATLABSession_HappyPath(t *testing.T) {
 	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
 	defer mockClientFactory.AssertExpectations(t)
 
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
 	mockSessionClient := &entitiesmocks.MockMATLABSessionClient{}
 	expectedMATLABRoot := filepath.Join("path", "to", "matlab", "R2023a")
 	expectedSessionID := entities.SessionID(123)
@@ -40,16 +45,23 @@ func TestMATLABManager_StartMATLABSession_HappyPath(t *testing.T) {
 		Port: "1234",
 	}
 
-	sessionCleanupFunc := func() error { return nil }
+	sessionCleanupFunc := func(_ bool) error { return nil }
 
 	expectedLocalSessionDetails := datatypes.LocalSessionDetails{
 		MATLABRoot:             expectedMATLABRoot,
 		IsStartingDirectorySet: false,
 	}
 
+	mockSessionPool.EXPECT().
+		Take(mock.Anything, mockLogger.AsMockArg(), entities.LocalSessionDetails{MATLABRoot: expectedMATLABRoot}).
+		Return(nil, false).
+		Once()
+
+	expectedSessionDir := filepath.Join("tmp", "session")
+
 	mockMATLABServices.EXPECT().
 		StartLocalMATLABSession(mock.Anything, expectedLocalSessionDetails).
-		Return(connectionDetails, sessionCleanupFunc, nil).
+		Return(connectionDetails, expectedSessionDir, sessionCleanupFunc, nil).
 		Once()
 
 	mockClientFactory.EXPECT().
@@ -57,12 +69,16 @@ func TestMATLABManager_StartMATLABSession_HappyPath(t *testing.T) {
 		Return(mockSessionClient, nil).
 		Once()
 
+	var addedClient matlabsessionstore.MATLABSessionClientWithCleanup
 	mockSessionStore.EXPECT().
 		Add(mock.AnythingOfType("*matlabmanager.matlabSessionClientWithCleanup")).
+		Run(func(client matlabsessionstore.MATLABSessionClientWithCleanup) {
+			addedClient = client
+		}).
 		Return(expectedSessionID).
 		Once()
 
-	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory)
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionPool)
 	ctx := t.Context()
 
 	startRequest := entities.LocalSessionDetails{
@@ -73,11 +89,403 @@ func TestMATLABManager_StartMATLABSession_HappyPath(t *testing.T) {
 	// Act
 	sessionID, err := manager.StartMATLABSession(ctx, mockLogger, startRequest)
 
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedSessionID, sessionID)
+	require.NotNil(t, addedClient)
+	assert.Equal(t, expectedSessionDir, addedClient.SessionDir())
+}
+
+func TestMATLABManager_StartMATLABSession_LocalSession_StopSession(t *testing.T) {
+	testCases := []struct {
+		name               string
+		exitErr            error
+		expectedKillMATLAB bool
+	}{
+		{
+			name:               "MATLAB exits",
+			expectedKillMATLAB: false,
+		},
+		{
+			name:               "MATLAB does not respond",
+			exitErr:            assert.AnError,
+			expectedKillMATLAB: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockMATLABServices := &mocks.MockMATLABServices{}
+			defer mockMATLABServices.AssertExpectations(t)
+
+			mockSessionStore := &mocks.MockMATLABSessionStore{}
+			defer mockSessionStore.AssertExpectations(t)
+
+			mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+			defer mockClientFactory.AssertExpectations(t)
+
+			mockSessionPool := &mocks.MockMATLABSessionPool{}
+			defer mockSessionPool.AssertExpectations(t)
+
+			mockSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockSessionClient.AssertExpectations(t)
+
+			ctx := t.Context()
+			startRequest := entities.LocalSessionDetails{
+				MATLABRoot: filepath.Join("path", "to", "matlab", "R2023a"),
+			}
+			connectionDetails := embeddedconnector.ConnectionDetails{
+				Host: "localhost",
+				Port: "1234",
+			}
+
+			sessionCleanupCalled := false
+			sessionCleanupKilledMATLAB := false
+			sessionCleanupFunc := func(killMATLAB bool) error {
+				sessionCleanupCalled = true
+				sessionCleanupKilledMATLAB = killMATLAB
+				return nil
+			}
+
+			var addedClient matlabsessionstore.MATLABSessionClientWithCleanup
+
+			mockSessionPool.EXPECT().
+				Take(mock.Anything, mockLogger.AsMockArg(), startRequest).
+				Return(nil, false).
+				Once()
+
+			mockMATLABServices.EXPECT().
+				StartLocalMATLABSession(mock.Anything, datatypes.LocalSessionDetails{MATLABRoot: startRequest.MATLABRoot}).
+				Return(connectionDetails, "", sessionCleanupFunc, nil).
+				Once()
+
+			mockClientFactory.EXPECT().
+				New(connectionDetails).
+				Return(mockSessionClient, nil).
+				Once()
+
+			mockSessionStore.EXPECT().
+				Add(mock.AnythingOfType("*matlabmanager.matlabSessionClientWithCleanup")).
+				Run(func(client matlabsessionstore.MATLABSessionClientWithCleanup) {
+					addedClient = client
+				}).
+				Return(entities.SessionID(123)).
+				Once()
+
+			mockSessionClient.EXPECT().
+				Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: "exit()"}).
+				Return(entities.EvalResponse{}, testCase.exitErr).
+				Once()
+
+			manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionPool)
+
+			_, err := manager.StartMATLABSession(ctx, mockLogger, startRequest)
+			require.NoError(t, err)
+			require.NotNil(t, addedClient)
+
+			// Act
+			err = addedClient.StopSession(ctx, mockLogger)
+
+			// Assert
+			require.NoError(t, err)
+			assert.True(t, sessionCleanupCalled)
+			assert.Equal(t, testCase.expectedKillMATLAB, sessionCleanupKilledMATLAB)
+		})
+	}
+}
+
+func TestMATLABManager_StartMATLABSession_UsesPooledSession(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockPooledClient := &sessionstoremocks.MockMATLABSessionClientWithCleanup{}
+	defer mockPooledClient.AssertExpectations(t)
+
+	expectedSessionID := entities.SessionID(123)
+
+	startRequest := entities.LocalSessionDetails{
+		MATLABRoot: filepath.Join("path", "to", "matlab", "R2023a"),
+	}
+
+	mockSessionPool.EXPECT().
+		Take(mock.Anything, mockLogger.AsMockArg(), startRequest).
+		Return(mockPooledClient, true).
+		Once()
+
+	mockSessionStore.EXPECT().
+		Add(mockPooledClient).
+		Return(expectedSessionID).
+		Once()
+
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionPool)
+
+	// Act
+	sessionID, err := manager.StartMATLABSession(t.Context(), mockLogger, startRequest)
+
 	// Assert
 	require.NoError(t, err)
 	assert.Equal(t, expectedSessionID, sessionID)
 }
 
+func TestMATLABManager_StartMATLABSession_SharedSession_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockSessionClient.AssertExpectations(t)
+
+	expectedDiscoveryFolder := filepath.Join("path", "to", "shared")
+	expectedSessionID := entities.SessionID(123)
+
+	connectionDetails := embeddedconnector.ConnectionDetails{
+		Host: "localhost",
+		Port: "1234",
+	}
+
+	sessionCleanupCalled := false
+	sessionCleanupFunc := func(_ bool) error {
+		sessionCleanupCalled = true
+		return nil
+	}
+
+	var addedClient matlabsessionstore.MATLABSessionClientWithCleanup
+
+	mockMATLABServices.EXPECT().
+		AttachToSharedMATLABSession(mock.Anything, datatypes.SharedSessionDetails{DiscoveryFolder: expectedDiscoveryFolder}).
+		Return(connectionDetails, sessionCleanupFunc, nil).
+		Once()
+
+	mockClientFactory.EXPECT().
+		New(connectionDetails).
+		Return(mockSessionClient, nil).
+		Once()
+
+	mockSessionStore.EXPECT().
+		Add(mock.AnythingOfType("*matlabmanager.matlabSessionClientWithCleanup")).
+		Run(func(client matlabsessionstore.MATLABSessionClientWithCleanup) {
+			addedClient = client
+		}).
+		Return(expectedSessionID).
+		Once()
+
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionPool)
+	ctx := t.Context()
+
+	startRequest := entities.SharedSessionDetails{
+		DiscoveryFolder: expectedDiscoveryFolder,
+	}
+
+	// Act
+	sessionID, err := manager.StartMATLABSession(ctx, mockLogger, startRequest)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedSessionID, sessionID)
+	require.NotNil(t, addedClient)
+	assert.Equal(t, startRequest, addedClient.SessionDetails())
+	assert.Empty(t, addedClient.SessionDir())
+
+	// Stopping a shared session must not exit MATLAB, so no Eval is expected on the session client
+	require.NoError(t, addedClient.StopSession(ctx, mockLogger))
+	assert.True(t, sessionCleanupCalled)
+}
+
+func TestMATLABManager_StartMATLABSession_RemoteSession_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockSessionClient.AssertExpectations(t)
+
+	expectedSessionID := entities.SessionID(123)
+
+	startRequest := entities.RemoteSessionDetails{
+		Host:            "matlab.example.com",
+		Port:            "31515",
+		APIKeyFile:      filepath.Join("path", "to", "apikey"),
+		CertificateFile: filepath.Join("path", "to", "cert.pem"),
+	}
+
+	connectionDetails := embeddedconnector.ConnectionDetails{
+		Host: startRequest.Host,
+		Port: startRequest.Port,
+	}
+
+	sessionCleanupCalled := false
+	sessionCleanupFunc := func(_ bool) error {
+		sessionCleanupCalled = true
+		return nil
+	}
+
+	var addedClient matlabsessionstore.MATLABSessionClientWithCleanup
+
+	mockMATLABServices.EXPECT().
+		ConnectToRemoteMATLABSession(mock.Anything, datatypes.RemoteSessionDetails{
+			Host:            startRequest.Host,
+			Port:            startRequest.Port,
+			APIKeyFile:      startRequest.APIKeyFile,
+			CertificateFile: startRequest.CertificateFile,
+		}).
+		Return(connectionDetails, sessionCleanupFunc, nil).
+		Once()
+
+	mockClientFactory.EXPECT().
+		New(connectionDetails).
+		Return(mockSessionClient, nil).
+		Once()
+
+	mockMATLABServices.EXPECT().
+		InstallMATLABPackage(mock.Anything, mock.Anything, mockSessionClient).
+		Return(nil).
+		Once()
+
+	mockSessionStore.EXPECT().
+		Add(mock.AnythingOfType("*matlabmanager.matlabSessionClientWithCleanup")).
+		Run(func(client matlabsessionstore.MATLABSessionClientWithCleanup) {
+			addedClient = client
+		}).
+		Return(expectedSessionID).
+		Once()
+
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionPool)
+	ctx := t.Context()
+
+	// Act
+	sessionID, err := manager.StartMATLABSession(ctx, mockLogger, startRequest)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedSessionID, sessionID)
+	require.NotNil(t, addedClient)
+	assert.Equal(t, startRequest, addedClient.SessionDetails())
+	assert.Empty(t, addedClient.SessionDir())
+
+	// Stopping a remote session must not exit MATLAB, so no Eval is expected on the session client
+	require.NoError(t, addedClient.StopSession(ctx, mockLogger))
+	assert.True(t, sessionCleanupCalled)
+}
+
+func TestMATLABManager_StartMATLABSession_RemoteSession_MATLABServicesError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	expectedError := assert.AnError
+
+	mockMATLABServices.EXPECT().
+		ConnectToRemoteMATLABSession(mock.Anything, mock.AnythingOfType("datatypes.RemoteSessionDetails")).
+		Return(embeddedconnector.ConnectionDetails{}, nil, expectedError).
+		Once()
+
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionPool)
+	ctx := t.Context()
+
+	// Act
+	sessionID, err := manager.StartMATLABSession(ctx, mockLogger, entities.RemoteSessionDetails{Host: "matlab.example.com"})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, sessionID)
+}
+
+func TestMATLABManager_StartMATLABSession_RemoteSession_InstallMATLABPackageError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockSessionClient.AssertExpectations(t)
+
+	expectedError := assert.AnError
+	connectionDetails := embeddedconnector.ConnectionDetails{Host: "matlab.example.com"}
+
+	mockMATLABServices.EXPECT().
+		ConnectToRemoteMATLABSession(mock.Anything, mock.AnythingOfType("datatypes.RemoteSessionDetails")).
+		Return(connectionDetails, func(_ bool) error { return nil }, nil).
+		Once()
+
+	mockClientFactory.EXPECT().
+		New(connectionDetails).
+		Return(mockSessionClient, nil).
+		Once()
+
+	mockMATLABServices.EXPECT().
+		InstallMATLABPackage(mock.Anything, mock.Anything, mockSessionClient).
+		Return(expectedError).
+		Once()
+
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionPool)
+	ctx := t.Context()
+
+	// Act
+	sessionID, err := manager.StartMATLABSession(ctx, mockLogger, entities.RemoteSessionDetails{Host: "matlab.example.com"})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, sessionID)
+}
+
 func TestMATLABManager_StartMATLABSession_MATLABServicesError(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
@@ -91,6 +499,9 @@ func TestMATLABManager_StartMATLABSession_MATLABServicesError(t *testing.T) {
 	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
 	defer mockClientFactory.AssertExpectations(t)
 
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
 	expectedMATLABRoot := filepath.Join("path", "to", "matlab", "R2023a")
 	expectedError := assert.AnError
 
@@ -99,12 +510,17 @@ func TestMATLABManager_StartMATLABSession_MATLABServicesError(t *testing.T) {
 		IsStartingDirectorySet: false,
 	}
 
+	mockSessionPool.EXPECT().
+		Take(mock.Anything, mockLogger.AsMockArg(), entities.LocalSessionDetails{MATLABRoot: expectedMATLABRoot}).
+		Return(nil, false).
+		Once()
+
 	mockMATLABServices.EXPECT().
 		StartLocalMATLABSession(mock.Anything, expectedLocalSessionDetails).
-		Return(embeddedconnector.ConnectionDetails{}, nil, expectedError).
+		Return(embeddedconnector.ConnectionDetails{}, "", nil, expectedError).
 		Once()
 
-	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory)
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionPool)
 	ctx := t.Context()
 
 	startRequest := entities.LocalSessionDetails{
@@ -133,12 +549,15 @@ func TestMATLABManager_StartMATLABSession_ClientFactoryError(t *testing.T) {
 	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
 	defer mockClientFactory.AssertExpectations(t)
 
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
 	expectedMATLABRoot := filepath.Join("path", "to", "matlab", "R2023a")
 	connectionDetails := embeddedconnector.ConnectionDetails{
 		Host: "localhost",
 		Port: "12345",
 	}
-	sessionCleanupFunc := func() error { return nil }
+	sessionCleanupFunc := func(_ bool) error { return nil }
 	expectedError := assert.AnError
 
 	expectedLocalSessionDetails := datatypes.LocalSessionDetails{
@@ -146,9 +565,14 @@ func TestMATLABManager_StartMATLABSession_ClientFactoryError(t *testing.T) {
 		IsStartingDirectorySet: false,
 	}
 
+	mockSessionPool.EXPECT().
+		Take(mock.Anything, mockLogger.AsMockArg(), entities.LocalSessionDetails{MATLABRoot: expectedMATLABRoot}).
+		Return(nil, false).
+		Once()
+
 	mockMATLABServices.EXPECT().
 		StartLocalMATLABSession(mock.Anything, expectedLocalSessionDetails).
-		Return(connectionDetails, sessionCleanupFunc, nil).
+		Return(connectionDetails, "", sessionCleanupFunc, nil).
 		Once()
 
 	mockClientFactory.EXPECT().
@@ -156,7 +580,7 @@ func TestMATLABManager_StartMATLABSession_ClientFactoryError(t *testing.T) {
 		Return(nil, expectedError).
 		Once()
 
-	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory)
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionPool)
 	ctx := t.Context()
 
 	startRequest := entities.LocalSessionDetails{
@@ -13,13 +13,28 @@ import (
 
 func (m *MATLABManager) StartMATLABSession(ctx context.Context, sessionLogger entities.Logger, startRequest entities.SessionDetails) (entities.SessionID, error) {
 	var zeroValue entities.SessionID
-	var client matlabsessionstore.MATLABSessionClientWithCleanup
 
+	if localRequest, ok := startRequest.(entities.LocalSessionDetails); ok {
+		if client, ok := m.sessionPool.Take(ctx, sessionLogger, localRequest); ok {
+			sessionLogger.Debug("Using a MATLAB session from the pool")
+			return m.sessionStore.Add(client), nil
+		}
+	}
+
+	client, err := m.startSession(ctx, sessionLogger, startRequest)
+	if err != nil {
+		return zeroValue, err
+	}
+
+	return m.sessionStore.Add(client), nil
+}
+
+func (m *MATLABManager) startSession(ctx context.Context, sessionLogger entities.Logger, startRequest entities.SessionDetails) (matlabsessionstore.MATLABSessionClientWithCleanup, error) {
 	switch request := startRequest.(type) {
 	case entities.LocalSessionDetails:
 		sessionLogger := sessionLogger.With("matlab-root", request.MATLABRoot)
 		// For now, we return embedded connector details, to decouple the session start logic from the client creation.
-		embeddedConnectorEndpoint, sessionCleanup, err := m.matlabServices.StartLocalMATLABSession(sessionLogger,
+		embeddedConnectorEndpoint, sessionDir, sessionCleanup, err := m.matlabServices.StartLocalMATLABSession(sessionLogger,
 			datatypes.LocalSessionDetails{
 				MATLABRoot:             request.MATLABRoot,
 				IsStartingDirectorySet: request.IsStartingDirectorySet,
@@ -28,16 +43,50 @@ func (m *MATLABManager) StartMATLABSession(ctx context.Context, sessionLogger en
 			},
 		)
 		if err != nil {
-			return zeroValue, err
+			return nil, err
 		}
 		embeddedConnectorClient, err := m.clientFactory.New(embeddedConnectorEndpoint)
 		if err != nil {
-			return zeroValue, err
+			return nil, err
+		}
+		return newMATLABSessionClientWithCleanup(embeddedConnectorClient, sessionCleanup, request, sessionDir, true), nil
+	case entities.SharedSessionDetails:
+		sessionLogger := sessionLogger.With("discovery-folder", request.DiscoveryFolder)
+		embeddedConnectorEndpoint, sessionCleanup, err := m.matlabServices.AttachToSharedMATLABSession(sessionLogger,
+			datatypes.SharedSessionDetails{
+				DiscoveryFolder: request.DiscoveryFolder,
+			},
+		)
+		if err != nil {
+			return nil, err
 		}
-		client = newMATLABSessionClientWithCleanup(embeddedConnectorClient, sessionCleanup)
+		embeddedConnectorClient, err := m.clientFactory.New(embeddedConnectorEndpoint)
+		if err != nil {
+			return nil, err
+		}
+		return newMATLABSessionClientWithCleanup(embeddedConnectorClient, sessionCleanup, request, "", false), nil
+	case entities.RemoteSessionDetails:
+		sessionLogger := sessionLogger.With("host", request.Host).With("port", request.Port)
+		embeddedConnectorEndpoint, sessionCleanup, err := m.matlabServices.ConnectToRemoteMATLABSession(sessionLogger,
+			datatypes.RemoteSessionDetails{
+				Host:            request.Host,
+				Port:            request.Port,
+				APIKeyFile:      request.APIKeyFile,
+				CertificateFile: request.CertificateFile,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+		embeddedConnectorClient, err := m.clientFactory.New(embeddedConnectorEndpoint)
+		if err != nil {
+			return nil, err
+		}
+		if err := m.matlabServices.InstallMATLABPackage(ctx, sessionLogger, embeddedConnectorClient); err != nil {
+			return nil, err
+		}
+		return newMATLABSessionClientWithCleanup(embeddedConnectorClient, sessionCleanup, request, "", false), nil
 	default:
-		return zeroValue, fmt.Errorf("unknown request type: %T", request)
+		return nil, fmt.Errorf("unknown request type: %T", request)
 	}
-
-	return m.sessionStore.Add(client), nil
 }
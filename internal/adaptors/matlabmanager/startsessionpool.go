@@ -0,0 +1,27 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabmanager
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionstore"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// StartSessionPool pre-starts MATLAB sessions for every discovered MATLAB root, matching the requests made by the start_matlab_session tool.
+func (m *MATLABManager) StartSessionPool(logger entities.Logger) {
+	matlabInfos := m.matlabServices.ListDiscoveredMatlabInfo(logger)
+
+	sessionDetails := make([]entities.LocalSessionDetails, 0, len(matlabInfos.MatlabInfo))
+	for _, matlabInfo := range matlabInfos.MatlabInfo {
+		sessionDetails = append(sessionDetails, entities.LocalSessionDetails{
+			MATLABRoot: matlabInfo.Location,
+		})
+	}
+
+	m.sessionPool.Start(logger, func(logger entities.Logger, sessionDetails entities.LocalSessionDetails) (matlabsessionstore.MATLABSessionClientWithCleanup, error) {
+		// Pooled sessions are started in the background, independently of any request
+		return m.startSession(context.Background(), logger, sessionDetails)
+	}, sessionDetails)
+}
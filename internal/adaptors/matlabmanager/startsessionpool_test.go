@@ -0,0 +1,93 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabmanager_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionpool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMATLABManager_StartSessionPool_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockSessionClient.AssertExpectations(t)
+
+	firstMATLABRoot := filepath.Join("path", "to", "matlab", "R2024b")
+	secondMATLABRoot := filepath.Join("path", "to", "matlab", "R2025a")
+	connectionDetails := embeddedconnector.ConnectionDetails{
+		Host: "localhost",
+		Port: "1234",
+	}
+
+	var capturedStarter matlabsessionpool.SessionStarter
+
+	mockMATLABServices.EXPECT().
+		ListDiscoveredMatlabInfo(mockLogger.AsMockArg()).
+		Return(datatypes.ListMatlabInfo{
+			MatlabInfo: []datatypes.MatlabInfo{
+				{Location: firstMATLABRoot},
+				{Location: secondMATLABRoot},
+			},
+		}).
+		Once()
+
+	mockSessionPool.EXPECT().
+		Start(mockLogger.AsMockArg(), mock.Anything, []entities.LocalSessionDetails{
+			{MATLABRoot: firstMATLABRoot},
+			{MATLABRoot: secondMATLABRoot},
+		}).
+		Run(func(_ entities.Logger, starter matlabsessionpool.SessionStarter, _ []entities.LocalSessionDetails) {
+			capturedStarter = starter
+		}).
+		Return().
+		Once()
+
+	mockMATLABServices.EXPECT().
+		StartLocalMATLABSession(mock.Anything, datatypes.LocalSessionDetails{MATLABRoot: firstMATLABRoot}).
+		Return(connectionDetails, "", func(_ bool) error { return nil }, nil).
+		Once()
+
+	mockClientFactory.EXPECT().
+		New(connectionDetails).
+		Return(mockSessionClient, nil).
+		Once()
+
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionPool)
+
+	// Act
+	manager.StartSessionPool(mockLogger)
+
+	// Assert
+	require.NotNil(t, capturedStarter)
+
+	client, err := capturedStarter(mockLogger, entities.LocalSessionDetails{MATLABRoot: firstMATLABRoot})
+	require.NoError(t, err)
+	require.NotNil(t, client)
+	assert.Equal(t, entities.LocalSessionDetails{MATLABRoot: firstMATLABRoot}, client.SessionDetails())
+}
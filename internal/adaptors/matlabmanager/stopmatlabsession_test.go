@@ -27,6 +27,9 @@ func TestMATLABManager_StopMATLABSession_HappyPath(t *testing.T) {
 	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
 	defer mockClientFactory.AssertExpectations(t)
 
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
 	mockSessionClient := &sessionstoremocks.MockMATLABSessionClientWithCleanup{}
 
 	expectedSessionID := entities.SessionID(123)
@@ -47,7 +50,7 @@ func TestMATLABManager_StopMATLABSession_HappyPath(t *testing.T) {
 		Return().
 		Once()
 
-	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory)
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionPool)
 
 	// Act
 	err := manager.StopMATLABSession(ctx, mockLogger, expectedSessionID)
@@ -69,6 +72,9 @@ func TestMATLABManager_StopMATLABSession_SessionStoreGetError(t *testing.T) {
 	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
 	defer mockClientFactory.AssertExpectations(t)
 
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
 	expectedSessionID := entities.SessionID(123)
 	ctx := t.Context()
 	expectedError := assert.AnError
@@ -78,7 +84,7 @@ func TestMATLABManager_StopMATLABSession_SessionStoreGetError(t *testing.T) {
 		Return(nil, expectedError).
 		Once()
 
-	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory)
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionPool)
 
 	// Act
 	err := manager.StopMATLABSession(ctx, mockLogger, expectedSessionID)
@@ -100,6 +106,9 @@ func TestMATLABManager_StopMATLABSession_StopSessionError(t *testing.T) {
 	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
 	defer mockClientFactory.AssertExpectations(t)
 
+	mockSessionPool := &mocks.MockMATLABSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
 	mockSessionClient := &sessionstoremocks.MockMATLABSessionClientWithCleanup{}
 
 	expectedSessionID := entities.SessionID(123)
@@ -121,7 +130,7 @@ func TestMATLABManager_StopMATLABSession_StopSessionError(t *testing.T) {
 		Return().
 		Once()
 
-	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory)
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockSessionPool)
 
 	// Act
 	err := manager.StopMATLABSession(ctx, mockLogger, expectedSessionID)
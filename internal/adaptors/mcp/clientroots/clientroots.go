@@ -0,0 +1,147 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package clientroots
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const (
+	fileURIScheme = "file"
+
+	// listRootsTimeout bounds how long the server waits for the MCP client to list its roots.
+	listRootsTimeout = 10 * time.Second
+)
+
+type LoggerFactory interface {
+	GetGlobalLogger() entities.Logger
+}
+
+type Config interface {
+	AllowedRoots() []string
+}
+
+// ClientRoots keeps track of the roots provided by the connected MCP client.
+type ClientRoots struct {
+	loggerFactory LoggerFactory
+	config        Config
+
+	lock          *sync.Mutex
+	roots         []string
+	supportsRoots bool
+}
+
+func New(
+	loggerFactory LoggerFactory,
+	config Config,
+) *ClientRoots {
+	return &ClientRoots{
+		loggerFactory: loggerFactory,
+		config:        config,
+
+		lock:  &sync.Mutex{},
+		roots: []string{},
+	}
+}
+
+// Roots returns the local folders of the roots provided by the MCP client, and whether the MCP client supports roots,
+// either because it advertised the roots capability, or because it listed its roots.
+// Until the MCP client lists its roots, there are none.
+func (c *ClientRoots) Roots() ([]string, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return slices.Clone(c.roots), c.supportsRoots
+}
+
+// HandleInitialized requests the roots from the MCP client once the session is initialized.
+// The roots are requested in the background, so that a slow MCP client does not block the handling of other messages.
+func (c *ClientRoots) HandleInitialized(ctx context.Context, request *mcp.InitializedRequest) {
+	if params := request.Session.InitializeParams(); params != nil && params.Capabilities != nil && params.Capabilities.Roots.ListChanged {
+		c.lock.Lock()
+		c.supportsRoots = true
+		c.lock.Unlock()
+	}
+
+	go c.update(context.WithoutCancel(ctx), request.Session)
+}
+
+// HandleRootsListChanged requests the roots from the MCP client again when they change.
+func (c *ClientRoots) HandleRootsListChanged(ctx context.Context, request *mcp.RootsListChangedRequest) {
+	go c.update(context.WithoutCancel(ctx), request.Session)
+}
+
+func (c *ClientRoots) update(ctx context.Context, session *mcp.ServerSession) {
+	logger := c.loggerFactory.GetGlobalLogger()
+
+	ctx, cancel := context.WithTimeout(ctx, listRootsTimeout)
+	defer cancel()
+
+	result, err := session.ListRoots(ctx, &mcp.ListRootsParams{})
+	if err != nil {
+		// Not all MCP clients support roots, in which case only the configured allowed roots apply.
+		// An MCP client which advertised roots but fails to list them keeps having none.
+		logger.WithError(err).Debug("Failed to list the MCP client roots")
+		return
+	}
+
+	roots := []string{}
+	for _, root := range result.Roots {
+		rootPath, err := fileURIToPath(root.URI)
+		if err != nil {
+			logger.With("uri", root.URI).WithError(err).Warn("Ignoring MCP client root")
+			continue
+		}
+		roots = append(roots, rootPath)
+	}
+
+	logger.With("roots", roots).Info("Updated the MCP client roots")
+
+	switch {
+	case len(c.config.AllowedRoots()) > 0:
+		logger.Info("Ignoring the MCP client roots for path validation, because allowed roots are configured")
+	case len(roots) > 0:
+		logger.With("roots", roots).Info("The MCP client roots restrict the paths the tools can access")
+	default:
+		logger.Warn("None of the MCP client roots is a local folder, so the tools cannot access any path")
+	}
+
+	c.lock.Lock()
+	c.roots = roots
+	c.supportsRoots = true
+	c.lock.Unlock()
+}
+
+func fileURIToPath(uri string) (string, error) {
+	parsedURI, err := url.Parse(uri)
+	if err != nil {
+		return "", err
+	}
+
+	if parsedURI.Scheme != fileURIScheme {
+		return "", fmt.Errorf("unsupported root URI scheme: %q", parsedURI.Scheme)
+	}
+
+	rootPath := parsedURI.Path
+
+	// On Windows, file URIs have the form file:///C:/path/to/folder
+	if len(rootPath) > 0 && rootPath[0] == '/' && filepath.VolumeName(rootPath[1:]) != "" {
+		rootPath = rootPath[1:]
+	}
+
+	rootPath = filepath.Clean(filepath.FromSlash(rootPath))
+	if !filepath.IsAbs(rootPath) {
+		return "", fmt.Errorf("root is not an absolute path: %s", rootPath)
+	}
+
+	return rootPath, nil
+}
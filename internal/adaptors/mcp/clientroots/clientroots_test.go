@@ -0,0 +1,310 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package clientroots_test
+
+import (
+	"net/url"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/clientroots"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/clientroots"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	waitTimeout  = time.Second
+	waitInterval = 5 * time.Millisecond
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	// Act
+	clientRoots := clientroots.New(mockLoggerFactory, mockConfig)
+
+	// Assert
+	require.NotNil(t, clientRoots)
+	roots, supportsRoots := clientRoots.Roots()
+	assert.Empty(t, roots)
+	assert.False(t, supportsRoots)
+}
+
+func TestClientRoots_HandleInitialized_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	testLogger := testutils.NewInspectableLogger()
+
+	projectFolder, err := filepath.Abs("project")
+	require.NoError(t, err)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(testLogger).
+		Once()
+
+	mockConfig.EXPECT().
+		AllowedRoots().
+		Return(nil).
+		Once()
+
+	clientRoots := clientroots.New(mockLoggerFactory, mockConfig)
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil)
+	client.AddRoots(
+		&mcp.Root{URI: fileURI(projectFolder), Name: "project"},
+		&mcp.Root{URI: "https://example.com/project", Name: "remote"},
+	)
+
+	// Act
+	clientSession := connect(t, clientRoots, client)
+	defer func() { _ = clientSession.Close() }()
+
+	// Assert
+	_, supportsRoots := clientRoots.Roots()
+	assert.True(t, supportsRoots, "The advertised roots capability should apply before the roots are listed")
+
+	waitForRoots(t, clientRoots, []string{projectFolder})
+
+	warnLogs := testLogger.WarnLogs()
+	fields, found := warnLogs["Ignoring MCP client root"]
+	require.True(t, found, "Expected warning not found")
+	assert.Equal(t, "https://example.com/project", fields["uri"])
+
+	infoLogs := testLogger.InfoLogs()
+	fields, found = infoLogs["The MCP client roots restrict the paths the tools can access"]
+	require.True(t, found, "Expected info log not found")
+	assert.Equal(t, []string{projectFolder}, fields["roots"])
+}
+
+func TestClientRoots_HandleInitialized_AllowedRootsConfigured(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	testLogger := testutils.NewInspectableLogger()
+
+	projectFolder, err := filepath.Abs("project")
+	require.NoError(t, err)
+
+	allowedRoot, err := filepath.Abs("allowed")
+	require.NoError(t, err)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(testLogger).
+		Once()
+
+	mockConfig.EXPECT().
+		AllowedRoots().
+		Return([]string{allowedRoot}).
+		Once()
+
+	clientRoots := clientroots.New(mockLoggerFactory, mockConfig)
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil)
+	client.AddRoots(&mcp.Root{URI: fileURI(projectFolder)})
+
+	// Act
+	clientSession := connect(t, clientRoots, client)
+	defer func() { _ = clientSession.Close() }()
+
+	// Assert
+	waitForRoots(t, clientRoots, []string{projectFolder})
+
+	infoLogs := testLogger.InfoLogs()
+	_, found := infoLogs["Ignoring the MCP client roots for path validation, because allowed roots are configured"]
+	assert.True(t, found, "Expected info log not found")
+	_, found = infoLogs["The MCP client roots restrict the paths the tools can access"]
+	assert.False(t, found, "Unexpected info log found")
+}
+
+func TestClientRoots_HandleRootsListChanged_NoLocalFolder(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	testLogger := testutils.NewInspectableLogger()
+
+	projectFolder, err := filepath.Abs("project")
+	require.NoError(t, err)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(testLogger).
+		Times(2)
+
+	mockConfig.EXPECT().
+		AllowedRoots().
+		Return(nil).
+		Times(2)
+
+	clientRoots := clientroots.New(mockLoggerFactory, mockConfig)
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil)
+	client.AddRoots(
+		&mcp.Root{URI: fileURI(projectFolder), Name: "project"},
+		&mcp.Root{URI: "https://example.com/project", Name: "remote"},
+	)
+
+	clientSession := connect(t, clientRoots, client)
+	defer func() { _ = clientSession.Close() }()
+
+	waitForRoots(t, clientRoots, []string{projectFolder})
+
+	// Act
+	client.RemoveRoots(fileURI(projectFolder))
+
+	// Assert
+	waitForRoots(t, clientRoots, []string{})
+
+	roots, supportsRoots := clientRoots.Roots()
+	assert.Empty(t, roots)
+	assert.True(t, supportsRoots)
+
+	_, found := testLogger.WarnLogs()["None of the MCP client roots is a local folder, so the tools cannot access any path"]
+	assert.True(t, found, "Expected warning not found")
+}
+
+func TestClientRoots_HandleRootsListChanged_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	testLogger := testutils.NewInspectableLogger()
+
+	firstProjectFolder, err := filepath.Abs("first")
+	require.NoError(t, err)
+
+	secondProjectFolder, err := filepath.Abs("second")
+	require.NoError(t, err)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(testLogger).
+		Times(2)
+
+	mockConfig.EXPECT().
+		AllowedRoots().
+		Return(nil).
+		Times(2)
+
+	clientRoots := clientroots.New(mockLoggerFactory, mockConfig)
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil)
+	client.AddRoots(&mcp.Root{URI: fileURI(firstProjectFolder)})
+
+	clientSession := connect(t, clientRoots, client)
+	defer func() { _ = clientSession.Close() }()
+
+	waitForRoots(t, clientRoots, []string{firstProjectFolder})
+
+	// Act
+	client.AddRoots(&mcp.Root{URI: fileURI(secondProjectFolder)})
+
+	// Assert
+	waitForRoots(t, clientRoots, []string{firstProjectFolder, secondProjectFolder})
+}
+
+func TestClientRoots_Roots_ReturnsCopy(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	projectFolder, err := filepath.Abs("project")
+	require.NoError(t, err)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(testutils.NewInspectableLogger()).
+		Once()
+
+	mockConfig.EXPECT().
+		AllowedRoots().
+		Return(nil).
+		Once()
+
+	clientRoots := clientroots.New(mockLoggerFactory, mockConfig)
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil)
+	client.AddRoots(&mcp.Root{URI: fileURI(projectFolder)})
+
+	clientSession := connect(t, clientRoots, client)
+	defer func() { _ = clientSession.Close() }()
+
+	waitForRoots(t, clientRoots, []string{projectFolder})
+
+	// Act
+	roots, _ := clientRoots.Roots()
+	roots[0] = "modified"
+
+	// Assert
+	roots, _ = clientRoots.Roots()
+	assert.Equal(t, []string{projectFolder}, roots)
+}
+
+// connect connects the client to a server using the client roots handlers,
+// and waits for the initialized notification to be handled.
+// The roots are then requested in the background, see waitForRoots.
+func connect(t *testing.T, clientRoots *clientroots.ClientRoots, client *mcp.Client) *mcp.ClientSession {
+	t.Helper()
+
+	mcpServer := mcp.NewServer(&mcp.Implementation{Name: "test"}, &mcp.ServerOptions{
+		InitializedHandler:      clientRoots.HandleInitialized,
+		RootsListChangedHandler: clientRoots.HandleRootsListChanged,
+	})
+
+	clientTransport, serverTransport := mcp.NewInMemoryTransports()
+
+	serverSession, err := mcpServer.Connect(t.Context(), serverTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = serverSession.Close() })
+
+	clientSession, err := client.Connect(t.Context(), clientTransport, nil)
+	require.NoError(t, err)
+
+	// Notifications are handled in order, so the initialized notification has been handled once the ping returns
+	require.NoError(t, clientSession.Ping(t.Context(), nil))
+
+	return clientSession
+}
+
+func fileURI(folder string) string {
+	return (&url.URL{Scheme: "file", Path: filepath.ToSlash(folder)}).String()
+}
+
+// waitForRoots waits for the roots requested in the background to be updated.
+func waitForRoots(t *testing.T, clientRoots *clientroots.ClientRoots, expectedRoots []string) {
+	t.Helper()
+
+	require.EventuallyWithT(t, func(c *assert.CollectT) {
+		roots, _ := clientRoots.Roots()
+		assert.ElementsMatch(c, expectedRoots, roots)
+	}, waitTimeout, waitInterval)
+}
@@ -0,0 +1,104 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package completion
+
+import (
+	"context"
+	"maps"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/completion/matlabfunctions"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/completion/sessionids"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/completion/testnames"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// maxValues is the maximum number of completion values the MCP specification allows in a response.
+const maxValues = 100
+
+const (
+	functionArgument = "function"
+	testNameArgument = "test_name"
+
+	// idArgument is the session ID variable of the URI templates of the session resources, such as matlab://session/{id}/stdout.
+	idArgument = "id"
+)
+
+type LoggerFactory interface {
+	NewMCPSessionLogger(session *mcp.ServerSession) entities.Logger
+}
+
+// Provider suggests values for one argument of the prompts and resource templates.
+type Provider interface {
+	// Complete returns the values starting with the given partial value.
+	// The arguments are the values the client already resolved for the other arguments, by argument name.
+	Complete(ctx context.Context, sessionLogger entities.Logger, value string, arguments map[string]string) ([]string, error)
+}
+
+// Completion answers the completion requests of the MCP clients, using the provider registered for the requested argument.
+type Completion struct {
+	loggerFactory LoggerFactory
+	providers     map[string]Provider
+}
+
+func New(
+	loggerFactory LoggerFactory,
+	matlabFunctionsProvider *matlabfunctions.Provider,
+	sessionIDsProvider *sessionids.Provider,
+	testNamesProvider *testnames.Provider,
+) *Completion {
+	return newCompletion(loggerFactory, map[string]Provider{
+		functionArgument: matlabFunctionsProvider,
+		idArgument:       sessionIDsProvider,
+		testNameArgument: testNamesProvider,
+	})
+}
+
+func newCompletion(loggerFactory LoggerFactory, providers map[string]Provider) *Completion {
+	return &Completion{
+		loggerFactory: loggerFactory,
+		providers:     providers,
+	}
+}
+
+// HandleCompletion is the completion handler of the MCP server.
+// Arguments without a provider have no completion values, rather than failing the request.
+func (c *Completion) HandleCompletion(ctx context.Context, request *mcp.CompleteRequest) (*mcp.CompleteResult, error) {
+	argument := request.Params.Argument
+	logger := c.loggerFactory.NewMCPSessionLogger(request.Session).
+		With("argument", argument.Name)
+
+	provider, ok := c.providers[argument.Name]
+	if !ok {
+		logger.Debug("No completion provider for the argument")
+		return newCompleteResult([]string{}), nil
+	}
+
+	arguments := map[string]string{}
+	if request.Params.Context != nil {
+		maps.Copy(arguments, request.Params.Context.Arguments)
+	}
+
+	values, err := provider.Complete(ctx, logger, argument.Value, arguments)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to complete the argument")
+		return nil, err
+	}
+
+	return newCompleteResult(values), nil
+}
+
+func newCompleteResult(values []string) *mcp.CompleteResult {
+	total := len(values)
+	if total > maxValues {
+		values = values[:maxValues]
+	}
+
+	return &mcp.CompleteResult{
+		Completion: mcp.CompletionResultDetails{
+			Values:  values,
+			Total:   total,
+			HasMore: total > maxValues,
+		},
+	}
+}
@@ -0,0 +1,7 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package completion
+
+func NewCompletion(loggerFactory LoggerFactory, providers map[string]Provider) *Completion {
+	return newCompletion(loggerFactory, providers)
+}
@@ -0,0 +1,219 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package completion_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/completion"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/completion/matlabfunctions"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/completion/sessionids"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/completion/testnames"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/completion"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	// Act
+	c := completion.New(mockLoggerFactory, &matlabfunctions.Provider{}, &sessionids.Provider{}, &testnames.Provider{})
+
+	// Assert
+	assert.NotNil(t, c)
+}
+
+func TestCompletion_HandleCompletion_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockProvider := &mocks.MockProvider{}
+	defer mockProvider.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedValues := []string{"sin", "sind", "sinh"}
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger).
+		Once()
+
+	mockProvider.EXPECT().
+		Complete(ctx, mockLogger.AsMockArg(), "sin", map[string]string{}).
+		Return(expectedValues, nil).
+		Once()
+
+	c := completion.NewCompletion(mockLoggerFactory, map[string]completion.Provider{"function": mockProvider})
+
+	// Act
+	result, err := c.HandleCompletion(ctx, &mcp.CompleteRequest{
+		Params: &mcp.CompleteParams{
+			Ref:      &mcp.CompleteReference{Type: "ref/resource", URI: "matlabdoc://{function}"},
+			Argument: mcp.CompleteParamsArgument{Name: "function", Value: "sin"},
+		},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedValues, result.Completion.Values)
+	assert.Equal(t, len(expectedValues), result.Completion.Total)
+	assert.False(t, result.Completion.HasMore)
+}
+
+func TestCompletion_HandleCompletion_ContextArguments(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockProvider := &mocks.MockProvider{}
+	defer mockProvider.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	contextArguments := map[string]string{"other": "value"}
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger).
+		Once()
+
+	mockProvider.EXPECT().
+		Complete(ctx, mockLogger.AsMockArg(), "", contextArguments).
+		Return([]string{}, nil).
+		Once()
+
+	c := completion.NewCompletion(mockLoggerFactory, map[string]completion.Provider{"function": mockProvider})
+
+	// Act
+	result, err := c.HandleCompletion(ctx, &mcp.CompleteRequest{
+		Params: &mcp.CompleteParams{
+			Ref:      &mcp.CompleteReference{Type: "ref/resource", URI: "matlabdoc://{function}"},
+			Argument: mcp.CompleteParamsArgument{Name: "function"},
+			Context:  &mcp.CompleteContext{Arguments: contextArguments},
+		},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, result.Completion.Values)
+}
+
+func TestCompletion_HandleCompletion_TooManyValues(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockProvider := &mocks.MockProvider{}
+	defer mockProvider.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	values := []string{}
+	for i := range 150 {
+		values = append(values, fmt.Sprintf("f%d", i))
+	}
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger).
+		Once()
+
+	mockProvider.EXPECT().
+		Complete(ctx, mockLogger.AsMockArg(), "f", map[string]string{}).
+		Return(values, nil).
+		Once()
+
+	c := completion.NewCompletion(mockLoggerFactory, map[string]completion.Provider{"function": mockProvider})
+
+	// Act
+	result, err := c.HandleCompletion(ctx, &mcp.CompleteRequest{
+		Params: &mcp.CompleteParams{
+			Ref:      &mcp.CompleteReference{Type: "ref/resource", URI: "matlabdoc://{function}"},
+			Argument: mcp.CompleteParamsArgument{Name: "function", Value: "f"},
+		},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, values[:100], result.Completion.Values)
+	assert.Equal(t, 150, result.Completion.Total)
+	assert.True(t, result.Completion.HasMore)
+}
+
+func TestCompletion_HandleCompletion_UnknownArgument(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockProvider := &mocks.MockProvider{}
+	defer mockProvider.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger).
+		Once()
+
+	c := completion.NewCompletion(mockLoggerFactory, map[string]completion.Provider{"function": mockProvider})
+
+	// Act
+	result, err := c.HandleCompletion(t.Context(), &mcp.CompleteRequest{
+		Params: &mcp.CompleteParams{
+			Ref:      &mcp.CompleteReference{Type: "ref/prompt", Name: "some_prompt"},
+			Argument: mcp.CompleteParamsArgument{Name: "unknown", Value: "x"},
+		},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotNil(t, result.Completion.Values)
+	assert.Empty(t, result.Completion.Values)
+}
+
+func TestCompletion_HandleCompletion_ProviderError(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockProvider := &mocks.MockProvider{}
+	defer mockProvider.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger).
+		Once()
+
+	mockProvider.EXPECT().
+		Complete(ctx, mockLogger.AsMockArg(), "sin", map[string]string{}).
+		Return(nil, assert.AnError).
+		Once()
+
+	c := completion.NewCompletion(mockLoggerFactory, map[string]completion.Provider{"function": mockProvider})
+
+	// Act
+	result, err := c.HandleCompletion(ctx, &mcp.CompleteRequest{
+		Params: &mcp.CompleteParams{
+			Ref:      &mcp.CompleteReference{Type: "ref/resource", URI: "matlabdoc://{function}"},
+			Argument: mcp.CompleteParamsArgument{Name: "function", Value: "sin"},
+		},
+	})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, result)
+	assert.NotEmpty(t, mockLogger.WarnLogs())
+}
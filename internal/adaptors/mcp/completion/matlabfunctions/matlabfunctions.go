@@ -0,0 +1,76 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabfunctions
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/findmatlabfunctions"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/debugsession"
+)
+
+type Config interface {
+	UseSingleMATLABSession() bool
+}
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request findmatlabfunctions.Args) (findmatlabfunctions.ReturnArgs, error)
+}
+
+type GlobalMATLAB interface {
+	RunningClient(ctx context.Context, logger entities.Logger) (entities.MATLABSessionClient, bool)
+}
+
+type DebugSession interface {
+	State() debugsession.State
+}
+
+// Provider completes the names of the MATLAB functions, classes and packages on the path of the MATLAB session.
+type Provider struct {
+	config       Config
+	usecase      Usecase
+	globalMATLAB GlobalMATLAB
+	debugSession DebugSession
+}
+
+func New(
+	config Config,
+	usecase Usecase,
+	globalMATLAB GlobalMATLAB,
+	debugSession DebugSession,
+) *Provider {
+	return &Provider{
+		config:       config,
+		usecase:      usecase,
+		globalMATLAB: globalMATLAB,
+		debugSession: debugSession,
+	}
+}
+
+func (p *Provider) Complete(ctx context.Context, sessionLogger entities.Logger, value string, _ map[string]string) ([]string, error) {
+	// Without a single MATLAB session, there is no MATLAB path to search.
+	if !p.config.UseSingleMATLABSession() {
+		return []string{}, nil
+	}
+
+	// Completing must neither start MATLAB, nor run within the code paused in the debugger.
+	if p.debugSession.State() != debugsession.StateIdle {
+		return []string{}, nil
+	}
+
+	client, ok := p.globalMATLAB.RunningClient(ctx, sessionLogger)
+	if !ok {
+		sessionLogger.Debug("No running MATLAB session to complete the function names")
+		return []string{}, nil
+	}
+
+	response, err := p.usecase.Execute(ctx, sessionLogger, client, findmatlabfunctions.Args{
+		Prefix: value,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response.FunctionNames, nil
+}
@@ -0,0 +1,247 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabfunctions_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/completion/matlabfunctions"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/findmatlabfunctions"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/debugsession"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/completion/matlabfunctions"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockDebugSession := &mocks.MockDebugSession{}
+	defer mockDebugSession.AssertExpectations(t)
+
+	// Act
+	provider := matlabfunctions.New(mockConfig, mockUsecase, mockGlobalMATLAB, mockDebugSession)
+
+	// Assert
+	assert.NotNil(t, provider)
+}
+
+func TestProvider_Complete_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockDebugSession := &mocks.MockDebugSession{}
+	defer mockDebugSession.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedFunctionNames := []string{"sin", "sind", "sinh"}
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(true).
+		Once()
+
+	mockDebugSession.EXPECT().
+		State().
+		Return(debugsession.StateIdle).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		RunningClient(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, true).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, findmatlabfunctions.Args{Prefix: "sin"}).
+		Return(findmatlabfunctions.ReturnArgs{FunctionNames: expectedFunctionNames}, nil).
+		Once()
+
+	provider := matlabfunctions.New(mockConfig, mockUsecase, mockGlobalMATLAB, mockDebugSession)
+
+	// Act
+	values, err := provider.Complete(ctx, mockLogger, "sin", map[string]string{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedFunctionNames, values)
+}
+
+func TestProvider_Complete_MultipleMATLABSessions(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockDebugSession := &mocks.MockDebugSession{}
+	defer mockDebugSession.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(false).
+		Once()
+
+	provider := matlabfunctions.New(mockConfig, mockUsecase, mockGlobalMATLAB, mockDebugSession)
+
+	// Act
+	values, err := provider.Complete(t.Context(), mockLogger, "sin", map[string]string{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, values)
+}
+
+func TestProvider_Complete_NoRunningMATLABSession(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockDebugSession := &mocks.MockDebugSession{}
+	defer mockDebugSession.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(true).
+		Once()
+
+	mockDebugSession.EXPECT().
+		State().
+		Return(debugsession.StateIdle).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		RunningClient(ctx, mockLogger.AsMockArg()).
+		Return(nil, false).
+		Once()
+
+	provider := matlabfunctions.New(mockConfig, mockUsecase, mockGlobalMATLAB, mockDebugSession)
+
+	// Act
+	values, err := provider.Complete(ctx, mockLogger, "sin", map[string]string{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, values)
+}
+
+func TestProvider_Complete_Debugging(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockDebugSession := &mocks.MockDebugSession{}
+	defer mockDebugSession.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(true).
+		Once()
+
+	mockDebugSession.EXPECT().
+		State().
+		Return(debugsession.StatePaused).
+		Once()
+
+	provider := matlabfunctions.New(mockConfig, mockUsecase, mockGlobalMATLAB, mockDebugSession)
+
+	// Act
+	values, err := provider.Complete(t.Context(), mockLogger, "sin", map[string]string{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, values)
+}
+
+func TestProvider_Complete_UsecaseError(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockDebugSession := &mocks.MockDebugSession{}
+	defer mockDebugSession.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(true).
+		Once()
+
+	mockDebugSession.EXPECT().
+		State().
+		Return(debugsession.StateIdle).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		RunningClient(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, true).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, findmatlabfunctions.Args{Prefix: "sin"}).
+		Return(findmatlabfunctions.ReturnArgs{}, assert.AnError).
+		Once()
+
+	provider := matlabfunctions.New(mockConfig, mockUsecase, mockGlobalMATLAB, mockDebugSession)
+
+	// Act
+	values, err := provider.Complete(ctx, mockLogger, "sin", map[string]string{})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, values)
+}
@@ -0,0 +1,40 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package sessionids
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+type SessionStore interface {
+	SessionIDs() []entities.SessionID
+}
+
+// Provider completes the IDs of the MATLAB sessions started by the server.
+type Provider struct {
+	sessionStore SessionStore
+}
+
+func New(
+	sessionStore SessionStore,
+) *Provider {
+	return &Provider{
+		sessionStore: sessionStore,
+	}
+}
+
+func (p *Provider) Complete(_ context.Context, _ entities.Logger, value string, _ map[string]string) ([]string, error) {
+	sessionIDs := []string{}
+	for _, sessionID := range p.sessionStore.SessionIDs() {
+		formattedSessionID := strconv.Itoa(int(sessionID))
+		if strings.HasPrefix(formattedSessionID, value) {
+			sessionIDs = append(sessionIDs, formattedSessionID)
+		}
+	}
+
+	return sessionIDs, nil
+}
@@ -0,0 +1,74 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package sessionids_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/completion/sessionids"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/completion/sessionids"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockSessionStore := &mocks.MockSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	// Act
+	provider := sessionids.New(mockSessionStore)
+
+	// Assert
+	assert.NotNil(t, provider)
+}
+
+func TestProvider_Complete_HappyPath(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    string
+		expected []string
+	}{
+		{
+			name:     "empty value",
+			value:    "",
+			expected: []string{"1", "2", "12"},
+		},
+		{
+			name:     "prefix",
+			value:    "1",
+			expected: []string{"1", "12"},
+		},
+		{
+			name:     "no match",
+			value:    "3",
+			expected: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockSessionStore := &mocks.MockSessionStore{}
+			defer mockSessionStore.AssertExpectations(t)
+
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockSessionStore.EXPECT().
+				SessionIDs().
+				Return([]entities.SessionID{1, 2, 12}).
+				Once()
+
+			provider := sessionids.New(mockSessionStore)
+
+			// Act
+			values, err := provider.Complete(t.Context(), mockLogger, tc.value, map[string]string{})
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, values)
+		})
+	}
+}
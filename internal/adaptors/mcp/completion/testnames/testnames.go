@@ -0,0 +1,185 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package testnames
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// testFileArgument is the argument with the path to the test file, which the client resolves before the test name.
+const testFileArgument = "test_file"
+
+var (
+	classdefPattern   = regexp.MustCompile(`^\s*classdef\b`)
+	blockPattern      = regexp.MustCompile(`^\s*(methods|properties|events|enumeration)\b\s*(?:\(([^)]*)\))?`)
+	testAttribute     = regexp.MustCompile(`\bTest\b`)
+	abstractAttribute = regexp.MustCompile(`\bAbstract\b`)
+	functionPattern   = regexp.MustCompile(`^\s*function\s+(?:(?:\[[^\]]*\]|[A-Za-z]\w*)\s*=\s*)?([A-Za-z]\w*)`)
+
+	// blockStartPattern and blockEndPattern match the keywords opening and closing the blocks,
+	// not the end keyword indexing into an array, such as x(end).
+	blockStartPattern = regexp.MustCompile(`(?:^|[,;])\s*(?:classdef|methods|properties|events|enumeration|arguments|function|if|for|parfor|while|switch|try|spmd)\b`)
+	blockEndPattern   = regexp.MustCompile(`(?:^|[,;])\s*end\s*(?:[,;]|$)`)
+)
+
+type PathValidator interface {
+	ValidateMATLABScript(filePath string) (string, error)
+}
+
+type OSLayer interface {
+	ReadFile(name string) ([]byte, error)
+}
+
+// Provider completes the names of the tests in a MATLAB test file.
+type Provider struct {
+	pathValidator PathValidator
+	osLayer       OSLayer
+}
+
+func New(
+	pathValidator PathValidator,
+	osLayer OSLayer,
+) *Provider {
+	return &Provider{
+		pathValidator: pathValidator,
+		osLayer:       osLayer,
+	}
+}
+
+func (p *Provider) Complete(_ context.Context, _ entities.Logger, value string, arguments map[string]string) ([]string, error) {
+	testFile := arguments[testFileArgument]
+	if testFile == "" {
+		return []string{}, nil
+	}
+
+	validatedTestFile, err := p.pathValidator.ValidateMATLABScript(testFile)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := p.osLayer.ReadFile(validatedTestFile)
+	if err != nil {
+		return nil, err
+	}
+
+	testNames := []string{}
+	for _, testName := range findTestNames(string(content)) {
+		if strings.HasPrefix(testName, value) {
+			testNames = append(testNames, testName)
+		}
+	}
+
+	return testNames, nil
+}
+
+// findTestNames returns the test methods of a class-based test, which are the methods of the blocks with the Test attribute,
+// or the tests of a function-based test, which are the local functions whose names start or end with "test".
+func findTestNames(content string) []string {
+	lines := strings.Split(content, "\n")
+
+	isClassBased := false
+	for _, line := range lines {
+		trimmedLine := strings.TrimSpace(line)
+		if trimmedLine == "" || strings.HasPrefix(trimmedLine, "%") {
+			continue
+		}
+		isClassBased = classdefPattern.MatchString(line)
+		break
+	}
+
+	testNames := []string{}
+	isInTestBlock := false
+	isMainFunction := true
+
+	// depth is the number of open blocks, so that only the methods directly within the blocks of the classdef are tests,
+	// rather than the functions nested in them or the local functions after the classdef.
+	depth := 0
+	isInAbstractBlock := false
+
+	for _, line := range lines {
+		if isClassBased {
+			code := stripComment(line)
+			blockDepth := depth
+			depth += len(blockStartPattern.FindAllString(code, -1)) - len(blockEndPattern.FindAllString(code, -1))
+
+			if match := blockPattern.FindStringSubmatch(code); match != nil && blockDepth == 1 {
+				isInTestBlock = match[1] == "methods" && testAttribute.MatchString(match[2])
+				isInAbstractBlock = match[1] == "methods" && abstractAttribute.MatchString(match[2])
+				continue
+			}
+
+			match := functionPattern.FindStringSubmatch(code)
+			if match == nil || blockDepth != 2 {
+				continue
+			}
+
+			// The abstract methods are only declared, so they have no end
+			if isInAbstractBlock {
+				depth--
+				continue
+			}
+
+			if isInTestBlock {
+				testNames = append(testNames, match[1])
+			}
+			continue
+		}
+
+		match := functionPattern.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+
+		name := match[1]
+
+		if isMainFunction {
+			isMainFunction = false
+			continue
+		}
+
+		lowerName := strings.ToLower(name)
+		if strings.HasPrefix(lowerName, "test") || strings.HasSuffix(lowerName, "test") {
+			testNames = append(testNames, name)
+		}
+	}
+
+	return testNames
+}
+
+// stripComment removes the comment at the end of a line of MATLAB code, ignoring the percent signs within the strings.
+func stripComment(line string) string {
+	var quote rune
+	var previous rune
+
+	for i, character := range line {
+		switch {
+		case quote != 0:
+			if character == quote {
+				quote = 0
+			}
+		case character == '"':
+			quote = character
+		case character == '\'' && !isTransposeOperand(previous):
+			quote = character
+		case character == '%':
+			return line[:i]
+		}
+
+		if character != ' ' && character != '\t' {
+			previous = character
+		}
+	}
+
+	return line
+}
+
+// isTransposeOperand reports whether a quote after the given character is the transpose operator, rather than the start of a character vector.
+func isTransposeOperand(character rune) bool {
+	return character == '_' || character == ')' || character == ']' || character == '}' || character == '.' ||
+		unicode.IsLetter(character) || unicode.IsDigit(character)
+}
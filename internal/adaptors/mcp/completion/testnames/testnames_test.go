@@ -0,0 +1,254 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package testnames_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/completion/testnames"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/completion/testnames"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const classBasedTest = `% Tests of movingAverage
+classdef movingAverageTest < matlab.unittest.TestCase
+    properties
+        Data
+    end
+
+    methods (TestClassSetup)
+        function createData(testCase)
+            testCase.Data = 1:10;
+        end
+    end
+
+    methods (Test, TestTags = {'Unit'})
+        function testDefaultWindow(testCase)
+            testCase.verifyEqual(movingAverage(testCase.Data), 1:10);
+        end
+
+        function testEmptyInput(testCase)
+            testCase.verifyEmpty(movingAverage([]));
+        end
+    end
+
+    methods
+        function result = helper(testCase)
+            result = testCase.Data;
+        end
+    end
+end
+`
+
+const classBasedTestWithLocalFunctions = `classdef windowTest < matlab.unittest.TestCase
+    methods (Abstract)
+        window = createWindow(testCase)
+    end
+
+    methods (Test)
+        function testWindowSize(testCase)
+            window = testCase.createWindow();
+            if isempty(window), return, end
+            for k = 1:numel(window)
+                testCase.verifyLessThanOrEqual(window(k), window(end)); % The window ends at its maximum
+            end
+
+            function nestedHelper
+                disp('%% not a comment, end');
+            end
+        end
+
+        function testWindowClass(testCase)
+            testCase.verifyClass(testCase.createWindow(), "double");
+        end
+    end
+end
+
+function testLocalHelper(testCase)
+disp(testCase);
+end
+`
+
+const functionBasedTest = `function tests = movingAverageTest
+tests = functiontests(localfunctions);
+end
+
+function setupOnce(testCase)
+testCase.TestData.Data = 1:10;
+end
+
+function testDefaultWindow(testCase)
+verifyEqual(testCase, movingAverage(testCase.TestData.Data), 1:10);
+end
+
+function emptyInputTest(testCase)
+verifyEmpty(testCase, movingAverage([]));
+end
+`
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	// Act
+	provider := testnames.New(mockPathValidator, mockOSLayer)
+
+	// Assert
+	assert.NotNil(t, provider)
+}
+
+func TestProvider_Complete_HappyPath(t *testing.T) {
+	testCases := []struct {
+		name     string
+		content  string
+		value    string
+		expected []string
+	}{
+		{
+			name:     "class-based test",
+			content:  classBasedTest,
+			value:    "",
+			expected: []string{"testDefaultWindow", "testEmptyInput"},
+		},
+		{
+			name:     "class-based test with prefix",
+			content:  classBasedTest,
+			value:    "testE",
+			expected: []string{"testEmptyInput"},
+		},
+		{
+			name:     "class-based test with nested blocks and local functions",
+			content:  classBasedTestWithLocalFunctions,
+			value:    "",
+			expected: []string{"testWindowSize", "testWindowClass"},
+		},
+		{
+			name:     "function-based test",
+			content:  functionBasedTest,
+			value:    "",
+			expected: []string{"testDefaultWindow", "emptyInputTest"},
+		},
+		{
+			name:     "not a test file",
+			content:  "function y = movingAverage(x)\ny = x;\nend\n",
+			value:    "",
+			expected: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockPathValidator := &mocks.MockPathValidator{}
+			defer mockPathValidator.AssertExpectations(t)
+
+			mockOSLayer := &mocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockLogger := testutils.NewInspectableLogger()
+			testFile := "/home/user/movingAverageTest.m"
+
+			mockPathValidator.EXPECT().
+				ValidateMATLABScript(testFile).
+				Return(testFile, nil).
+				Once()
+
+			mockOSLayer.EXPECT().
+				ReadFile(testFile).
+				Return([]byte(tc.content), nil).
+				Once()
+
+			provider := testnames.New(mockPathValidator, mockOSLayer)
+
+			// Act
+			values, err := provider.Complete(t.Context(), mockLogger, tc.value, map[string]string{"test_file": testFile})
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, values)
+		})
+	}
+}
+
+func TestProvider_Complete_NoTestFile(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	provider := testnames.New(mockPathValidator, mockOSLayer)
+
+	// Act
+	values, err := provider.Complete(t.Context(), mockLogger, "test", map[string]string{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, values)
+}
+
+func TestProvider_Complete_InvalidTestFile(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	testFile := "/outside/allowedRoots/movingAverageTest.m"
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABScript(testFile).
+		Return("", assert.AnError).
+		Once()
+
+	provider := testnames.New(mockPathValidator, mockOSLayer)
+
+	// Act
+	values, err := provider.Complete(t.Context(), mockLogger, "", map[string]string{"test_file": testFile})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, values)
+}
+
+func TestProvider_Complete_ReadFileError(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	testFile := "/home/user/movingAverageTest.m"
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABScript(testFile).
+		Return(testFile, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(testFile).
+		Return(nil, assert.AnError).
+		Once()
+
+	provider := testnames.New(mockPathValidator, mockOSLayer)
+
+	// Act
+	values, err := provider.Complete(t.Context(), mockLogger, "", map[string]string{"test_file": testFile})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, values)
+}
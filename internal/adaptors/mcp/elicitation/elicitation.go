@@ -0,0 +1,61 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package elicitation
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const acceptAction = "accept"
+
+var (
+	ErrNoSession    = errors.New("no MCP session to ask the user for confirmation")
+	ErrNotSupported = errors.New("the MCP client does not support elicitation")
+)
+
+type sessionContextKey struct{}
+
+// ContextWithSession returns a copy of the context holding the MCP session of the current request,
+// so that the user of this session can be asked for confirmation while handling the request.
+func ContextWithSession(ctx context.Context, session *mcp.ServerSession) context.Context {
+	return context.WithValue(ctx, sessionContextKey{}, session)
+}
+
+// Elicitor asks the user of the MCP session held by the context for confirmation.
+type Elicitor struct{}
+
+func New() *Elicitor {
+	return &Elicitor{}
+}
+
+// Confirm shows the message to the user and returns true if the user accepts it.
+func (e *Elicitor) Confirm(ctx context.Context, sessionLogger entities.Logger, message string) (bool, error) {
+	session, ok := ctx.Value(sessionContextKey{}).(*mcp.ServerSession)
+	if !ok || session == nil {
+		return false, ErrNoSession
+	}
+
+	if params := session.InitializeParams(); params == nil || params.Capabilities == nil || params.Capabilities.Elicitation == nil {
+		return false, ErrNotSupported
+	}
+
+	result, err := session.Elicit(ctx, &mcp.ElicitParams{
+		Message: message,
+		RequestedSchema: &jsonschema.Schema{
+			Type:       "object",
+			Properties: map[string]*jsonschema.Schema{},
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	sessionLogger.With("action", result.Action).Debug("User answered the confirmation request")
+
+	return result.Action == acceptAction, nil
+}
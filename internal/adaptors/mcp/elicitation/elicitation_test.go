@@ -0,0 +1,138 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package elicitation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/elicitation"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Act
+	elicitor := elicitation.New()
+
+	// Assert
+	assert.NotNil(t, elicitor)
+}
+
+func TestElicitor_Confirm_HappyPath(t *testing.T) {
+	testCases := []struct {
+		name     string
+		action   string
+		expected bool
+	}{
+		{name: "accept", action: "accept", expected: true},
+		{name: "decline", action: "decline", expected: false},
+		{name: "cancel", action: "cancel", expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			const expectedMessage = "Do you want to run it?"
+
+			var receivedMessage string
+			client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, &mcp.ClientOptions{
+				ElicitationHandler: func(_ context.Context, request *mcp.ElicitRequest) (*mcp.ElicitResult, error) {
+					receivedMessage = request.Params.Message
+					return &mcp.ElicitResult{Action: tc.action}, nil
+				},
+			})
+
+			serverSession := connect(t, client)
+
+			elicitor := elicitation.New()
+
+			// Act
+			confirmed, err := elicitor.Confirm(elicitation.ContextWithSession(t.Context(), serverSession), mockLogger, expectedMessage)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, confirmed)
+			assert.Equal(t, expectedMessage, receivedMessage)
+		})
+	}
+}
+
+func TestElicitor_Confirm_ElicitationNotSupported(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil)
+
+	serverSession := connect(t, client)
+
+	elicitor := elicitation.New()
+
+	// Act
+	confirmed, err := elicitor.Confirm(elicitation.ContextWithSession(t.Context(), serverSession), mockLogger, "Do you want to run it?")
+
+	// Assert
+	require.ErrorIs(t, err, elicitation.ErrNotSupported)
+	assert.False(t, confirmed)
+}
+
+func TestElicitor_Confirm_NoSession(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	elicitor := elicitation.New()
+
+	// Act
+	confirmed, err := elicitor.Confirm(t.Context(), mockLogger, "Do you want to run it?")
+
+	// Assert
+	require.ErrorIs(t, err, elicitation.ErrNoSession)
+	assert.False(t, confirmed)
+}
+
+func TestElicitor_Confirm_ElicitError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, &mcp.ClientOptions{
+		ElicitationHandler: func(context.Context, *mcp.ElicitRequest) (*mcp.ElicitResult, error) {
+			return nil, assert.AnError
+		},
+	})
+
+	serverSession := connect(t, client)
+
+	elicitor := elicitation.New()
+
+	// Act
+	confirmed, err := elicitor.Confirm(elicitation.ContextWithSession(t.Context(), serverSession), mockLogger, "Do you want to run it?")
+
+	// Assert
+	require.ErrorContains(t, err, assert.AnError.Error())
+	assert.False(t, confirmed)
+}
+
+func connect(t *testing.T, client *mcp.Client) *mcp.ServerSession {
+	t.Helper()
+
+	mcpServer := mcp.NewServer(&mcp.Implementation{Name: "test"}, nil)
+
+	clientTransport, serverTransport := mcp.NewInMemoryTransports()
+
+	serverSession, err := mcpServer.Connect(t.Context(), serverTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = serverSession.Close() })
+
+	clientSession, err := client.Connect(t.Context(), clientTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = clientSession.Close() })
+
+	// Notifications are handled in order, so the session is initialized once the ping returns
+	require.NoError(t, clientSession.Ping(t.Context(), nil))
+
+	return serverSession
+}
@@ -0,0 +1,133 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package baseprompt
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const UnexpectedErrorPrefix = "unexpected error occurred: "
+
+type LoggerFactory interface {
+	NewMCPSessionLogger(session *mcp.ServerSession) entities.Logger
+}
+
+type Argument struct {
+	Name        string
+	Description string
+	Required    bool
+}
+
+// PromptHandler returns the text of the user message of the prompt.
+// The arguments are the values the client provided, by argument name. Missing optional arguments are empty.
+type PromptHandler func(ctx context.Context, logger entities.Logger, arguments map[string]string) (string, error)
+
+func New(
+	name string,
+	title string,
+	description string,
+	arguments []Argument,
+	loggerFactory LoggerFactory,
+	handler PromptHandler,
+) *Prompt {
+	return &Prompt{
+		name:          name,
+		title:         title,
+		description:   description,
+		arguments:     arguments,
+		loggerFactory: loggerFactory,
+		handler:       handler,
+	}
+}
+
+type Prompt struct {
+	name          string
+	title         string
+	description   string
+	arguments     []Argument
+	loggerFactory LoggerFactory
+	handler       PromptHandler
+}
+
+func (p *Prompt) AddToServer(server prompts.Server) {
+	arguments := make([]*mcp.PromptArgument, len(p.arguments))
+	for i, argument := range p.arguments {
+		arguments[i] = &mcp.PromptArgument{
+			Name:        argument.Name,
+			Description: argument.Description,
+			Required:    argument.Required,
+		}
+	}
+
+	server.AddPrompt(
+		&mcp.Prompt{
+			Name:        p.name,
+			Title:       p.title,
+			Description: p.description,
+			Arguments:   arguments,
+		},
+		p.promptHandler(),
+	)
+}
+
+func (p *Prompt) promptHandler() mcp.PromptHandler {
+	return func(ctx context.Context, req *mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+		logger := p.loggerFactory.NewMCPSessionLogger(req.Session).With("prompt-name", p.name)
+		logger.Debug("Handling prompt request")
+		defer logger.Debug("Handled prompt request")
+
+		if p.handler == nil {
+			err := fmt.Errorf(UnexpectedErrorPrefix + "no prompt handler available")
+			logger.WithError(err).Warn("Prompt handler is nil")
+			return nil, err
+		}
+
+		arguments := map[string]string{}
+		for _, argument := range p.arguments {
+			value := req.Params.Arguments[argument.Name]
+			if argument.Required && value == "" {
+				err := fmt.Errorf("missing required argument %q", argument.Name)
+				logger.WithError(err).Warn("Prompt request is missing a required argument")
+				return nil, err
+			}
+			arguments[argument.Name] = value
+		}
+
+		text, err := p.handler(ctx, logger, arguments)
+		if err != nil {
+			logger.WithError(err).Warn("Prompt handler returned an error")
+			return nil, err
+		}
+
+		return &mcp.GetPromptResult{
+			Description: p.description,
+			Messages: []*mcp.PromptMessage{
+				{
+					Role:    "user",
+					Content: &mcp.TextContent{Text: text},
+				},
+			},
+		}, nil
+	}
+}
+
+func (p *Prompt) Name() string {
+	return p.name
+}
+
+func (p *Prompt) Title() string {
+	return p.title
+}
+
+func (p *Prompt) Description() string {
+	return p.description
+}
+
+func (p *Prompt) Arguments() []Argument {
+	return p.arguments
+}
@@ -0,0 +1,225 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package baseprompt_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/baseprompt"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/prompts"
+	basepromptmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/prompts/baseprompt"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	name        = "test_prompt"
+	title       = "Test Prompt"
+	description = "A test prompt"
+)
+
+var arguments = []baseprompt.Argument{
+	{Name: "file_path", Description: "Path to the file", Required: true},
+	{Name: "function_name", Description: "Name of the function"},
+}
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basepromptmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	handler := func(ctx context.Context, logger entities.Logger, arguments map[string]string) (string, error) {
+		return "", nil
+	}
+
+	// Act
+	p := baseprompt.New(name, title, description, arguments, mockLoggerFactory, handler)
+
+	// Assert
+	require.NotNil(t, p)
+	assert.Equal(t, name, p.Name())
+	assert.Equal(t, title, p.Title())
+	assert.Equal(t, description, p.Description())
+	assert.Equal(t, arguments, p.Arguments())
+}
+
+func TestPrompt_AddToServer_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basepromptmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockServer := &mocks.MockServer{}
+	defer mockServer.AssertExpectations(t)
+
+	p := baseprompt.New(name, title, description, arguments, mockLoggerFactory, nil)
+
+	mockServer.EXPECT().AddPrompt(
+		&mcp.Prompt{
+			Name:        name,
+			Title:       title,
+			Description: description,
+			Arguments: []*mcp.PromptArgument{
+				{Name: "file_path", Description: "Path to the file", Required: true},
+				{Name: "function_name", Description: "Name of the function"},
+			},
+		},
+		mock.AnythingOfType("mcp.PromptHandler"),
+	).Return().Once()
+
+	// Act
+	p.AddToServer(mockServer)
+
+	// Assert
+	// Assertions are handled by mock expectations
+}
+
+func TestPrompt_PromptHandler_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &basepromptmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger).
+		Once()
+
+	handler := func(ctx context.Context, logger entities.Logger, arguments map[string]string) (string, error) {
+		assert.Equal(t, map[string]string{"file_path": "/home/user/analysis.m", "function_name": ""}, arguments)
+		return "Review the code", nil
+	}
+
+	p := baseprompt.New(name, title, description, arguments, mockLoggerFactory, handler)
+	capturedHandler := capturePromptHandler(t, p)
+
+	// Act
+	result, err := capturedHandler(t.Context(), &mcp.GetPromptRequest{
+		Params: &mcp.GetPromptParams{
+			Name:      name,
+			Arguments: map[string]string{"file_path": "/home/user/analysis.m", "unknown": "ignored"},
+		},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, description, result.Description)
+	require.Len(t, result.Messages, 1)
+	assert.Equal(t, mcp.Role("user"), result.Messages[0].Role)
+	assert.Equal(t, &mcp.TextContent{Text: "Review the code"}, result.Messages[0].Content)
+}
+
+func TestPrompt_PromptHandler_MissingRequiredArgument(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &basepromptmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger).
+		Once()
+
+	handler := func(ctx context.Context, logger entities.Logger, arguments map[string]string) (string, error) {
+		t.Fatal("the handler should not be called")
+		return "", nil
+	}
+
+	p := baseprompt.New(name, title, description, arguments, mockLoggerFactory, handler)
+	capturedHandler := capturePromptHandler(t, p)
+
+	// Act
+	result, err := capturedHandler(t.Context(), &mcp.GetPromptRequest{
+		Params: &mcp.GetPromptParams{
+			Name:      name,
+			Arguments: map[string]string{"function_name": "analyze"},
+		},
+	})
+
+	// Assert
+	require.EqualError(t, err, `missing required argument "file_path"`)
+	assert.Nil(t, result)
+}
+
+func TestPrompt_PromptHandler_HandlerError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &basepromptmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger).
+		Once()
+
+	handler := func(ctx context.Context, logger entities.Logger, arguments map[string]string) (string, error) {
+		return "", assert.AnError
+	}
+
+	p := baseprompt.New(name, title, description, arguments, mockLoggerFactory, handler)
+	capturedHandler := capturePromptHandler(t, p)
+
+	// Act
+	result, err := capturedHandler(t.Context(), &mcp.GetPromptRequest{
+		Params: &mcp.GetPromptParams{
+			Name:      name,
+			Arguments: map[string]string{"file_path": "/home/user/analysis.m"},
+		},
+	})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, result)
+}
+
+func TestPrompt_PromptHandler_NilHandler(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &basepromptmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger).
+		Once()
+
+	p := baseprompt.New(name, title, description, arguments, mockLoggerFactory, nil)
+	capturedHandler := capturePromptHandler(t, p)
+
+	// Act
+	result, err := capturedHandler(t.Context(), &mcp.GetPromptRequest{
+		Params: &mcp.GetPromptParams{
+			Name: name,
+		},
+	})
+
+	// Assert
+	require.ErrorContains(t, err, baseprompt.UnexpectedErrorPrefix)
+	assert.Nil(t, result)
+}
+
+func capturePromptHandler(t *testing.T, p *baseprompt.Prompt) mcp.PromptHandler {
+	var capturedHandler mcp.PromptHandler
+
+	mockServer := &mocks.MockServer{}
+	defer mockServer.AssertExpectations(t)
+
+	mockServer.EXPECT().AddPrompt(
+		mock.Anything,
+		mock.AnythingOfType("mcp.PromptHandler"),
+	).Run(func(prompt *mcp.Prompt, h mcp.PromptHandler) {
+		capturedHandler = h
+	}).Return().Once()
+
+	p.AddToServer(mockServer)
+
+	return capturedHandler
+}
@@ -0,0 +1,15 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package customprompts
+
+import "regexp"
+
+const (
+	descriptionFormat         = "Prompt from %s."
+	argumentDescriptionFormat = "Value of {{%s}} in the prompt file."
+
+	titlePrefix = "# "
+)
+
+// argumentPattern matches the {{argument}} placeholders of the prompt files.
+var argumentPattern = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
@@ -0,0 +1,132 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package customprompts
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/baseprompt"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/markdownfiles"
+)
+
+type Config interface {
+	PromptFiles() []string
+}
+
+type LoggerFactory interface {
+	baseprompt.LoggerFactory
+	GetGlobalLogger() entities.Logger
+}
+
+type OSLayer interface {
+	Stat(name string) (osfacade.FileInfo, error)
+	ReadFile(name string) ([]byte, error)
+}
+
+type FileLayer interface {
+	Glob(pattern string) ([]string, error)
+}
+
+// Prompt exposes each of the prompt files from the configuration as its own prompt.
+// The name of a prompt is the file name without the extension,
+// its title is the first line of the file if it is a Markdown heading,
+// and its arguments are the {{argument}} placeholders of the file.
+type Prompt struct {
+	config        Config
+	loggerFactory LoggerFactory
+	osLayer       OSLayer
+	fileLayer     FileLayer
+}
+
+func New(
+	config Config,
+	loggerFactory LoggerFactory,
+	osLayer OSLayer,
+	fileLayer FileLayer,
+) *Prompt {
+	return &Prompt{
+		config:        config,
+		loggerFactory: loggerFactory,
+		osLayer:       osLayer,
+		fileLayer:     fileLayer,
+	}
+}
+
+func (p *Prompt) AddToServer(server prompts.Server) {
+	if len(p.config.PromptFiles()) == 0 {
+		return
+	}
+
+	logger := p.loggerFactory.GetGlobalLogger()
+	names := []string{}
+
+	for _, file := range markdownfiles.List(logger, p.osLayer, p.fileLayer, p.config.PromptFiles()) {
+		path := file.Path
+		baseName := filepath.Base(path)
+		name := strings.TrimSuffix(baseName, filepath.Ext(baseName))
+		if slices.Contains(names, name) {
+			logger.With("path", path).Warn("Ignoring prompt file with the same name as another one")
+			continue
+		}
+
+		content, err := p.osLayer.ReadFile(path)
+		if err != nil {
+			logger.WithError(err).With("path", path).Warn("Failed to read the prompt file")
+			continue
+		}
+
+		names = append(names, name)
+		logger.With("path", path).Debug("Adding prompt")
+		newPrompt(name, baseName, string(content), p.loggerFactory).AddToServer(server)
+	}
+}
+
+func newPrompt(name string, fileName string, content string, loggerFactory baseprompt.LoggerFactory) *baseprompt.Prompt {
+	title := ""
+	firstLine, _, _ := strings.Cut(content, "\n")
+	if strings.HasPrefix(firstLine, titlePrefix) {
+		title = strings.TrimSpace(strings.TrimPrefix(firstLine, titlePrefix))
+	}
+
+	arguments := []baseprompt.Argument{}
+	for _, match := range argumentPattern.FindAllStringSubmatch(content, -1) {
+		argumentName := match[1]
+		if slices.ContainsFunc(arguments, func(argument baseprompt.Argument) bool { return argument.Name == argumentName }) {
+			continue
+		}
+
+		arguments = append(arguments, baseprompt.Argument{
+			Name:        argumentName,
+			Description: fmt.Sprintf(argumentDescriptionFormat, argumentName),
+			Required:    true,
+		})
+	}
+
+	return baseprompt.New(
+		name,
+		title,
+		fmt.Sprintf(descriptionFormat, fileName),
+		arguments,
+		loggerFactory,
+		Handler(content),
+	)
+}
+
+// Handler replaces the {{argument}} placeholders of the prompt file with the values of the arguments.
+func Handler(content string) baseprompt.PromptHandler {
+	return func(_ context.Context, logger entities.Logger, arguments map[string]string) (string, error) {
+		logger.Info("Returning custom prompt")
+
+		return argumentPattern.ReplaceAllStringFunc(content, func(placeholder string) string {
+			argumentName := argumentPattern.FindStringSubmatch(placeholder)[1]
+			return arguments[argumentName]
+		}), nil
+	}
+}
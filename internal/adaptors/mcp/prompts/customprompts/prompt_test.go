@@ -0,0 +1,235 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package customprompts_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/customprompts"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	promptsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/prompts"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/prompts/customprompts"
+	osfacademocks "github.com/matlab/matlab-mcp-core-server/mocks/facades/osfacade"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrompt_AddToServer_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockServer := &promptsmocks.MockServer{}
+	defer mockServer.AssertExpectations(t)
+
+	mockFileInfo := &osfacademocks.MockFileInfo{}
+	defer mockFileInfo.AssertExpectations(t)
+
+	mockFolderInfo := &osfacademocks.MockFileInfo{}
+	defer mockFolderInfo.AssertExpectations(t)
+
+	mockFolderFileInfo := &osfacademocks.MockFileInfo{}
+	defer mockFolderFileInfo.AssertExpectations(t)
+
+	filePath := filepath.Join("home", "user", "refactor.md")
+	folderPath := filepath.Join("home", "user", "prompts")
+	folderFilePath := filepath.Join(folderPath, "document.md")
+
+	mockConfig.EXPECT().
+		PromptFiles().
+		Return([]string{filePath, folderPath})
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(testutils.NewInspectableLogger()).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat(filePath).
+		Return(mockFileInfo, nil).
+		Once()
+
+	mockFileInfo.EXPECT().IsDir().Return(false).Once()
+
+	mockOSLayer.EXPECT().
+		Stat(folderPath).
+		Return(mockFolderInfo, nil).
+		Once()
+
+	mockFolderInfo.EXPECT().IsDir().Return(true).Once()
+
+	mockFileLayer.EXPECT().
+		Glob(filepath.Join(folderPath, "*.md")).
+		Return([]string{folderFilePath}, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat(folderFilePath).
+		Return(mockFolderFileInfo, nil).
+		Once()
+
+	mockFolderFileInfo.EXPECT().IsDir().Return(false).Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(filePath).
+		Return([]byte("# Refactor a Function\n\nRefactor {{function_name}} in {{ file_path }}, then run the tests of {{function_name}}."), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(folderFilePath).
+		Return([]byte("Document the code."), nil).
+		Once()
+
+	mockServer.EXPECT().AddPrompt(
+		&mcp.Prompt{
+			Name:        "refactor",
+			Title:       "Refactor a Function",
+			Description: "Prompt from refactor.md.",
+			Arguments: []*mcp.PromptArgument{
+				{Name: "function_name", Description: "Value of {{function_name}} in the prompt file.", Required: true},
+				{Name: "file_path", Description: "Value of {{file_path}} in the prompt file.", Required: true},
+			},
+		},
+		mock.AnythingOfType("mcp.PromptHandler"),
+	).Return().Once()
+
+	mockServer.EXPECT().AddPrompt(
+		&mcp.Prompt{
+			Name:        "document",
+			Description: "Prompt from document.md.",
+			Arguments:   []*mcp.PromptArgument{},
+		},
+		mock.AnythingOfType("mcp.PromptHandler"),
+	).Return().Once()
+
+	prompt := customprompts.New(mockConfig, mockLoggerFactory, mockOSLayer, mockFileLayer)
+
+	// Act
+	prompt.AddToServer(mockServer)
+
+	// Assert
+	// Assertions are handled by mock expectations
+}
+
+func TestPrompt_AddToServer_NoPromptFiles(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockServer := &promptsmocks.MockServer{}
+	defer mockServer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		PromptFiles().
+		Return([]string{}).
+		Once()
+
+	prompt := customprompts.New(mockConfig, mockLoggerFactory, mockOSLayer, mockFileLayer)
+
+	// Act
+	prompt.AddToServer(mockServer)
+
+	// Assert
+	// Nothing is added to the server
+}
+
+func TestPrompt_AddToServer_UnreadableFiles(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockServer := &promptsmocks.MockServer{}
+	defer mockServer.AssertExpectations(t)
+
+	mockFileInfo := &osfacademocks.MockFileInfo{}
+	defer mockFileInfo.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	missingPath := filepath.Join("home", "user", "missing.md")
+	unreadablePath := filepath.Join("home", "user", "unreadable.md")
+
+	mockConfig.EXPECT().
+		PromptFiles().
+		Return([]string{missingPath, unreadablePath})
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat(missingPath).
+		Return(nil, assert.AnError).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat(unreadablePath).
+		Return(mockFileInfo, nil).
+		Once()
+
+	mockFileInfo.EXPECT().IsDir().Return(false).Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(unreadablePath).
+		Return(nil, assert.AnError).
+		Once()
+
+	prompt := customprompts.New(mockConfig, mockLoggerFactory, mockOSLayer, mockFileLayer)
+
+	// Act
+	prompt.AddToServer(mockServer)
+
+	// Assert
+	warnLogs := mockLogger.WarnLogs()
+	require.Len(t, warnLogs, 2)
+	assert.Equal(t, missingPath, warnLogs["Failed to read the file"]["path"])
+	assert.Equal(t, unreadablePath, warnLogs["Failed to read the prompt file"]["path"])
+}
+
+func TestHandler_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	handler := customprompts.Handler("Refactor {{function_name}} in {{ file_path }}, then run the tests of {{function_name}}.")
+
+	// Act
+	text, err := handler(t.Context(), mockLogger, map[string]string{
+		"function_name": "movingAverage",
+		"file_path":     "/home/user/movingAverage.m",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "Refactor movingAverage in /home/user/movingAverage.m, then run the tests of movingAverage.", text)
+}
@@ -0,0 +1,15 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package debugfailingtests
+
+const (
+	name        = "debug_failing_matlab_tests"
+	title       = "Debug Failing MATLAB Tests"
+	description = "Runs a MATLAB test file, finds the root cause of each failure, and fixes it."
+
+	testFileArgument            = "test_file"
+	testFileArgumentDescription = "Absolute path to the MATLAB test file with the failing tests."
+
+	testNameArgument            = "test_name"
+	testNameArgumentDescription = "Name of the failing test procedure to focus on. By default, all the failing tests of the file."
+)
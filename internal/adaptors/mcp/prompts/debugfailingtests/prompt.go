@@ -0,0 +1,54 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package debugfailingtests
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/baseprompt"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+type Prompt struct {
+	*baseprompt.Prompt
+}
+
+func New(loggerFactory baseprompt.LoggerFactory) *Prompt {
+	return &Prompt{
+		Prompt: baseprompt.New(
+			name,
+			title,
+			description,
+			[]baseprompt.Argument{
+				{Name: testFileArgument, Description: testFileArgumentDescription, Required: true},
+				{Name: testNameArgument, Description: testNameArgumentDescription},
+			},
+			loggerFactory,
+			Handler(),
+		),
+	}
+}
+
+func Handler() baseprompt.PromptHandler {
+	return func(_ context.Context, logger entities.Logger, arguments map[string]string) (string, error) {
+		logger.Info("Returning debug failing MATLAB tests prompt")
+
+		var text strings.Builder
+		fmt.Fprintf(&text, "The MATLAB tests in `%s` are failing.", arguments[testFileArgument])
+
+		if testName := arguments[testNameArgument]; testName != "" {
+			fmt.Fprintf(&text, " Focus on the test `%s`.", testName)
+		}
+
+		text.WriteString("\n\n" +
+			"Run the tests in MATLAB and read the diagnostics of each failure. " +
+			"For each failure, find the root cause in the code under test or in the test itself, and explain it before changing any code. " +
+			"Fix the code under test, unless the test is wrong, in which case explain why before fixing the test. " +
+			"Do not remove a test or weaken what it verifies to make it pass.\n\n" +
+			"Run the tests again to confirm that they all pass, and summarize the root causes and the fixes.")
+
+		return text.String(), nil
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package debugfailingtests_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/baseprompt"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/debugfailingtests"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	basepromptmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/prompts/baseprompt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := basepromptmocks.NewMockLoggerFactory(t)
+
+	// Act
+	prompt := debugfailingtests.New(mockLoggerFactory)
+
+	// Assert
+	require.NotNil(t, prompt)
+	assert.Equal(t, "debug_failing_matlab_tests", prompt.Name())
+	assert.Equal(t, "Debug Failing MATLAB Tests", prompt.Title())
+	assert.Equal(t, []baseprompt.Argument{
+		{Name: "test_file", Description: "Absolute path to the MATLAB test file with the failing tests.", Required: true},
+		{Name: "test_name", Description: "Name of the failing test procedure to focus on. By default, all the failing tests of the file."},
+	}, prompt.Arguments())
+}
+
+func TestHandler_HappyPath(t *testing.T) {
+	testCases := []struct {
+		name             string
+		testName         string
+		expectedFocus    bool
+		expectedContains string
+	}{
+		{
+			name:             "all tests",
+			testName:         "",
+			expectedFocus:    false,
+			expectedContains: "The MATLAB tests in `/home/user/movingAverageTest.m` are failing.",
+		},
+		{
+			name:             "single test",
+			testName:         "testEmptyInput",
+			expectedFocus:    true,
+			expectedContains: "Focus on the test `testEmptyInput`.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			handler := debugfailingtests.Handler()
+
+			// Act
+			text, err := handler(t.Context(), mockLogger, map[string]string{
+				"test_file": "/home/user/movingAverageTest.m",
+				"test_name": tc.testName,
+			})
+
+			// Assert
+			require.NoError(t, err)
+			assert.Contains(t, text, tc.expectedContains)
+			assert.Equal(t, tc.expectedFocus, strings.Contains(text, "Focus on the test"))
+		})
+	}
+}
@@ -0,0 +1,15 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package prompts
+
+import (
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type Server interface {
+	AddPrompt(prompt *mcp.Prompt, handler mcp.PromptHandler)
+}
+
+type Prompt interface {
+	AddToServer(server Server)
+}
@@ -0,0 +1,12 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package reviewcode
+
+const (
+	name        = "review_matlab_code"
+	title       = "Review MATLAB Code Against the Coding Guidelines"
+	description = "Reviews a MATLAB file against the coding guidelines provided as resources by the server, and reports the issues with suggested fixes."
+
+	filePathArgument            = "file_path"
+	filePathArgumentDescription = "Absolute path to the MATLAB file to review."
+)
@@ -0,0 +1,45 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package reviewcode
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/baseprompt"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+const textFormat = "Review the MATLAB code in `%s`.\n\n" +
+	"Read the coding guidelines provided as resources by the MATLAB MCP server, whose URIs start with `guidelines://coding`, and check the code against them. " +
+	"Also check the code with the Code Analyzer.\n\n" +
+	"For each issue, quote the code, name the guideline or the Code Analyzer message it breaks, and suggest a fix. " +
+	"Group the issues by severity, starting with the ones that can cause wrong results or errors. " +
+	"Do not modify the file."
+
+type Prompt struct {
+	*baseprompt.Prompt
+}
+
+func New(loggerFactory baseprompt.LoggerFactory) *Prompt {
+	return &Prompt{
+		Prompt: baseprompt.New(
+			name,
+			title,
+			description,
+			[]baseprompt.Argument{
+				{Name: filePathArgument, Description: filePathArgumentDescription, Required: true},
+			},
+			loggerFactory,
+			Handler(),
+		),
+	}
+}
+
+func Handler() baseprompt.PromptHandler {
+	return func(_ context.Context, logger entities.Logger, arguments map[string]string) (string, error) {
+		logger.Info("Returning review MATLAB code prompt")
+
+		return fmt.Sprintf(textFormat, arguments[filePathArgument]), nil
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package reviewcode_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/reviewcode"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	basepromptmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/prompts/baseprompt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := basepromptmocks.NewMockLoggerFactory(t)
+
+	// Act
+	prompt := reviewcode.New(mockLoggerFactory)
+
+	// Assert
+	require.NotNil(t, prompt)
+	assert.Equal(t, "review_matlab_code", prompt.Name())
+	assert.Equal(t, "Review MATLAB Code Against the Coding Guidelines", prompt.Title())
+	require.Len(t, prompt.Arguments(), 1)
+	assert.Equal(t, "file_path", prompt.Arguments()[0].Name)
+	assert.True(t, prompt.Arguments()[0].Required)
+}
+
+func TestHandler_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	handler := reviewcode.Handler()
+
+	// Act
+	text, err := handler(t.Context(), mockLogger, map[string]string{
+		"file_path": "/home/user/analysis.m",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Contains(t, text, "Review the MATLAB code in `/home/user/analysis.m`.")
+	assert.Contains(t, text, "guidelines://coding")
+}
@@ -0,0 +1,15 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package vectorizeloop
+
+const (
+	name        = "vectorize_matlab_loop"
+	title       = "Vectorize MATLAB Loops"
+	description = "Replaces the loops of MATLAB code with vectorized operations, and checks that the results are unchanged."
+
+	filePathArgument            = "file_path"
+	filePathArgumentDescription = "Absolute path to the MATLAB file with the loops to vectorize."
+
+	functionNameArgument            = "function_name"
+	functionNameArgumentDescription = "Name of the function of the file with the loops to vectorize. By default, all the loops of the file."
+)
@@ -0,0 +1,54 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package vectorizeloop
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/baseprompt"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+type Prompt struct {
+	*baseprompt.Prompt
+}
+
+func New(loggerFactory baseprompt.LoggerFactory) *Prompt {
+	return &Prompt{
+		Prompt: baseprompt.New(
+			name,
+			title,
+			description,
+			[]baseprompt.Argument{
+				{Name: filePathArgument, Description: filePathArgumentDescription, Required: true},
+				{Name: functionNameArgument, Description: functionNameArgumentDescription},
+			},
+			loggerFactory,
+			Handler(),
+		),
+	}
+}
+
+func Handler() baseprompt.PromptHandler {
+	return func(_ context.Context, logger entities.Logger, arguments map[string]string) (string, error) {
+		logger.Info("Returning vectorize MATLAB loop prompt")
+
+		var text strings.Builder
+		if functionName := arguments[functionNameArgument]; functionName != "" {
+			fmt.Fprintf(&text, "Vectorize the loops of the function `%s` in `%s`.", functionName, arguments[filePathArgument])
+		} else {
+			fmt.Fprintf(&text, "Vectorize the loops in `%s`.", arguments[filePathArgument])
+		}
+
+		text.WriteString("\n\n" +
+			"Replace the loops with array operations, logical indexing, implicit expansion, and functions such as `sum`, `cumsum`, `accumarray` or `histcounts`, " +
+			"when this makes the code faster and keeps it readable. Keep the loops whose iterations depend on each other, or whose vectorized version would need much more memory.\n\n" +
+			"Before changing the code, run it in MATLAB on representative inputs, including edge cases such as empty inputs, and record the results and the execution time. " +
+			"After changing the code, run it again on the same inputs, check that the results are identical, and compare the execution times.\n\n" +
+			"Summarize which loops you vectorized, which ones you kept and why, and the speedup.")
+
+		return text.String(), nil
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package vectorizeloop_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/vectorizeloop"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	basepromptmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/prompts/baseprompt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := basepromptmocks.NewMockLoggerFactory(t)
+
+	// Act
+	prompt := vectorizeloop.New(mockLoggerFactory)
+
+	// Assert
+	require.NotNil(t, prompt)
+	assert.Equal(t, "vectorize_matlab_loop", prompt.Name())
+	assert.Equal(t, "Vectorize MATLAB Loops", prompt.Title())
+	require.Len(t, prompt.Arguments(), 2)
+	assert.Equal(t, "file_path", prompt.Arguments()[0].Name)
+	assert.True(t, prompt.Arguments()[0].Required)
+	assert.Equal(t, "function_name", prompt.Arguments()[1].Name)
+	assert.False(t, prompt.Arguments()[1].Required)
+}
+
+func TestHandler_HappyPath(t *testing.T) {
+	testCases := []struct {
+		name             string
+		functionName     string
+		expectedContains string
+	}{
+		{
+			name:             "whole file",
+			functionName:     "",
+			expectedContains: "Vectorize the loops in `/home/user/analysis.m`.",
+		},
+		{
+			name:             "single function",
+			functionName:     "smoothSignal",
+			expectedContains: "Vectorize the loops of the function `smoothSignal` in `/home/user/analysis.m`.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			handler := vectorizeloop.Handler()
+
+			// Act
+			text, err := handler(t.Context(), mockLogger, map[string]string{
+				"file_path":     "/home/user/analysis.m",
+				"function_name": tc.functionName,
+			})
+
+			// Assert
+			require.NoError(t, err)
+			assert.Contains(t, text, tc.expectedContains)
+			assert.Contains(t, text, "check that the results are identical")
+		})
+	}
+}
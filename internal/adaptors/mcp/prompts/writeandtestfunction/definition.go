@@ -0,0 +1,18 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package writeandtestfunction
+
+const (
+	name        = "write_and_test_matlab_function"
+	title       = "Write and Test a MATLAB Function"
+	description = "Writes a MATLAB function and its unit tests, then checks the code and runs the tests until they pass."
+
+	functionNameArgument            = "function_name"
+	functionNameArgumentDescription = "Name of the MATLAB function to write. Example: `computeMovingAverage`."
+
+	behaviorArgument            = "behavior"
+	behaviorArgumentDescription = "Description of what the function does, including its inputs and outputs."
+
+	folderArgument            = "folder"
+	folderArgumentDescription = "Absolute path to the folder where to create the function and its tests. By default, the current MATLAB folder."
+)
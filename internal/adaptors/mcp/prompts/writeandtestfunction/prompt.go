@@ -0,0 +1,59 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package writeandtestfunction
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/baseprompt"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+type Prompt struct {
+	*baseprompt.Prompt
+}
+
+func New(loggerFactory baseprompt.LoggerFactory) *Prompt {
+	return &Prompt{
+		Prompt: baseprompt.New(
+			name,
+			title,
+			description,
+			[]baseprompt.Argument{
+				{Name: functionNameArgument, Description: functionNameArgumentDescription, Required: true},
+				{Name: behaviorArgument, Description: behaviorArgumentDescription, Required: true},
+				{Name: folderArgument, Description: folderArgumentDescription},
+			},
+			loggerFactory,
+			Handler(),
+		),
+	}
+}
+
+func Handler() baseprompt.PromptHandler {
+	return func(_ context.Context, logger entities.Logger, arguments map[string]string) (string, error) {
+		logger.Info("Returning write and test MATLAB function prompt")
+
+		functionName := arguments[functionNameArgument]
+
+		var text strings.Builder
+		fmt.Fprintf(&text, "Write a MATLAB function named `%s` with the following behavior:\n\n%s\n\n", functionName, arguments[behaviorArgument])
+
+		if folder := arguments[folderArgument]; folder != "" {
+			fmt.Fprintf(&text, "Create the function in `%s.m` and its tests in `%sTest.m`, in the folder `%s`.\n\n", functionName, functionName, folder)
+		} else {
+			fmt.Fprintf(&text, "Create the function in `%s.m` and its tests in `%sTest.m`, in the current MATLAB folder.\n\n", functionName, functionName)
+		}
+
+		text.WriteString("Follow the coding guidelines provided as resources by the MATLAB MCP server. " +
+			"Validate the inputs with an `arguments` block, and document the function with a help comment. " +
+			"Write the tests with the MATLAB unit testing framework, covering the typical cases, the edge cases such as empty inputs, and the error cases.\n\n" +
+			"Then check the code with the Code Analyzer and fix the issues it reports. " +
+			"Run the tests in MATLAB, and fix the function until all the tests pass. " +
+			"Do not weaken a test to make it pass.")
+
+		return text.String(), nil
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package writeandtestfunction_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/baseprompt"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/writeandtestfunction"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	basepromptmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/prompts/baseprompt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := basepromptmocks.NewMockLoggerFactory(t)
+
+	// Act
+	prompt := writeandtestfunction.New(mockLoggerFactory)
+
+	// Assert
+	require.NotNil(t, prompt)
+	assert.Equal(t, "write_and_test_matlab_function", prompt.Name())
+	assert.Equal(t, "Write and Test a MATLAB Function", prompt.Title())
+	assert.Equal(t, []string{"function_name", "behavior", "folder"}, argumentNames(prompt.Arguments()))
+}
+
+func TestHandler_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	handler := writeandtestfunction.Handler()
+
+	// Act
+	text, err := handler(t.Context(), mockLogger, map[string]string{
+		"function_name": "movingAverage",
+		"behavior":      "Computes the moving average of a vector.",
+		"folder":        "/home/user/project",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Contains(t, text, "Write a MATLAB function named `movingAverage`")
+	assert.Contains(t, text, "Computes the moving average of a vector.")
+	assert.Contains(t, text, "in `movingAverage.m` and its tests in `movingAverageTest.m`, in the folder `/home/user/project`")
+}
+
+func TestHandler_NoFolder(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	handler := writeandtestfunction.Handler()
+
+	// Act
+	text, err := handler(t.Context(), mockLogger, map[string]string{
+		"function_name": "movingAverage",
+		"behavior":      "Computes the moving average of a vector.",
+		"folder":        "",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Contains(t, text, "in the current MATLAB folder")
+}
+
+func argumentNames(arguments []baseprompt.Argument) []string {
+	names := make([]string, len(arguments))
+	for i, argument := range arguments {
+		names[i] = argument.Name
+	}
+	return names
+}
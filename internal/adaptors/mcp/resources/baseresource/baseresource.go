@@ -18,9 +18,11 @@ type LoggerFactory interface {
 	NewMCPSessionLogger(session *mcp.ServerSession) entities.Logger
 }
 
+// ResourceContents holds either text, or binary data such as images in Blob.
 type ResourceContents struct {
 	MIMEType string
 	Text     string
+	Blob     []byte
 }
 
 type ReadResourceResult struct {
@@ -98,17 +100,7 @@ func (r *Resource) resourceHandler() mcp.ResourceHandler {
 			return nil, err
 		}
 
-		mcpContents := make([]*mcp.ResourceContents, len(result.Contents))
-		for i, c := range result.Contents {
-			mcpContents[i] = &mcp.ResourceContents{
-				MIMEType: c.MIMEType,
-				Text:     c.Text,
-			}
-		}
-
-		return &mcp.ReadResourceResult{
-			Contents: mcpContents,
-		}, nil
+		return toMCPResult(req.Params.URI, result), nil
 	}
 }
 
@@ -136,6 +128,22 @@ func (r *Resource) URI() string {
 	return r.uri
 }
 
+func toMCPResult(uri string, result *ReadResourceResult) *mcp.ReadResourceResult {
+	mcpContents := make([]*mcp.ResourceContents, len(result.Contents))
+	for i, c := range result.Contents {
+		mcpContents[i] = &mcp.ResourceContents{
+			URI:      uri,
+			MIMEType: c.MIMEType,
+			Text:     c.Text,
+			Blob:     c.Blob,
+		}
+	}
+
+	return &mcp.ReadResourceResult{
+		Contents: mcpContents,
+	}
+}
+
 func validateMIMEType(mimeType string) error {
 	if mimeType == "" {
 		return fmt.Errorf("invalid MIME type: empty string")
@@ -203,6 +203,7 @@ func TestResource_ResourceHandler_HappyPath(t *testing.T) {
 	// Assert
 	require.NoError(t, handlerErr)
 	require.Len(t, result.Contents, 1)
+	assert.Equal(t, uri, result.Contents[0].URI)
 	assert.Equal(t, "text/plain", result.Contents[0].MIMEType)
 	assert.Equal(t, "test content", result.Contents[0].Text)
 }
@@ -0,0 +1,121 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package sessionresolver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/debugsession"
+)
+
+// sessionIDArgument is the URI template variable holding the session ID, in multi-session mode.
+const sessionIDArgument = "id"
+
+var ErrMATLABNotRunning = errors.New("no MATLAB session is running, use a tool which starts MATLAB before reading this resource")
+
+type Config interface {
+	UseSingleMATLABSession() bool
+}
+
+type GlobalMATLAB interface {
+	RunningClient(ctx context.Context, logger entities.Logger) (entities.MATLABSessionClient, bool)
+	RunningSessionID() (entities.SessionID, bool)
+}
+
+type MATLABManager interface {
+	GetMATLABSessionClient(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID) (entities.MATLABSessionClient, error)
+	GetMATLABSessionDirectory(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID) (string, error)
+}
+
+type DebugSession interface {
+	State() debugsession.State
+}
+
+// Resolver resolves the MATLAB session a resource reads from:
+// the session whose ID is in the resource URI in multi-session mode, and the global MATLAB session in single-session mode.
+// Reading a resource never starts MATLAB.
+type Resolver struct {
+	config        Config
+	globalMATLAB  GlobalMATLAB
+	matlabManager MATLABManager
+	debugSession  DebugSession
+}
+
+func New(
+	config Config,
+	globalMATLAB GlobalMATLAB,
+	matlabManager MATLABManager,
+	debugSession DebugSession,
+) *Resolver {
+	return &Resolver{
+		config:        config,
+		globalMATLAB:  globalMATLAB,
+		matlabManager: matlabManager,
+		debugSession:  debugSession,
+	}
+}
+
+// UseSingleMATLABSession reports whether the resources read from the global MATLAB session, so have no session ID in their URI.
+func (r *Resolver) UseSingleMATLABSession() bool {
+	return r.config.UseSingleMATLABSession()
+}
+
+// Client returns the client of the MATLAB session to read from.
+// In single-session mode, MATLAB must not be debugging code, as the resource would be read within the code paused in the debugger.
+func (r *Resolver) Client(ctx context.Context, logger entities.Logger, arguments map[string]string) (entities.MATLABSessionClient, error) {
+	if !r.config.UseSingleMATLABSession() {
+		sessionID, err := parseSessionID(arguments)
+		if err != nil {
+			return nil, err
+		}
+
+		return r.matlabManager.GetMATLABSessionClient(ctx, logger.With("session_id", sessionID), sessionID)
+	}
+
+	if state := r.debugSession.State(); state != debugsession.StateIdle {
+		return nil, fmt.Errorf("the resource cannot be read while the MATLAB code is %s in the debugger, continue or quit the debugging first", state)
+	}
+
+	client, ok := r.globalMATLAB.RunningClient(ctx, logger)
+	if !ok {
+		return nil, ErrMATLABNotRunning
+	}
+
+	return client, nil
+}
+
+// SessionDirectory returns the session directory of the MATLAB session to read from.
+func (r *Resolver) SessionDirectory(ctx context.Context, logger entities.Logger, arguments map[string]string) (string, error) {
+	sessionID, err := r.sessionID(arguments)
+	if err != nil {
+		return "", err
+	}
+
+	return r.matlabManager.GetMATLABSessionDirectory(ctx, logger.With("session_id", sessionID), sessionID)
+}
+
+func (r *Resolver) sessionID(arguments map[string]string) (entities.SessionID, error) {
+	if !r.config.UseSingleMATLABSession() {
+		return parseSessionID(arguments)
+	}
+
+	sessionID, ok := r.globalMATLAB.RunningSessionID()
+	if !ok {
+		return sessionID, ErrMATLABNotRunning
+	}
+
+	return sessionID, nil
+}
+
+func parseSessionID(arguments map[string]string) (entities.SessionID, error) {
+	id, err := strconv.Atoi(arguments[sessionIDArgument])
+	if err != nil {
+		return 0, fmt.Errorf("invalid session ID: %q", arguments[sessionIDArgument])
+	}
+
+	return entities.SessionID(id), nil
+}
@@ -0,0 +1,335 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package sessionresolver_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/baseresource/sessionresolver"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/debugsession"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/resources/baseresource/sessionresolver"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_Client_MultiSession_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABManager := &mocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockDebugSession := &mocks.MockDebugSession{}
+	defer mockDebugSession.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(false).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), entities.SessionID(4)).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	resolver := sessionresolver.New(mockConfig, mockGlobalMATLAB, mockMATLABManager, mockDebugSession)
+
+	// Act
+	client, err := resolver.Client(ctx, mockLogger, map[string]string{"id": "4"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, mockMATLABSessionClient, client)
+}
+
+func TestResolver_Client_MultiSession_InvalidSessionID(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABManager := &mocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockDebugSession := &mocks.MockDebugSession{}
+	defer mockDebugSession.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(false).
+		Once()
+
+	resolver := sessionresolver.New(mockConfig, mockGlobalMATLAB, mockMATLABManager, mockDebugSession)
+
+	// Act
+	client, err := resolver.Client(t.Context(), mockLogger, map[string]string{"id": "abc"})
+
+	// Assert
+	require.EqualError(t, err, `invalid session ID: "abc"`)
+	assert.Nil(t, client)
+}
+
+func TestResolver_Client_SingleSession_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABManager := &mocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockDebugSession := &mocks.MockDebugSession{}
+	defer mockDebugSession.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(true).
+		Once()
+
+	mockDebugSession.EXPECT().
+		State().
+		Return(debugsession.StateIdle).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		RunningClient(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, true).
+		Once()
+
+	resolver := sessionresolver.New(mockConfig, mockGlobalMATLAB, mockMATLABManager, mockDebugSession)
+
+	// Act
+	client, err := resolver.Client(ctx, mockLogger, map[string]string{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, mockMATLABSessionClient, client)
+}
+
+func TestResolver_Client_SingleSession_MATLABNotRunning(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABManager := &mocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockDebugSession := &mocks.MockDebugSession{}
+	defer mockDebugSession.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(true).
+		Once()
+
+	mockDebugSession.EXPECT().
+		State().
+		Return(debugsession.StateIdle).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		RunningClient(ctx, mockLogger.AsMockArg()).
+		Return(nil, false).
+		Once()
+
+	resolver := sessionresolver.New(mockConfig, mockGlobalMATLAB, mockMATLABManager, mockDebugSession)
+
+	// Act
+	client, err := resolver.Client(ctx, mockLogger, map[string]string{})
+
+	// Assert
+	require.ErrorIs(t, err, sessionresolver.ErrMATLABNotRunning)
+	assert.Nil(t, client)
+}
+
+func TestResolver_Client_SingleSession_WhileDebugging(t *testing.T) {
+	testCases := []debugsession.State{
+		debugsession.StateRunning,
+		debugsession.StatePaused,
+	}
+
+	for _, state := range testCases {
+		t.Run(string(state), func(t *testing.T) {
+			// Arrange
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+			defer mockGlobalMATLAB.AssertExpectations(t)
+
+			mockMATLABManager := &mocks.MockMATLABManager{}
+			defer mockMATLABManager.AssertExpectations(t)
+
+			mockDebugSession := &mocks.MockDebugSession{}
+			defer mockDebugSession.AssertExpectations(t)
+
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockConfig.EXPECT().
+				UseSingleMATLABSession().
+				Return(true).
+				Once()
+
+			mockDebugSession.EXPECT().
+				State().
+				Return(state).
+				Once()
+
+			resolver := sessionresolver.New(mockConfig, mockGlobalMATLAB, mockMATLABManager, mockDebugSession)
+
+			// Act
+			client, err := resolver.Client(t.Context(), mockLogger, map[string]string{})
+
+			// Assert
+			require.ErrorContains(t, err, "in the debugger")
+			assert.Nil(t, client)
+		})
+	}
+}
+
+func TestResolver_SessionDirectory_MultiSession_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABManager := &mocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockDebugSession := &mocks.MockDebugSession{}
+	defer mockDebugSession.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedSessionDir := filepath.Join("tmp", "session")
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(false).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		GetMATLABSessionDirectory(ctx, mockLogger.AsMockArg(), entities.SessionID(2)).
+		Return(expectedSessionDir, nil).
+		Once()
+
+	resolver := sessionresolver.New(mockConfig, mockGlobalMATLAB, mockMATLABManager, mockDebugSession)
+
+	// Act
+	sessionDir, err := resolver.SessionDirectory(ctx, mockLogger, map[string]string{"id": "2"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedSessionDir, sessionDir)
+}
+
+func TestResolver_SessionDirectory_SingleSession_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABManager := &mocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockDebugSession := &mocks.MockDebugSession{}
+	defer mockDebugSession.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedSessionDir := filepath.Join("tmp", "session")
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(true).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		RunningSessionID().
+		Return(entities.SessionID(1), true).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		GetMATLABSessionDirectory(ctx, mockLogger.AsMockArg(), entities.SessionID(1)).
+		Return(expectedSessionDir, nil).
+		Once()
+
+	resolver := sessionresolver.New(mockConfig, mockGlobalMATLAB, mockMATLABManager, mockDebugSession)
+
+	// Act
+	sessionDir, err := resolver.SessionDirectory(ctx, mockLogger, map[string]string{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedSessionDir, sessionDir)
+}
+
+func TestResolver_SessionDirectory_SingleSession_MATLABNotRunning(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockGlobalMATLAB := &mocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABManager := &mocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockDebugSession := &mocks.MockDebugSession{}
+	defer mockDebugSession.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(true).
+		Once()
+
+	mockGlobalMATLAB.EXPECT().
+		RunningSessionID().
+		Return(entities.SessionID(0), false).
+		Once()
+
+	resolver := sessionresolver.New(mockConfig, mockGlobalMATLAB, mockMATLABManager, mockDebugSession)
+
+	// Act
+	sessionDir, err := resolver.SessionDirectory(t.Context(), mockLogger, map[string]string{})
+
+	// Assert
+	require.ErrorIs(t, err, sessionresolver.ErrMATLABNotRunning)
+	assert.Empty(t, sessionDir)
+}
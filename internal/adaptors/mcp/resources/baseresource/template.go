@@ -0,0 +1,127 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package baseresource
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/yosida95/uritemplate/v3"
+)
+
+// TemplateHandler reads the resource matching the URI template.
+// The arguments are the values of the URI template variables, by variable name.
+type TemplateHandler func(ctx context.Context, logger entities.Logger, arguments map[string]string) (*ReadResourceResult, error)
+
+func NewTemplate(
+	name string,
+	title string,
+	description string,
+	mimeType string,
+	uriTemplate string,
+	loggerFactory LoggerFactory,
+	handler TemplateHandler,
+) (*ResourceTemplate, error) {
+	if err := validateMIMEType(mimeType); err != nil {
+		return nil, err
+	}
+
+	parsedURITemplate, err := uritemplate.New(uriTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid URI template %q: %w", uriTemplate, err)
+	}
+
+	return &ResourceTemplate{
+		name:              name,
+		title:             title,
+		description:       description,
+		mimeType:          mimeType,
+		uriTemplate:       uriTemplate,
+		parsedURITemplate: parsedURITemplate,
+		loggerFactory:     loggerFactory,
+		handler:           handler,
+	}, nil
+}
+
+// ResourceTemplate is a family of resources, such as matlabdoc://{function}, whose URIs match a URI template.
+type ResourceTemplate struct {
+	name              string
+	title             string
+	description       string
+	mimeType          string
+	uriTemplate       string
+	parsedURITemplate *uritemplate.Template
+	loggerFactory     LoggerFactory
+	handler           TemplateHandler
+}
+
+func (r *ResourceTemplate) AddToServer(server resources.Server) {
+	server.AddResourceTemplate(
+		&mcp.ResourceTemplate{
+			Name:        r.name,
+			Title:       r.title,
+			Description: r.description,
+			MIMEType:    r.mimeType,
+			URITemplate: r.uriTemplate,
+		},
+		r.resourceHandler(),
+	)
+}
+
+func (r *ResourceTemplate) resourceHandler() mcp.ResourceHandler {
+	return func(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+		logger := r.loggerFactory.NewMCPSessionLogger(req.Session).
+			With("resource-name", r.name).
+			With("uri", req.Params.URI)
+		logger.Debug("Handling resource request")
+		defer logger.Debug("Handled resource request")
+
+		if r.handler == nil {
+			err := fmt.Errorf(UnexpectedErrorPrefix + "no resource handler available")
+			logger.WithError(err).Warn("Resource handler is nil")
+			return nil, err
+		}
+
+		values := r.parsedURITemplate.Match(req.Params.URI)
+		if values == nil {
+			logger.Warn("Resource URI does not match the URI template")
+			return nil, mcp.ResourceNotFoundError(req.Params.URI)
+		}
+
+		arguments := map[string]string{}
+		for _, variableName := range r.parsedURITemplate.Varnames() {
+			arguments[variableName] = values.Get(variableName).String()
+		}
+
+		result, err := r.handler(ctx, logger, arguments)
+		if err != nil {
+			logger.WithError(err).Warn("Resource handler returned an error")
+			return nil, err
+		}
+
+		return toMCPResult(req.Params.URI, result), nil
+	}
+}
+
+func (r *ResourceTemplate) Name() string {
+	return r.name
+}
+
+func (r *ResourceTemplate) Title() string {
+	return r.title
+}
+
+func (r *ResourceTemplate) Description() string {
+	return r.description
+}
+
+func (r *ResourceTemplate) MimeType() string {
+	return r.mimeType
+}
+
+func (r *ResourceTemplate) URITemplate() string {
+	return r.uriTemplate
+}
@@ -0,0 +1,320 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package baseresource_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/baseresource"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/resources"
+	baseresourcemocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/resources/baseresource"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTemplate_HappyPath(t *testing.T) {
+	// Arrange
+	const (
+		name        = "test_template"
+		title       = "Test Template"
+		description = "A test resource template"
+		mimeType    = "text/plain"
+		uriTemplate = "test://{item}"
+	)
+
+	mockLoggerFactory := &baseresourcemocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	handler := func(ctx context.Context, logger entities.Logger, arguments map[string]string) (*baseresource.ReadResourceResult, error) {
+		return &baseresource.ReadResourceResult{}, nil
+	}
+
+	// Act
+	r, err := baseresource.NewTemplate(name, title, description, mimeType, uriTemplate, mockLoggerFactory, handler)
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotNil(t, r)
+	assert.Equal(t, name, r.Name())
+	assert.Equal(t, title, r.Title())
+	assert.Equal(t, description, r.Description())
+	assert.Equal(t, mimeType, r.MimeType())
+	assert.Equal(t, uriTemplate, r.URITemplate())
+}
+
+func TestNewTemplate_InvalidMimeType(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &baseresourcemocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	// Act
+	r, err := baseresource.NewTemplate("test_template", "Test Template", "A test resource template", "invalid-mime-type", "test://{item}", mockLoggerFactory, nil)
+
+	// Assert
+	require.ErrorContains(t, err, "must be in format type/subtype")
+	assert.Nil(t, r)
+}
+
+func TestNewTemplate_InvalidURITemplate(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &baseresourcemocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	// Act
+	r, err := baseresource.NewTemplate("test_template", "Test Template", "A test resource template", "text/plain", "test://{item", mockLoggerFactory, nil)
+
+	// Assert
+	require.ErrorContains(t, err, "invalid URI template")
+	assert.Nil(t, r)
+}
+
+func TestResourceTemplate_AddToServer_HappyPath(t *testing.T) {
+	// Arrange
+	const (
+		name        = "test_template"
+		title       = "Test Template"
+		description = "A test resource template"
+		mimeType    = "text/plain"
+		uriTemplate = "test://{item}"
+	)
+
+	mockLoggerFactory := &baseresourcemocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	r, err := baseresource.NewTemplate(name, title, description, mimeType, uriTemplate, mockLoggerFactory, nil)
+	require.NoError(t, err)
+
+	mockServer := &mocks.MockServer{}
+	defer mockServer.AssertExpectations(t)
+
+	mockServer.EXPECT().AddResourceTemplate(
+		&mcp.ResourceTemplate{
+			Name:        name,
+			Title:       title,
+			Description: description,
+			MIMEType:    mimeType,
+			URITemplate: uriTemplate,
+		},
+		mock.AnythingOfType("mcp.ResourceHandler"),
+	).Return()
+
+	// Act
+	r.AddToServer(mockServer)
+
+	// Assert
+}
+
+func TestResourceTemplate_ResourceHandler_HappyPath(t *testing.T) {
+	// Arrange
+	const uri = "test://session/42/stdout"
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &baseresourcemocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger).
+		Once()
+
+	var capturedArguments map[string]string
+	handler := func(ctx context.Context, logger entities.Logger, arguments map[string]string) (*baseresource.ReadResourceResult, error) {
+		capturedArguments = arguments
+		return &baseresource.ReadResourceResult{
+			Contents: []baseresource.ResourceContents{
+				{MIMEType: "text/plain", Text: "test content"},
+			},
+		}, nil
+	}
+
+	r, err := baseresource.NewTemplate("test_template", "Test Template", "A test resource template", "text/plain", "test://session/{id}/{stream}", mockLoggerFactory, handler)
+	require.NoError(t, err)
+
+	capturedHandler := captureTemplateHandler(t, r)
+
+	// Act
+	result, handlerErr := capturedHandler(t.Context(), &mcp.ReadResourceRequest{
+		Params: &mcp.ReadResourceParams{
+			URI: uri,
+		},
+	})
+
+	// Assert
+	require.NoError(t, handlerErr)
+	assert.Equal(t, map[string]string{"id": "42", "stream": "stdout"}, capturedArguments)
+	require.Len(t, result.Contents, 1)
+	assert.Equal(t, uri, result.Contents[0].URI)
+	assert.Equal(t, "text/plain", result.Contents[0].MIMEType)
+	assert.Equal(t, "test content", result.Contents[0].Text)
+}
+
+func TestResourceTemplate_ResourceHandler_URIDoesNotMatch(t *testing.T) {
+	// Arrange
+	const uri = "other://something"
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &baseresourcemocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger).
+		Once()
+
+	handler := func(ctx context.Context, logger entities.Logger, arguments map[string]string) (*baseresource.ReadResourceResult, error) {
+		t.Fatal("handler should not be called")
+		return nil, nil
+	}
+
+	r, err := baseresource.NewTemplate("test_template", "Test Template", "A test resource template", "text/plain", "test://{item}", mockLoggerFactory, handler)
+	require.NoError(t, err)
+
+	capturedHandler := captureTemplateHandler(t, r)
+
+	// Act
+	result, handlerErr := capturedHandler(t.Context(), &mcp.ReadResourceRequest{
+		Params: &mcp.ReadResourceParams{
+			URI: uri,
+		},
+	})
+
+	// Assert
+	require.Error(t, handlerErr)
+	assert.Contains(t, handlerErr.Error(), "not found")
+	assert.Nil(t, result)
+}
+
+func TestResourceTemplate_ResourceHandler_HandlerError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &baseresourcemocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger).
+		Once()
+
+	expectedError := assert.AnError
+
+	handler := func(ctx context.Context, logger entities.Logger, arguments map[string]string) (*baseresource.ReadResourceResult, error) {
+		return nil, expectedError
+	}
+
+	r, err := baseresource.NewTemplate("test_template", "Test Template", "A test resource template", "text/plain", "test://{item}", mockLoggerFactory, handler)
+	require.NoError(t, err)
+
+	capturedHandler := captureTemplateHandler(t, r)
+
+	// Act
+	result, handlerErr := capturedHandler(t.Context(), &mcp.ReadResourceRequest{
+		Params: &mcp.ReadResourceParams{
+			URI: "test://item",
+		},
+	})
+
+	// Assert
+	require.ErrorIs(t, handlerErr, expectedError)
+	assert.Nil(t, result)
+}
+
+func TestResourceTemplate_ResourceHandler_NilHandler(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &baseresourcemocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger).
+		Once()
+
+	r, err := baseresource.NewTemplate("test_template", "Test Template", "A test resource template", "text/plain", "test://{item}", mockLoggerFactory, nil)
+	require.NoError(t, err)
+
+	capturedHandler := captureTemplateHandler(t, r)
+
+	// Act
+	result, handlerErr := capturedHandler(t.Context(), &mcp.ReadResourceRequest{
+		Params: &mcp.ReadResourceParams{
+			URI: "test://item",
+		},
+	})
+
+	// Assert
+	require.Error(t, handlerErr)
+	assert.Contains(t, handlerErr.Error(), baseresource.UnexpectedErrorPrefix)
+	assert.Nil(t, result)
+}
+
+func captureTemplateHandler(t *testing.T, r *baseresource.ResourceTemplate) mcp.ResourceHandler {
+	t.Helper()
+
+	var capturedHandler mcp.ResourceHandler
+	mockServer := &mocks.MockServer{}
+	defer mockServer.AssertExpectations(t)
+
+	mockServer.EXPECT().AddResourceTemplate(
+		mock.Anything,
+		mock.AnythingOfType("mcp.ResourceHandler"),
+	).Run(func(template *mcp.ResourceTemplate, h mcp.ResourceHandler) {
+		capturedHandler = h
+	}).Return()
+
+	r.AddToServer(mockServer)
+
+	return capturedHandler
+}
+
+func TestResourceTemplate_ResourceHandler_BlobContents(t *testing.T) {
+	// Arrange
+	const uri = "test://image"
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &baseresourcemocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger).
+		Once()
+
+	expectedBlob := []byte{0x89, 'P', 'N', 'G'}
+
+	handler := func(ctx context.Context, logger entities.Logger, arguments map[string]string) (*baseresource.ReadResourceResult, error) {
+		return &baseresource.ReadResourceResult{
+			Contents: []baseresource.ResourceContents{
+				{MIMEType: "image/png", Blob: expectedBlob},
+			},
+		}, nil
+	}
+
+	r, err := baseresource.NewTemplate("test_template", "Test Template", "A test resource template", "image/png", "test://{item}", mockLoggerFactory, handler)
+	require.NoError(t, err)
+
+	capturedHandler := captureTemplateHandler(t, r)
+
+	// Act
+	result, handlerErr := capturedHandler(t.Context(), &mcp.ReadResourceRequest{
+		Params: &mcp.ReadResourceParams{
+			URI: uri,
+		},
+	})
+
+	// Assert
+	require.NoError(t, handlerErr)
+	require.Len(t, result.Contents, 1)
+	assert.Equal(t, "image/png", result.Contents[0].MIMEType)
+	assert.Equal(t, expectedBlob, result.Contents[0].Blob)
+	assert.Empty(t, result.Contents[0].Text)
+}
@@ -0,0 +1,15 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package customcodingguidelines
+
+import "time"
+
+const (
+	namePrefix        = "coding_guidelines_"
+	titleFormat       = "Coding Guidelines (%s)"
+	descriptionFormat = "Provides the coding guidelines from %s. Follow them in addition to, or instead of, the MATLAB coding guidelines."
+	mimeType          = "text/markdown"
+	uriPrefix         = "guidelines://coding/"
+
+	reloadInterval = 2 * time.Second
+)
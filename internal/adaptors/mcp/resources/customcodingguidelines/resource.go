@@ -0,0 +1,212 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package customcodingguidelines
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/url"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/baseresource"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/markdownfiles"
+)
+
+type Config interface {
+	CodingGuidelines() []string
+}
+
+type LoggerFactory interface {
+	baseresource.LoggerFactory
+	GetGlobalLogger() entities.Logger
+}
+
+type OSLayer interface {
+	Stat(name string) (osfacade.FileInfo, error)
+	ReadFile(name string) ([]byte, error)
+}
+
+type FileLayer interface {
+	Glob(pattern string) ([]string, error)
+}
+
+type LifecycleSignaler interface {
+	AddShutdownFunction(shutdownFcn func() error)
+}
+
+// Resource exposes each of the coding guidelines files from the configuration as its own resource.
+type Resource struct {
+	config            Config
+	loggerFactory     LoggerFactory
+	osLayer           OSLayer
+	fileLayer         FileLayer
+	lifecycleSignaler LifecycleSignaler
+
+	l     *sync.Mutex
+	files map[string]guidelinesFile
+}
+
+type guidelinesFile struct {
+	path    string
+	name    string
+	size    int64
+	modTime time.Time
+}
+
+func New(
+	config Config,
+	loggerFactory LoggerFactory,
+	osLayer OSLayer,
+	fileLayer FileLayer,
+	lifecycleSignaler LifecycleSignaler,
+) *Resource {
+	return &Resource{
+		config:            config,
+		loggerFactory:     loggerFactory,
+		osLayer:           osLayer,
+		fileLayer:         fileLayer,
+		lifecycleSignaler: lifecycleSignaler,
+
+		l:     new(sync.Mutex),
+		files: map[string]guidelinesFile{},
+	}
+}
+
+// AddToServer adds a resource for each coding guidelines file, then keeps them in sync with the files,
+// so that the clients are notified when a file is added, changed or removed.
+func (r *Resource) AddToServer(server resources.Server) {
+	if len(r.config.CodingGuidelines()) == 0 {
+		return
+	}
+
+	logger := r.loggerFactory.GetGlobalLogger()
+	r.reload(logger, server)
+
+	stop := make(chan struct{})
+	r.lifecycleSignaler.AddShutdownFunction(func() error {
+		close(stop)
+		return nil
+	})
+
+	go func() {
+		ticker := time.NewTicker(reloadInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				r.reload(logger, server)
+			}
+		}
+	}()
+}
+
+// reload adds the resources of the new or changed files, and removes the ones of the deleted files.
+func (r *Resource) reload(logger entities.Logger, server resources.Server) {
+	r.l.Lock()
+	defer r.l.Unlock()
+
+	files := r.listFiles(logger)
+
+	for _, uri := range slices.Sorted(maps.Keys(files)) {
+		file := files[uri]
+
+		previousFile, exists := r.files[uri]
+		if exists && previousFile.size == file.size && previousFile.modTime.Equal(file.modTime) {
+			continue
+		}
+
+		resource, err := baseresource.New(
+			namePrefix+file.name,
+			fmt.Sprintf(titleFormat, file.name),
+			fmt.Sprintf(descriptionFormat, file.path),
+			mimeType,
+			file.size,
+			uri,
+			r.loggerFactory,
+			Handler(r.osLayer, file.path),
+		)
+		if err != nil {
+			logger.WithError(err).With("path", file.path).Warn("Failed to create the coding guidelines resource")
+			continue
+		}
+
+		logger.With("path", file.path).Debug("Adding coding guidelines resource")
+		resource.AddToServer(server)
+	}
+
+	removedURIs := []string{}
+	for _, uri := range slices.Sorted(maps.Keys(r.files)) {
+		if _, exists := files[uri]; !exists {
+			removedURIs = append(removedURIs, uri)
+		}
+	}
+
+	if len(removedURIs) > 0 {
+		logger.With("uris", removedURIs).Debug("Removing coding guidelines resources")
+		server.RemoveResources(removedURIs...)
+	}
+
+	r.files = files
+}
+
+// listFiles returns the coding guidelines files by URI.
+// Folders are expanded to the Markdown files they contain.
+func (r *Resource) listFiles(logger entities.Logger) map[string]guidelinesFile {
+	files := map[string]guidelinesFile{}
+
+	for _, file := range markdownfiles.List(logger, r.osLayer, r.fileLayer, r.config.CodingGuidelines()) {
+		addFile(logger, files, file.Path, file.Info)
+	}
+
+	return files
+}
+
+func addFile(logger entities.Logger, files map[string]guidelinesFile, path string, info osfacade.FileInfo) {
+	baseName := filepath.Base(path)
+	name := strings.TrimSuffix(baseName, filepath.Ext(baseName))
+	uri := uriPrefix + url.PathEscape(name)
+
+	if existingFile, exists := files[uri]; exists {
+		logger.With("path", path).With("existing-path", existingFile.path).Warn("Ignoring coding guidelines with the same name as other ones")
+		return
+	}
+
+	files[uri] = guidelinesFile{
+		path:    path,
+		name:    name,
+		size:    info.Size(),
+		modTime: info.ModTime(),
+	}
+}
+
+// Handler reads the file on every request, so that the clients always get its latest content.
+func Handler(osLayer OSLayer, path string) baseresource.ResourceHandler {
+	return func(_ context.Context, logger entities.Logger) (*baseresource.ReadResourceResult, error) {
+		logger.With("path", path).Info("Returning coding guidelines resource")
+
+		content, err := osLayer.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the coding guidelines: %w", err)
+		}
+
+		return &baseresource.ReadResourceResult{
+			Contents: []baseresource.ResourceContents{
+				{
+					MIMEType: mimeType,
+					Text:     string(content),
+				},
+			},
+		}, nil
+	}
+}
@@ -0,0 +1,12 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package customcodingguidelines
+
+import (
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+func (r *Resource) Reload(logger entities.Logger, server resources.Server) {
+	r.reload(logger, server)
+}
@@ -0,0 +1,435 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package customcodingguidelines_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/customcodingguidelines"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	resourcesmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/resources"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/resources/customcodingguidelines"
+	osfacademocks "github.com/matlab/matlab-mcp-core-server/mocks/facades/osfacade"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResource_AddToServer_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockServer := &resourcesmocks.MockServer{}
+	defer mockServer.AssertExpectations(t)
+
+	mockFileInfo := &osfacademocks.MockFileInfo{}
+	defer mockFileInfo.AssertExpectations(t)
+
+	mockFolderInfo := &osfacademocks.MockFileInfo{}
+	defer mockFolderInfo.AssertExpectations(t)
+
+	mockFolderFileInfo := &osfacademocks.MockFileInfo{}
+	defer mockFolderFileInfo.AssertExpectations(t)
+
+	filePath := filepath.Join("home", "user", "team-style.md")
+	folderPath := filepath.Join("home", "user", "guidelines")
+	folderFilePath := filepath.Join(folderPath, "testing.md")
+	modTime := time.Now()
+
+	mockConfig.EXPECT().
+		CodingGuidelines().
+		Return([]string{filePath, folderPath})
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(testutils.NewInspectableLogger()).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat(filePath).
+		Return(mockFileInfo, nil).
+		Once()
+
+	mockFileInfo.EXPECT().IsDir().Return(false).Once()
+	mockFileInfo.EXPECT().Size().Return(int64(42)).Once()
+	mockFileInfo.EXPECT().ModTime().Return(modTime).Once()
+
+	mockOSLayer.EXPECT().
+		Stat(folderPath).
+		Return(mockFolderInfo, nil).
+		Once()
+
+	mockFolderInfo.EXPECT().IsDir().Return(true).Once()
+
+	mockFileLayer.EXPECT().
+		Glob(filepath.Join(folderPath, "*.md")).
+		Return([]string{folderFilePath}, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat(folderFilePath).
+		Return(mockFolderFileInfo, nil).
+		Once()
+
+	mockFolderFileInfo.EXPECT().IsDir().Return(false).Once()
+	mockFolderFileInfo.EXPECT().Size().Return(int64(7)).Once()
+	mockFolderFileInfo.EXPECT().ModTime().Return(modTime).Once()
+
+	mockServer.EXPECT().AddResource(
+		&mcp.Resource{
+			Name:        "coding_guidelines_team-style",
+			Title:       "Coding Guidelines (team-style)",
+			Description: "Provides the coding guidelines from " + filePath + ". Follow them in addition to, or instead of, the MATLAB coding guidelines.",
+			MIMEType:    "text/markdown",
+			Size:        42,
+			URI:         "guidelines://coding/team-style",
+		},
+		mock.AnythingOfType("mcp.ResourceHandler"),
+	).Return().Once()
+
+	mockServer.EXPECT().AddResource(
+		&mcp.Resource{
+			Name:        "coding_guidelines_testing",
+			Title:       "Coding Guidelines (testing)",
+			Description: "Provides the coding guidelines from " + folderFilePath + ". Follow them in addition to, or instead of, the MATLAB coding guidelines.",
+			MIMEType:    "text/markdown",
+			Size:        7,
+			URI:         "guidelines://coding/testing",
+		},
+		mock.AnythingOfType("mcp.ResourceHandler"),
+	).Return().Once()
+
+	var capturedShutdownFunc func() error
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(shutdownFcn func() error) {
+			capturedShutdownFunc = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	resource := customcodingguidelines.New(mockConfig, mockLoggerFactory, mockOSLayer, mockFileLayer, mockLifecycleSignaler)
+
+	// Act
+	resource.AddToServer(mockServer)
+
+	// Assert
+	require.NotNil(t, capturedShutdownFunc)
+	require.NoError(t, capturedShutdownFunc())
+}
+
+func TestResource_AddToServer_NoCodingGuidelines(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockServer := &resourcesmocks.MockServer{}
+	defer mockServer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		CodingGuidelines().
+		Return([]string{}).
+		Once()
+
+	resource := customcodingguidelines.New(mockConfig, mockLoggerFactory, mockOSLayer, mockFileLayer, mockLifecycleSignaler)
+
+	// Act
+	resource.AddToServer(mockServer)
+
+	// Assert
+	// Nothing is added to the server, and no reload is scheduled
+}
+
+func TestResource_Reload_ChangedAndRemovedFiles(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockServer := &resourcesmocks.MockServer{}
+	defer mockServer.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	unchangedPath := filepath.Join("home", "user", "unchanged.md")
+	changedPath := filepath.Join("home", "user", "changed.md")
+	removedPath := filepath.Join("home", "user", "removed.md")
+	modTime := time.Now()
+
+	mockConfig.EXPECT().
+		CodingGuidelines().
+		Return([]string{unchangedPath, changedPath, removedPath})
+
+	expectFile := func(path string, size int64, modTime time.Time) {
+		mockFileInfo := &osfacademocks.MockFileInfo{}
+		t.Cleanup(func() { mockFileInfo.AssertExpectations(t) })
+
+		mockOSLayer.EXPECT().
+			Stat(path).
+			Return(mockFileInfo, nil).
+			Once()
+
+		mockFileInfo.EXPECT().IsDir().Return(false).Once()
+		mockFileInfo.EXPECT().Size().Return(size).Once()
+		mockFileInfo.EXPECT().ModTime().Return(modTime).Once()
+	}
+
+	// First reload
+	expectFile(unchangedPath, 1, modTime)
+	expectFile(changedPath, 2, modTime)
+	expectFile(removedPath, 3, modTime)
+
+	mockServer.EXPECT().
+		AddResource(mock.Anything, mock.AnythingOfType("mcp.ResourceHandler")).
+		Return().
+		Times(3)
+
+	// Second reload
+	expectFile(unchangedPath, 1, modTime)
+	expectFile(changedPath, 20, modTime.Add(time.Second))
+
+	mockOSLayer.EXPECT().
+		Stat(removedPath).
+		Return(nil, assert.AnError).
+		Once()
+
+	mockServer.EXPECT().
+		AddResource(
+			mock.MatchedBy(func(resource *mcp.Resource) bool {
+				return resource.URI == "guidelines://coding/changed" && resource.Size == 20
+			}),
+			mock.AnythingOfType("mcp.ResourceHandler"),
+		).
+		Return().
+		Once()
+
+	mockServer.EXPECT().
+		RemoveResources([]string{"guidelines://coding/removed"}).
+		Return().
+		Once()
+
+	resource := customcodingguidelines.New(mockConfig, mockLoggerFactory, mockOSLayer, mockFileLayer, mockLifecycleSignaler)
+	resource.Reload(mockLogger, mockServer)
+
+	// Act
+	resource.Reload(mockLogger, mockServer)
+
+	// Assert
+	warnLogs := mockLogger.WarnLogs()
+	require.Len(t, warnLogs, 1)
+	assert.Contains(t, warnLogs, "Failed to read the file")
+}
+
+func TestResource_Reload_DuplicateNames(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockServer := &resourcesmocks.MockServer{}
+	defer mockServer.AssertExpectations(t)
+
+	mockFileInfo := &osfacademocks.MockFileInfo{}
+	defer mockFileInfo.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	firstPath := filepath.Join("home", "user", "style.md")
+	secondPath := filepath.Join("home", "team", "style.md")
+
+	mockConfig.EXPECT().
+		CodingGuidelines().
+		Return([]string{firstPath, secondPath}).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat(firstPath).
+		Return(mockFileInfo, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat(secondPath).
+		Return(mockFileInfo, nil).
+		Once()
+
+	mockFileInfo.EXPECT().IsDir().Return(false).Times(2)
+	mockFileInfo.EXPECT().Size().Return(int64(1)).Once()
+	mockFileInfo.EXPECT().ModTime().Return(time.Now()).Once()
+
+	mockServer.EXPECT().
+		AddResource(
+			mock.MatchedBy(func(resource *mcp.Resource) bool {
+				return resource.URI == "guidelines://coding/style"
+			}),
+			mock.AnythingOfType("mcp.ResourceHandler"),
+		).
+		Return().
+		Once()
+
+	resource := customcodingguidelines.New(mockConfig, mockLoggerFactory, mockOSLayer, mockFileLayer, mockLifecycleSignaler)
+
+	// Act
+	resource.Reload(mockLogger, mockServer)
+
+	// Assert
+	warnLogs := mockLogger.WarnLogs()
+	require.Len(t, warnLogs, 1)
+	assert.Contains(t, warnLogs, "Ignoring coding guidelines with the same name as other ones")
+}
+
+func TestResource_Reload_GlobError(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockServer := &resourcesmocks.MockServer{}
+	defer mockServer.AssertExpectations(t)
+
+	mockFolderInfo := &osfacademocks.MockFileInfo{}
+	defer mockFolderInfo.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	folderPath := filepath.Join("home", "user", "guidelines")
+
+	mockConfig.EXPECT().
+		CodingGuidelines().
+		Return([]string{folderPath}).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat(folderPath).
+		Return(mockFolderInfo, nil).
+		Once()
+
+	mockFolderInfo.EXPECT().IsDir().Return(true).Once()
+
+	mockFileLayer.EXPECT().
+		Glob(filepath.Join(folderPath, "*.md")).
+		Return(nil, assert.AnError).
+		Once()
+
+	resource := customcodingguidelines.New(mockConfig, mockLoggerFactory, mockOSLayer, mockFileLayer, mockLifecycleSignaler)
+
+	// Act
+	resource.Reload(mockLogger, mockServer)
+
+	// Assert
+	warnLogs := mockLogger.WarnLogs()
+	require.Len(t, warnLogs, 1)
+	assert.Contains(t, warnLogs, "Failed to list the Markdown files of the folder")
+}
+
+func TestHandler_HappyPath(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	path := filepath.Join("home", "user", "team-style.md")
+	content := "# Team Style\n\nUse camelCase for variables."
+
+	mockOSLayer.EXPECT().
+		ReadFile(path).
+		Return([]byte(content), nil).
+		Once()
+
+	handler := customcodingguidelines.Handler(mockOSLayer, path)
+
+	// Act
+	result, err := handler(t.Context(), mockLogger)
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.Len(t, result.Contents, 1)
+	assert.Equal(t, "text/markdown", result.Contents[0].MIMEType)
+	assert.Equal(t, content, result.Contents[0].Text)
+}
+
+func TestHandler_ReadFileError(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	path := filepath.Join("home", "user", "team-style.md")
+
+	mockOSLayer.EXPECT().
+		ReadFile(path).
+		Return(nil, assert.AnError).
+		Once()
+
+	handler := customcodingguidelines.Handler(mockOSLayer, path)
+
+	// Act
+	result, err := handler(t.Context(), mockLogger)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, result)
+}
@@ -0,0 +1,16 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabdocumentation
+
+const (
+	name        = "matlab_documentation"
+	title       = "MATLAB Documentation"
+	description = "Provides the help text of a MATLAB function, class or package, as returned by the MATLAB help command. Replace {function} with the name of the function, for example matlabdoc://sin or matlabdoc://matlab.unittest.TestCase. Function names can be completed with the MCP completion request. Requires a running MATLAB session which is not debugging code."
+	mimeType    = "text/plain"
+	uriTemplate = "matlabdoc://{function}"
+
+	multiSessionDescription = "Provides the help text of a MATLAB function, class or package, as returned by the MATLAB help command in a MATLAB session. Replace {id} with the session ID returned by the start_matlab_session tool, and {function} with the name of the function, for example matlab://session/1/documentation/sin."
+	multiSessionURITemplate = "matlab://session/{id}/documentation/{function}"
+
+	functionArgument = "function"
+)
@@ -0,0 +1,77 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabdocumentation
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/baseresource"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getmatlabdocumentation"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request getmatlabdocumentation.Args) (getmatlabdocumentation.ReturnArgs, error)
+}
+
+type SessionResolver interface {
+	UseSingleMATLABSession() bool
+	Client(ctx context.Context, logger entities.Logger, arguments map[string]string) (entities.MATLABSessionClient, error)
+}
+
+type Resource struct {
+	*baseresource.ResourceTemplate
+}
+
+func New(loggerFactory baseresource.LoggerFactory, usecase Usecase, sessionResolver SessionResolver) (*Resource, error) {
+	resourceDescription, resourceURITemplate := multiSessionDescription, multiSessionURITemplate
+	if sessionResolver.UseSingleMATLABSession() {
+		resourceDescription, resourceURITemplate = description, uriTemplate
+	}
+
+	baseRes, err := baseresource.NewTemplate(
+		name,
+		title,
+		resourceDescription,
+		mimeType,
+		resourceURITemplate,
+		loggerFactory,
+		Handler(usecase, sessionResolver),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Resource{
+		ResourceTemplate: baseRes,
+	}, nil
+}
+
+func Handler(usecase Usecase, sessionResolver SessionResolver) baseresource.TemplateHandler {
+	return func(ctx context.Context, logger entities.Logger, arguments map[string]string) (*baseresource.ReadResourceResult, error) {
+		functionName := arguments[functionArgument]
+
+		logger.With("function", functionName).Info("Returning MATLAB documentation resource")
+
+		client, err := sessionResolver.Client(ctx, logger, arguments)
+		if err != nil {
+			return nil, err
+		}
+
+		response, err := usecase.Execute(ctx, logger, client, getmatlabdocumentation.Args{
+			FunctionName: functionName,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return &baseresource.ReadResourceResult{
+			Contents: []baseresource.ResourceContents{
+				{
+					MIMEType: mimeType,
+					Text:     response.Text,
+				},
+			},
+		}, nil
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabdocumentation_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabdocumentation"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getmatlabdocumentation"
+	baseresourcemocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/resources/baseresource"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/resources/matlabdocumentation"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	testCases := []struct {
+		name                   string
+		useSingleMATLABSession bool
+		expectedURITemplate    string
+	}{
+		{
+			name:                   "multi session",
+			useSingleMATLABSession: false,
+			expectedURITemplate:    "matlab://session/{id}/documentation/{function}",
+		},
+		{
+			name:                   "single session",
+			useSingleMATLABSession: true,
+			expectedURITemplate:    "matlabdoc://{function}",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockLoggerFactory := baseresourcemocks.NewMockLoggerFactory(t)
+			mockUsecase := mocks.NewMockUsecase(t)
+			mockSessionResolver := mocks.NewMockSessionResolver(t)
+
+			mockSessionResolver.EXPECT().
+				UseSingleMATLABSession().
+				Return(tc.useSingleMATLABSession).
+				Once()
+
+			// Act
+			resource, err := matlabdocumentation.New(mockLoggerFactory, mockUsecase, mockSessionResolver)
+
+			// Assert
+			require.NoError(t, err)
+			require.NotNil(t, resource)
+			assert.Equal(t, "matlab_documentation", resource.Name())
+			assert.Equal(t, "MATLAB Documentation", resource.Title())
+			assert.Equal(t, "text/plain", resource.MimeType())
+			assert.Equal(t, tc.expectedURITemplate, resource.URITemplate())
+		})
+	}
+}
+
+func TestHandler_HappyPath(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockSessionResolver := &mocks.MockSessionResolver{}
+	defer mockSessionResolver.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const expectedText = " sin    Sine of argument in radians.\n"
+
+	mockSessionResolver.EXPECT().
+		Client(ctx, mockLogger.AsMockArg(), map[string]string{"function": "sin"}).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, getmatlabdocumentation.Args{FunctionName: "sin"}).
+		Return(getmatlabdocumentation.ReturnArgs{Text: expectedText}, nil).
+		Once()
+
+	// Act
+	result, err := matlabdocumentation.Handler(mockUsecase, mockSessionResolver)(ctx, mockLogger, map[string]string{"function": "sin"})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, result.Contents, 1)
+	assert.Equal(t, "text/plain", result.Contents[0].MIMEType)
+	assert.Equal(t, expectedText, result.Contents[0].Text)
+}
+
+func TestHandler_SessionResolverError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockSessionResolver := &mocks.MockSessionResolver{}
+	defer mockSessionResolver.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockSessionResolver.EXPECT().
+		Client(ctx, mockLogger.AsMockArg(), map[string]string{"function": "sin"}).
+		Return(nil, assert.AnError).
+		Once()
+
+	// Act
+	result, err := matlabdocumentation.Handler(mockUsecase, mockSessionResolver)(ctx, mockLogger, map[string]string{"function": "sin"})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, result)
+}
+
+func TestHandler_UsecaseError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockSessionResolver := &mocks.MockSessionResolver{}
+	defer mockSessionResolver.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockSessionResolver.EXPECT().
+		Client(ctx, mockLogger.AsMockArg(), map[string]string{"function": "notAFunction"}).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, getmatlabdocumentation.Args{FunctionName: "notAFunction"}).
+		Return(getmatlabdocumentation.ReturnArgs{}, assert.AnError).
+		Once()
+
+	// Act
+	result, err := matlabdocumentation.Handler(mockUsecase, mockSessionResolver)(ctx, mockLogger, map[string]string{"function": "notAFunction"})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, result)
+}
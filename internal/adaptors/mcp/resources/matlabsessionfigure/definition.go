@@ -0,0 +1,16 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabsessionfigure
+
+const (
+	name        = "matlab_session_figure"
+	title       = "MATLAB Session Figure"
+	description = "Provides a PNG image of a figure of a MATLAB session. Replace {id} with the session ID returned by the start_matlab_session tool, and {n} with the figure number, as listed by the matlab://session/{id}/workspace resource."
+	mimeType    = "image/png"
+	uriTemplate = "matlab://session/{id}/figures/{n}"
+
+	singleSessionDescription = "Provides a PNG image of a figure of the MATLAB session. Replace {n} with the figure number, as listed by the matlab://session/workspace resource. Requires a running MATLAB session which is not debugging code."
+	singleSessionURITemplate = "matlab://session/figures/{n}"
+
+	figureNumberArgument = "n"
+)
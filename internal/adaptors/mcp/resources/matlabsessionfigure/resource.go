@@ -0,0 +1,83 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabsessionfigure
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/baseresource"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getmatlabfigure"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request getmatlabfigure.Args) (getmatlabfigure.ReturnArgs, error)
+}
+
+type SessionResolver interface {
+	UseSingleMATLABSession() bool
+	Client(ctx context.Context, logger entities.Logger, arguments map[string]string) (entities.MATLABSessionClient, error)
+}
+
+type Resource struct {
+	*baseresource.ResourceTemplate
+}
+
+func New(loggerFactory baseresource.LoggerFactory, usecase Usecase, sessionResolver SessionResolver) (*Resource, error) {
+	resourceDescription, resourceURITemplate := description, uriTemplate
+	if sessionResolver.UseSingleMATLABSession() {
+		resourceDescription, resourceURITemplate = singleSessionDescription, singleSessionURITemplate
+	}
+
+	baseRes, err := baseresource.NewTemplate(
+		name,
+		title,
+		resourceDescription,
+		mimeType,
+		resourceURITemplate,
+		loggerFactory,
+		Handler(usecase, sessionResolver),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Resource{
+		ResourceTemplate: baseRes,
+	}, nil
+}
+
+func Handler(usecase Usecase, sessionResolver SessionResolver) baseresource.TemplateHandler {
+	return func(ctx context.Context, logger entities.Logger, arguments map[string]string) (*baseresource.ReadResourceResult, error) {
+		figureNumber, err := strconv.Atoi(arguments[figureNumberArgument])
+		if err != nil {
+			return nil, fmt.Errorf("invalid figure number: %q", arguments[figureNumberArgument])
+		}
+
+		logger = logger.With("figure_number", figureNumber)
+		logger.Info("Returning MATLAB session figure resource")
+
+		client, err := sessionResolver.Client(ctx, logger, arguments)
+		if err != nil {
+			return nil, err
+		}
+
+		response, err := usecase.Execute(ctx, logger, client, getmatlabfigure.Args{
+			FigureNumber: figureNumber,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return &baseresource.ReadResourceResult{
+			Contents: []baseresource.ResourceContents{
+				{
+					MIMEType: mimeType,
+					Blob:     response.PNG,
+				},
+			},
+		}, nil
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabsessionfigure_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabsessionfigure"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getmatlabfigure"
+	baseresourcemocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/resources/baseresource"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/resources/matlabsessionfigure"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	testCases := []struct {
+		name                   string
+		useSingleMATLABSession bool
+		expectedURITemplate    string
+	}{
+		{
+			name:                   "multi session",
+			useSingleMATLABSession: false,
+			expectedURITemplate:    "matlab://session/{id}/figures/{n}",
+		},
+		{
+			name:                   "single session",
+			useSingleMATLABSession: true,
+			expectedURITemplate:    "matlab://session/figures/{n}",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockLoggerFactory := baseresourcemocks.NewMockLoggerFactory(t)
+			mockUsecase := mocks.NewMockUsecase(t)
+			mockSessionResolver := mocks.NewMockSessionResolver(t)
+
+			mockSessionResolver.EXPECT().
+				UseSingleMATLABSession().
+				Return(tc.useSingleMATLABSession).
+				Once()
+
+			// Act
+			resource, err := matlabsessionfigure.New(mockLoggerFactory, mockUsecase, mockSessionResolver)
+
+			// Assert
+			require.NoError(t, err)
+			require.NotNil(t, resource)
+			assert.Equal(t, "matlab_session_figure", resource.Name())
+			assert.Equal(t, "MATLAB Session Figure", resource.Title())
+			assert.Equal(t, "image/png", resource.MimeType())
+			assert.Equal(t, tc.expectedURITemplate, resource.URITemplate())
+		})
+	}
+}
+
+func TestHandler_HappyPath(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockSessionResolver := &mocks.MockSessionResolver{}
+	defer mockSessionResolver.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedPNG := []byte{0x89, 'P', 'N', 'G'}
+
+	mockSessionResolver.EXPECT().
+		Client(ctx, mockLogger.AsMockArg(), map[string]string{"id": "2", "n": "5"}).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, getmatlabfigure.Args{FigureNumber: 5}).
+		Return(getmatlabfigure.ReturnArgs{PNG: expectedPNG}, nil).
+		Once()
+
+	handler := matlabsessionfigure.Handler(mockUsecase, mockSessionResolver)
+
+	// Act
+	result, err := handler(ctx, mockLogger, map[string]string{"id": "2", "n": "5"})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, result.Contents, 1)
+	assert.Equal(t, "image/png", result.Contents[0].MIMEType)
+	assert.Equal(t, expectedPNG, result.Contents[0].Blob)
+}
+
+func TestHandler_InvalidFigureNumber(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockSessionResolver := &mocks.MockSessionResolver{}
+	defer mockSessionResolver.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	handler := matlabsessionfigure.Handler(mockUsecase, mockSessionResolver)
+
+	// Act
+	result, err := handler(t.Context(), mockLogger, map[string]string{"id": "1", "n": "gcf"})
+
+	// Assert
+	require.EqualError(t, err, `invalid figure number: "gcf"`)
+	assert.Nil(t, result)
+}
+
+func TestHandler_SessionResolverError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockSessionResolver := &mocks.MockSessionResolver{}
+	defer mockSessionResolver.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockSessionResolver.EXPECT().
+		Client(ctx, mockLogger.AsMockArg(), map[string]string{"id": "x", "n": "1"}).
+		Return(nil, assert.AnError).
+		Once()
+
+	handler := matlabsessionfigure.Handler(mockUsecase, mockSessionResolver)
+
+	// Act
+	result, err := handler(ctx, mockLogger, map[string]string{"id": "x", "n": "1"})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, result)
+}
+
+func TestHandler_UsecaseError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockSessionResolver := &mocks.MockSessionResolver{}
+	defer mockSessionResolver.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockSessionResolver.EXPECT().
+		Client(ctx, mockLogger.AsMockArg(), map[string]string{"id": "1", "n": "1"}).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, getmatlabfigure.Args{FigureNumber: 1}).
+		Return(getmatlabfigure.ReturnArgs{}, assert.AnError).
+		Once()
+
+	handler := matlabsessionfigure.Handler(mockUsecase, mockSessionResolver)
+
+	// Act
+	result, err := handler(ctx, mockLogger, map[string]string{"id": "1", "n": "1"})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, result)
+}
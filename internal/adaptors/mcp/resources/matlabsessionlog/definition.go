@@ -0,0 +1,23 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabsessionlog
+
+const (
+	stdoutName        = "matlab_session_stdout"
+	stdoutTitle       = "MATLAB Session Standard Output"
+	stdoutDescription = "Provides the text a MATLAB session started by the server wrote to its standard output, such as the output of fprintf and disp calls made outside of tool calls. Replace {id} with the session ID returned by the start_matlab_session tool."
+	stdoutURITemplate = "matlab://session/{id}/stdout"
+
+	stderrName        = "matlab_session_stderr"
+	stderrTitle       = "MATLAB Session Standard Error"
+	stderrDescription = "Provides the text a MATLAB session started by the server wrote to its standard error, such as startup errors and warnings. Replace {id} with the session ID returned by the start_matlab_session tool."
+	stderrURITemplate = "matlab://session/{id}/stderr"
+
+	singleSessionStdoutDescription = "Provides the text the MATLAB session started by the server wrote to its standard output, such as the output of fprintf and disp calls made outside of tool calls."
+	singleSessionStdoutURITemplate = "matlab://session/stdout"
+
+	singleSessionStderrDescription = "Provides the text the MATLAB session started by the server wrote to its standard error, such as startup errors and warnings."
+	singleSessionStderrURITemplate = "matlab://session/stderr"
+
+	mimeType = "text/plain"
+)
@@ -0,0 +1,107 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabsessionlog
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/baseresource"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/readmatlabsessionlog"
+)
+
+type Usecase interface {
+	Execute(sessionLogger entities.Logger, request readmatlabsessionlog.Args) (readmatlabsessionlog.ReturnArgs, error)
+}
+
+type SessionResolver interface {
+	UseSingleMATLABSession() bool
+	SessionDirectory(ctx context.Context, logger entities.Logger, arguments map[string]string) (string, error)
+}
+
+// StdoutResource is the standard output log of a MATLAB session.
+type StdoutResource struct {
+	*baseresource.ResourceTemplate
+}
+
+// StderrResource is the standard error log of a MATLAB session.
+type StderrResource struct {
+	*baseresource.ResourceTemplate
+}
+
+func NewStdout(loggerFactory baseresource.LoggerFactory, usecase Usecase, sessionResolver SessionResolver) (*StdoutResource, error) {
+	description, uriTemplate := stdoutDescription, stdoutURITemplate
+	if sessionResolver.UseSingleMATLABSession() {
+		description, uriTemplate = singleSessionStdoutDescription, singleSessionStdoutURITemplate
+	}
+
+	baseRes, err := baseresource.NewTemplate(
+		stdoutName,
+		stdoutTitle,
+		description,
+		mimeType,
+		uriTemplate,
+		loggerFactory,
+		Handler(readmatlabsessionlog.StandardOutput, usecase, sessionResolver),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StdoutResource{
+		ResourceTemplate: baseRes,
+	}, nil
+}
+
+func NewStderr(loggerFactory baseresource.LoggerFactory, usecase Usecase, sessionResolver SessionResolver) (*StderrResource, error) {
+	description, uriTemplate := stderrDescription, stderrURITemplate
+	if sessionResolver.UseSingleMATLABSession() {
+		description, uriTemplate = singleSessionStderrDescription, singleSessionStderrURITemplate
+	}
+
+	baseRes, err := baseresource.NewTemplate(
+		stderrName,
+		stderrTitle,
+		description,
+		mimeType,
+		uriTemplate,
+		loggerFactory,
+		Handler(readmatlabsessionlog.StandardError, usecase, sessionResolver),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StderrResource{
+		ResourceTemplate: baseRes,
+	}, nil
+}
+
+func Handler(stream readmatlabsessionlog.Stream, usecase Usecase, sessionResolver SessionResolver) baseresource.TemplateHandler {
+	return func(ctx context.Context, logger entities.Logger, arguments map[string]string) (*baseresource.ReadResourceResult, error) {
+		logger = logger.With("stream", stream)
+		logger.Info("Returning MATLAB session log resource")
+
+		sessionDir, err := sessionResolver.SessionDirectory(ctx, logger, arguments)
+		if err != nil {
+			return nil, err
+		}
+
+		response, err := usecase.Execute(logger, readmatlabsessionlog.Args{
+			SessionDir: sessionDir,
+			Stream:     stream,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return &baseresource.ReadResourceResult{
+			Contents: []baseresource.ResourceContents{
+				{
+					MIMEType: mimeType,
+					Text:     response.Text,
+				},
+			},
+		}, nil
+	}
+}
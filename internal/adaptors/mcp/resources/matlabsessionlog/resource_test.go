@@ -0,0 +1,197 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabsessionlog_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabsessionlog"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/readmatlabsessionlog"
+	baseresourcemocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/resources/baseresource"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/resources/matlabsessionlog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStdout_HappyPath(t *testing.T) {
+	testCases := []struct {
+		name                   string
+		useSingleMATLABSession bool
+		expectedURITemplate    string
+	}{
+		{
+			name:                   "multi session",
+			useSingleMATLABSession: false,
+			expectedURITemplate:    "matlab://session/{id}/stdout",
+		},
+		{
+			name:                   "single session",
+			useSingleMATLABSession: true,
+			expectedURITemplate:    "matlab://session/stdout",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockLoggerFactory := baseresourcemocks.NewMockLoggerFactory(t)
+			mockUsecase := mocks.NewMockUsecase(t)
+			mockSessionResolver := mocks.NewMockSessionResolver(t)
+
+			mockSessionResolver.EXPECT().
+				UseSingleMATLABSession().
+				Return(tc.useSingleMATLABSession).
+				Once()
+
+			// Act
+			resource, err := matlabsessionlog.NewStdout(mockLoggerFactory, mockUsecase, mockSessionResolver)
+
+			// Assert
+			require.NoError(t, err)
+			require.NotNil(t, resource)
+			assert.Equal(t, "matlab_session_stdout", resource.Name())
+			assert.Equal(t, "text/plain", resource.MimeType())
+			assert.Equal(t, tc.expectedURITemplate, resource.URITemplate())
+		})
+	}
+}
+
+func TestNewStderr_HappyPath(t *testing.T) {
+	testCases := []struct {
+		name                   string
+		useSingleMATLABSession bool
+		expectedURITemplate    string
+	}{
+		{
+			name:                   "multi session",
+			useSingleMATLABSession: false,
+			expectedURITemplate:    "matlab://session/{id}/stderr",
+		},
+		{
+			name:                   "single session",
+			useSingleMATLABSession: true,
+			expectedURITemplate:    "matlab://session/stderr",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockLoggerFactory := baseresourcemocks.NewMockLoggerFactory(t)
+			mockUsecase := mocks.NewMockUsecase(t)
+			mockSessionResolver := mocks.NewMockSessionResolver(t)
+
+			mockSessionResolver.EXPECT().
+				UseSingleMATLABSession().
+				Return(tc.useSingleMATLABSession).
+				Once()
+
+			// Act
+			resource, err := matlabsessionlog.NewStderr(mockLoggerFactory, mockUsecase, mockSessionResolver)
+
+			// Assert
+			require.NoError(t, err)
+			require.NotNil(t, resource)
+			assert.Equal(t, "matlab_session_stderr", resource.Name())
+			assert.Equal(t, "text/plain", resource.MimeType())
+			assert.Equal(t, tc.expectedURITemplate, resource.URITemplate())
+		})
+	}
+}
+
+func TestHandler_HappyPath(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockSessionResolver := &mocks.MockSessionResolver{}
+	defer mockSessionResolver.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const expectedText = "Warning: something happened\n"
+
+	sessionDir := filepath.Join("tmp", "session")
+
+	mockSessionResolver.EXPECT().
+		SessionDirectory(ctx, mockLogger.AsMockArg(), map[string]string{"id": "3"}).
+		Return(sessionDir, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(mockLogger.AsMockArg(), readmatlabsessionlog.Args{SessionDir: sessionDir, Stream: readmatlabsessionlog.StandardError}).
+		Return(readmatlabsessionlog.ReturnArgs{Text: expectedText}, nil).
+		Once()
+
+	handler := matlabsessionlog.Handler(readmatlabsessionlog.StandardError, mockUsecase, mockSessionResolver)
+
+	// Act
+	result, err := handler(ctx, mockLogger, map[string]string{"id": "3"})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, result.Contents, 1)
+	assert.Equal(t, "text/plain", result.Contents[0].MIMEType)
+	assert.Equal(t, expectedText, result.Contents[0].Text)
+}
+
+func TestHandler_GetSessionDirectoryError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockSessionResolver := &mocks.MockSessionResolver{}
+	defer mockSessionResolver.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockSessionResolver.EXPECT().
+		SessionDirectory(ctx, mockLogger.AsMockArg(), map[string]string{"id": "1"}).
+		Return("", assert.AnError).
+		Once()
+
+	handler := matlabsessionlog.Handler(readmatlabsessionlog.StandardOutput, mockUsecase, mockSessionResolver)
+
+	// Act
+	result, err := handler(ctx, mockLogger, map[string]string{"id": "1"})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, result)
+}
+
+func TestHandler_UsecaseError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockSessionResolver := &mocks.MockSessionResolver{}
+	defer mockSessionResolver.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	sessionDir := filepath.Join("tmp", "session")
+
+	mockSessionResolver.EXPECT().
+		SessionDirectory(ctx, mockLogger.AsMockArg(), map[string]string{"id": "1"}).
+		Return(sessionDir, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(mockLogger.AsMockArg(), readmatlabsessionlog.Args{SessionDir: sessionDir, Stream: readmatlabsessionlog.StandardOutput}).
+		Return(readmatlabsessionlog.ReturnArgs{}, assert.AnError).
+		Once()
+
+	handler := matlabsessionlog.Handler(readmatlabsessionlog.StandardOutput, mockUsecase, mockSessionResolver)
+
+	// Act
+	result, err := handler(ctx, mockLogger, map[string]string{"id": "1"})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, result)
+}
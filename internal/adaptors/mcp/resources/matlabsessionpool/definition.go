@@ -0,0 +1,20 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabsessionpool
+
+const (
+	name        = "matlab_session_pool_status"
+	title       = "MATLAB Session Pool Status"
+	description = "Reports, for each MATLAB installation, how many idle MATLAB sessions are ready to be handed out by `start_matlab_session`, and how many are still starting."
+	mimeType    = "application/json"
+	uri         = "matlab://session-pool/status"
+
+	estimatedSize = 1024 // 1 kB
+)
+
+type poolStatus struct {
+	MATLABRoot       string `json:"matlab_root"`
+	TargetSize       int    `json:"target_size"`
+	IdleSessions     int    `json:"idle_sessions"`
+	StartingSessions int    `json:"starting_sessions"`
+}
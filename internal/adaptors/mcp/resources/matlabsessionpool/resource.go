@@ -0,0 +1,71 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabsessionpool
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/baseresource"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+type SessionPool interface {
+	Status() []entities.MATLABSessionPoolStatus
+}
+
+type Resource struct {
+	*baseresource.Resource
+}
+
+func New(loggerFactory baseresource.LoggerFactory, sessionPool SessionPool) (*Resource, error) {
+	baseRes, err := baseresource.New(
+		name,
+		title,
+		description,
+		mimeType,
+		estimatedSize,
+		uri,
+		loggerFactory,
+		Handler(sessionPool),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Resource{
+		Resource: baseRes,
+	}, nil
+}
+
+func Handler(sessionPool SessionPool) baseresource.ResourceHandler {
+	return func(_ context.Context, logger entities.Logger) (*baseresource.ReadResourceResult, error) {
+		logger.Info("Returning MATLAB session pool status resource")
+
+		status := sessionPool.Status()
+
+		pools := make([]poolStatus, 0, len(status))
+		for _, s := range status {
+			pools = append(pools, poolStatus{
+				MATLABRoot:       s.MATLABRoot,
+				TargetSize:       s.TargetSize,
+				IdleSessions:     s.IdleSessions,
+				StartingSessions: s.StartingSessions,
+			})
+		}
+
+		text, err := json.Marshal(pools)
+		if err != nil {
+			return nil, err
+		}
+
+		return &baseresource.ReadResourceResult{
+			Contents: []baseresource.ResourceContents{
+				{
+					MIMEType: mimeType,
+					Text:     string(text),
+				},
+			},
+		}, nil
+	}
+}
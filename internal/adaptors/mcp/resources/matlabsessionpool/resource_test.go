@@ -0,0 +1,91 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabsessionpool_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabsessionpool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	baseresourcemocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/resources/baseresource"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/resources/matlabsessionpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := baseresourcemocks.NewMockLoggerFactory(t)
+
+	mockSessionPool := &mocks.MockSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	// Act
+	resource, err := matlabsessionpool.New(mockLoggerFactory, mockSessionPool)
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, resource)
+	assert.Equal(t, "matlab_session_pool_status", resource.Name())
+	assert.Equal(t, "MATLAB Session Pool Status", resource.Title())
+	assert.Equal(t, "application/json", resource.MimeType())
+	assert.Equal(t, "matlab://session-pool/status", resource.URI())
+}
+
+func TestHandler_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockSessionPool := &mocks.MockSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	matlabRoot := "/usr/local/MATLAB/R2025a"
+
+	mockSessionPool.EXPECT().
+		Status().
+		Return([]entities.MATLABSessionPoolStatus{
+			{
+				MATLABRoot:       matlabRoot,
+				TargetSize:       2,
+				IdleSessions:     1,
+				StartingSessions: 1,
+			},
+		}).
+		Once()
+
+	handler := matlabsessionpool.Handler(mockSessionPool)
+
+	// Act
+	result, err := handler(t.Context(), mockLogger)
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.Len(t, result.Contents, 1)
+	assert.Equal(t, "application/json", result.Contents[0].MIMEType)
+	assert.JSONEq(t, `[{"matlab_root":"`+matlabRoot+`","target_size":2,"idle_sessions":1,"starting_sessions":1}]`, result.Contents[0].Text)
+}
+
+func TestHandler_EmptyPool(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockSessionPool := &mocks.MockSessionPool{}
+	defer mockSessionPool.AssertExpectations(t)
+
+	mockSessionPool.EXPECT().
+		Status().
+		Return(nil).
+		Once()
+
+	handler := matlabsessionpool.Handler(mockSessionPool)
+
+	// Act
+	result, err := handler(t.Context(), mockLogger)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, result.Contents, 1)
+	assert.JSONEq(t, `[]`, result.Contents[0].Text)
+}
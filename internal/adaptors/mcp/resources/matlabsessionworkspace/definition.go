@@ -0,0 +1,26 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabsessionworkspace
+
+const (
+	name        = "matlab_session_workspace"
+	title       = "MATLAB Session Workspace"
+	description = "Lists the variables of the base workspace of a MATLAB session, with their size, class and number of bytes, and the numbers of the open figures. Replace {id} with the session ID returned by the start_matlab_session tool."
+	mimeType    = "application/json"
+	uriTemplate = "matlab://session/{id}/workspace"
+
+	singleSessionDescription = "Lists the variables of the base workspace of the MATLAB session, with their size, class and number of bytes, and the numbers of the open figures. Requires a running MATLAB session which is not debugging code."
+	singleSessionURITemplate = "matlab://session/workspace"
+)
+
+type variable struct {
+	Name  string `json:"name"`
+	Size  []int  `json:"size"`
+	Class string `json:"class"`
+	Bytes int64  `json:"bytes"`
+}
+
+type workspace struct {
+	Variables []variable `json:"variables"`
+	Figures   []int      `json:"figures"`
+}
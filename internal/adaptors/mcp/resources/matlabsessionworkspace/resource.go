@@ -0,0 +1,92 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabsessionworkspace
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/baseresource"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listmatlabworkspace"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request listmatlabworkspace.Args) (listmatlabworkspace.ReturnArgs, error)
+}
+
+type SessionResolver interface {
+	UseSingleMATLABSession() bool
+	Client(ctx context.Context, logger entities.Logger, arguments map[string]string) (entities.MATLABSessionClient, error)
+}
+
+type Resource struct {
+	*baseresource.ResourceTemplate
+}
+
+func New(loggerFactory baseresource.LoggerFactory, usecase Usecase, sessionResolver SessionResolver) (*Resource, error) {
+	resourceDescription, resourceURITemplate := description, uriTemplate
+	if sessionResolver.UseSingleMATLABSession() {
+		resourceDescription, resourceURITemplate = singleSessionDescription, singleSessionURITemplate
+	}
+
+	baseRes, err := baseresource.NewTemplate(
+		name,
+		title,
+		resourceDescription,
+		mimeType,
+		resourceURITemplate,
+		loggerFactory,
+		Handler(usecase, sessionResolver),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Resource{
+		ResourceTemplate: baseRes,
+	}, nil
+}
+
+func Handler(usecase Usecase, sessionResolver SessionResolver) baseresource.TemplateHandler {
+	return func(ctx context.Context, logger entities.Logger, arguments map[string]string) (*baseresource.ReadResourceResult, error) {
+		logger.Info("Returning MATLAB session workspace resource")
+
+		client, err := sessionResolver.Client(ctx, logger, arguments)
+		if err != nil {
+			return nil, err
+		}
+
+		response, err := usecase.Execute(ctx, logger, client, listmatlabworkspace.Args{})
+		if err != nil {
+			return nil, err
+		}
+
+		result := workspace{
+			Variables: make([]variable, 0, len(response.Variables)),
+			Figures:   response.FigureNumbers,
+		}
+		for _, v := range response.Variables {
+			result.Variables = append(result.Variables, variable{
+				Name:  v.Name,
+				Size:  v.Size,
+				Class: v.Class,
+				Bytes: v.Bytes,
+			})
+		}
+
+		text, err := json.Marshal(result)
+		if err != nil {
+			return nil, err
+		}
+
+		return &baseresource.ReadResourceResult{
+			Contents: []baseresource.ResourceContents{
+				{
+					MIMEType: mimeType,
+					Text:     string(text),
+				},
+			},
+		}, nil
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabsessionworkspace_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabsessionworkspace"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listmatlabworkspace"
+	baseresourcemocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/resources/baseresource"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/resources/matlabsessionworkspace"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	testCases := []struct {
+		name                   string
+		useSingleMATLABSession bool
+		expectedURITemplate    string
+	}{
+		{
+			name:                   "multi session",
+			useSingleMATLABSession: false,
+			expectedURITemplate:    "matlab://session/{id}/workspace",
+		},
+		{
+			name:                   "single session",
+			useSingleMATLABSession: true,
+			expectedURITemplate:    "matlab://session/workspace",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockLoggerFactory := baseresourcemocks.NewMockLoggerFactory(t)
+			mockUsecase := mocks.NewMockUsecase(t)
+			mockSessionResolver := mocks.NewMockSessionResolver(t)
+
+			mockSessionResolver.EXPECT().
+				UseSingleMATLABSession().
+				Return(tc.useSingleMATLABSession).
+				Once()
+
+			// Act
+			resource, err := matlabsessionworkspace.New(mockLoggerFactory, mockUsecase, mockSessionResolver)
+
+			// Assert
+			require.NoError(t, err)
+			require.NotNil(t, resource)
+			assert.Equal(t, "matlab_session_workspace", resource.Name())
+			assert.Equal(t, "MATLAB Session Workspace", resource.Title())
+			assert.Equal(t, "application/json", resource.MimeType())
+			assert.Equal(t, tc.expectedURITemplate, resource.URITemplate())
+		})
+	}
+}
+
+func TestHandler_HappyPath(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockSessionResolver := &mocks.MockSessionResolver{}
+	defer mockSessionResolver.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockSessionResolver.EXPECT().
+		Client(ctx, mockLogger.AsMockArg(), map[string]string{"id": "1"}).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, listmatlabworkspace.Args{}).
+		Return(listmatlabworkspace.ReturnArgs{
+			Variables: []listmatlabworkspace.Variable{
+				{Name: "x", Size: []int{1, 10}, Class: "double", Bytes: 80},
+			},
+			FigureNumbers: []int{1},
+		}, nil).
+		Once()
+
+	handler := matlabsessionworkspace.Handler(mockUsecase, mockSessionResolver)
+
+	// Act
+	result, err := handler(ctx, mockLogger, map[string]string{"id": "1"})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, result.Contents, 1)
+	assert.Equal(t, "application/json", result.Contents[0].MIMEType)
+	assert.JSONEq(t, `{"variables":[{"name":"x","size":[1,10],"class":"double","bytes":80}],"figures":[1]}`, result.Contents[0].Text)
+}
+
+func TestHandler_SessionResolverError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockSessionResolver := &mocks.MockSessionResolver{}
+	defer mockSessionResolver.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockSessionResolver.EXPECT().
+		Client(ctx, mockLogger.AsMockArg(), map[string]string{"id": ""}).
+		Return(nil, assert.AnError).
+		Once()
+
+	handler := matlabsessionworkspace.Handler(mockUsecase, mockSessionResolver)
+
+	// Act
+	result, err := handler(ctx, mockLogger, map[string]string{"id": ""})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, result)
+}
+
+func TestHandler_UsecaseError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockSessionResolver := &mocks.MockSessionResolver{}
+	defer mockSessionResolver.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockSessionResolver.EXPECT().
+		Client(ctx, mockLogger.AsMockArg(), map[string]string{"id": "1"}).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, listmatlabworkspace.Args{}).
+		Return(listmatlabworkspace.ReturnArgs{}, assert.AnError).
+		Once()
+
+	handler := matlabsessionworkspace.Handler(mockUsecase, mockSessionResolver)
+
+	// Act
+	result, err := handler(ctx, mockLogger, map[string]string{"id": "1"})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, result)
+}
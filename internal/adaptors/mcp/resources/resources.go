@@ -8,6 +8,8 @@ import (
 
 type Server interface {
 	AddResource(resource *mcp.Resource, handler mcp.ResourceHandler)
+	AddResourceTemplate(template *mcp.ResourceTemplate, handler mcp.ResourceHandler)
+	RemoveResources(uris ...string)
 }
 
 type Resource interface {
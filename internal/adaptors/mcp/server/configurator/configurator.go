@@ -3,22 +3,61 @@
 package configurator
 
 import (
+	"fmt"
+	"slices"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/customprompts"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/debugfailingtests"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/reviewcode"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/vectorizeloop"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/writeandtestfunction"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/codingguidelines"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/customcodingguidelines"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabdocumentation"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabsessionfigure"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabsessionlog"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabsessionpool"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabsessionworkspace"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool/approval"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool/audit"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool/debugguard"
 	evalmatlabcodemultisession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/evalmatlabcode"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/listavailablematlabs"
+	resetmatlabstatemultisession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/resetmatlabstate"
+	restartmatlabsessionmultisession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/restartmatlabsession"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/startmatlabsession"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/stopmatlabsession"
+	analyzematlabdependenciessinglesession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/analyzematlabdependencies"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/benchmarkmatlabcode"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/checkmatlabcode"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/checksimulinkmodel"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/clearmatlabbreakpoints"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/controlmatlabdebugger"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/debugmatlabcode"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/detectmatlabtoolboxes"
 	evalmatlabcodesinglesession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/evalmatlabcode"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/listsimulinkblocks"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/loadsimulinkmodel"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/profilematlabcode"
+	resetmatlabstatesinglesession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/resetmatlabstate"
+	restartmatlabsessionsinglesession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/restartmatlabsession"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runmatlabfile"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runmatlabtestfile"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/setmatlabbreakpoint"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/setsimulinkblockparameters"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/simulatesimulinkmodel"
 )
 
 type Config interface {
 	UseSingleMATLABSession() bool
+	EnabledTools() []string
+	DisabledTools() []string
+	ReadOnly() bool
+	ToolsRequiringApproval() []string
+	ReplaceCodingGuidelines() bool
 }
 
 type Configurator struct {
@@ -29,16 +68,53 @@ type Configurator struct {
 	startMATLABSessionTool   tools.Tool
 	stopMATLABSessionTool    tools.Tool
 	evalInMATLABSessionTool  tools.Tool
+	restartMATLABSessionTool tools.Tool
+	resetMATLABStateTool     tools.Tool
 
 	// Single Session tools
-	evalInGlobalMATLABSessionTool                  tools.Tool
-	checkMATLABCodeInGlobalMATLABSessionTool       tools.Tool
-	detectMATLABToolboxesInGlobalMATLABSessionTool tools.Tool
-	runMATLABFileInGlobalMATLABSessionTool         tools.Tool
-	runMATLABTestFileInGlobalMATLABSessionTool     tools.Tool
+	evalInGlobalMATLABSessionTool                       tools.Tool
+	checkMATLABCodeInGlobalMATLABSessionTool            tools.Tool
+	detectMATLABToolboxesInGlobalMATLABSessionTool      tools.Tool
+	runMATLABFileInGlobalMATLABSessionTool              tools.Tool
+	runMATLABTestFileInGlobalMATLABSessionTool          tools.Tool
+	restartGlobalMATLABSessionTool                      tools.Tool
+	resetGlobalMATLABStateTool                          tools.Tool
+	analyzeMATLABDependenciesInGlobalMATLABSessionTool  tools.Tool
+	setBreakpointInGlobalMATLABSessionTool              tools.Tool
+	clearBreakpointsInGlobalMATLABSessionTool           tools.Tool
+	debugCodeInGlobalMATLABSessionTool                  tools.Tool
+	controlDebuggerInGlobalMATLABSessionTool            tools.Tool
+	profileCodeInGlobalMATLABSessionTool                tools.Tool
+	benchmarkCodeInGlobalMATLABSessionTool              tools.Tool
+	loadSimulinkModelInGlobalMATLABSessionTool          tools.Tool
+	listSimulinkBlocksInGlobalMATLABSessionTool         tools.Tool
+	setSimulinkBlockParametersInGlobalMATLABSessionTool tools.Tool
+	simulateSimulinkModelInGlobalMATLABSessionTool      tools.Tool
+	checkSimulinkModelInGlobalMATLABSessionTool         tools.Tool
+
+	// Tool middlewares
+	auditMiddleware      tools.Middleware
+	debugGuardMiddleware tools.Middleware
+	approvalMiddleware   tools.Middleware
 
 	// Resources
-	codingGuidelinesResource resources.Resource
+	codingGuidelinesResource       resources.Resource
+	customCodingGuidelinesResource resources.Resource
+	matlabDocumentationResource    resources.Resource
+	matlabSessionStdoutResource    resources.Resource
+	matlabSessionStderrResource    resources.Resource
+	matlabSessionFigureResource    resources.Resource
+	matlabSessionWorkspaceResource resources.Resource
+
+	// Multi Session resources
+	matlabSessionPoolResource resources.Resource
+
+	// Prompts
+	writeAndTestFunctionPrompt prompts.Prompt
+	debugFailingTestsPrompt    prompts.Prompt
+	vectorizeLoopPrompt        prompts.Prompt
+	reviewCodePrompt           prompts.Prompt
+	customPrompts              prompts.Prompt
 }
 
 func New(
@@ -48,14 +124,47 @@ func New(
 	startMATLABSessionTool *startmatlabsession.Tool,
 	stopMATLABSessionTool *stopmatlabsession.Tool,
 	evalInMATLABSessionTool *evalmatlabcodemultisession.Tool,
+	restartMATLABSessionTool *restartmatlabsessionmultisession.Tool,
+	resetMATLABStateTool *resetmatlabstatemultisession.Tool,
 
 	evalInGlobalMATLABSessionTool *evalmatlabcodesinglesession.Tool,
 	checkMATLABCodeInGlobalMATLABSession *checkmatlabcode.Tool,
 	detectMATLABToolboxesInGlobalMATLABSessionTool *detectmatlabtoolboxes.Tool,
 	runMATLABFileInGlobalMATLABSessionTool *runmatlabfile.Tool,
 	runMATLABTestFileInGlobalMATLABSessionTool *runmatlabtestfile.Tool,
+	restartGlobalMATLABSessionTool *restartmatlabsessionsinglesession.Tool,
+	resetGlobalMATLABStateTool *resetmatlabstatesinglesession.Tool,
+	analyzeMATLABDependenciesInGlobalMATLABSessionTool *analyzematlabdependenciessinglesession.Tool,
+	setBreakpointInGlobalMATLABSessionTool *setmatlabbreakpoint.Tool,
+	clearBreakpointsInGlobalMATLABSessionTool *clearmatlabbreakpoints.Tool,
+	debugCodeInGlobalMATLABSessionTool *debugmatlabcode.Tool,
+	controlDebuggerInGlobalMATLABSessionTool *controlmatlabdebugger.Tool,
+	profileCodeInGlobalMATLABSessionTool *profilematlabcode.Tool,
+	benchmarkCodeInGlobalMATLABSessionTool *benchmarkmatlabcode.Tool,
+	loadSimulinkModelInGlobalMATLABSessionTool *loadsimulinkmodel.Tool,
+	listSimulinkBlocksInGlobalMATLABSessionTool *listsimulinkblocks.Tool,
+	setSimulinkBlockParametersInGlobalMATLABSessionTool *setsimulinkblockparameters.Tool,
+	simulateSimulinkModelInGlobalMATLABSessionTool *simulatesimulinkmodel.Tool,
+	checkSimulinkModelInGlobalMATLABSessionTool *checksimulinkmodel.Tool,
+
+	auditMiddleware *audit.Middleware,
+	debugGuardMiddleware *debugguard.Middleware,
+	approvalMiddleware *approval.Middleware,
 
 	codingGuidelinesResource *codingguidelines.Resource,
+	customCodingGuidelinesResource *customcodingguidelines.Resource,
+	matlabDocumentationResource *matlabdocumentation.Resource,
+	matlabSessionPoolResource *matlabsessionpool.Resource,
+	matlabSessionStdoutResource *matlabsessionlog.StdoutResource,
+	matlabSessionStderrResource *matlabsessionlog.StderrResource,
+	matlabSessionFigureResource *matlabsessionfigure.Resource,
+	matlabSessionWorkspaceResource *matlabsessionworkspace.Resource,
+
+	writeAndTestFunctionPrompt *writeandtestfunction.Prompt,
+	debugFailingTestsPrompt *debugfailingtests.Prompt,
+	vectorizeLoopPrompt *vectorizeloop.Prompt,
+	reviewCodePrompt *reviewcode.Prompt,
+	customPrompts *customprompts.Prompt,
 ) *Configurator {
 	return &Configurator{
 		config: config,
@@ -64,40 +173,206 @@ func New(
 		startMATLABSessionTool:   startMATLABSessionTool,
 		stopMATLABSessionTool:    stopMATLABSessionTool,
 		evalInMATLABSessionTool:  evalInMATLABSessionTool,
+		restartMATLABSessionTool: restartMATLABSessionTool,
+		resetMATLABStateTool:     resetMATLABStateTool,
+
+		evalInGlobalMATLABSessionTool:                       evalInGlobalMATLABSessionTool,
+		checkMATLABCodeInGlobalMATLABSessionTool:            checkMATLABCodeInGlobalMATLABSession,
+		detectMATLABToolboxesInGlobalMATLABSessionTool:      detectMATLABToolboxesInGlobalMATLABSessionTool,
+		runMATLABFileInGlobalMATLABSessionTool:              runMATLABFileInGlobalMATLABSessionTool,
+		runMATLABTestFileInGlobalMATLABSessionTool:          runMATLABTestFileInGlobalMATLABSessionTool,
+		restartGlobalMATLABSessionTool:                      restartGlobalMATLABSessionTool,
+		resetGlobalMATLABStateTool:                          resetGlobalMATLABStateTool,
+		analyzeMATLABDependenciesInGlobalMATLABSessionTool:  analyzeMATLABDependenciesInGlobalMATLABSessionTool,
+		setBreakpointInGlobalMATLABSessionTool:              setBreakpointInGlobalMATLABSessionTool,
+		clearBreakpointsInGlobalMATLABSessionTool:           clearBreakpointsInGlobalMATLABSessionTool,
+		debugCodeInGlobalMATLABSessionTool:                  debugCodeInGlobalMATLABSessionTool,
+		controlDebuggerInGlobalMATLABSessionTool:            controlDebuggerInGlobalMATLABSessionTool,
+		profileCodeInGlobalMATLABSessionTool:                profileCodeInGlobalMATLABSessionTool,
+		benchmarkCodeInGlobalMATLABSessionTool:              benchmarkCodeInGlobalMATLABSessionTool,
+		loadSimulinkModelInGlobalMATLABSessionTool:          loadSimulinkModelInGlobalMATLABSessionTool,
+		listSimulinkBlocksInGlobalMATLABSessionTool:         listSimulinkBlocksInGlobalMATLABSessionTool,
+		setSimulinkBlockParametersInGlobalMATLABSessionTool: setSimulinkBlockParametersInGlobalMATLABSessionTool,
+		simulateSimulinkModelInGlobalMATLABSessionTool:      simulateSimulinkModelInGlobalMATLABSessionTool,
+		checkSimulinkModelInGlobalMATLABSessionTool:         checkSimulinkModelInGlobalMATLABSessionTool,
 
-		evalInGlobalMATLABSessionTool:                  evalInGlobalMATLABSessionTool,
-		checkMATLABCodeInGlobalMATLABSessionTool:       checkMATLABCodeInGlobalMATLABSession,
-		detectMATLABToolboxesInGlobalMATLABSessionTool: detectMATLABToolboxesInGlobalMATLABSessionTool,
-		runMATLABFileInGlobalMATLABSessionTool:         runMATLABFileInGlobalMATLABSessionTool,
-		runMATLABTestFileInGlobalMATLABSessionTool:     runMATLABTestFileInGlobalMATLABSessionTool,
+		auditMiddleware:      auditMiddleware,
+		debugGuardMiddleware: debugGuardMiddleware,
+		approvalMiddleware:   approvalMiddleware,
 
-		codingGuidelinesResource: codingGuidelinesResource,
+		codingGuidelinesResource:       codingGuidelinesResource,
+		customCodingGuidelinesResource: customCodingGuidelinesResource,
+
+		matlabDocumentationResource: matlabDocumentationResource,
+
+		matlabSessionPoolResource:      matlabSessionPoolResource,
+		matlabSessionStdoutResource:    matlabSessionStdoutResource,
+		matlabSessionStderrResource:    matlabSessionStderrResource,
+		matlabSessionFigureResource:    matlabSessionFigureResource,
+		matlabSessionWorkspaceResource: matlabSessionWorkspaceResource,
+
+		writeAndTestFunctionPrompt: writeAndTestFunctionPrompt,
+		debugFailingTestsPrompt:    debugFailingTestsPrompt,
+		vectorizeLoopPrompt:        vectorizeLoopPrompt,
+		reviewCodePrompt:           reviewCodePrompt,
+		customPrompts:              customPrompts,
 	}
 }
 
-func (c *Configurator) GetToolsToAdd() []tools.Tool {
-	// Choose which tool to expose
+// GetToolsToAdd returns the tools to expose for the current mode.
+// In read-only mode, only the tools which do not execute MATLAB code are exposed.
+// If enabled tools are configured, only those are exposed. Disabled tools are never exposed.
+func (c *Configurator) GetToolsToAdd() ([]tools.Tool, error) {
+	enabledTools := c.config.EnabledTools()
+	disabledTools := c.config.DisabledTools()
+
+	if err := c.validateToolNames(enabledTools); err != nil {
+		return nil, fmt.Errorf("invalid enabled tools: %w", err)
+	}
 
+	if err := c.validateToolNames(disabledTools); err != nil {
+		return nil, fmt.Errorf("invalid disabled tools: %w", err)
+	}
+
+	if err := c.validateToolNames(c.config.ToolsRequiringApproval()); err != nil {
+		return nil, fmt.Errorf("invalid tools requiring approval: %w", err)
+	}
+
+	// Choose which tool to expose
+	readOnlyTools, otherTools := c.getMultiSessionTools()
 	if c.config.UseSingleMATLABSession() {
-		return []tools.Tool{
-			c.evalInGlobalMATLABSessionTool,
-			c.checkMATLABCodeInGlobalMATLABSessionTool,
-			c.detectMATLABToolboxesInGlobalMATLABSessionTool,
-			c.runMATLABFileInGlobalMATLABSessionTool,
-			c.runMATLABTestFileInGlobalMATLABSessionTool,
+		readOnlyTools, otherTools = c.getSingleSessionTools()
+	}
+
+	candidateTools := readOnlyTools
+	if !c.config.ReadOnly() {
+		candidateTools = append(candidateTools, otherTools...)
+	}
+
+	toolsToAdd := []tools.Tool{}
+	for _, tool := range candidateTools {
+		if len(enabledTools) > 0 && !slices.Contains(enabledTools, tool.Name()) {
+			continue
+		}
+
+		if slices.Contains(disabledTools, tool.Name()) {
+			continue
 		}
+
+		toolsToAdd = append(toolsToAdd, tool)
+	}
+
+	return toolsToAdd, nil
+}
+
+// getSingleSessionTools returns the single session tools which do not execute MATLAB code, then the other ones.
+func (c *Configurator) getSingleSessionTools() ([]tools.Tool, []tools.Tool) {
+	readOnlyTools := []tools.Tool{
+		c.checkMATLABCodeInGlobalMATLABSessionTool,
+		c.detectMATLABToolboxesInGlobalMATLABSessionTool,
+		c.analyzeMATLABDependenciesInGlobalMATLABSessionTool,
 	}
 
-	return []tools.Tool{
+	otherTools := []tools.Tool{
+		c.evalInGlobalMATLABSessionTool,
+		c.runMATLABFileInGlobalMATLABSessionTool,
+		c.runMATLABTestFileInGlobalMATLABSessionTool,
+		c.restartGlobalMATLABSessionTool,
+		c.resetGlobalMATLABStateTool,
+		c.setBreakpointInGlobalMATLABSessionTool,
+		c.clearBreakpointsInGlobalMATLABSessionTool,
+		c.debugCodeInGlobalMATLABSessionTool,
+		c.controlDebuggerInGlobalMATLABSessionTool,
+		c.profileCodeInGlobalMATLABSessionTool,
+		c.benchmarkCodeInGlobalMATLABSessionTool,
+		c.loadSimulinkModelInGlobalMATLABSessionTool,
+		c.listSimulinkBlocksInGlobalMATLABSessionTool,
+		c.setSimulinkBlockParametersInGlobalMATLABSessionTool,
+		c.simulateSimulinkModelInGlobalMATLABSessionTool,
+		c.checkSimulinkModelInGlobalMATLABSessionTool,
+	}
+
+	return readOnlyTools, otherTools
+}
+
+// getMultiSessionTools returns the multi session tools which do not execute MATLAB code, then the other ones.
+func (c *Configurator) getMultiSessionTools() ([]tools.Tool, []tools.Tool) {
+	readOnlyTools := []tools.Tool{
 		c.listAvailableMATLABsTool,
+	}
+
+	otherTools := []tools.Tool{
 		c.startMATLABSessionTool,
 		c.stopMATLABSessionTool,
 		c.evalInMATLABSessionTool,
+		c.restartMATLABSessionTool,
+		c.resetMATLABStateTool,
+	}
+
+	return readOnlyTools, otherTools
+}
+
+// validateToolNames checks the names against the tools of both modes,
+// so that the same list can be used regardless of the mode.
+func (c *Configurator) validateToolNames(toolNames []string) error {
+	singleSessionReadOnlyTools, singleSessionOtherTools := c.getSingleSessionTools()
+	multiSessionReadOnlyTools, multiSessionOtherTools := c.getMultiSessionTools()
+	allTools := slices.Concat(singleSessionReadOnlyTools, singleSessionOtherTools, multiSessionReadOnlyTools, multiSessionOtherTools)
+
+	for _, toolName := range toolNames {
+		if !slices.ContainsFunc(allTools, func(tool tools.Tool) bool { return tool.Name() == toolName }) {
+			return fmt.Errorf("unknown tool %q", toolName)
+		}
 	}
+
+	return nil
 }
 
+// GetToolMiddlewares returns the middlewares wrapping the calls to every tool, the outermost first.
+// The audit log is the outermost one, so that it also records the calls the user rejected.
+// The calls refused while debugging are refused before asking the user to approve them.
+func (c *Configurator) GetToolMiddlewares() []tools.Middleware {
+	return []tools.Middleware{
+		c.auditMiddleware,
+		c.debugGuardMiddleware,
+		c.approvalMiddleware,
+	}
+}
+
+// GetResourcesToAdd returns the resources to expose for the current mode.
+// The custom coding guidelines are exposed in addition to the MATLAB coding guidelines, unless they replace them.
+// The MATLAB session resources are exposed in both modes, and only have a session ID in their URI in multi-session mode.
 func (c *Configurator) GetResourcesToAdd() []resources.Resource {
-	return []resources.Resource{
-		c.codingGuidelinesResource,
+	resourcesToAdd := []resources.Resource{}
+	if !c.config.ReplaceCodingGuidelines() {
+		resourcesToAdd = append(resourcesToAdd, c.codingGuidelinesResource)
+	}
+	resourcesToAdd = append(resourcesToAdd,
+		c.customCodingGuidelinesResource,
+		c.matlabDocumentationResource,
+		c.matlabSessionStdoutResource,
+		c.matlabSessionStderrResource,
+		c.matlabSessionFigureResource,
+		c.matlabSessionWorkspaceResource,
+	)
+
+	if c.config.UseSingleMATLABSession() {
+		return resourcesToAdd
+	}
+
+	return append(resourcesToAdd,
+		c.matlabSessionPoolResource,
+	)
+}
+
+// GetPromptsToAdd returns the prompts to expose, which are the same in both modes.
+// The custom prompts come last, so that a prompt file replaces the built-in prompt with the same name.
+func (c *Configurator) GetPromptsToAdd() []prompts.Prompt {
+	return []prompts.Prompt{
+		c.writeAndTestFunctionPrompt,
+		c.debugFailingTestsPrompt,
+		c.vectorizeLoopPrompt,
+		c.reviewCodePrompt,
+		c.customPrompts,
 	}
 }
@@ -3,22 +3,56 @@
 package configurator_test
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/customprompts"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/debugfailingtests"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/reviewcode"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/vectorizeloop"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/writeandtestfunction"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/codingguidelines"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/customcodingguidelines"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabdocumentation"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabsessionfigure"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabsessionlog"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabsessionpool"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabsessionworkspace"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server/configurator"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool/approval"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool/audit"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool/debugguard"
 	evalmatlabmultisession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/evalmatlabcode"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/listavailablematlabs"
+	resetmatlabstatemultisession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/resetmatlabstate"
+	restartmatlabmultisession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/restartmatlabsession"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/startmatlabsession"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/stopmatlabsession"
+	analyzematlabdependenciessinglesession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/analyzematlabdependencies"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/benchmarkmatlabcode"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/checkmatlabcode"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/checksimulinkmodel"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/clearmatlabbreakpoints"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/controlmatlabdebugger"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/debugmatlabcode"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/detectmatlabtoolboxes"
 	evalmatlabsinglesession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/evalmatlabcode"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/listsimulinkblocks"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/loadsimulinkmodel"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/profilematlabcode"
+	resetmatlabstatesinglesession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/resetmatlabstate"
+	restartmatlabsinglesession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/restartmatlabsession"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runmatlabfile"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runmatlabtestfile"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/setmatlabbreakpoint"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/setsimulinkblockparameters"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/simulatesimulinkmodel"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
 	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/server/configurator"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -32,12 +66,43 @@ func TestNew_HappyPath(t *testing.T) {
 	startMATLABSessionTool := &startmatlabsession.Tool{}
 	stopMATLABSessionTool := &stopmatlabsession.Tool{}
 	evalInMATLABSessionTool := &evalmatlabmultisession.Tool{}
+	restartMATLABSessionTool := &restartmatlabmultisession.Tool{}
 	evalInGlobalMATLABSessionTool := &evalmatlabsinglesession.Tool{}
 	checkMATLABCodeInGlobalMATLABSession := &checkmatlabcode.Tool{}
 	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
 	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
 	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	restartGlobalMATLABSessionTool := &restartmatlabsinglesession.Tool{}
+	resetMATLABStateTool := &resetmatlabstatemultisession.Tool{}
+	resetGlobalMATLABStateTool := &resetmatlabstatesinglesession.Tool{}
+	analyzeMATLABDependenciesInGlobalMATLABSessionTool := &analyzematlabdependenciessinglesession.Tool{}
+	setBreakpointInGlobalMATLABSessionTool := &setmatlabbreakpoint.Tool{}
+	clearBreakpointsInGlobalMATLABSessionTool := &clearmatlabbreakpoints.Tool{}
+	debugCodeInGlobalMATLABSessionTool := &debugmatlabcode.Tool{}
+	controlDebuggerInGlobalMATLABSessionTool := &controlmatlabdebugger.Tool{}
+	profileCodeInGlobalMATLABSessionTool := &profilematlabcode.Tool{}
+	benchmarkCodeInGlobalMATLABSessionTool := &benchmarkmatlabcode.Tool{}
+	loadSimulinkModelInGlobalMATLABSessionTool := &loadsimulinkmodel.Tool{}
+	listSimulinkBlocksInGlobalMATLABSessionTool := &listsimulinkblocks.Tool{}
+	setSimulinkBlockParametersInGlobalMATLABSessionTool := &setsimulinkblockparameters.Tool{}
+	simulateSimulinkModelInGlobalMATLABSessionTool := &simulatesimulinkmodel.Tool{}
+	checkSimulinkModelInGlobalMATLABSessionTool := &checksimulinkmodel.Tool{}
+	auditMiddleware := &audit.Middleware{}
+	debugGuardMiddleware := &debugguard.Middleware{}
+	approvalMiddleware := &approval.Middleware{}
 	codingGuidelinesResource := &codingguidelines.Resource{}
+	customCodingGuidelinesResource := &customcodingguidelines.Resource{}
+	matlabDocumentationResource := &matlabdocumentation.Resource{}
+	matlabSessionPoolResource := &matlabsessionpool.Resource{}
+	matlabSessionStdoutResource := &matlabsessionlog.StdoutResource{}
+	matlabSessionStderrResource := &matlabsessionlog.StderrResource{}
+	matlabSessionFigureResource := &matlabsessionfigure.Resource{}
+	matlabSessionWorkspaceResource := &matlabsessionworkspace.Resource{}
+	writeAndTestFunctionPrompt := &writeandtestfunction.Prompt{}
+	debugFailingTestsPrompt := &debugfailingtests.Prompt{}
+	vectorizeLoopPrompt := &vectorizeloop.Prompt{}
+	reviewCodePrompt := &reviewcode.Prompt{}
+	customPrompts := &customprompts.Prompt{}
 
 	// Act
 	result := configurator.New(
@@ -46,12 +111,43 @@ func TestNew_HappyPath(t *testing.T) {
 		startMATLABSessionTool,
 		stopMATLABSessionTool,
 		evalInMATLABSessionTool,
+		restartMATLABSessionTool,
+		resetMATLABStateTool,
 		evalInGlobalMATLABSessionTool,
 		checkMATLABCodeInGlobalMATLABSession,
 		detectMATLABToolboxesInSingleSessionTool,
 		runMATLABFileInGlobalMATLABSessionTool,
 		runMATLABTestFileInGlobalMATLABSessionTool,
+		restartGlobalMATLABSessionTool,
+		resetGlobalMATLABStateTool,
+		analyzeMATLABDependenciesInGlobalMATLABSessionTool,
+		setBreakpointInGlobalMATLABSessionTool,
+		clearBreakpointsInGlobalMATLABSessionTool,
+		debugCodeInGlobalMATLABSessionTool,
+		controlDebuggerInGlobalMATLABSessionTool,
+		profileCodeInGlobalMATLABSessionTool,
+		benchmarkCodeInGlobalMATLABSessionTool,
+		loadSimulinkModelInGlobalMATLABSessionTool,
+		listSimulinkBlocksInGlobalMATLABSessionTool,
+		setSimulinkBlockParametersInGlobalMATLABSessionTool,
+		simulateSimulinkModelInGlobalMATLABSessionTool,
+		checkSimulinkModelInGlobalMATLABSessionTool,
+		auditMiddleware,
+		debugGuardMiddleware,
+		approvalMiddleware,
 		codingGuidelinesResource,
+		customCodingGuidelinesResource,
+		matlabDocumentationResource,
+		matlabSessionPoolResource,
+		matlabSessionStdoutResource,
+		matlabSessionStderrResource,
+		matlabSessionFigureResource,
+		matlabSessionWorkspaceResource,
+		writeAndTestFunctionPrompt,
+		debugFailingTestsPrompt,
+		vectorizeLoopPrompt,
+		reviewCodePrompt,
+		customPrompts,
 	)
 
 	// Assert
@@ -67,41 +163,126 @@ func TestConfigurator_GetToolsToAdd_MultipleMATLABSession_HappyPath(t *testing.T
 	startMATLABSessionTool := &startmatlabsession.Tool{}
 	stopMATLABSessionTool := &stopmatlabsession.Tool{}
 	evalInMATLABSessionTool := &evalmatlabmultisession.Tool{}
+	restartMATLABSessionTool := &restartmatlabmultisession.Tool{}
 	evalInGlobalMATLABSessionTool := &evalmatlabsinglesession.Tool{}
 	checkMATLABCodeInGlobalMATLABSession := &checkmatlabcode.Tool{}
 	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
 	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
 	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	restartGlobalMATLABSessionTool := &restartmatlabsinglesession.Tool{}
+	resetMATLABStateTool := &resetmatlabstatemultisession.Tool{}
+	resetGlobalMATLABStateTool := &resetmatlabstatesinglesession.Tool{}
+	analyzeMATLABDependenciesInGlobalMATLABSessionTool := &analyzematlabdependenciessinglesession.Tool{}
+	setBreakpointInGlobalMATLABSessionTool := &setmatlabbreakpoint.Tool{}
+	clearBreakpointsInGlobalMATLABSessionTool := &clearmatlabbreakpoints.Tool{}
+	debugCodeInGlobalMATLABSessionTool := &debugmatlabcode.Tool{}
+	controlDebuggerInGlobalMATLABSessionTool := &controlmatlabdebugger.Tool{}
+	profileCodeInGlobalMATLABSessionTool := &profilematlabcode.Tool{}
+	benchmarkCodeInGlobalMATLABSessionTool := &benchmarkmatlabcode.Tool{}
+	loadSimulinkModelInGlobalMATLABSessionTool := &loadsimulinkmodel.Tool{}
+	listSimulinkBlocksInGlobalMATLABSessionTool := &listsimulinkblocks.Tool{}
+	setSimulinkBlockParametersInGlobalMATLABSessionTool := &setsimulinkblockparameters.Tool{}
+	simulateSimulinkModelInGlobalMATLABSessionTool := &simulatesimulinkmodel.Tool{}
+	checkSimulinkModelInGlobalMATLABSessionTool := &checksimulinkmodel.Tool{}
+	auditMiddleware := &audit.Middleware{}
+	debugGuardMiddleware := &debugguard.Middleware{}
+	approvalMiddleware := &approval.Middleware{}
 	codingGuidelinesResource := &codingguidelines.Resource{}
+	customCodingGuidelinesResource := &customcodingguidelines.Resource{}
+	matlabDocumentationResource := &matlabdocumentation.Resource{}
+	matlabSessionPoolResource := &matlabsessionpool.Resource{}
+	matlabSessionStdoutResource := &matlabsessionlog.StdoutResource{}
+	matlabSessionStderrResource := &matlabsessionlog.StderrResource{}
+	matlabSessionFigureResource := &matlabsessionfigure.Resource{}
+	matlabSessionWorkspaceResource := &matlabsessionworkspace.Resource{}
+	writeAndTestFunctionPrompt := &writeandtestfunction.Prompt{}
+	debugFailingTestsPrompt := &debugfailingtests.Prompt{}
+	vectorizeLoopPrompt := &vectorizeloop.Prompt{}
+	reviewCodePrompt := &reviewcode.Prompt{}
+	customPrompts := &customprompts.Prompt{}
+
+	mockConfig.EXPECT().
+		EnabledTools().
+		Return(nil).
+		Once()
+
+	mockConfig.EXPECT().
+		DisabledTools().
+		Return(nil).
+		Once()
+
+	mockConfig.EXPECT().
+		ToolsRequiringApproval().
+		Return(nil).
+		Once()
 
 	mockConfig.EXPECT().
 		UseSingleMATLABSession().
 		Return(false).
 		Once()
 
+	mockConfig.EXPECT().
+		ReadOnly().
+		Return(false).
+		Once()
+
 	c := configurator.New(
 		mockConfig,
 		listAvailableMATLABsTool,
 		startMATLABSessionTool,
 		stopMATLABSessionTool,
 		evalInMATLABSessionTool,
+		restartMATLABSessionTool,
+		resetMATLABStateTool,
 		evalInGlobalMATLABSessionTool,
 		checkMATLABCodeInGlobalMATLABSession,
 		detectMATLABToolboxesInSingleSessionTool,
 		runMATLABFileInGlobalMATLABSessionTool,
 		runMATLABTestFileInGlobalMATLABSessionTool,
+		restartGlobalMATLABSessionTool,
+		resetGlobalMATLABStateTool,
+		analyzeMATLABDependenciesInGlobalMATLABSessionTool,
+		setBreakpointInGlobalMATLABSessionTool,
+		clearBreakpointsInGlobalMATLABSessionTool,
+		debugCodeInGlobalMATLABSessionTool,
+		controlDebuggerInGlobalMATLABSessionTool,
+		profileCodeInGlobalMATLABSessionTool,
+		benchmarkCodeInGlobalMATLABSessionTool,
+		loadSimulinkModelInGlobalMATLABSessionTool,
+		listSimulinkBlocksInGlobalMATLABSessionTool,
+		setSimulinkBlockParametersInGlobalMATLABSessionTool,
+		simulateSimulinkModelInGlobalMATLABSessionTool,
+		checkSimulinkModelInGlobalMATLABSessionTool,
+		auditMiddleware,
+		debugGuardMiddleware,
+		approvalMiddleware,
 		codingGuidelinesResource,
+		customCodingGuidelinesResource,
+		matlabDocumentationResource,
+		matlabSessionPoolResource,
+		matlabSessionStdoutResource,
+		matlabSessionStderrResource,
+		matlabSessionFigureResource,
+		matlabSessionWorkspaceResource,
+		writeAndTestFunctionPrompt,
+		debugFailingTestsPrompt,
+		vectorizeLoopPrompt,
+		reviewCodePrompt,
+		customPrompts,
 	)
 
 	// Act
-	toolsToAdd := c.GetToolsToAdd()
+	toolsToAdd, err := c.GetToolsToAdd()
 
 	// Assert
+	require.NoError(t, err)
 	assert.ElementsMatch(t, toolsToAdd, []tools.Tool{
 		listAvailableMATLABsTool,
 		startMATLABSessionTool,
 		stopMATLABSessionTool,
 		evalInMATLABSessionTool,
+		restartMATLABSessionTool,
+		resetMATLABStateTool,
 	}, "GetToolsToAdd should return all the injected tools for multi session")
 }
 
@@ -114,46 +295,483 @@ func TestConfigurator_GetToolsToAdd_SingleMATLABSession_HappyPath(t *testing.T)
 	startMATLABSessionTool := &startmatlabsession.Tool{}
 	stopMATLABSessionTool := &stopmatlabsession.Tool{}
 	evalInMATLABSessionTool := &evalmatlabmultisession.Tool{}
+	restartMATLABSessionTool := &restartmatlabmultisession.Tool{}
 	evalInGlobalMATLABSessionTool := &evalmatlabsinglesession.Tool{}
 	checkMATLABCodeInGlobalMATLABSession := &checkmatlabcode.Tool{}
 	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
 	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
 	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	restartGlobalMATLABSessionTool := &restartmatlabsinglesession.Tool{}
+	resetMATLABStateTool := &resetmatlabstatemultisession.Tool{}
+	resetGlobalMATLABStateTool := &resetmatlabstatesinglesession.Tool{}
+	analyzeMATLABDependenciesInGlobalMATLABSessionTool := &analyzematlabdependenciessinglesession.Tool{}
+	setBreakpointInGlobalMATLABSessionTool := &setmatlabbreakpoint.Tool{}
+	clearBreakpointsInGlobalMATLABSessionTool := &clearmatlabbreakpoints.Tool{}
+	debugCodeInGlobalMATLABSessionTool := &debugmatlabcode.Tool{}
+	controlDebuggerInGlobalMATLABSessionTool := &controlmatlabdebugger.Tool{}
+	profileCodeInGlobalMATLABSessionTool := &profilematlabcode.Tool{}
+	benchmarkCodeInGlobalMATLABSessionTool := &benchmarkmatlabcode.Tool{}
+	loadSimulinkModelInGlobalMATLABSessionTool := &loadsimulinkmodel.Tool{}
+	listSimulinkBlocksInGlobalMATLABSessionTool := &listsimulinkblocks.Tool{}
+	setSimulinkBlockParametersInGlobalMATLABSessionTool := &setsimulinkblockparameters.Tool{}
+	simulateSimulinkModelInGlobalMATLABSessionTool := &simulatesimulinkmodel.Tool{}
+	checkSimulinkModelInGlobalMATLABSessionTool := &checksimulinkmodel.Tool{}
+	auditMiddleware := &audit.Middleware{}
+	debugGuardMiddleware := &debugguard.Middleware{}
+	approvalMiddleware := &approval.Middleware{}
 	codingGuidelinesResource := &codingguidelines.Resource{}
+	customCodingGuidelinesResource := &customcodingguidelines.Resource{}
+	matlabDocumentationResource := &matlabdocumentation.Resource{}
+	matlabSessionPoolResource := &matlabsessionpool.Resource{}
+	matlabSessionStdoutResource := &matlabsessionlog.StdoutResource{}
+	matlabSessionStderrResource := &matlabsessionlog.StderrResource{}
+	matlabSessionFigureResource := &matlabsessionfigure.Resource{}
+	matlabSessionWorkspaceResource := &matlabsessionworkspace.Resource{}
+	writeAndTestFunctionPrompt := &writeandtestfunction.Prompt{}
+	debugFailingTestsPrompt := &debugfailingtests.Prompt{}
+	vectorizeLoopPrompt := &vectorizeloop.Prompt{}
+	reviewCodePrompt := &reviewcode.Prompt{}
+	customPrompts := &customprompts.Prompt{}
+
+	mockConfig.EXPECT().
+		EnabledTools().
+		Return(nil).
+		Once()
+
+	mockConfig.EXPECT().
+		DisabledTools().
+		Return(nil).
+		Once()
+
+	mockConfig.EXPECT().
+		ToolsRequiringApproval().
+		Return(nil).
+		Once()
 
 	mockConfig.EXPECT().
 		UseSingleMATLABSession().
 		Return(true).
 		Once()
 
+	mockConfig.EXPECT().
+		ReadOnly().
+		Return(false).
+		Once()
+
 	c := configurator.New(
 		mockConfig,
 		listAvailableMATLABsTool,
 		startMATLABSessionTool,
 		stopMATLABSessionTool,
 		evalInMATLABSessionTool,
+		restartMATLABSessionTool,
+		resetMATLABStateTool,
 		evalInGlobalMATLABSessionTool,
 		checkMATLABCodeInGlobalMATLABSession,
 		detectMATLABToolboxesInSingleSessionTool,
 		runMATLABFileInGlobalMATLABSessionTool,
 		runMATLABTestFileInGlobalMATLABSessionTool,
+		restartGlobalMATLABSessionTool,
+		resetGlobalMATLABStateTool,
+		analyzeMATLABDependenciesInGlobalMATLABSessionTool,
+		setBreakpointInGlobalMATLABSessionTool,
+		clearBreakpointsInGlobalMATLABSessionTool,
+		debugCodeInGlobalMATLABSessionTool,
+		controlDebuggerInGlobalMATLABSessionTool,
+		profileCodeInGlobalMATLABSessionTool,
+		benchmarkCodeInGlobalMATLABSessionTool,
+		loadSimulinkModelInGlobalMATLABSessionTool,
+		listSimulinkBlocksInGlobalMATLABSessionTool,
+		setSimulinkBlockParametersInGlobalMATLABSessionTool,
+		simulateSimulinkModelInGlobalMATLABSessionTool,
+		checkSimulinkModelInGlobalMATLABSessionTool,
+		auditMiddleware,
+		debugGuardMiddleware,
+		approvalMiddleware,
 		codingGuidelinesResource,
+		customCodingGuidelinesResource,
+		matlabDocumentationResource,
+		matlabSessionPoolResource,
+		matlabSessionStdoutResource,
+		matlabSessionStderrResource,
+		matlabSessionFigureResource,
+		matlabSessionWorkspaceResource,
+		writeAndTestFunctionPrompt,
+		debugFailingTestsPrompt,
+		vectorizeLoopPrompt,
+		reviewCodePrompt,
+		customPrompts,
 	)
 
 	// Act
-	toolsToAdd := c.GetToolsToAdd()
+	toolsToAdd, err := c.GetToolsToAdd()
 
 	// Assert
+	require.NoError(t, err)
 	assert.ElementsMatch(t, toolsToAdd, []tools.Tool{
 		evalInGlobalMATLABSessionTool,
 		checkMATLABCodeInGlobalMATLABSession,
 		runMATLABFileInGlobalMATLABSessionTool,
 		runMATLABTestFileInGlobalMATLABSessionTool,
 		detectMATLABToolboxesInSingleSessionTool,
+		restartGlobalMATLABSessionTool,
+		resetGlobalMATLABStateTool,
+		analyzeMATLABDependenciesInGlobalMATLABSessionTool,
+		setBreakpointInGlobalMATLABSessionTool,
+		clearBreakpointsInGlobalMATLABSessionTool,
+		debugCodeInGlobalMATLABSessionTool,
+		controlDebuggerInGlobalMATLABSessionTool,
+		profileCodeInGlobalMATLABSessionTool,
+		benchmarkCodeInGlobalMATLABSessionTool,
+		loadSimulinkModelInGlobalMATLABSessionTool,
+		listSimulinkBlocksInGlobalMATLABSessionTool,
+		setSimulinkBlockParametersInGlobalMATLABSessionTool,
+		simulateSimulinkModelInGlobalMATLABSessionTool,
+		checkSimulinkModelInGlobalMATLABSessionTool,
 	}, "GetToolsToAdd should all injected tools for single session")
 }
 
-func TestConfigurator_GetResourcesToAdd_HappyPath(t *testing.T) {
+func TestConfigurator_GetResourcesToAdd_SingleSession(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	listAvailableMATLABsTool := &listavailablematlabs.Tool{}
+	startMATLABSessionTool := &startmatlabsession.Tool{}
+	stopMATLABSessionTool := &stopmatlabsession.Tool{}
+	evalInMATLABSessionTool := &evalmatlabmultisession.Tool{}
+	restartMATLABSessionTool := &restartmatlabmultisession.Tool{}
+	evalInGlobalMATLABSessionTool := &evalmatlabsinglesession.Tool{}
+	checkMATLABCodeInGlobalMATLABSession := &checkmatlabcode.Tool{}
+	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
+	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
+	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	restartGlobalMATLABSessionTool := &restartmatlabsinglesession.Tool{}
+	resetMATLABStateTool := &resetmatlabstatemultisession.Tool{}
+	resetGlobalMATLABStateTool := &resetmatlabstatesinglesession.Tool{}
+	analyzeMATLABDependenciesInGlobalMATLABSessionTool := &analyzematlabdependenciessinglesession.Tool{}
+	setBreakpointInGlobalMATLABSessionTool := &setmatlabbreakpoint.Tool{}
+	clearBreakpointsInGlobalMATLABSessionTool := &clearmatlabbreakpoints.Tool{}
+	debugCodeInGlobalMATLABSessionTool := &debugmatlabcode.Tool{}
+	controlDebuggerInGlobalMATLABSessionTool := &controlmatlabdebugger.Tool{}
+	profileCodeInGlobalMATLABSessionTool := &profilematlabcode.Tool{}
+	benchmarkCodeInGlobalMATLABSessionTool := &benchmarkmatlabcode.Tool{}
+	loadSimulinkModelInGlobalMATLABSessionTool := &loadsimulinkmodel.Tool{}
+	listSimulinkBlocksInGlobalMATLABSessionTool := &listsimulinkblocks.Tool{}
+	setSimulinkBlockParametersInGlobalMATLABSessionTool := &setsimulinkblockparameters.Tool{}
+	simulateSimulinkModelInGlobalMATLABSessionTool := &simulatesimulinkmodel.Tool{}
+	checkSimulinkModelInGlobalMATLABSessionTool := &checksimulinkmodel.Tool{}
+	auditMiddleware := &audit.Middleware{}
+	debugGuardMiddleware := &debugguard.Middleware{}
+	approvalMiddleware := &approval.Middleware{}
+	codingGuidelinesResource := &codingguidelines.Resource{}
+	customCodingGuidelinesResource := &customcodingguidelines.Resource{}
+	matlabDocumentationResource := &matlabdocumentation.Resource{}
+	matlabSessionPoolResource := &matlabsessionpool.Resource{}
+	matlabSessionStdoutResource := &matlabsessionlog.StdoutResource{}
+	matlabSessionStderrResource := &matlabsessionlog.StderrResource{}
+	matlabSessionFigureResource := &matlabsessionfigure.Resource{}
+	matlabSessionWorkspaceResource := &matlabsessionworkspace.Resource{}
+	writeAndTestFunctionPrompt := &writeandtestfunction.Prompt{}
+	debugFailingTestsPrompt := &debugfailingtests.Prompt{}
+	vectorizeLoopPrompt := &vectorizeloop.Prompt{}
+	reviewCodePrompt := &reviewcode.Prompt{}
+	customPrompts := &customprompts.Prompt{}
+
+	mockConfig.EXPECT().
+		ReplaceCodingGuidelines().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(true).
+		Once()
+
+	c := configurator.New(
+		mockConfig,
+		listAvailableMATLABsTool,
+		startMATLABSessionTool,
+		stopMATLABSessionTool,
+		evalInMATLABSessionTool,
+		restartMATLABSessionTool,
+		resetMATLABStateTool,
+		evalInGlobalMATLABSessionTool,
+		checkMATLABCodeInGlobalMATLABSession,
+		detectMATLABToolboxesInSingleSessionTool,
+		runMATLABFileInGlobalMATLABSessionTool,
+		runMATLABTestFileInGlobalMATLABSessionTool,
+		restartGlobalMATLABSessionTool,
+		resetGlobalMATLABStateTool,
+		analyzeMATLABDependenciesInGlobalMATLABSessionTool,
+		setBreakpointInGlobalMATLABSessionTool,
+		clearBreakpointsInGlobalMATLABSessionTool,
+		debugCodeInGlobalMATLABSessionTool,
+		controlDebuggerInGlobalMATLABSessionTool,
+		profileCodeInGlobalMATLABSessionTool,
+		benchmarkCodeInGlobalMATLABSessionTool,
+		loadSimulinkModelInGlobalMATLABSessionTool,
+		listSimulinkBlocksInGlobalMATLABSessionTool,
+		setSimulinkBlockParametersInGlobalMATLABSessionTool,
+		simulateSimulinkModelInGlobalMATLABSessionTool,
+		checkSimulinkModelInGlobalMATLABSessionTool,
+		auditMiddleware,
+		debugGuardMiddleware,
+		approvalMiddleware,
+		codingGuidelinesResource,
+		customCodingGuidelinesResource,
+		matlabDocumentationResource,
+		matlabSessionPoolResource,
+		matlabSessionStdoutResource,
+		matlabSessionStderrResource,
+		matlabSessionFigureResource,
+		matlabSessionWorkspaceResource,
+		writeAndTestFunctionPrompt,
+		debugFailingTestsPrompt,
+		vectorizeLoopPrompt,
+		reviewCodePrompt,
+		customPrompts,
+	)
+
+	// Act
+	result := c.GetResourcesToAdd()
+
+	// Assert
+	assert.ElementsMatch(t, []resources.Resource{
+		codingGuidelinesResource,
+		customCodingGuidelinesResource,
+		matlabDocumentationResource,
+		matlabSessionStdoutResource,
+		matlabSessionStderrResource,
+		matlabSessionFigureResource,
+		matlabSessionWorkspaceResource,
+	}, result)
+}
+
+func TestConfigurator_GetResourcesToAdd_MultiSession(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	listAvailableMATLABsTool := &listavailablematlabs.Tool{}
+	startMATLABSessionTool := &startmatlabsession.Tool{}
+	stopMATLABSessionTool := &stopmatlabsession.Tool{}
+	evalInMATLABSessionTool := &evalmatlabmultisession.Tool{}
+	restartMATLABSessionTool := &restartmatlabmultisession.Tool{}
+	evalInGlobalMATLABSessionTool := &evalmatlabsinglesession.Tool{}
+	checkMATLABCodeInGlobalMATLABSession := &checkmatlabcode.Tool{}
+	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
+	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
+	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	restartGlobalMATLABSessionTool := &restartmatlabsinglesession.Tool{}
+	resetMATLABStateTool := &resetmatlabstatemultisession.Tool{}
+	resetGlobalMATLABStateTool := &resetmatlabstatesinglesession.Tool{}
+	analyzeMATLABDependenciesInGlobalMATLABSessionTool := &analyzematlabdependenciessinglesession.Tool{}
+	setBreakpointInGlobalMATLABSessionTool := &setmatlabbreakpoint.Tool{}
+	clearBreakpointsInGlobalMATLABSessionTool := &clearmatlabbreakpoints.Tool{}
+	debugCodeInGlobalMATLABSessionTool := &debugmatlabcode.Tool{}
+	controlDebuggerInGlobalMATLABSessionTool := &controlmatlabdebugger.Tool{}
+	profileCodeInGlobalMATLABSessionTool := &profilematlabcode.Tool{}
+	benchmarkCodeInGlobalMATLABSessionTool := &benchmarkmatlabcode.Tool{}
+	loadSimulinkModelInGlobalMATLABSessionTool := &loadsimulinkmodel.Tool{}
+	listSimulinkBlocksInGlobalMATLABSessionTool := &listsimulinkblocks.Tool{}
+	setSimulinkBlockParametersInGlobalMATLABSessionTool := &setsimulinkblockparameters.Tool{}
+	simulateSimulinkModelInGlobalMATLABSessionTool := &simulatesimulinkmodel.Tool{}
+	checkSimulinkModelInGlobalMATLABSessionTool := &checksimulinkmodel.Tool{}
+	auditMiddleware := &audit.Middleware{}
+	debugGuardMiddleware := &debugguard.Middleware{}
+	approvalMiddleware := &approval.Middleware{}
+	codingGuidelinesResource := &codingguidelines.Resource{}
+	customCodingGuidelinesResource := &customcodingguidelines.Resource{}
+	matlabDocumentationResource := &matlabdocumentation.Resource{}
+	matlabSessionPoolResource := &matlabsessionpool.Resource{}
+	matlabSessionStdoutResource := &matlabsessionlog.StdoutResource{}
+	matlabSessionStderrResource := &matlabsessionlog.StderrResource{}
+	matlabSessionFigureResource := &matlabsessionfigure.Resource{}
+	matlabSessionWorkspaceResource := &matlabsessionworkspace.Resource{}
+	writeAndTestFunctionPrompt := &writeandtestfunction.Prompt{}
+	debugFailingTestsPrompt := &debugfailingtests.Prompt{}
+	vectorizeLoopPrompt := &vectorizeloop.Prompt{}
+	reviewCodePrompt := &reviewcode.Prompt{}
+	customPrompts := &customprompts.Prompt{}
+
+	mockConfig.EXPECT().
+		ReplaceCodingGuidelines().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(false).
+		Once()
+
+	c := configurator.New(
+		mockConfig,
+		listAvailableMATLABsTool,
+		startMATLABSessionTool,
+		stopMATLABSessionTool,
+		evalInMATLABSessionTool,
+		restartMATLABSessionTool,
+		resetMATLABStateTool,
+		evalInGlobalMATLABSessionTool,
+		checkMATLABCodeInGlobalMATLABSession,
+		detectMATLABToolboxesInSingleSessionTool,
+		runMATLABFileInGlobalMATLABSessionTool,
+		runMATLABTestFileInGlobalMATLABSessionTool,
+		restartGlobalMATLABSessionTool,
+		resetGlobalMATLABStateTool,
+		analyzeMATLABDependenciesInGlobalMATLABSessionTool,
+		setBreakpointInGlobalMATLABSessionTool,
+		clearBreakpointsInGlobalMATLABSessionTool,
+		debugCodeInGlobalMATLABSessionTool,
+		controlDebuggerInGlobalMATLABSessionTool,
+		profileCodeInGlobalMATLABSessionTool,
+		benchmarkCodeInGlobalMATLABSessionTool,
+		loadSimulinkModelInGlobalMATLABSessionTool,
+		listSimulinkBlocksInGlobalMATLABSessionTool,
+		setSimulinkBlockParametersInGlobalMATLABSessionTool,
+		simulateSimulinkModelInGlobalMATLABSessionTool,
+		checkSimulinkModelInGlobalMATLABSessionTool,
+		auditMiddleware,
+		debugGuardMiddleware,
+		approvalMiddleware,
+		codingGuidelinesResource,
+		customCodingGuidelinesResource,
+		matlabDocumentationResource,
+		matlabSessionPoolResource,
+		matlabSessionStdoutResource,
+		matlabSessionStderrResource,
+		matlabSessionFigureResource,
+		matlabSessionWorkspaceResource,
+		writeAndTestFunctionPrompt,
+		debugFailingTestsPrompt,
+		vectorizeLoopPrompt,
+		reviewCodePrompt,
+		customPrompts,
+	)
+
+	// Act
+	result := c.GetResourcesToAdd()
+
+	// Assert
+	assert.ElementsMatch(t, []resources.Resource{
+		codingGuidelinesResource,
+		customCodingGuidelinesResource,
+		matlabDocumentationResource,
+		matlabSessionPoolResource,
+		matlabSessionStdoutResource,
+		matlabSessionStderrResource,
+		matlabSessionFigureResource,
+		matlabSessionWorkspaceResource,
+	}, result)
+}
+
+func TestConfigurator_GetPromptsToAdd_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	listAvailableMATLABsTool := &listavailablematlabs.Tool{}
+	startMATLABSessionTool := &startmatlabsession.Tool{}
+	stopMATLABSessionTool := &stopmatlabsession.Tool{}
+	evalInMATLABSessionTool := &evalmatlabmultisession.Tool{}
+	restartMATLABSessionTool := &restartmatlabmultisession.Tool{}
+	evalInGlobalMATLABSessionTool := &evalmatlabsinglesession.Tool{}
+	checkMATLABCodeInGlobalMATLABSession := &checkmatlabcode.Tool{}
+	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
+	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
+	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	restartGlobalMATLABSessionTool := &restartmatlabsinglesession.Tool{}
+	resetMATLABStateTool := &resetmatlabstatemultisession.Tool{}
+	resetGlobalMATLABStateTool := &resetmatlabstatesinglesession.Tool{}
+	analyzeMATLABDependenciesInGlobalMATLABSessionTool := &analyzematlabdependenciessinglesession.Tool{}
+	setBreakpointInGlobalMATLABSessionTool := &setmatlabbreakpoint.Tool{}
+	clearBreakpointsInGlobalMATLABSessionTool := &clearmatlabbreakpoints.Tool{}
+	debugCodeInGlobalMATLABSessionTool := &debugmatlabcode.Tool{}
+	controlDebuggerInGlobalMATLABSessionTool := &controlmatlabdebugger.Tool{}
+	profileCodeInGlobalMATLABSessionTool := &profilematlabcode.Tool{}
+	benchmarkCodeInGlobalMATLABSessionTool := &benchmarkmatlabcode.Tool{}
+	loadSimulinkModelInGlobalMATLABSessionTool := &loadsimulinkmodel.Tool{}
+	listSimulinkBlocksInGlobalMATLABSessionTool := &listsimulinkblocks.Tool{}
+	setSimulinkBlockParametersInGlobalMATLABSessionTool := &setsimulinkblockparameters.Tool{}
+	simulateSimulinkModelInGlobalMATLABSessionTool := &simulatesimulinkmodel.Tool{}
+	checkSimulinkModelInGlobalMATLABSessionTool := &checksimulinkmodel.Tool{}
+	auditMiddleware := &audit.Middleware{}
+	debugGuardMiddleware := &debugguard.Middleware{}
+	approvalMiddleware := &approval.Middleware{}
+	codingGuidelinesResource := &codingguidelines.Resource{}
+	customCodingGuidelinesResource := &customcodingguidelines.Resource{}
+	matlabDocumentationResource := &matlabdocumentation.Resource{}
+	matlabSessionPoolResource := &matlabsessionpool.Resource{}
+	matlabSessionStdoutResource := &matlabsessionlog.StdoutResource{}
+	matlabSessionStderrResource := &matlabsessionlog.StderrResource{}
+	matlabSessionFigureResource := &matlabsessionfigure.Resource{}
+	matlabSessionWorkspaceResource := &matlabsessionworkspace.Resource{}
+	writeAndTestFunctionPrompt := &writeandtestfunction.Prompt{}
+	debugFailingTestsPrompt := &debugfailingtests.Prompt{}
+	vectorizeLoopPrompt := &vectorizeloop.Prompt{}
+	reviewCodePrompt := &reviewcode.Prompt{}
+	customPrompts := &customprompts.Prompt{}
+
+	c := configurator.New(
+		mockConfig,
+		listAvailableMATLABsTool,
+		startMATLABSessionTool,
+		stopMATLABSessionTool,
+		evalInMATLABSessionTool,
+		restartMATLABSessionTool,
+		resetMATLABStateTool,
+		evalInGlobalMATLABSessionTool,
+		checkMATLABCodeInGlobalMATLABSession,
+		detectMATLABToolboxesInSingleSessionTool,
+		runMATLABFileInGlobalMATLABSessionTool,
+		runMATLABTestFileInGlobalMATLABSessionTool,
+		restartGlobalMATLABSessionTool,
+		resetGlobalMATLABStateTool,
+		analyzeMATLABDependenciesInGlobalMATLABSessionTool,
+		setBreakpointInGlobalMATLABSessionTool,
+		clearBreakpointsInGlobalMATLABSessionTool,
+		debugCodeInGlobalMATLABSessionTool,
+		controlDebuggerInGlobalMATLABSessionTool,
+		profileCodeInGlobalMATLABSessionTool,
+		benchmarkCodeInGlobalMATLABSessionTool,
+		loadSimulinkModelInGlobalMATLABSessionTool,
+		listSimulinkBlocksInGlobalMATLABSessionTool,
+		setSimulinkBlockParametersInGlobalMATLABSessionTool,
+		simulateSimulinkModelInGlobalMATLABSessionTool,
+		checkSimulinkModelInGlobalMATLABSessionTool,
+		auditMiddleware,
+		debugGuardMiddleware,
+		approvalMiddleware,
+		codingGuidelinesResource,
+		customCodingGuidelinesResource,
+		matlabDocumentationResource,
+		matlabSessionPoolResource,
+		matlabSessionStdoutResource,
+		matlabSessionStderrResource,
+		matlabSessionFigureResource,
+		matlabSessionWorkspaceResource,
+		writeAndTestFunctionPrompt,
+		debugFailingTestsPrompt,
+		vectorizeLoopPrompt,
+		reviewCodePrompt,
+		customPrompts,
+	)
+
+	// Act
+	result := c.GetPromptsToAdd()
+
+	// Assert
+	assert.Equal(t, []prompts.Prompt{
+		writeAndTestFunctionPrompt,
+		debugFailingTestsPrompt,
+		vectorizeLoopPrompt,
+		reviewCodePrompt,
+		customPrompts,
+	}, result)
+}
+
+func TestConfigurator_GetResourcesToAdd_ReplaceCodingGuidelines(t *testing.T) {
 	// Arrange
 	mockConfig := &mocks.MockConfig{}
 	defer mockConfig.AssertExpectations(t)
@@ -162,12 +780,53 @@ func TestConfigurator_GetResourcesToAdd_HappyPath(t *testing.T) {
 	startMATLABSessionTool := &startmatlabsession.Tool{}
 	stopMATLABSessionTool := &stopmatlabsession.Tool{}
 	evalInMATLABSessionTool := &evalmatlabmultisession.Tool{}
+	restartMATLABSessionTool := &restartmatlabmultisession.Tool{}
 	evalInGlobalMATLABSessionTool := &evalmatlabsinglesession.Tool{}
 	checkMATLABCodeInGlobalMATLABSession := &checkmatlabcode.Tool{}
 	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
 	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
 	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	restartGlobalMATLABSessionTool := &restartmatlabsinglesession.Tool{}
+	resetMATLABStateTool := &resetmatlabstatemultisession.Tool{}
+	resetGlobalMATLABStateTool := &resetmatlabstatesinglesession.Tool{}
+	analyzeMATLABDependenciesInGlobalMATLABSessionTool := &analyzematlabdependenciessinglesession.Tool{}
+	setBreakpointInGlobalMATLABSessionTool := &setmatlabbreakpoint.Tool{}
+	clearBreakpointsInGlobalMATLABSessionTool := &clearmatlabbreakpoints.Tool{}
+	debugCodeInGlobalMATLABSessionTool := &debugmatlabcode.Tool{}
+	controlDebuggerInGlobalMATLABSessionTool := &controlmatlabdebugger.Tool{}
+	profileCodeInGlobalMATLABSessionTool := &profilematlabcode.Tool{}
+	benchmarkCodeInGlobalMATLABSessionTool := &benchmarkmatlabcode.Tool{}
+	loadSimulinkModelInGlobalMATLABSessionTool := &loadsimulinkmodel.Tool{}
+	listSimulinkBlocksInGlobalMATLABSessionTool := &listsimulinkblocks.Tool{}
+	setSimulinkBlockParametersInGlobalMATLABSessionTool := &setsimulinkblockparameters.Tool{}
+	simulateSimulinkModelInGlobalMATLABSessionTool := &simulatesimulinkmodel.Tool{}
+	checkSimulinkModelInGlobalMATLABSessionTool := &checksimulinkmodel.Tool{}
+	auditMiddleware := &audit.Middleware{}
+	debugGuardMiddleware := &debugguard.Middleware{}
+	approvalMiddleware := &approval.Middleware{}
 	codingGuidelinesResource := &codingguidelines.Resource{}
+	customCodingGuidelinesResource := &customcodingguidelines.Resource{}
+	matlabDocumentationResource := &matlabdocumentation.Resource{}
+	matlabSessionPoolResource := &matlabsessionpool.Resource{}
+	matlabSessionStdoutResource := &matlabsessionlog.StdoutResource{}
+	matlabSessionStderrResource := &matlabsessionlog.StderrResource{}
+	matlabSessionFigureResource := &matlabsessionfigure.Resource{}
+	matlabSessionWorkspaceResource := &matlabsessionworkspace.Resource{}
+	writeAndTestFunctionPrompt := &writeandtestfunction.Prompt{}
+	debugFailingTestsPrompt := &debugfailingtests.Prompt{}
+	vectorizeLoopPrompt := &vectorizeloop.Prompt{}
+	reviewCodePrompt := &reviewcode.Prompt{}
+	customPrompts := &customprompts.Prompt{}
+
+	mockConfig.EXPECT().
+		ReplaceCodingGuidelines().
+		Return(true).
+		Once()
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(true).
+		Once()
 
 	c := configurator.New(
 		mockConfig,
@@ -175,17 +834,378 @@ func TestConfigurator_GetResourcesToAdd_HappyPath(t *testing.T) {
 		startMATLABSessionTool,
 		stopMATLABSessionTool,
 		evalInMATLABSessionTool,
+		restartMATLABSessionTool,
+		resetMATLABStateTool,
 		evalInGlobalMATLABSessionTool,
 		checkMATLABCodeInGlobalMATLABSession,
 		detectMATLABToolboxesInSingleSessionTool,
 		runMATLABFileInGlobalMATLABSessionTool,
 		runMATLABTestFileInGlobalMATLABSessionTool,
+		restartGlobalMATLABSessionTool,
+		resetGlobalMATLABStateTool,
+		analyzeMATLABDependenciesInGlobalMATLABSessionTool,
+		setBreakpointInGlobalMATLABSessionTool,
+		clearBreakpointsInGlobalMATLABSessionTool,
+		debugCodeInGlobalMATLABSessionTool,
+		controlDebuggerInGlobalMATLABSessionTool,
+		profileCodeInGlobalMATLABSessionTool,
+		benchmarkCodeInGlobalMATLABSessionTool,
+		loadSimulinkModelInGlobalMATLABSessionTool,
+		listSimulinkBlocksInGlobalMATLABSessionTool,
+		setSimulinkBlockParametersInGlobalMATLABSessionTool,
+		simulateSimulinkModelInGlobalMATLABSessionTool,
+		checkSimulinkModelInGlobalMATLABSessionTool,
+		auditMiddleware,
+		debugGuardMiddleware,
+		approvalMiddleware,
 		codingGuidelinesResource,
+		customCodingGuidelinesResource,
+		matlabDocumentationResource,
+		matlabSessionPoolResource,
+		matlabSessionStdoutResource,
+		matlabSessionStderrResource,
+		matlabSessionFigureResource,
+		matlabSessionWorkspaceResource,
+		writeAndTestFunctionPrompt,
+		debugFailingTestsPrompt,
+		vectorizeLoopPrompt,
+		reviewCodePrompt,
+		customPrompts,
 	)
 
 	// Act
 	result := c.GetResourcesToAdd()
 
 	// Assert
-	assert.ElementsMatch(t, []resources.Resource{codingGuidelinesResource}, result)
+	assert.ElementsMatch(t, []resources.Resource{
+		customCodingGuidelinesResource,
+		matlabDocumentationResource,
+		matlabSessionStdoutResource,
+		matlabSessionStderrResource,
+		matlabSessionFigureResource,
+		matlabSessionWorkspaceResource,
+	}, result)
+}
+
+func TestConfigurator_GetToolMiddlewares_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	c := newConfiguratorWithNamedTools(t, mockConfig)
+
+	// Act
+	result := c.GetToolMiddlewares()
+
+	// Assert
+	require.Len(t, result, 3)
+	assert.IsType(t, &audit.Middleware{}, result[0], "The audit log should be the outermost middleware")
+	assert.IsType(t, &debugguard.Middleware{}, result[1], "The calls refused while debugging should not need approval")
+	assert.IsType(t, &approval.Middleware{}, result[2])
+}
+
+func TestConfigurator_GetToolsToAdd_Filtering(t *testing.T) {
+	testCases := []struct {
+		name                   string
+		useSingleMATLABSession bool
+		enabledTools           []string
+		disabledTools          []string
+		readOnly               bool
+		expectedToolNames      []string
+	}{
+		{
+			name:                   "read-only single session",
+			useSingleMATLABSession: true,
+			readOnly:               true,
+			expectedToolNames:      []string{"check_matlab_code", "detect_matlab_toolboxes", "analyze_matlab_dependencies"},
+		},
+		{
+			name:                   "read-only multi session",
+			useSingleMATLABSession: false,
+			readOnly:               true,
+			expectedToolNames:      []string{"list_available_matlabs"},
+		},
+		{
+			name:                   "enabled tools",
+			useSingleMATLABSession: true,
+			enabledTools:           []string{"check_matlab_code", "evaluate_matlab_code"},
+			expectedToolNames:      []string{"check_matlab_code", "evaluate_matlab_code"},
+		},
+		{
+			name:                   "enabled tools of the other mode are ignored",
+			useSingleMATLABSession: true,
+			enabledTools:           []string{"check_matlab_code", "start_matlab_session"},
+			expectedToolNames:      []string{"check_matlab_code"},
+		},
+		{
+			name:                   "disabled tools",
+			useSingleMATLABSession: false,
+			disabledTools:          []string{"eval_in_matlab_session", "reset_matlab_state"},
+			expectedToolNames:      []string{"list_available_matlabs", "start_matlab_session", "stop_matlab_session", "restart_matlab_session"},
+		},
+		{
+			name:                   "disabled tools take precedence over enabled tools",
+			useSingleMATLABSession: true,
+			enabledTools:           []string{"check_matlab_code", "run_matlab_file"},
+			disabledTools:          []string{"run_matlab_file"},
+			expectedToolNames:      []string{"check_matlab_code"},
+		},
+		{
+			name:                   "read-only takes precedence over enabled tools",
+			useSingleMATLABSession: true,
+			enabledTools:           []string{"check_matlab_code", "evaluate_matlab_code"},
+			readOnly:               true,
+			expectedToolNames:      []string{"check_matlab_code"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockConfig.EXPECT().
+				EnabledTools().
+				Return(tc.enabledTools).
+				Once()
+
+			mockConfig.EXPECT().
+				DisabledTools().
+				Return(tc.disabledTools).
+				Once()
+
+			mockConfig.EXPECT().
+				ToolsRequiringApproval().
+				Return(nil).
+				Once()
+
+			mockConfig.EXPECT().
+				UseSingleMATLABSession().
+				Return(tc.useSingleMATLABSession).
+				Once()
+
+			mockConfig.EXPECT().
+				ReadOnly().
+				Return(tc.readOnly).
+				Once()
+
+			c := newConfiguratorWithNamedTools(t, mockConfig)
+
+			// Act
+			toolsToAdd, err := c.GetToolsToAdd()
+
+			// Assert
+			require.NoError(t, err)
+			toolNames := []string{}
+			for _, tool := range toolsToAdd {
+				toolNames = append(toolNames, tool.Name())
+			}
+			assert.ElementsMatch(t, tc.expectedToolNames, toolNames)
+		})
+	}
+}
+
+// TestConfigurator_GetToolsToAdd_AuditedArguments checks that every tool declares the inputs holding MATLAB code and file system paths,
+// so that the audit log records them.
+func TestConfigurator_GetToolsToAdd_AuditedArguments(t *testing.T) {
+	type auditedArguments struct {
+		code []string
+		path []string
+	}
+
+	expectedAuditedArguments := map[string]auditedArguments{
+		"list_available_matlabs":        {},
+		"start_matlab_session":          {path: []string{"matlab_root"}},
+		"stop_matlab_session":           {},
+		"eval_in_matlab_session":        {code: []string{"code"}, path: []string{"project_path"}},
+		"restart_matlab_session":        {},
+		"reset_matlab_state":            {},
+		"evaluate_matlab_code":          {code: []string{"code"}, path: []string{"project_path"}},
+		"check_matlab_code":             {path: []string{"script_path"}},
+		"detect_matlab_toolboxes":       {},
+		"run_matlab_file":               {path: []string{"script_path"}},
+		"run_matlab_test_file":          {path: []string{"script_path"}},
+		"analyze_matlab_dependencies":   {path: []string{"path"}},
+		"set_matlab_breakpoint":         {code: []string{"condition"}, path: []string{"file_path"}},
+		"clear_matlab_breakpoints":      {path: []string{"file_path"}},
+		"debug_matlab_code":             {code: []string{"code"}, path: []string{"project_path"}},
+		"control_matlab_debugger":       {},
+		"profile_matlab_code":           {code: []string{"code"}, path: []string{"project_path"}},
+		"benchmark_matlab_code":         {code: []string{"code", "compare_code", "setup_code"}, path: []string{"project_path"}},
+		"load_simulink_model":           {path: []string{"model_path"}},
+		"list_simulink_blocks":          {},
+		"set_simulink_block_parameters": {},
+		"simulate_simulink_model":       {code: []string{"stop_time"}},
+		"check_simulink_model":          {path: []string{"configuration_file"}},
+	}
+
+	for _, useSingleMATLABSession := range []bool{false, true} {
+		t.Run(fmt.Sprintf("single session %t", useSingleMATLABSession), func(t *testing.T) {
+			// Arrange
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockConfig.EXPECT().
+				EnabledTools().
+				Return(nil).
+				Once()
+
+			mockConfig.EXPECT().
+				DisabledTools().
+				Return(nil).
+				Once()
+
+			mockConfig.EXPECT().
+				ToolsRequiringApproval().
+				Return(nil).
+				Once()
+
+			mockConfig.EXPECT().
+				UseSingleMATLABSession().
+				Return(useSingleMATLABSession).
+				Once()
+
+			mockConfig.EXPECT().
+				ReadOnly().
+				Return(false).
+				Once()
+
+			c := newConfiguratorWithNamedTools(t, mockConfig)
+
+			// Act
+			toolsToAdd, err := c.GetToolsToAdd()
+
+			// Assert
+			require.NoError(t, err)
+			for _, tool := range toolsToAdd {
+				auditedTool, ok := tool.(interface {
+					CodeArguments() []string
+					PathArguments() []string
+				})
+				require.True(t, ok, "The %s tool should declare its audited arguments", tool.Name())
+
+				expected, ok := expectedAuditedArguments[tool.Name()]
+				require.True(t, ok, "The audited arguments of the %s tool should be checked", tool.Name())
+				assert.ElementsMatch(t, expected.code, auditedTool.CodeArguments(), "Code arguments of the %s tool", tool.Name())
+				assert.ElementsMatch(t, expected.path, auditedTool.PathArguments(), "Path arguments of the %s tool", tool.Name())
+			}
+		})
+	}
+}
+
+func TestConfigurator_GetToolsToAdd_UnknownTool(t *testing.T) {
+	testCases := []struct {
+		name                   string
+		enabledTools           []string
+		disabledTools          []string
+		toolsRequiringApproval []string
+		expectedError          string
+	}{
+		{
+			name:          "unknown enabled tool",
+			enabledTools:  []string{"check_matlab_code", "format_hard_drive"},
+			expectedError: `invalid enabled tools: unknown tool "format_hard_drive"`,
+		},
+		{
+			name:          "unknown disabled tool",
+			disabledTools: []string{"evaluate_matlab"},
+			expectedError: `invalid disabled tools: unknown tool "evaluate_matlab"`,
+		},
+		{
+			name:                   "unknown tool requiring approval",
+			toolsRequiringApproval: []string{"evaluate_matlab_code", "run_matlab"},
+			expectedError:          `invalid tools requiring approval: unknown tool "run_matlab"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockConfig.EXPECT().
+				EnabledTools().
+				Return(tc.enabledTools).
+				Once()
+
+			mockConfig.EXPECT().
+				DisabledTools().
+				Return(tc.disabledTools).
+				Once()
+
+			if tc.toolsRequiringApproval != nil {
+				mockConfig.EXPECT().
+					ToolsRequiringApproval().
+					Return(tc.toolsRequiringApproval).
+					Once()
+			}
+
+			c := newConfiguratorWithNamedTools(t, mockConfig)
+
+			// Act
+			toolsToAdd, err := c.GetToolsToAdd()
+
+			// Assert
+			require.EqualError(t, err, tc.expectedError)
+			assert.Nil(t, toolsToAdd)
+		})
+	}
+}
+
+// newConfiguratorWithNamedTools creates a configurator with real tools, so that tools can be filtered by name.
+func newConfiguratorWithNamedTools(t *testing.T, mockConfig *mocks.MockConfig) *configurator.Configurator {
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	t.Cleanup(func() { mockLoggerFactory.AssertExpectations(t) })
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(testutils.NewInspectableLogger()).
+		Times(25)
+
+	return configurator.New(
+		mockConfig,
+		listavailablematlabs.New(mockLoggerFactory, nil),
+		startmatlabsession.New(mockLoggerFactory, nil),
+		stopmatlabsession.New(mockLoggerFactory, nil),
+		evalmatlabmultisession.New(mockLoggerFactory, nil, nil),
+		restartmatlabmultisession.New(mockLoggerFactory, nil, nil),
+		resetmatlabstatemultisession.New(mockLoggerFactory, nil, nil),
+		evalmatlabsinglesession.New(mockLoggerFactory, nil, nil),
+		checkmatlabcode.New(mockLoggerFactory, nil, nil),
+		detectmatlabtoolboxes.New(mockLoggerFactory, nil, nil),
+		runmatlabfile.New(mockLoggerFactory, nil, nil),
+		runmatlabtestfile.New(mockLoggerFactory, nil, nil),
+		restartmatlabsinglesession.New(mockLoggerFactory, nil, nil, nil),
+		resetmatlabstatesinglesession.New(mockLoggerFactory, nil, nil),
+		analyzematlabdependenciessinglesession.New(mockLoggerFactory, nil, nil),
+		setmatlabbreakpoint.New(mockLoggerFactory, nil, nil),
+		clearmatlabbreakpoints.New(mockLoggerFactory, nil, nil),
+		debugmatlabcode.New(mockLoggerFactory, nil, nil),
+		controlmatlabdebugger.New(mockLoggerFactory, nil, nil),
+		profilematlabcode.New(mockLoggerFactory, nil, nil),
+		benchmarkmatlabcode.New(mockLoggerFactory, nil, nil),
+		loadsimulinkmodel.New(mockLoggerFactory, nil, nil),
+		listsimulinkblocks.New(mockLoggerFactory, nil, nil),
+		setsimulinkblockparameters.New(mockLoggerFactory, nil, nil),
+		simulatesimulinkmodel.New(mockLoggerFactory, nil, nil),
+		checksimulinkmodel.New(mockLoggerFactory, nil, nil),
+		&audit.Middleware{},
+		&debugguard.Middleware{},
+		&approval.Middleware{},
+		&codingguidelines.Resource{},
+		&customcodingguidelines.Resource{},
+		&matlabdocumentation.Resource{},
+		&matlabsessionpool.Resource{},
+		&matlabsessionlog.StdoutResource{},
+		&matlabsessionlog.StderrResource{},
+		&matlabsessionfigure.Resource{},
+		&matlabsessionworkspace.Resource{},
+		&writeandtestfunction.Prompt{},
+		&debugfailingtests.Prompt{},
+		&vectorizeloop.Prompt{},
+		&reviewcode.Prompt{},
+		&customprompts.Prompt{},
+	)
 }
@@ -3,20 +3,95 @@
 package server
 
 import (
+	"context"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
 type ServerConfig interface {
 	Version() string
+	ReadOnly() bool
+	UseSingleMATLABSession() bool
+	RemoteMATLABHost() string
+	CodingGuidelines() []string
+	ReplaceCodingGuidelines() bool
+	PromptFiles() []string
+}
+
+type ClientRootsHandler interface {
+	HandleInitialized(ctx context.Context, request *mcp.InitializedRequest)
+	HandleRootsListChanged(ctx context.Context, request *mcp.RootsListChangedRequest)
 }
 
-func NewMCPSDKServer(config ServerConfig) *mcp.Server {
+type CompletionHandler interface {
+	HandleCompletion(ctx context.Context, request *mcp.CompleteRequest) (*mcp.CompleteResult, error)
+}
+
+func NewMCPSDKServer(config ServerConfig, configurator MCPServerConfigurator, clientRootsHandler ClientRootsHandler, completionHandler CompletionHandler) (*mcp.Server, error) {
+	toolsToAdd, err := configurator.GetToolsToAdd()
+	if err != nil {
+		return nil, err
+	}
+
+	serverInstructions, err := generateInstructions(config, toolsToAdd)
+	if err != nil {
+		return nil, err
+	}
+
 	impl := &mcp.Implementation{
 		Name:    name,
 		Version: config.Version(),
 	}
 	options := &mcp.ServerOptions{
-		Instructions: instructions,
+		Instructions:            serverInstructions,
+		InitializedHandler:      clientRootsHandler.HandleInitialized,
+		RootsListChangedHandler: clientRootsHandler.HandleRootsListChanged,
+		CompletionHandler:       completionHandler.HandleCompletion,
+	}
+	return mcp.NewServer(impl, options), nil
+}
+
+type instructionsData struct {
+	ReadOnly                bool
+	SingleSession           bool
+	RemoteSession           bool
+	HasCodingGuidelines     bool
+	ReplaceCodingGuidelines bool
+	HasPromptFiles          bool
+	toolNames               []string
+}
+
+// HasTool is used by the instructions template to only describe the tools which are exposed.
+func (d instructionsData) HasTool(toolName string) bool {
+	return slices.Contains(d.toolNames, toolName)
+}
+
+func generateInstructions(config ServerConfig, toolsToAdd []tools.Tool) (string, error) {
+	instructionsTemplate, err := template.New(name).Parse(instructions)
+	if err != nil {
+		return "", err
 	}
-	return mcp.NewServer(impl, options)
+
+	data := instructionsData{
+		ReadOnly:                config.ReadOnly(),
+		SingleSession:           config.UseSingleMATLABSession(),
+		RemoteSession:           config.RemoteMATLABHost() != "",
+		HasCodingGuidelines:     len(config.CodingGuidelines()) > 0,
+		ReplaceCodingGuidelines: config.ReplaceCodingGuidelines(),
+		HasPromptFiles:          len(config.PromptFiles()) > 0,
+	}
+	for _, tool := range toolsToAdd {
+		data.toolNames = append(data.toolNames, tool.Name())
+	}
+
+	var generatedInstructions strings.Builder
+	if err := instructionsTemplate.Execute(&generatedInstructions, data); err != nil {
+		return "", err
+	}
+
+	return generatedInstructions.String(), nil
 }
@@ -0,0 +1,383 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package server_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/server"
+	toolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMCPSDKServer_Instructions(t *testing.T) {
+	testCases := []struct {
+		name                    string
+		readOnly                bool
+		singleSession           bool
+		remoteMATLABHost        string
+		codingGuidelines        []string
+		replaceCodingGuidelines bool
+		promptFiles             []string
+		toolNames               []string
+		expectedContains        []string
+		expectedNotContains     []string
+	}{
+		{
+			name:          "all single session tools",
+			readOnly:      false,
+			singleSession: true,
+			toolNames:     []string{"evaluate_matlab_code", "check_matlab_code", "run_matlab_file"},
+			expectedContains: []string{
+				"Available tools:\n\n- Statically analyze a MATLAB .m script.\n- Execute inline MATLAB commands.\n- Execute a MATLAB .m script file.\n\n",
+			},
+			expectedNotContains: []string{
+				"read-only mode",
+				"Run a MATLAB test script.",
+			},
+		},
+		{
+			name:          "read-only",
+			readOnly:      true,
+			singleSession: true,
+			toolNames:     []string{"check_matlab_code", "detect_matlab_toolboxes"},
+			expectedContains: []string{
+				"read-only mode",
+				"Available tools:\n\n- List installed MATLAB toolboxes and versions.\n- Statically analyze a MATLAB .m script.\n\n",
+			},
+			expectedNotContains: []string{
+				"Execute inline MATLAB commands.",
+			},
+		},
+		{
+			name:      "multi session tools",
+			readOnly:  false,
+			toolNames: []string{"list_available_matlabs", "start_matlab_session", "eval_in_matlab_session"},
+			expectedContains: []string{
+				"- List the MATLAB installations available on this machine.",
+				"- Start a MATLAB session.",
+				"- Execute inline MATLAB commands.",
+			},
+			expectedNotContains: []string{
+				"Stop a MATLAB session.",
+			},
+		},
+		{
+			name:          "debugger, profiler, benchmark and Simulink tools",
+			singleSession: true,
+			toolNames: []string{
+				"debug_matlab_code", "control_matlab_debugger", "set_matlab_breakpoint", "clear_matlab_breakpoints",
+				"profile_matlab_code", "benchmark_matlab_code",
+				"load_simulink_model", "list_simulink_blocks", "set_simulink_block_parameters", "simulate_simulink_model", "check_simulink_model",
+			},
+			expectedContains: []string{
+				"- Run MATLAB code in the debugger until it pauses at a breakpoint",
+				"- Step, step in, step out, continue or quit the code paused in the debugger.",
+				"- Set a breakpoint on a line of a MATLAB file",
+				"- Clear the breakpoints of a MATLAB file, or all breakpoints.",
+				"- Profile MATLAB code",
+				"- Measure the run time statistics of MATLAB code",
+				"- Load a Simulink model.",
+				"- List the blocks of a loaded Simulink model",
+				"- Set the parameters of a block of a loaded Simulink model.",
+				"- Simulate a loaded Simulink model",
+				"- Run Model Advisor checks on a loaded Simulink model.",
+				"only the debugger tools and restart_matlab_session can run",
+			},
+		},
+		{
+			name:          "single session resources",
+			singleSession: true,
+			expectedContains: []string{
+				"The MATLAB desktop is visible to the user.",
+				"Available resources:\n\n- guidelines://coding. ",
+				"- matlabdoc://{function}.",
+				"- matlab://session/workspace.",
+				"- matlab://session/figures/{n}.",
+				"- matlab://session/stdout and matlab://session/stderr.",
+				"Available prompts:\n\n- write_and_test_matlab_function.",
+				"- debug_failing_matlab_tests.",
+				"- vectorize_matlab_loop.",
+				"- review_matlab_code.",
+			},
+			expectedNotContains: []string{
+				"guidelines://coding/<name>",
+				"matlab://session/{id}",
+				"matlab://session-pool/status",
+				"prompt files",
+				"only the debugger tools",
+			},
+		},
+		{
+			name:          "multi session resources",
+			singleSession: false,
+			expectedContains: []string{
+				"- matlab://session/{id}/documentation/{function}.",
+				"- matlab://session/{id}/workspace.",
+				"- matlab://session/{id}/figures/{n}.",
+				"- matlab://session/{id}/stdout and matlab://session/{id}/stderr.",
+				"- matlab://session-pool/status.",
+			},
+			expectedNotContains: []string{
+				"matlabdoc://",
+			},
+		},
+		{
+			name:                    "custom coding guidelines and prompts",
+			singleSession:           true,
+			codingGuidelines:        []string{"team-style.md"},
+			replaceCodingGuidelines: true,
+			promptFiles:             []string{"prompts"},
+			expectedContains: []string{
+				"Available resources:\n\n- guidelines://coding/<name>.",
+				"- The prompts from the prompt files of the user.",
+			},
+			expectedNotContains: []string{
+				"- guidelines://coding. ",
+			},
+		},
+		{
+			name:             "remote session",
+			singleSession:    true,
+			remoteMATLABHost: "matlab.example.com",
+			expectedContains: []string{
+				"a MATLAB session running on a remote machine. The MATLAB desktop is not visible to the user",
+			},
+			expectedNotContains: []string{
+				"The MATLAB desktop is visible to the user.",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockServerConfig := &mocks.MockServerConfig{}
+			defer mockServerConfig.AssertExpectations(t)
+
+			mockConfigurator := &mocks.MockMCPServerConfigurator{}
+			defer mockConfigurator.AssertExpectations(t)
+
+			mockClientRootsHandler := &mocks.MockClientRootsHandler{}
+			defer mockClientRootsHandler.AssertExpectations(t)
+
+			mockCompletionHandler := &mocks.MockCompletionHandler{}
+			defer mockCompletionHandler.AssertExpectations(t)
+
+			toolsToAdd := []tools.Tool{}
+			for _, toolName := range tc.toolNames {
+				mockTool := &toolsmocks.MockTool{}
+				defer mockTool.AssertExpectations(t)
+
+				mockTool.EXPECT().
+					Name().
+					Return(toolName).
+					Once()
+
+				toolsToAdd = append(toolsToAdd, mockTool)
+			}
+
+			mockConfigurator.EXPECT().
+				GetToolsToAdd().
+				Return(toolsToAdd, nil).
+				Once()
+
+			mockServerConfig.EXPECT().
+				ReadOnly().
+				Return(tc.readOnly).
+				Once()
+
+			mockServerConfig.EXPECT().
+				UseSingleMATLABSession().
+				Return(tc.singleSession).
+				Once()
+
+			mockServerConfig.EXPECT().
+				RemoteMATLABHost().
+				Return(tc.remoteMATLABHost).
+				Once()
+
+			mockServerConfig.EXPECT().
+				CodingGuidelines().
+				Return(tc.codingGuidelines).
+				Once()
+
+			mockServerConfig.EXPECT().
+				ReplaceCodingGuidelines().
+				Return(tc.replaceCodingGuidelines).
+				Once()
+
+			mockServerConfig.EXPECT().
+				PromptFiles().
+				Return(tc.promptFiles).
+				Once()
+
+			mockServerConfig.EXPECT().
+				Version().
+				Return("1.0.0").
+				Once()
+
+			mockClientRootsHandler.EXPECT().
+				HandleInitialized(mock.Anything, mock.Anything).
+				Return().
+				Once()
+
+			// Act
+			mcpServer, err := server.NewMCPSDKServer(mockServerConfig, mockConfigurator, mockClientRootsHandler, mockCompletionHandler)
+
+			// Assert
+			require.NoError(t, err)
+			instructions := getInstructions(t, mcpServer)
+			for _, expected := range tc.expectedContains {
+				assert.Contains(t, instructions, expected)
+			}
+			for _, notExpected := range tc.expectedNotContains {
+				assert.NotContains(t, instructions, notExpected)
+			}
+		})
+	}
+}
+
+func TestNewMCPSDKServer_GetToolsToAddReturnsError(t *testing.T) {
+	// Arrange
+	mockServerConfig := &mocks.MockServerConfig{}
+	defer mockServerConfig.AssertExpectations(t)
+
+	mockConfigurator := &mocks.MockMCPServerConfigurator{}
+	defer mockConfigurator.AssertExpectations(t)
+
+	mockClientRootsHandler := &mocks.MockClientRootsHandler{}
+	defer mockClientRootsHandler.AssertExpectations(t)
+
+	mockCompletionHandler := &mocks.MockCompletionHandler{}
+	defer mockCompletionHandler.AssertExpectations(t)
+
+	mockConfigurator.EXPECT().
+		GetToolsToAdd().
+		Return(nil, assert.AnError).
+		Once()
+
+	// Act
+	mcpServer, err := server.NewMCPSDKServer(mockServerConfig, mockConfigurator, mockClientRootsHandler, mockCompletionHandler)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, mcpServer)
+}
+
+func TestNewMCPSDKServer_CompletionHandler(t *testing.T) {
+	// Arrange
+	mockServerConfig := &mocks.MockServerConfig{}
+	defer mockServerConfig.AssertExpectations(t)
+
+	mockConfigurator := &mocks.MockMCPServerConfigurator{}
+	defer mockConfigurator.AssertExpectations(t)
+
+	mockClientRootsHandler := &mocks.MockClientRootsHandler{}
+	defer mockClientRootsHandler.AssertExpectations(t)
+
+	mockCompletionHandler := &mocks.MockCompletionHandler{}
+	defer mockCompletionHandler.AssertExpectations(t)
+
+	expectedValues := []string{"sin", "sinh"}
+
+	mockConfigurator.EXPECT().
+		GetToolsToAdd().
+		Return([]tools.Tool{}, nil).
+		Once()
+
+	mockServerConfig.EXPECT().
+		ReadOnly().
+		Return(false).
+		Once()
+
+	mockServerConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(true).
+		Once()
+
+	mockServerConfig.EXPECT().
+		RemoteMATLABHost().
+		Return("").
+		Once()
+
+	mockServerConfig.EXPECT().
+		CodingGuidelines().
+		Return(nil).
+		Once()
+
+	mockServerConfig.EXPECT().
+		ReplaceCodingGuidelines().
+		Return(false).
+		Once()
+
+	mockServerConfig.EXPECT().
+		PromptFiles().
+		Return(nil).
+		Once()
+
+	mockServerConfig.EXPECT().
+		Version().
+		Return("1.0.0").
+		Once()
+
+	mockClientRootsHandler.EXPECT().
+		HandleInitialized(mock.Anything, mock.Anything).
+		Return().
+		Once()
+
+	mockCompletionHandler.EXPECT().
+		HandleCompletion(mock.Anything, mock.Anything).
+		Return(&mcp.CompleteResult{Completion: mcp.CompletionResultDetails{Values: expectedValues}}, nil).
+		Once()
+
+	mcpServer, err := server.NewMCPSDKServer(mockServerConfig, mockConfigurator, mockClientRootsHandler, mockCompletionHandler)
+	require.NoError(t, err)
+
+	clientTransport, serverTransport := mcp.NewInMemoryTransports()
+
+	serverSession, err := mcpServer.Connect(t.Context(), serverTransport, nil)
+	require.NoError(t, err)
+	defer func() { _ = serverSession.Close() }()
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil)
+	clientSession, err := client.Connect(t.Context(), clientTransport, nil)
+	require.NoError(t, err)
+	defer func() { _ = clientSession.Close() }()
+
+	// Act
+	result, err := clientSession.Complete(t.Context(), &mcp.CompleteParams{
+		Ref:      &mcp.CompleteReference{Type: "ref/resource", URI: "matlabdoc://{function}"},
+		Argument: mcp.CompleteParamsArgument{Name: "function", Value: "sin"},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotNil(t, clientSession.InitializeResult().Capabilities.Completions)
+	assert.Equal(t, expectedValues, result.Completion.Values)
+}
+
+func getInstructions(t *testing.T, mcpServer *mcp.Server) string {
+	t.Helper()
+
+	clientTransport, serverTransport := mcp.NewInMemoryTransports()
+
+	serverSession, err := mcpServer.Connect(t.Context(), serverTransport, nil)
+	require.NoError(t, err)
+	defer func() { _ = serverSession.Close() }()
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil)
+	clientSession, err := client.Connect(t.Context(), clientTransport, nil)
+	require.NoError(t, err)
+	defer func() { _ = clientSession.Close() }()
+
+	// Notifications are handled in order, so the initialized notification has been handled once the ping returns
+	require.NoError(t, clientSession.Ping(t.Context(), nil))
+
+	return clientSession.InitializeResult().Instructions
+}
@@ -5,6 +5,7 @@ package server
 import (
 	"context"
 
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
@@ -20,8 +21,10 @@ type LifecycleSignaler interface {
 }
 
 type MCPServerConfigurator interface {
-	GetToolsToAdd() []tools.Tool
+	GetToolsToAdd() ([]tools.Tool, error)
+	GetToolMiddlewares() []tools.Middleware
 	GetResourcesToAdd() []resources.Resource
+	GetPromptsToAdd() []prompts.Prompt
 }
 
 type Server struct {
@@ -39,9 +42,14 @@ func New(
 ) (*Server, error) {
 	logger := loggerFactory.GetGlobalLogger()
 
-	toolsToAdd := configurator.GetToolsToAdd()
+	toolsToAdd, err := configurator.GetToolsToAdd()
+	if err != nil {
+		return nil, err
+	}
+
+	toolMiddlewares := configurator.GetToolMiddlewares()
 	for _, tool := range toolsToAdd {
-		if err := tool.AddToServer(mcpserver); err != nil {
+		if err := tool.AddToServer(mcpserver, toolMiddlewares...); err != nil {
 			return nil, err
 		}
 	}
@@ -53,6 +61,12 @@ func New(
 	}
 	logger.With("count", len(resourcesToAdd)).Info("Added resources to MCP SDK server")
 
+	promptsToAdd := configurator.GetPromptsToAdd()
+	for _, prompt := range promptsToAdd {
+		prompt.AddToServer(mcpserver)
+	}
+	logger.With("count", len(promptsToAdd)).Info("Added prompts to MCP SDK server")
+
 	return &Server{
 		mcpServer:         mcpserver,
 		serverLogger:      logger,
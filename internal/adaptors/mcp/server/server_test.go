@@ -5,10 +5,12 @@ package server_test
 import (
 	"testing"
 
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
 	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	promptmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/prompts"
 	resourcemocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/resources"
 	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/server"
 	toolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools"
@@ -32,23 +34,21 @@ func TestNew_HappyPath(t *testing.T) {
 	mockResource := &resourcemocks.MockResource{}
 	defer mockResource.AssertExpectations(t)
 
+	mockPrompt := &promptmocks.MockPrompt{}
+	defer mockPrompt.AssertExpectations(t)
+
 	mockFirstTool := &toolsmocks.MockTool{}
 	defer mockFirstTool.AssertExpectations(t)
 
 	mockSecondTool := &toolsmocks.MockTool{}
 	defer mockSecondTool.AssertExpectations(t)
 
-	mockServerConfig := &mocks.MockServerConfig{}
-	defer mockServerConfig.AssertExpectations(t)
+	mockMiddleware := &toolsmocks.MockMiddleware{}
+	defer mockMiddleware.AssertExpectations(t)
 
 	mockLogger := testutils.NewInspectableLogger()
 
-	mockServerConfig.EXPECT().
-		Version().
-		Return("1.0.0").
-		Once()
-
-	expectedMCPServer := server.NewMCPSDKServer(mockServerConfig)
+	expectedMCPServer := mcp.NewServer(&mcp.Implementation{Name: "test"}, nil)
 
 	mockLoggerFactory.EXPECT().
 		GetGlobalLogger().
@@ -57,7 +57,12 @@ func TestNew_HappyPath(t *testing.T) {
 
 	mockConfigurator.EXPECT().
 		GetToolsToAdd().
-		Return([]tools.Tool{mockFirstTool, mockSecondTool}).
+		Return([]tools.Tool{mockFirstTool, mockSecondTool}, nil).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetToolMiddlewares().
+		Return([]tools.Middleware{mockMiddleware}).
 		Once()
 
 	mockConfigurator.EXPECT().
@@ -65,13 +70,18 @@ func TestNew_HappyPath(t *testing.T) {
 		Return([]resources.Resource{mockResource}).
 		Once()
 
+	mockConfigurator.EXPECT().
+		GetPromptsToAdd().
+		Return([]prompts.Prompt{mockPrompt}).
+		Once()
+
 	mockFirstTool.EXPECT().
-		AddToServer(expectedMCPServer).
+		AddToServer(expectedMCPServer, []tools.Middleware{mockMiddleware}).
 		Return(nil).
 		Once()
 
 	mockSecondTool.EXPECT().
-		AddToServer(expectedMCPServer).
+		AddToServer(expectedMCPServer, []tools.Middleware{mockMiddleware}).
 		Return(nil).
 		Once()
 
@@ -80,6 +90,11 @@ func TestNew_HappyPath(t *testing.T) {
 		Return().
 		Once()
 
+	mockPrompt.EXPECT().
+		AddToServer(expectedMCPServer).
+		Return().
+		Once()
+
 	// Act
 	server, err := server.New(expectedMCPServer, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator)
 
@@ -102,18 +117,10 @@ func TestNew_AddToServerReturnsError(t *testing.T) {
 	mockTool := &toolsmocks.MockTool{}
 	defer mockTool.AssertExpectations(t)
 
-	mockServerConfig := &mocks.MockServerConfig{}
-	defer mockServerConfig.AssertExpectations(t)
-
 	mockLogger := testutils.NewInspectableLogger()
 	expectedError := assert.AnError
 
-	mockServerConfig.EXPECT().
-		Version().
-		Return("1.0.0").
-		Once()
-
-	expectedMCPServer := server.NewMCPSDKServer(mockServerConfig)
+	expectedMCPServer := mcp.NewServer(&mcp.Implementation{Name: "test"}, nil)
 
 	mockLoggerFactory.EXPECT().
 		GetGlobalLogger().
@@ -122,7 +129,12 @@ func TestNew_AddToServerReturnsError(t *testing.T) {
 
 	mockConfigurator.EXPECT().
 		GetToolsToAdd().
-		Return([]tools.Tool{mockTool}).
+		Return([]tools.Tool{mockTool}, nil).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetToolMiddlewares().
+		Return(nil).
 		Once()
 
 	mockTool.EXPECT().
@@ -139,7 +151,7 @@ func TestNew_AddToServerReturnsError(t *testing.T) {
 	assert.Empty(t, server, "Server should be nil when error occurs")
 }
 
-func TestNew_HandlesNoToolsOrResources(t *testing.T) {
+func TestNew_GetToolsToAddReturnsError(t *testing.T) {
 	// Arrange
 	mockLoggerFactory := &mocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
@@ -150,17 +162,41 @@ func TestNew_HandlesNoToolsOrResources(t *testing.T) {
 	mockConfigurator := &mocks.MockMCPServerConfigurator{}
 	defer mockConfigurator.AssertExpectations(t)
 
-	mockServerConfig := &mocks.MockServerConfig{}
-	defer mockServerConfig.AssertExpectations(t)
-
 	mockLogger := testutils.NewInspectableLogger()
+	expectedMCPServer := mcp.NewServer(&mcp.Implementation{Name: "test"}, nil)
 
-	mockServerConfig.EXPECT().
-		Version().
-		Return("1.0.0").
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetToolsToAdd().
+		Return(nil, assert.AnError).
 		Once()
 
-	expectedMCPServer := server.NewMCPSDKServer(mockServerConfig)
+	// Act
+	server, err := server.New(expectedMCPServer, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, server, "Server should be nil when error occurs")
+}
+
+func TestNew_HandlesNoToolsOrResources(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockConfigurator := &mocks.MockMCPServerConfigurator{}
+	defer mockConfigurator.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	expectedMCPServer := mcp.NewServer(&mcp.Implementation{Name: "test"}, nil)
 
 	mockLoggerFactory.EXPECT().
 		GetGlobalLogger().
@@ -169,6 +205,11 @@ func TestNew_HandlesNoToolsOrResources(t *testing.T) {
 
 	mockConfigurator.EXPECT().
 		GetToolsToAdd().
+		Return(nil, nil).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetToolMiddlewares().
 		Return(nil).
 		Once()
 
@@ -177,6 +218,11 @@ func TestNew_HandlesNoToolsOrResources(t *testing.T) {
 		Return(nil).
 		Once()
 
+	mockConfigurator.EXPECT().
+		GetPromptsToAdd().
+		Return(nil).
+		Once()
+
 	// Act
 	server, err := server.New(expectedMCPServer, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator)
 
@@ -196,17 +242,9 @@ func TestServer_Run_HappyPath(t *testing.T) {
 	mockConfigurator := &mocks.MockMCPServerConfigurator{}
 	defer mockConfigurator.AssertExpectations(t)
 
-	mockServerConfig := &mocks.MockServerConfig{}
-	defer mockServerConfig.AssertExpectations(t)
-
 	mockLogger := testutils.NewInspectableLogger()
 
-	mockServerConfig.EXPECT().
-		Version().
-		Return("1.0.0").
-		Once()
-
-	expectedMCPServer := server.NewMCPSDKServer(mockServerConfig)
+	expectedMCPServer := mcp.NewServer(&mcp.Implementation{Name: "test"}, nil)
 
 	mockLoggerFactory.EXPECT().
 		GetGlobalLogger().
@@ -215,6 +253,11 @@ func TestServer_Run_HappyPath(t *testing.T) {
 
 	mockConfigurator.EXPECT().
 		GetToolsToAdd().
+		Return(nil, nil).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetToolMiddlewares().
 		Return(nil).
 		Once()
 
@@ -223,6 +266,11 @@ func TestServer_Run_HappyPath(t *testing.T) {
 		Return(nil).
 		Once()
 
+	mockConfigurator.EXPECT().
+		GetPromptsToAdd().
+		Return(nil).
+		Once()
+
 	capturedShutdownFuncC := make(chan func() error)
 	mockLifecycleSignaler.EXPECT().
 		AddShutdownFunction(mock.AnythingOfType("func() error")).
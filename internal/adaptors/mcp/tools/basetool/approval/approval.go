@@ -0,0 +1,77 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package approval
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+type Config interface {
+	ToolsRequiringApproval() []string
+}
+
+type Confirmer interface {
+	Confirm(ctx context.Context, sessionLogger entities.Logger, message string) (bool, error)
+}
+
+// Middleware asks the user to approve the calls to the tools which require approval,
+// showing the tool arguments, such as the code and the project folder, and only proceeds on acceptance.
+type Middleware struct {
+	config    Config
+	confirmer Confirmer
+}
+
+func New(
+	config Config,
+	confirmer Confirmer,
+) *Middleware {
+	return &Middleware{
+		config:    config,
+		confirmer: confirmer,
+	}
+}
+
+func (m *Middleware) Handle(ctx context.Context, logger entities.Logger, call tools.ToolCall, next tools.ToolCallHandler) (any, error) {
+	if !slices.Contains(m.config.ToolsRequiringApproval(), call.ToolName) {
+		return next(ctx)
+	}
+
+	approved, err := m.confirmer.Confirm(ctx, logger, approvalMessage(call))
+	if err != nil {
+		logger.WithError(err).Warn("Failed to ask the user to approve the tool call")
+		return nil, fmt.Errorf("the %s tool call requires the user's approval, but the approval request failed: %w", call.ToolName, err)
+	}
+
+	decisionLogger := logger.With("approved", approved)
+	if !approved {
+		decisionLogger.Info("User rejected the tool call")
+		return nil, fmt.Errorf("the user rejected the %s tool call", call.ToolName)
+	}
+
+	decisionLogger.Info("User approved the tool call")
+	return next(ctx)
+}
+
+func approvalMessage(call tools.ToolCall) string {
+	var message strings.Builder
+	fmt.Fprintf(&message, "Do you approve this call to the %s tool?\n", call.ToolName)
+
+	for _, name := range slices.Sorted(maps.Keys(call.Arguments)) {
+		value := fmt.Sprint(call.Arguments[name])
+		if strings.Contains(value, "\n") {
+			// Show multi-line values, such as code, as a block
+			fmt.Fprintf(&message, "\n%s:\n%s\n", name, value)
+			continue
+		}
+		fmt.Fprintf(&message, "\n%s: %s\n", name, value)
+	}
+
+	return message.String()
+}
@@ -0,0 +1,197 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package approval_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool/approval"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool/approval"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const expectedMessage = `Do you approve this call to the evaluate_matlab_code tool?
+
+code:
+x = 1;
+disp(x)
+
+project_path: /home/user/project
+`
+
+var toolCall = tools.ToolCall{
+	ToolName: "evaluate_matlab_code",
+	Arguments: map[string]any{
+		"project_path": "/home/user/project",
+		"code":         "x = 1;\ndisp(x)",
+	},
+}
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfirmer := &mocks.MockConfirmer{}
+	defer mockConfirmer.AssertExpectations(t)
+
+	// Act
+	middleware := approval.New(mockConfig, mockConfirmer)
+
+	// Assert
+	assert.NotNil(t, middleware)
+}
+
+func TestMiddleware_Handle_ApprovalNotRequired(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfirmer := &mocks.MockConfirmer{}
+	defer mockConfirmer.AssertExpectations(t)
+
+	expectedOutput := "output"
+
+	mockConfig.EXPECT().
+		ToolsRequiringApproval().
+		Return([]string{"run_matlab_file"}).
+		Once()
+
+	middleware := approval.New(mockConfig, mockConfirmer)
+
+	// Act
+	output, err := middleware.Handle(t.Context(), mockLogger, toolCall, func(ctx context.Context) (any, error) {
+		return expectedOutput, nil
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedOutput, output)
+}
+
+func TestMiddleware_Handle_Approved(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfirmer := &mocks.MockConfirmer{}
+	defer mockConfirmer.AssertExpectations(t)
+
+	expectedOutput := "output"
+
+	mockConfig.EXPECT().
+		ToolsRequiringApproval().
+		Return([]string{"evaluate_matlab_code"}).
+		Once()
+
+	mockConfirmer.EXPECT().
+		Confirm(t.Context(), mockLogger.AsMockArg(), expectedMessage).
+		Return(true, nil).
+		Once()
+
+	middleware := approval.New(mockConfig, mockConfirmer)
+
+	// Act
+	output, err := middleware.Handle(t.Context(), mockLogger, toolCall, func(ctx context.Context) (any, error) {
+		return expectedOutput, nil
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedOutput, output)
+
+	infoLogs := mockLogger.InfoLogs()
+	fields, found := infoLogs["User approved the tool call"]
+	require.True(t, found, "Expected info log not found")
+	assert.Equal(t, true, fields["approved"])
+}
+
+func TestMiddleware_Handle_Rejected(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfirmer := &mocks.MockConfirmer{}
+	defer mockConfirmer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		ToolsRequiringApproval().
+		Return([]string{"evaluate_matlab_code"}).
+		Once()
+
+	mockConfirmer.EXPECT().
+		Confirm(t.Context(), mockLogger.AsMockArg(), expectedMessage).
+		Return(false, nil).
+		Once()
+
+	middleware := approval.New(mockConfig, mockConfirmer)
+
+	nextCalled := false
+
+	// Act
+	output, err := middleware.Handle(t.Context(), mockLogger, toolCall, func(ctx context.Context) (any, error) {
+		nextCalled = true
+		return nil, nil
+	})
+
+	// Assert
+	require.EqualError(t, err, "the user rejected the evaluate_matlab_code tool call")
+	assert.Nil(t, output)
+	assert.False(t, nextCalled, "The tool call should not proceed")
+
+	infoLogs := mockLogger.InfoLogs()
+	fields, found := infoLogs["User rejected the tool call"]
+	require.True(t, found, "Expected info log not found")
+	assert.Equal(t, false, fields["approved"])
+}
+
+func TestMiddleware_Handle_ConfirmError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfirmer := &mocks.MockConfirmer{}
+	defer mockConfirmer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		ToolsRequiringApproval().
+		Return([]string{"evaluate_matlab_code"}).
+		Once()
+
+	mockConfirmer.EXPECT().
+		Confirm(t.Context(), mockLogger.AsMockArg(), expectedMessage).
+		Return(false, assert.AnError).
+		Once()
+
+	middleware := approval.New(mockConfig, mockConfirmer)
+
+	nextCalled := false
+
+	// Act
+	output, err := middleware.Handle(t.Context(), mockLogger, toolCall, func(ctx context.Context) (any, error) {
+		nextCalled = true
+		return nil, nil
+	})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Contains(t, err.Error(), "the evaluate_matlab_code tool call requires the user's approval")
+	assert.Nil(t, output)
+	assert.False(t, nextCalled, "The tool call should not proceed")
+
+	warnLogs := mockLogger.WarnLogs()
+	_, found := warnLogs["Failed to ask the user to approve the tool call"]
+	assert.True(t, found, "Expected warning not found")
+}
@@ -0,0 +1,233 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
+)
+
+const (
+	defaultAuditLogFileName   = "audit.jsonl"
+	defaultAuditLogFileFolder = "matlab-mcp-core-server"
+
+	sessionIDArgument  = "session_id"
+	parametersArgument = "parameters"
+)
+
+type Config interface {
+	AuditLogFile() string
+	BaseDir() string
+}
+
+type OSLayer interface {
+	OpenFile(name string, flag int, perm os.FileMode) (osfacade.File, error)
+	MkdirAll(name string, perm os.FileMode) error
+	UserConfigDir() (string, error)
+}
+
+type PathResolver interface {
+	ResolvePath(filePath string) (string, error)
+}
+
+type LifecycleSignaler interface {
+	AddShutdownFunction(shutdownFcn func() error)
+}
+
+// auditEntry is a line of the audit log.
+type auditEntry struct {
+	Timestamp     time.Time         `json:"timestamp"`
+	ClientName    string            `json:"client_name,omitempty"`
+	ClientVersion string            `json:"client_version,omitempty"`
+	ToolName      string            `json:"tool_name"`
+	SessionID     string            `json:"session_id,omitempty"`
+	Paths         []string          `json:"paths,omitempty"`
+	Code          map[string]string `json:"code,omitempty"`
+	Parameters    []auditParameter  `json:"parameters,omitempty"`
+	DurationMS    int64             `json:"duration_ms"`
+	Success       bool              `json:"success"`
+	Error         string            `json:"error,omitempty"`
+	OutputSize    int               `json:"output_size"`
+}
+
+// auditParameter is a Simulink block parameter set by a tool.
+type auditParameter struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// Middleware appends an entry to the audit log for every tool call,
+// so that there is a record of what the MCP clients ran.
+// It records the code and path arguments which the tools declare in their inputs.
+type Middleware struct {
+	pathResolver PathResolver
+
+	lock *sync.Mutex
+	file osfacade.File
+	now  func() time.Time
+}
+
+func New(
+	config Config,
+	osLayer OSLayer,
+	pathResolver PathResolver,
+	lifecycleSignaler LifecycleSignaler,
+) (*Middleware, error) {
+	auditLogFile := config.AuditLogFile()
+	if auditLogFile == "" {
+		var err error
+		if auditLogFile, err = defaultAuditLogFile(config, osLayer); err != nil {
+			return nil, err
+		}
+	}
+
+	file, err := osLayer.OpenFile(auditLogFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	middleware := &Middleware{
+		pathResolver: pathResolver,
+
+		lock: new(sync.Mutex),
+		file: file,
+		now:  time.Now,
+	}
+
+	lifecycleSignaler.AddShutdownFunction(middleware.close)
+
+	return middleware, nil
+}
+
+// defaultAuditLogFile returns a location which is the same every time the server runs, so that the audit log is kept:
+// the log folder when it is configured, as the server otherwise logs to a new temporary folder, or the user configuration folder.
+func defaultAuditLogFile(config Config, osLayer OSLayer) (string, error) {
+	auditLogFolder := config.BaseDir()
+	if auditLogFolder == "" {
+		userConfigDir, err := osLayer.UserConfigDir()
+		if err != nil {
+			return "", fmt.Errorf("failed to find a folder for the audit log, specify the audit log file: %w", err)
+		}
+		auditLogFolder = filepath.Join(userConfigDir, defaultAuditLogFileFolder)
+	}
+
+	if err := osLayer.MkdirAll(auditLogFolder, 0o700); err != nil {
+		return "", err
+	}
+
+	return filepath.Join(auditLogFolder, defaultAuditLogFileName), nil
+}
+
+func (m *Middleware) Handle(ctx context.Context, logger entities.Logger, call tools.ToolCall, next tools.ToolCallHandler) (any, error) {
+	start := m.now()
+	output, err := next(ctx)
+	duration := m.now().Sub(start)
+
+	entry := m.newEntry(call)
+	entry.Timestamp = start.UTC()
+	entry.DurationMS = duration.Milliseconds()
+	entry.Success = err == nil
+	if err != nil {
+		entry.Error = err.Error()
+	} else {
+		entry.OutputSize = outputSize(output)
+	}
+
+	if writeErr := m.write(entry); writeErr != nil {
+		logger.WithError(writeErr).Error("Failed to write the audit log entry")
+	}
+
+	return output, err
+}
+
+func (m *Middleware) write(entry auditEntry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	_, err = m.file.Write(append(line, '\n'))
+	return err
+}
+
+func (m *Middleware) close() error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return m.file.Close()
+}
+
+func (m *Middleware) newEntry(call tools.ToolCall) auditEntry {
+	entry := auditEntry{
+		ToolName: call.ToolName,
+	}
+
+	if call.Session != nil {
+		if params := call.Session.InitializeParams(); params != nil && params.ClientInfo != nil {
+			entry.ClientName = params.ClientInfo.Name
+			entry.ClientVersion = params.ClientInfo.Version
+		}
+	}
+
+	// In single-session mode, the tools have no session ID, as they all use the same MATLAB session
+	if sessionID, ok := call.Arguments[sessionIDArgument]; ok {
+		entry.SessionID = fmt.Sprint(sessionID)
+	}
+
+	for _, name := range call.CodeArguments {
+		if code, ok := call.Arguments[name].(string); ok && code != "" {
+			if entry.Code == nil {
+				entry.Code = map[string]string{}
+			}
+			entry.Code[name] = code
+		}
+	}
+
+	if parameters, ok := call.Arguments[parametersArgument].([]any); ok {
+		for _, parameter := range parameters {
+			if parameter, ok := parameter.(map[string]any); ok {
+				name, _ := parameter["name"].(string)
+				value, _ := parameter["value"].(string)
+				entry.Parameters = append(entry.Parameters, auditParameter{Name: name, Value: value})
+			}
+		}
+	}
+
+	// The paths are recorded as the path validator resolves them, with symbolic links and .. segments resolved.
+	// Paths which cannot be resolved are recorded as given, as the tools reject them.
+	for _, name := range call.PathArguments {
+		path, ok := call.Arguments[name].(string)
+		if !ok || path == "" {
+			continue
+		}
+		if resolvedPath, err := m.pathResolver.ResolvePath(path); err == nil {
+			path = resolvedPath
+		}
+		entry.Paths = append(entry.Paths, path)
+	}
+	slices.Sort(entry.Paths)
+
+	return entry
+}
+
+// outputSize returns the size, in bytes, of the tool output encoded as JSON.
+func outputSize(output any) int {
+	encodedOutput, err := json.Marshal(output)
+	if err != nil {
+		return 0
+	}
+	return len(encodedOutput)
+}
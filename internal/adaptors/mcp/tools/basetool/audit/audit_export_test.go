@@ -0,0 +1,9 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package audit
+
+import "time"
+
+func (m *Middleware) SetNow(now func() time.Time) {
+	m.now = now
+}
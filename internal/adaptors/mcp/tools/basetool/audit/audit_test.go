@@ -0,0 +1,642 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package audit_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool/audit"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool/audit"
+	osfacademocks "github.com/matlab/matlab-mcp-core-server/mocks/facades/osfacade"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const auditLogFlags = os.O_APPEND | os.O_CREATE | os.O_WRONLY
+
+func TestNew_DefaultAuditLogFile(t *testing.T) {
+	logFolder := filepath.Join("var", "log", "matlab-mcp")
+	userConfigDir := filepath.Join("home", "user", ".config")
+
+	tests := []struct {
+		name                string
+		logFolder           string
+		expectedAuditLogDir string
+		expectUserConfigDir bool
+	}{
+		{
+			name:                "Log folder configured",
+			logFolder:           logFolder,
+			expectedAuditLogDir: logFolder,
+		},
+		{
+			name:                "No log folder configured",
+			logFolder:           "",
+			expectedAuditLogDir: filepath.Join(userConfigDir, "matlab-mcp-core-server"),
+			expectUserConfigDir: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockOSLayer := &mocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			mockPathResolver := &mocks.MockPathResolver{}
+			defer mockPathResolver.AssertExpectations(t)
+
+			mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+			defer mockLifecycleSignaler.AssertExpectations(t)
+
+			mockFile := &osfacademocks.MockFile{}
+			defer mockFile.AssertExpectations(t)
+
+			mockConfig.EXPECT().
+				AuditLogFile().
+				Return("").
+				Once()
+
+			mockConfig.EXPECT().
+				BaseDir().
+				Return(tt.logFolder).
+				Once()
+
+			if tt.expectUserConfigDir {
+				mockOSLayer.EXPECT().
+					UserConfigDir().
+					Return(userConfigDir, nil).
+					Once()
+			}
+
+			mockOSLayer.EXPECT().
+				MkdirAll(tt.expectedAuditLogDir, os.FileMode(0o700)).
+				Return(nil).
+				Once()
+
+			mockOSLayer.EXPECT().
+				OpenFile(filepath.Join(tt.expectedAuditLogDir, "audit.jsonl"), auditLogFlags, os.FileMode(0o600)).
+				Return(mockFile, nil).
+				Once()
+
+			mockLifecycleSignaler.EXPECT().
+				AddShutdownFunction(mock.AnythingOfType("func() error")).
+				Return().
+				Once()
+
+			// Act
+			middleware, err := audit.New(mockConfig, mockOSLayer, mockPathResolver, mockLifecycleSignaler)
+
+			// Assert
+			require.NoError(t, err)
+			assert.NotNil(t, middleware)
+		})
+	}
+}
+
+func TestNew_UserConfigDirError(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockPathResolver := &mocks.MockPathResolver{}
+	defer mockPathResolver.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		AuditLogFile().
+		Return("").
+		Once()
+
+	mockConfig.EXPECT().
+		BaseDir().
+		Return("").
+		Once()
+
+	mockOSLayer.EXPECT().
+		UserConfigDir().
+		Return("", assert.AnError).
+		Once()
+
+	// Act
+	middleware, err := audit.New(mockConfig, mockOSLayer, mockPathResolver, mockLifecycleSignaler)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, middleware)
+}
+
+func TestNew_MkdirAllError(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockPathResolver := &mocks.MockPathResolver{}
+	defer mockPathResolver.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	logFolder := filepath.Join("var", "log", "matlab-mcp")
+
+	mockConfig.EXPECT().
+		AuditLogFile().
+		Return("").
+		Once()
+
+	mockConfig.EXPECT().
+		BaseDir().
+		Return(logFolder).
+		Once()
+
+	mockOSLayer.EXPECT().
+		MkdirAll(logFolder, os.FileMode(0o700)).
+		Return(assert.AnError).
+		Once()
+
+	// Act
+	middleware, err := audit.New(mockConfig, mockOSLayer, mockPathResolver, mockLifecycleSignaler)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, middleware)
+}
+
+func TestNew_ConfiguredAuditLogFile(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockPathResolver := &mocks.MockPathResolver{}
+	defer mockPathResolver.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockFile := &osfacademocks.MockFile{}
+	defer mockFile.AssertExpectations(t)
+
+	auditLogFile := filepath.Join("var", "log", "audit.jsonl")
+
+	mockConfig.EXPECT().
+		AuditLogFile().
+		Return(auditLogFile).
+		Once()
+
+	mockOSLayer.EXPECT().
+		OpenFile(auditLogFile, auditLogFlags, os.FileMode(0o600)).
+		Return(mockFile, nil).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	// Act
+	middleware, err := audit.New(mockConfig, mockOSLayer, mockPathResolver, mockLifecycleSignaler)
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotNil(t, middleware)
+}
+
+func TestNew_OpenFileError(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockPathResolver := &mocks.MockPathResolver{}
+	defer mockPathResolver.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	auditLogFile := filepath.Join("var", "log", "audit.jsonl")
+
+	mockConfig.EXPECT().
+		AuditLogFile().
+		Return(auditLogFile).
+		Once()
+
+	mockOSLayer.EXPECT().
+		OpenFile(auditLogFile, auditLogFlags, os.FileMode(0o600)).
+		Return(nil, assert.AnError).
+		Once()
+
+	// Act
+	middleware, err := audit.New(mockConfig, mockOSLayer, mockPathResolver, mockLifecycleSignaler)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, middleware)
+}
+
+func TestMiddleware_Shutdown_ClosesFile(t *testing.T) {
+	// Arrange
+	mockFile := &osfacademocks.MockFile{}
+	defer mockFile.AssertExpectations(t)
+
+	_, shutdown := newMiddlewareWithShutdown(t, mockFile, &mocks.MockPathResolver{})
+
+	mockFile.EXPECT().
+		Close().
+		Return(nil).
+		Once()
+
+	// Act
+	err := shutdown()
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestMiddleware_Handle_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockFile := &osfacademocks.MockFile{}
+	defer mockFile.AssertExpectations(t)
+
+	mockPathResolver := &mocks.MockPathResolver{}
+	defer mockPathResolver.AssertExpectations(t)
+
+	middleware := newMiddleware(t, mockFile, mockPathResolver)
+
+	serverSession := connect(t, &mcp.Implementation{Name: "test-client", Version: "1.2.3"})
+
+	call := tools.ToolCall{
+		ToolName: "evaluate_matlab_code",
+		Session:  serverSession,
+		Arguments: map[string]any{
+			"session_id":   float64(3),
+			"code":         "disp(1)",
+			"project_path": "/home/user/link",
+		},
+		CodeArguments: []string{"code"},
+		PathArguments: []string{"project_path"},
+	}
+	expectedOutput := map[string]string{"result": "1"}
+
+	mockPathResolver.EXPECT().
+		ResolvePath("/home/user/link").
+		Return("/home/user/project", nil).
+		Once()
+
+	var writtenLine []byte
+	mockFile.EXPECT().
+		Write(mock.Anything).
+		RunAndReturn(func(b []byte) (int, error) {
+			writtenLine = b
+			return len(b), nil
+		}).
+		Once()
+
+	// Act
+	output, err := middleware.Handle(t.Context(), mockLogger, call, func(ctx context.Context) (any, error) {
+		return expectedOutput, nil
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedOutput, output)
+
+	require.True(t, len(writtenLine) > 0 && writtenLine[len(writtenLine)-1] == '\n', "The entry should be a JSON line")
+
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal(writtenLine, &entry))
+	assert.Equal(t, "2025-01-02T03:04:05Z", entry["timestamp"])
+	assert.Equal(t, "test-client", entry["client_name"])
+	assert.Equal(t, "1.2.3", entry["client_version"])
+	assert.Equal(t, "evaluate_matlab_code", entry["tool_name"])
+	assert.Equal(t, "3", entry["session_id"])
+	assert.Equal(t, []any{"/home/user/project"}, entry["paths"])
+	assert.Equal(t, map[string]any{"code": "disp(1)"}, entry["code"])
+	assert.NotContains(t, entry, "parameters")
+	assert.InDelta(t, 1500, entry["duration_ms"], 0)
+	assert.Equal(t, true, entry["success"])
+	assert.NotContains(t, entry, "error")
+	assert.InDelta(t, len(`{"result":"1"}`), entry["output_size"], 0)
+}
+
+func TestMiddleware_Handle_RecordsArguments(t *testing.T) {
+	tests := []struct {
+		name               string
+		arguments          map[string]any
+		codeArguments      []string
+		pathArguments      []string
+		resolvedPaths      map[string]string
+		expectedPaths      any
+		expectedCode       any
+		expectedParameters any
+	}{
+		{
+			name: "Benchmark code",
+			arguments: map[string]any{
+				"code":         "f(x)",
+				"setup_code":   "x = rand(10);",
+				"compare_code": "g(x)",
+				"runs":         float64(5),
+			},
+			codeArguments: []string{"code", "compare_code", "setup_code"},
+			expectedCode:  map[string]any{"code": "f(x)", "setup_code": "x = rand(10);", "compare_code": "g(x)"},
+		},
+		{
+			name: "Breakpoint condition",
+			arguments: map[string]any{
+				"file_path": "/home/user/project/f.m",
+				"line":      float64(3),
+				"condition": "x > 3",
+			},
+			codeArguments: []string{"condition"},
+			pathArguments: []string{"file_path"},
+			resolvedPaths: map[string]string{"/home/user/project/f.m": "/home/user/project/f.m"},
+			expectedPaths: []any{"/home/user/project/f.m"},
+			expectedCode:  map[string]any{"condition": "x > 3"},
+		},
+		{
+			name: "Simulink block parameters",
+			arguments: map[string]any{
+				"block": "vdp/Mu",
+				"parameters": []any{
+					map[string]any{"name": "Gain", "value": "2.5"},
+					map[string]any{"name": "SampleTime", "value": "-1"},
+				},
+			},
+			expectedParameters: []any{
+				map[string]any{"name": "Gain", "value": "2.5"},
+				map[string]any{"name": "SampleTime", "value": "-1"},
+			},
+		},
+		{
+			name: "Simulink model path, stop time and block path",
+			arguments: map[string]any{
+				"model_path": "/home/user/project/vdp.slx",
+				"stop_time":  "10",
+				"block_path": "vdp/Mu",
+			},
+			codeArguments: []string{"stop_time"},
+			pathArguments: []string{"model_path"},
+			resolvedPaths: map[string]string{"/home/user/project/vdp.slx": "/data/project/vdp.slx"},
+			expectedPaths: []any{"/data/project/vdp.slx"},
+			expectedCode:  map[string]any{"stop_time": "10"},
+		},
+		{
+			name: "Paths which cannot be resolved",
+			arguments: map[string]any{
+				"path":               "/home/user/project/missing.m",
+				"configuration_file": "relative.json",
+			},
+			pathArguments: []string{"path", "configuration_file"},
+			expectedPaths: []any{"/home/user/project/missing.m", "relative.json"},
+		},
+		{
+			name: "Arguments the tool does not declare",
+			arguments: map[string]any{
+				"code":        "disp(1)",
+				"script_path": "/home/user/project/f.m",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockFile := &osfacademocks.MockFile{}
+			defer mockFile.AssertExpectations(t)
+
+			mockPathResolver := &mocks.MockPathResolver{}
+			defer mockPathResolver.AssertExpectations(t)
+
+			middleware := newMiddleware(t, mockFile, mockPathResolver)
+
+			for _, name := range tt.pathArguments {
+				path, ok := tt.arguments[name].(string)
+				if !ok {
+					continue
+				}
+				if resolvedPath, ok := tt.resolvedPaths[path]; ok {
+					mockPathResolver.EXPECT().
+						ResolvePath(path).
+						Return(resolvedPath, nil).
+						Once()
+				} else {
+					mockPathResolver.EXPECT().
+						ResolvePath(path).
+						Return("", assert.AnError).
+						Once()
+				}
+			}
+
+			var writtenLine []byte
+			mockFile.EXPECT().
+				Write(mock.Anything).
+				RunAndReturn(func(b []byte) (int, error) {
+					writtenLine = b
+					return len(b), nil
+				}).
+				Once()
+
+			// Act
+			_, err := middleware.Handle(t.Context(), mockLogger, tools.ToolCall{ToolName: "tool", Arguments: tt.arguments, CodeArguments: tt.codeArguments, PathArguments: tt.pathArguments}, func(ctx context.Context) (any, error) {
+				return nil, nil
+			})
+
+			// Assert
+			require.NoError(t, err)
+
+			var entry map[string]any
+			require.NoError(t, json.Unmarshal(writtenLine, &entry))
+			assert.Equal(t, tt.expectedPaths, entry["paths"])
+			assert.Equal(t, tt.expectedCode, entry["code"])
+			assert.Equal(t, tt.expectedParameters, entry["parameters"])
+			assert.NotContains(t, entry, "session_id")
+		})
+	}
+}
+
+func TestMiddleware_Handle_ToolCallError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockFile := &osfacademocks.MockFile{}
+	defer mockFile.AssertExpectations(t)
+
+	mockPathResolver := &mocks.MockPathResolver{}
+	defer mockPathResolver.AssertExpectations(t)
+
+	middleware := newMiddleware(t, mockFile, mockPathResolver)
+
+	call := tools.ToolCall{
+		ToolName: "run_matlab_file",
+		Arguments: map[string]any{
+			"script_path": "/home/user/project/script.m",
+		},
+		PathArguments: []string{"script_path"},
+	}
+
+	mockPathResolver.EXPECT().
+		ResolvePath("/home/user/project/script.m").
+		Return("/home/user/project/script.m", nil).
+		Once()
+
+	var writtenLine []byte
+	mockFile.EXPECT().
+		Write(mock.Anything).
+		RunAndReturn(func(b []byte) (int, error) {
+			writtenLine = b
+			return len(b), nil
+		}).
+		Once()
+
+	// Act
+	output, err := middleware.Handle(t.Context(), mockLogger, call, func(ctx context.Context) (any, error) {
+		return nil, assert.AnError
+	})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, output)
+
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal(writtenLine, &entry))
+	assert.Equal(t, "run_matlab_file", entry["tool_name"])
+	assert.Equal(t, []any{"/home/user/project/script.m"}, entry["paths"])
+	assert.NotContains(t, entry, "code")
+	assert.NotContains(t, entry, "session_id")
+	assert.Equal(t, false, entry["success"])
+	assert.Equal(t, assert.AnError.Error(), entry["error"])
+	assert.InDelta(t, 0, entry["output_size"], 0)
+}
+
+func TestMiddleware_Handle_WriteError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockFile := &osfacademocks.MockFile{}
+	defer mockFile.AssertExpectations(t)
+
+	middleware := newMiddleware(t, mockFile, &mocks.MockPathResolver{})
+
+	expectedOutput := "output"
+
+	mockFile.EXPECT().
+		Write(mock.Anything).
+		Return(0, assert.AnError).
+		Once()
+
+	// Act
+	output, err := middleware.Handle(t.Context(), mockLogger, tools.ToolCall{ToolName: "check_matlab_code"}, func(ctx context.Context) (any, error) {
+		return expectedOutput, nil
+	})
+
+	// Assert
+	require.NoError(t, err, "Failing to write the audit log should not fail the tool call")
+	assert.Equal(t, expectedOutput, output)
+
+	errorLogs := mockLogger.ErrorLogs()
+	_, found := errorLogs["Failed to write the audit log entry"]
+	assert.True(t, found, "Expected error log not found")
+}
+
+// newMiddleware creates a middleware writing to the file, where each call starts at 2025-01-02T03:04:05Z and lasts 1.5 seconds.
+func newMiddleware(t *testing.T, file *osfacademocks.MockFile, pathResolver audit.PathResolver) *audit.Middleware {
+	t.Helper()
+
+	middleware, _ := newMiddlewareWithShutdown(t, file, pathResolver)
+	return middleware
+}
+
+func newMiddlewareWithShutdown(t *testing.T, file *osfacademocks.MockFile, pathResolver audit.PathResolver) (*audit.Middleware, func() error) {
+	t.Helper()
+
+	mockConfig := &mocks.MockConfig{}
+	t.Cleanup(func() { mockConfig.AssertExpectations(t) })
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	t.Cleanup(func() { mockOSLayer.AssertExpectations(t) })
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	t.Cleanup(func() { mockLifecycleSignaler.AssertExpectations(t) })
+
+	var capturedShutdownFunc func() error
+
+	mockConfig.EXPECT().
+		AuditLogFile().
+		Return("audit.jsonl").
+		Once()
+
+	mockOSLayer.EXPECT().
+		OpenFile("audit.jsonl", auditLogFlags, os.FileMode(0o600)).
+		Return(file, nil).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(shutdownFcn func() error) {
+			capturedShutdownFunc = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	middleware, err := audit.New(mockConfig, mockOSLayer, pathResolver, mockLifecycleSignaler)
+	require.NoError(t, err)
+
+	start := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	times := []time.Time{start, start.Add(1500 * time.Millisecond)}
+	middleware.SetNow(func() time.Time {
+		now := times[0]
+		times = times[1:]
+		return now
+	})
+
+	return middleware, capturedShutdownFunc
+}
+
+// connect connects a client to a server, and returns the server session.
+func connect(t *testing.T, clientInfo *mcp.Implementation) *mcp.ServerSession {
+	t.Helper()
+
+	mcpServer := mcp.NewServer(&mcp.Implementation{Name: "test"}, nil)
+	client := mcp.NewClient(clientInfo, nil)
+
+	clientTransport, serverTransport := mcp.NewInMemoryTransports()
+
+	serverSession, err := mcpServer.Connect(t.Context(), serverTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = serverSession.Close() })
+
+	clientSession, err := client.Connect(t.Context(), clientTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = clientSession.Close() })
+
+	return serverSession
+}
@@ -39,6 +39,16 @@ func (t tool[_, _]) Description() string {
 	return t.description
 }
 
+// CodeArguments returns the inputs of the tool holding MATLAB code, as the audit tag of the inputs declares them.
+func (_ tool[ToolInput, _]) CodeArguments() []string {
+	return argumentsWithAuditTag[ToolInput](auditTagCode)
+}
+
+// PathArguments returns the inputs of the tool holding file system paths, as the audit tag of the inputs declares them.
+func (_ tool[ToolInput, _]) PathArguments() []string {
+	return argumentsWithAuditTag[ToolInput](auditTagPath)
+}
+
 func (_ tool[ToolInput, _]) GetInputSchema() (any, error) {
 	return jsonschema.For[ToolInput](&jsonschema.ForOptions{})
 }
@@ -0,0 +1,56 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package debugguard
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/debugsession"
+)
+
+const controlDebuggerToolName = "control_matlab_debugger"
+
+// allowedToolNames are the tools which can run while debugging: the debugger tools themselves,
+// and restarting MATLAB, to recover from code which never pauses nor ends.
+var allowedToolNames = []string{
+	controlDebuggerToolName,
+	"set_matlab_breakpoint",
+	"clear_matlab_breakpoints",
+	"restart_matlab_session",
+}
+
+type DebugSession interface {
+	State() debugsession.State
+}
+
+// Middleware refuses the calls to the tools, other than the debugger ones, while code runs or is paused in the debugger,
+// as MATLAB would run them within the paused code.
+type Middleware struct {
+	debugSession DebugSession
+}
+
+func New(
+	debugSession DebugSession,
+) *Middleware {
+	return &Middleware{
+		debugSession: debugSession,
+	}
+}
+
+func (m *Middleware) Handle(ctx context.Context, logger entities.Logger, call tools.ToolCall, next tools.ToolCallHandler) (any, error) {
+	if slices.Contains(allowedToolNames, call.ToolName) {
+		return next(ctx)
+	}
+
+	state := m.debugSession.State()
+	if state == debugsession.StateIdle {
+		return next(ctx)
+	}
+
+	logger.With("state", state).Info("Refused the tool call while debugging")
+	return nil, fmt.Errorf("the %s tool cannot run while the MATLAB code is %s in the debugger, use the %s tool to continue or quit the debugging first", call.ToolName, state, controlDebuggerToolName)
+}
@@ -0,0 +1,106 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package debugguard_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool/debugguard"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/debugsession"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool/debugguard"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const expectedOutput = "output"
+
+func next(ctx context.Context) (any, error) {
+	return expectedOutput, nil
+}
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockDebugSession := &mocks.MockDebugSession{}
+	defer mockDebugSession.AssertExpectations(t)
+
+	// Act
+	middleware := debugguard.New(mockDebugSession)
+
+	// Assert
+	assert.NotNil(t, middleware)
+}
+
+func TestMiddleware_Handle_Idle(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockDebugSession := &mocks.MockDebugSession{}
+	defer mockDebugSession.AssertExpectations(t)
+
+	mockDebugSession.EXPECT().
+		State().
+		Return(debugsession.StateIdle).
+		Once()
+
+	middleware := debugguard.New(mockDebugSession)
+
+	// Act
+	output, err := middleware.Handle(t.Context(), mockLogger, tools.ToolCall{ToolName: "evaluate_matlab_code"}, next)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedOutput, output)
+}
+
+func TestMiddleware_Handle_Debugging(t *testing.T) {
+	for _, state := range []debugsession.State{debugsession.StatePaused, debugsession.StateRunning} {
+		t.Run(string(state), func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockDebugSession := &mocks.MockDebugSession{}
+			defer mockDebugSession.AssertExpectations(t)
+
+			mockDebugSession.EXPECT().
+				State().
+				Return(state).
+				Once()
+
+			middleware := debugguard.New(mockDebugSession)
+
+			// Act
+			output, err := middleware.Handle(t.Context(), mockLogger, tools.ToolCall{ToolName: "evaluate_matlab_code"}, func(ctx context.Context) (any, error) {
+				t.Fatal("the tool should not run")
+				return nil, nil
+			})
+
+			// Assert
+			require.EqualError(t, err, "the evaluate_matlab_code tool cannot run while the MATLAB code is "+string(state)+" in the debugger, use the control_matlab_debugger tool to continue or quit the debugging first")
+			assert.Nil(t, output)
+		})
+	}
+}
+
+func TestMiddleware_Handle_AllowedTools(t *testing.T) {
+	for _, toolName := range []string{"control_matlab_debugger", "set_matlab_breakpoint", "clear_matlab_breakpoints", "restart_matlab_session"} {
+		t.Run(toolName, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockDebugSession := &mocks.MockDebugSession{}
+			defer mockDebugSession.AssertExpectations(t)
+
+			middleware := debugguard.New(mockDebugSession)
+
+			// Act
+			output, err := middleware.Handle(t.Context(), mockLogger, tools.ToolCall{ToolName: toolName}, next)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, expectedOutput, output)
+		})
+	}
+}
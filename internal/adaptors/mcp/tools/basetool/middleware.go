@@ -0,0 +1,75 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package basetool
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// handleWithMiddlewares runs the handler within the middlewares, the first middleware being the outermost one.
+func handleWithMiddlewares(ctx context.Context, logger entities.Logger, middlewares []tools.Middleware, call tools.ToolCall, handler tools.ToolCallHandler) (any, error) {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		middleware, next := middlewares[i], handler
+		handler = func(ctx context.Context) (any, error) {
+			return middleware.Handle(ctx, logger, call, next)
+		}
+	}
+
+	return handler(ctx)
+}
+
+// auditTag is the struct tag with which the tool inputs declare the arguments the middlewares must record:
+// "code" for MATLAB code, or expressions that MATLAB evaluates, and "path" for file system paths.
+const auditTag = "audit"
+
+const (
+	auditTagCode = "code"
+	auditTagPath = "path"
+)
+
+func newToolCall[ToolInput any](toolName string, session *mcp.ServerSession, input ToolInput) tools.ToolCall {
+	arguments := map[string]any{}
+
+	// The input was decoded from JSON, so encoding it again cannot fail
+	if encodedInput, err := json.Marshal(input); err == nil {
+		_ = json.Unmarshal(encodedInput, &arguments)
+	}
+
+	return tools.ToolCall{
+		ToolName:      toolName,
+		Session:       session,
+		Arguments:     arguments,
+		CodeArguments: argumentsWithAuditTag[ToolInput](auditTagCode),
+		PathArguments: argumentsWithAuditTag[ToolInput](auditTagPath),
+	}
+}
+
+// argumentsWithAuditTag returns the JSON names of the tool inputs whose audit tag has the given value.
+func argumentsWithAuditTag[ToolInput any](value string) []string {
+	inputType := reflect.TypeFor[ToolInput]()
+	if inputType.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var arguments []string
+	for i := range inputType.NumField() {
+		field := inputType.Field(i)
+		if field.Tag.Get(auditTag) != value {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "" {
+			name = field.Name
+		}
+		arguments = append(arguments, name)
+	}
+
+	return arguments
+}
@@ -7,6 +7,8 @@ import (
 	"fmt"
 
 	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/elicitation"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/facades/mcpfacade"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
@@ -40,7 +42,7 @@ func NewToolWithStructuredContent[ToolInput, ToolOutput any](
 	}
 }
 
-func (t ToolWithStructuredContentOutput[_, _]) AddToServer(server *mcp.Server) error {
+func (t ToolWithStructuredContentOutput[_, _]) AddToServer(server *mcp.Server, middlewares ...tools.Middleware) error {
 	inputSchema, err := t.GetInputSchema()
 	if err != nil {
 		return err
@@ -60,19 +62,25 @@ func (t ToolWithStructuredContentOutput[_, _]) AddToServer(server *mcp.Server) e
 			InputSchema:  inputSchema,
 			OutputSchema: outputSchema,
 		},
-		t.Handler(),
+		t.handlerWithMiddlewares(middlewares),
 	)
 
 	return nil
 }
 
 func (t ToolWithStructuredContentOutput[ToolInput, ToolOutput]) Handler() mcp.ToolHandlerFor[ToolInput, ToolOutput] {
+	return t.handlerWithMiddlewares(nil)
+}
+
+func (t ToolWithStructuredContentOutput[ToolInput, ToolOutput]) handlerWithMiddlewares(middlewares []tools.Middleware) mcp.ToolHandlerFor[ToolInput, ToolOutput] {
 	return func(ctx context.Context, req *mcp.CallToolRequest, input ToolInput) (*mcp.CallToolResult, ToolOutput, error) {
 		logger := t.loggerFactory.NewMCPSessionLogger(req.Session).
 			With("tool-name", t.name)
 		logger.Debug("Handling tool call request")
 		defer logger.Debug("Handled tool call request")
 
+		ctx = elicitation.ContextWithSession(ctx, req.Session)
+
 		var toolOutputZeroValue ToolOutput
 
 		if t.structuredContentHandler == nil {
@@ -81,11 +89,20 @@ func (t ToolWithStructuredContentOutput[ToolInput, ToolOutput]) Handler() mcp.To
 			return nil, toolOutputZeroValue, err
 		}
 
-		toolOutput, err := t.structuredContentHandler(ctx, logger, input)
+		output, err := handleWithMiddlewares(ctx, logger, middlewares, newToolCall(t.name, req.Session, input), func(ctx context.Context) (any, error) {
+			return t.structuredContentHandler(ctx, logger, input)
+		})
 		if err != nil {
 			logger.WithError(err).Warn("Structured handler returned an error")
 			return nil, toolOutputZeroValue, err
 		}
+
+		toolOutput, ok := output.(ToolOutput)
+		if !ok {
+			err := fmt.Errorf(UnexpectedErrorPrefixForLLM+"unexpected tool output type: %T", output)
+			logger.WithError(err).Warn("Middleware returned an unexpected output")
+			return nil, toolOutputZeroValue, err
+		}
 		return nil, toolOutput, nil
 	}
 }
@@ -7,9 +7,12 @@ import (
 	"testing"
 
 	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/elicitation"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	toolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools"
 	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/stretchr/testify/assert"
@@ -272,5 +275,244 @@ func TestToolWithStructuredContentOutput_Handler_ContextPropagation(t *testing.T
 
 	// Assert
 	require.NoError(t, err, "Handler should not return an error")
-	assert.Equal(t, t.Context(), capturedContext, "Context should be propagated to handler")
+	assert.Equal(t, elicitation.ContextWithSession(t.Context(), expectedSession), capturedContext, "Context should be propagated to handler, with the MCP session")
+}
+
+func TestToolWithStructuredContentOutput_AddToServer_WithMiddlewares(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockAdder := &mocks.MockToolAdder[TestInput, TestOutput]{}
+	defer mockAdder.AssertExpectations(t)
+
+	mockOuterMiddleware := &toolsmocks.MockMiddleware{}
+	defer mockOuterMiddleware.AssertExpectations(t)
+
+	mockInnerMiddleware := &toolsmocks.MockMiddleware{}
+	defer mockInnerMiddleware.AssertExpectations(t)
+
+	mockGlobalLogger := testutils.NewInspectableLogger()
+	mockSessionLogger := testutils.NewInspectableLogger()
+	expectedSession := &mcp.ServerSession{}
+	expectedInput := TestInput{Message: "test message"}
+	expectedToolCall := tools.ToolCall{
+		ToolName:  "test-tool",
+		Session:   expectedSession,
+		Arguments: map[string]any{"message": "test message"},
+	}
+
+	calls := []string{}
+	handler := func(ctx context.Context, logger entities.Logger, input TestInput) (TestOutput, error) {
+		calls = append(calls, "handler")
+		return TestOutput{Result: "processed: " + input.Message}, nil
+	}
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockGlobalLogger).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(expectedSession).
+		Return(mockSessionLogger).
+		Once()
+
+	mockOuterMiddleware.EXPECT().
+		Handle(mock.Anything, mock.Anything, expectedToolCall, mock.Anything).
+		RunAndReturn(func(ctx context.Context, logger entities.Logger, call tools.ToolCall, next tools.ToolCallHandler) (any, error) {
+			calls = append(calls, "outer middleware")
+			return next(ctx)
+		}).
+		Once()
+
+	mockInnerMiddleware.EXPECT().
+		Handle(mock.Anything, mock.Anything, expectedToolCall, mock.Anything).
+		RunAndReturn(func(ctx context.Context, logger entities.Logger, call tools.ToolCall, next tools.ToolCallHandler) (any, error) {
+			calls = append(calls, "inner middleware")
+			return next(ctx)
+		}).
+		Once()
+
+	tool := basetool.NewToolWithStructuredContent(
+		"test-tool",
+		"Test Tool",
+		"A test tool",
+		mockLoggerFactory,
+		handler,
+	)
+
+	var addedHandler mcp.ToolHandlerFor[TestInput, TestOutput]
+	mockAdder.EXPECT().
+		AddTool(mock.Anything, mock.Anything, mock.Anything).
+		Run(func(server *mcp.Server, tool *mcp.Tool, handler mcp.ToolHandlerFor[TestInput, TestOutput]) {
+			addedHandler = handler
+		}).
+		Return().
+		Once()
+
+	tool.SetToolAdder(mockAdder)
+
+	err := tool.AddToServer(mcp.NewServer(&mcp.Implementation{}, &mcp.ServerOptions{}), mockOuterMiddleware, mockInnerMiddleware)
+	require.NoError(t, err, "AddToServer should not return an error")
+
+	// Act
+	result, output, err := addedHandler(t.Context(), &mcp.CallToolRequest{Session: expectedSession}, expectedInput)
+
+	// Assert
+	require.NoError(t, err, "Handler should not return an error")
+	assert.Nil(t, result, "Result should be nil for structured content output")
+	assert.Equal(t, TestOutput{Result: "processed: test message"}, output, "Output should match expected output")
+	assert.Equal(t, []string{"outer middleware", "inner middleware", "handler"}, calls, "Middlewares should run in order, around the handler")
+}
+
+func TestToolWithStructuredContentOutput_AddToServer_MiddlewareError(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockAdder := &mocks.MockToolAdder[TestInput, TestOutput]{}
+	defer mockAdder.AssertExpectations(t)
+
+	mockMiddleware := &toolsmocks.MockMiddleware{}
+	defer mockMiddleware.AssertExpectations(t)
+
+	mockGlobalLogger := testutils.NewInspectableLogger()
+	mockSessionLogger := testutils.NewInspectableLogger()
+	expectedSession := &mcp.ServerSession{}
+	expectedError := assert.AnError
+
+	handlerCalled := false
+	handler := func(ctx context.Context, logger entities.Logger, input TestInput) (TestOutput, error) {
+		handlerCalled = true
+		return TestOutput{}, nil
+	}
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockGlobalLogger).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(expectedSession).
+		Return(mockSessionLogger).
+		Once()
+
+	mockMiddleware.EXPECT().
+		Handle(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, expectedError).
+		Once()
+
+	tool := basetool.NewToolWithStructuredContent(
+		"test-tool",
+		"Test Tool",
+		"A test tool",
+		mockLoggerFactory,
+		handler,
+	)
+
+	var addedHandler mcp.ToolHandlerFor[TestInput, TestOutput]
+	mockAdder.EXPECT().
+		AddTool(mock.Anything, mock.Anything, mock.Anything).
+		Run(func(server *mcp.Server, tool *mcp.Tool, handler mcp.ToolHandlerFor[TestInput, TestOutput]) {
+			addedHandler = handler
+		}).
+		Return().
+		Once()
+
+	tool.SetToolAdder(mockAdder)
+
+	err := tool.AddToServer(mcp.NewServer(&mcp.Implementation{}, &mcp.ServerOptions{}), mockMiddleware)
+	require.NoError(t, err, "AddToServer should not return an error")
+
+	// Act
+	result, output, err := addedHandler(t.Context(), &mcp.CallToolRequest{Session: expectedSession}, TestInput{Message: "test message"})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Handler should return the middleware error")
+	assert.Nil(t, result, "Result should be nil when error occurs")
+	assert.Empty(t, output, "Output should be zero value when error occurs")
+	assert.False(t, handlerCalled, "Handler should not be called when a middleware stops the tool call")
+}
+
+type TestAuditedInput struct {
+	ProjectPath string `json:"project_path" audit:"path"`
+	Code        string `json:"code" audit:"code"`
+	SetupCode   string `json:"setup_code,omitempty" audit:"code"`
+	Runs        int    `json:"runs,omitempty"`
+}
+
+func TestToolWithStructuredContentOutput_AddToServer_DeclaresAuditedArguments(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockAdder := &mocks.MockToolAdder[TestAuditedInput, TestOutput]{}
+	defer mockAdder.AssertExpectations(t)
+
+	mockMiddleware := &toolsmocks.MockMiddleware{}
+	defer mockMiddleware.AssertExpectations(t)
+
+	mockGlobalLogger := testutils.NewInspectableLogger()
+	mockSessionLogger := testutils.NewInspectableLogger()
+	expectedSession := &mcp.ServerSession{}
+	input := TestAuditedInput{ProjectPath: "/home/user/project", Code: "f(x)", Runs: 3}
+	expectedToolCall := tools.ToolCall{
+		ToolName:      "test-tool",
+		Session:       expectedSession,
+		Arguments:     map[string]any{"project_path": "/home/user/project", "code": "f(x)", "runs": float64(3)},
+		CodeArguments: []string{"code", "setup_code"},
+		PathArguments: []string{"project_path"},
+	}
+
+	handler := func(ctx context.Context, logger entities.Logger, input TestAuditedInput) (TestOutput, error) {
+		return TestOutput{}, nil
+	}
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockGlobalLogger).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(expectedSession).
+		Return(mockSessionLogger).
+		Once()
+
+	mockMiddleware.EXPECT().
+		Handle(mock.Anything, mock.Anything, expectedToolCall, mock.Anything).
+		RunAndReturn(func(ctx context.Context, logger entities.Logger, call tools.ToolCall, next tools.ToolCallHandler) (any, error) {
+			return next(ctx)
+		}).
+		Once()
+
+	tool := basetool.NewToolWithStructuredContent(
+		"test-tool",
+		"Test Tool",
+		"A test tool",
+		mockLoggerFactory,
+		handler,
+	)
+
+	var addedHandler mcp.ToolHandlerFor[TestAuditedInput, TestOutput]
+	mockAdder.EXPECT().
+		AddTool(mock.Anything, mock.Anything, mock.Anything).
+		Run(func(server *mcp.Server, tool *mcp.Tool, handler mcp.ToolHandlerFor[TestAuditedInput, TestOutput]) {
+			addedHandler = handler
+		}).
+		Return().
+		Once()
+
+	tool.SetToolAdder(mockAdder)
+
+	err := tool.AddToServer(mcp.NewServer(&mcp.Implementation{}, &mcp.ServerOptions{}), mockMiddleware)
+	require.NoError(t, err, "AddToServer should not return an error")
+
+	// Act
+	_, _, err = addedHandler(t.Context(), &mcp.CallToolRequest{Session: expectedSession}, input)
+
+	// Assert
+	require.NoError(t, err, "Handler should not return an error")
+	assert.Equal(t, []string{"code", "setup_code"}, tool.CodeArguments(), "The code arguments should be the inputs with the code audit tag")
+	assert.Equal(t, []string{"project_path"}, tool.PathArguments(), "The path arguments should be the inputs with the path audit tag")
 }
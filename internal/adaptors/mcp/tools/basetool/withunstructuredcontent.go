@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/elicitation"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/facades/mcpfacade"
@@ -41,7 +42,7 @@ func NewToolWithUnstructuredContent[ToolInput any](
 	}
 }
 
-func (t ToolWithUnstructuredContentOutput[_]) AddToServer(server *mcp.Server) error {
+func (t ToolWithUnstructuredContentOutput[_]) AddToServer(server *mcp.Server, middlewares ...tools.Middleware) error {
 	inputSchema, err := t.GetInputSchema()
 	if err != nil {
 		return err
@@ -56,30 +57,45 @@ func (t ToolWithUnstructuredContentOutput[_]) AddToServer(server *mcp.Server) er
 			InputSchema:  inputSchema,
 			OutputSchema: nil,
 		},
-		t.Handler(),
+		t.handlerWithMiddlewares(middlewares),
 	)
 
 	return nil
 }
 
 func (t ToolWithUnstructuredContentOutput[ToolInput]) Handler() mcp.ToolHandlerFor[ToolInput, any] {
+	return t.handlerWithMiddlewares(nil)
+}
+
+func (t ToolWithUnstructuredContentOutput[ToolInput]) handlerWithMiddlewares(middlewares []tools.Middleware) mcp.ToolHandlerFor[ToolInput, any] {
 	return func(ctx context.Context, req *mcp.CallToolRequest, input ToolInput) (*mcp.CallToolResult, any, error) {
 		logger := t.loggerFactory.NewMCPSessionLogger(req.Session).
 			With("tool-name", t.name)
 		logger.Debug("Handling tool call request")
 		defer logger.Debug("Handled tool call request")
 
+		ctx = elicitation.ContextWithSession(ctx, req.Session)
+
 		if t.unstructuredContentHandler == nil {
 			err := fmt.Errorf(UnexpectedErrorPrefixForLLM + "no unstructured handler available")
 			logger.WithError(err).Warn("Unstructured content handler is nil")
 			return nil, nil, err
 		}
 
-		richContent, err := t.unstructuredContentHandler(ctx, logger, input)
+		output, err := handleWithMiddlewares(ctx, logger, middlewares, newToolCall(t.name, req.Session, input), func(ctx context.Context) (any, error) {
+			return t.unstructuredContentHandler(ctx, logger, input)
+		})
 		if err != nil {
 			logger.WithError(err).Warn("Unstructured handler returned an error")
 			return nil, nil, err
 		}
+
+		richContent, ok := output.(tools.RichContent)
+		if !ok {
+			err := fmt.Errorf(UnexpectedErrorPrefixForLLM+"unexpected tool output type: %T", output)
+			logger.WithError(err).Warn("Middleware returned an unexpected output")
+			return nil, nil, err
+		}
 		return richContentToUnstructuredContent(richContent), nil, nil
 	}
 }
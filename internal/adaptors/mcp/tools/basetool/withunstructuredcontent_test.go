@@ -7,10 +7,12 @@ import (
 	"testing"
 
 	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/elicitation"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	toolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools"
 	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/stretchr/testify/assert"
@@ -452,5 +454,83 @@ func TestToolWithUnstructuredContentOutput_Handler_ContextPropagation(t *testing
 
 	// Assert
 	require.NoError(t, err, "Handler should not return an error")
-	assert.Equal(t, t.Context(), <-contextReceived, "Context should be propagated to handler")
+	assert.Equal(t, elicitation.ContextWithSession(t.Context(), expectedSession), <-contextReceived, "Context should be propagated to handler, with the MCP session")
+}
+
+func TestToolWithUnstructuredContentOutput_AddToServer_WithMiddlewares(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockAdder := &mocks.MockToolAdder[TestUnstructuredInput, any]{}
+	defer mockAdder.AssertExpectations(t)
+
+	mockMiddleware := &toolsmocks.MockMiddleware{}
+	defer mockMiddleware.AssertExpectations(t)
+
+	mockGlobalLogger := testutils.NewInspectableLogger()
+	mockSessionLogger := testutils.NewInspectableLogger()
+	expectedSession := &mcp.ServerSession{}
+	expectedInput := TestUnstructuredInput{Query: "test query"}
+	expectedToolCall := tools.ToolCall{
+		ToolName:  "test-tool",
+		Session:   expectedSession,
+		Arguments: map[string]any{"query": "test query"},
+	}
+
+	handler := func(ctx context.Context, logger entities.Logger, input TestUnstructuredInput) (tools.RichContent, error) {
+		return tools.RichContent{TextContent: []string{"processed: " + input.Query}}, nil
+	}
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockGlobalLogger).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(expectedSession).
+		Return(mockSessionLogger).
+		Once()
+
+	mockMiddleware.EXPECT().
+		Handle(mock.Anything, mock.Anything, expectedToolCall, mock.Anything).
+		RunAndReturn(func(ctx context.Context, logger entities.Logger, call tools.ToolCall, next tools.ToolCallHandler) (any, error) {
+			return next(ctx)
+		}).
+		Once()
+
+	tool := basetool.NewToolWithUnstructuredContent(
+		"test-tool",
+		"Test Tool",
+		"A test tool",
+		mockLoggerFactory,
+		handler,
+	)
+
+	var addedHandler mcp.ToolHandlerFor[TestUnstructuredInput, any]
+	mockAdder.EXPECT().
+		AddTool(mock.Anything, mock.Anything, mock.Anything).
+		Run(func(server *mcp.Server, tool *mcp.Tool, handler mcp.ToolHandlerFor[TestUnstructuredInput, any]) {
+			addedHandler = handler
+		}).
+		Return().
+		Once()
+
+	tool.SetToolAdder(mockAdder)
+
+	err := tool.AddToServer(mcp.NewServer(&mcp.Implementation{}, &mcp.ServerOptions{}), mockMiddleware)
+	require.NoError(t, err, "AddToServer should not return an error")
+
+	// Act
+	result, output, err := addedHandler(t.Context(), &mcp.CallToolRequest{Session: expectedSession}, expectedInput)
+
+	// Assert
+	require.NoError(t, err, "Handler should not return an error")
+	assert.Nil(t, output, "Output should be nil for unstructured content")
+	require.NotNil(t, result, "Result should not be nil")
+	require.Len(t, result.Content, 1, "Should have 1 content item")
+
+	textContent, ok := result.Content[0].(*mcp.TextContent)
+	require.True(t, ok, "Content should be text content")
+	assert.Equal(t, "processed: test query", textContent.Text, "Text content should match")
 }
@@ -5,7 +5,7 @@ package listavailablematlabs
 const (
 	name        = "list_available_matlabs"
 	title       = "List Available MATLABs"
-	description = "List the installed MATLAB versions on the host and their root directories."
+	description = "List the installed MATLAB versions on the host, their root directories, and the products installed in each of them. Use the installed products to choose a MATLAB that has the toolboxes you need."
 )
 
 type Args struct{}
@@ -15,6 +15,15 @@ type ReturnArgs struct {
 }
 
 type EnvironmentInfo struct {
-	Version    string `json:"version"     jsonschema:"The MATLAB version."`
-	MATLABRoot string `json:"matlab_root" jsonschema:"The MATLAB installation root directory."`
+	Version           string        `json:"version"            jsonschema:"The MATLAB version."`
+	MATLABRoot        string        `json:"matlab_root"        jsonschema:"The MATLAB installation root directory."`
+	UpdateLevel       int           `json:"update_level"       jsonschema:"The update level of the MATLAB release."`
+	IsPrerelease      bool          `json:"is_prerelease"      jsonschema:"Whether the MATLAB is a prerelease."`
+	Architecture      string        `json:"architecture"       jsonschema:"The architecture of the MATLAB installation, such as glnxa64, maca64, maci64 or win64."`
+	InstalledProducts []ProductInfo `json:"installed_products" jsonschema:"The products installed with MATLAB, including toolboxes."`
+}
+
+type ProductInfo struct {
+	Name    string `json:"name"    jsonschema:"The product name."`
+	Version string `json:"version" jsonschema:"The product version."`
 }
@@ -41,9 +41,21 @@ func Handler(usecase Usecase) basetool.HandlerWithStructuredContentOutput[Args,
 func convertToAnnotatedEquivalentType(environmentInfos listavailablematlabs.ReturnArgs) ReturnArgs {
 	convertedEnvironmentInfos := make([]EnvironmentInfo, len(environmentInfos))
 	for i, env := range environmentInfos {
+		installedProducts := make([]ProductInfo, len(env.Products))
+		for j, product := range env.Products {
+			installedProducts[j] = ProductInfo{
+				Name:    product.Name,
+				Version: product.Version,
+			}
+		}
+
 		convertedEnvironmentInfos[i] = EnvironmentInfo{
-			Version:    env.Version,
-			MATLABRoot: env.MATLABRoot,
+			Version:           env.Version,
+			MATLABRoot:        env.MATLABRoot,
+			UpdateLevel:       env.UpdateLevel,
+			IsPrerelease:      env.IsPrerelease,
+			Architecture:      env.Architecture,
+			InstalledProducts: installedProducts,
 		}
 	}
 	return ReturnArgs{
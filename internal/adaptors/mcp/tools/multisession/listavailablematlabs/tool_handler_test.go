@@ -45,12 +45,19 @@ func TestTool_Handler_HappyPath(t *testing.T) {
 	mockLogger := testutils.NewInspectableLogger()
 	mockEnvironments := []entities.EnvironmentInfo{
 		{
-			MATLABRoot: "/path/to/matlab/R2023a",
-			Version:    "R2023a",
+			MATLABRoot:   "/path/to/matlab/R2023a",
+			Version:      "R2023a",
+			UpdateLevel:  2,
+			Architecture: "glnxa64",
+			Products: []entities.ProductInfo{
+				{Name: "MATLAB", Version: "9.14"},
+				{Name: "Signal Processing Toolbox", Version: "9.2"},
+			},
 		},
 		{
-			MATLABRoot: "/path/to/matlab/R2022b",
-			Version:    "R2022b",
+			MATLABRoot:   "/path/to/matlab/R2022b",
+			Version:      "R2022b",
+			IsPrerelease: true,
 		},
 	}
 	ctx := t.Context()
@@ -74,6 +81,15 @@ func TestTool_Handler_HappyPath(t *testing.T) {
 
 	assert.Equal(t, mockEnvironments[1].MATLABRoot, result.AvailableMATLABs[1].MATLABRoot, "Second environment should have correct MATLAB root")
 	assert.Equal(t, mockEnvironments[1].Version, result.AvailableMATLABs[1].Version, "Second environment should have correct version")
+	assert.Equal(t, 2, result.AvailableMATLABs[0].UpdateLevel, "First environment should have correct update level")
+	assert.Equal(t, "glnxa64", result.AvailableMATLABs[0].Architecture, "First environment should have correct architecture")
+	assert.Equal(t, []listavailablematlabs.ProductInfo{
+		{Name: "MATLAB", Version: "9.14"},
+		{Name: "Signal Processing Toolbox", Version: "9.2"},
+	}, result.AvailableMATLABs[0].InstalledProducts, "First environment should have correct installed products")
+	assert.False(t, result.AvailableMATLABs[0].IsPrerelease, "First environment should not be a prerelease")
+	assert.True(t, result.AvailableMATLABs[1].IsPrerelease, "Second environment should be a prerelease")
+	assert.Empty(t, result.AvailableMATLABs[1].InstalledProducts, "Second environment should have no installed products")
 	assert.Len(t, mockLogger.InfoLogs(), 2, "Bounding info logs should be creates")
 }
 
@@ -0,0 +1,26 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package resetmatlabstate
+
+const (
+	name        = "reset_matlab_state"
+	title       = "Reset MATLAB State"
+	description = "Reset the state of an existing MATLAB session, given its session ID (`session_id`), without restarting MATLAB. Choose what to reset: clear workspace variables (`clear_variables`), close figures (`close_figures`), clear cached functions and classes (`clear_functions`), restore the default search path (`restore_default_path`), and change back to the starting folder (`change_to_starting_directory`)."
+)
+
+type Args struct {
+	SessionID                 int  `json:"session_id"                             jsonschema:"The ID of the MATLAB session to reset."`
+	ClearVariables            bool `json:"clear_variables,omitempty"              jsonschema:"Clear all variables from the base workspace."`
+	CloseFigures              bool `json:"close_figures,omitempty"                jsonschema:"Close all open figures."`
+	ClearFunctions            bool `json:"clear_functions,omitempty"              jsonschema:"Clear cached functions and class definitions - This also clears all variables from the base workspace."`
+	RestoreDefaultPath        bool `json:"restore_default_path,omitempty"         jsonschema:"Restore the default MATLAB search path, removing any folders added during the session."`
+	ChangeToStartingDirectory bool `json:"change_to_starting_directory,omitempty" jsonschema:"Change the current folder back to the folder the MATLAB session started in."`
+}
+
+type ReturnArgs struct {
+	ResponseText string `json:"response_text" jsonschema:"A message indicating the result of the operation."`
+}
+
+const (
+	responseTextIfMATLABStateResetSuccessfully = "MATLAB state reset successfully."
+)
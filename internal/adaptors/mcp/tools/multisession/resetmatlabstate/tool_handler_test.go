@@ -0,0 +1,140 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package resetmatlabstate_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/resetmatlabstate"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	resetmatlabstateusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/resetmatlabstate"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/multisession/resetmatlabstate"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	tool := resetmatlabstate.New(mockLoggerFactory, mockUsecase, mockMATLABManager)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const sessionID = 7
+
+	mockMATLABManager.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), entities.SessionID(sessionID)).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, resetmatlabstateusecase.Args{
+			ClearFunctions: true,
+		}).
+		Return(nil).
+		Once()
+
+	// Act
+	result, err := resetmatlabstate.Handler(mockUsecase, mockMATLABManager)(ctx, mockLogger, resetmatlabstate.Args{
+		SessionID:      sessionID,
+		ClearFunctions: true,
+	})
+
+	// Assert
+	require.NoError(t, err, "Handler should not return an error")
+	assert.Equal(t, "MATLAB state reset successfully.", result.ResponseText)
+}
+
+func TestTool_Handler_GetMATLABSessionClientReturnsError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const sessionID = 7
+	expectedError := assert.AnError
+
+	mockMATLABManager.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), entities.SessionID(sessionID)).
+		Return(nil, expectedError).
+		Once()
+
+	// Act
+	result, err := resetmatlabstate.Handler(mockUsecase, mockMATLABManager)(ctx, mockLogger, resetmatlabstate.Args{SessionID: sessionID})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, result)
+}
+
+func TestTool_Handler_UsecaseReturnsError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const sessionID = 7
+	expectedError := assert.AnError
+
+	mockMATLABManager.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), entities.SessionID(sessionID)).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, resetmatlabstateusecase.Args{}).
+		Return(expectedError).
+		Once()
+
+	// Act
+	result, err := resetmatlabstate.Handler(mockUsecase, mockMATLABManager)(ctx, mockLogger, resetmatlabstate.Args{SessionID: sessionID})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, result)
+}
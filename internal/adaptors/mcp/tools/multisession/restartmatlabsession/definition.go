@@ -0,0 +1,23 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package restartmatlabsession
+
+const (
+	name        = "restart_matlab_session"
+	title       = "Restart MATLAB Session"
+	description = "Restarts an existing MATLAB session, given its session ID (`session_id`), with the same MATLAB root and starting directory. The session ID is unchanged. A shared or remote MATLAB session, which the server did not start, cannot be restarted. Optionally preserve workspace variables (`preserved_variables`) across the restart."
+)
+
+type Args struct {
+	SessionID          int      `json:"session_id"                    jsonschema:"The ID of the MATLAB session to restart."`
+	PreservedVariables []string `json:"preserved_variables,omitempty" jsonschema:"Names of workspace variables to preserve across the restart - They are saved to a MAT file before the restart and loaded back afterwards - Example: [\"data\", \"results\"]."`
+}
+
+type ReturnArgs struct {
+	ResponseText       string   `json:"response_text"       jsonschema:"A message indicating the result of the operation."`
+	PreservedVariables []string `json:"preserved_variables" jsonschema:"Names of the workspace variables restored in the restarted MATLAB session."`
+}
+
+const (
+	responseTextIfMATLABSessionRestartedSuccessfully = "MATLAB session restarted successfully."
+)
@@ -0,0 +1,73 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package restartmatlabsession
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/restartmatlabsession"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, session restartmatlabsession.Session, request restartmatlabsession.Args) (restartmatlabsession.ReturnArgs, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+	matlabManager entities.MATLABManager,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase, matlabManager)),
+	}
+}
+
+func Handler(usecase Usecase, matlabManager entities.MATLABManager) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionID := entities.SessionID(inputs.SessionID)
+
+		sessionLogger = sessionLogger.With("session_id", sessionID)
+
+		sessionLogger.Info("Executing restart MATLAB session tool")
+		defer sessionLogger.Info("Done - Executing restart MATLAB session tool")
+
+		response, err := usecase.Execute(ctx, sessionLogger, newManagedSession(matlabManager, sessionID), restartmatlabsession.Args{
+			PreservedVariables: inputs.PreservedVariables,
+		})
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		return ReturnArgs{
+			ResponseText:       responseTextIfMATLABSessionRestartedSuccessfully,
+			PreservedVariables: response.PreservedVariables,
+		}, nil
+	}
+}
+
+// managedSession adapts a session from the MATLAB manager to the session expected by the usecase.
+type managedSession struct {
+	matlabManager entities.MATLABManager
+	sessionID     entities.SessionID
+}
+
+func newManagedSession(matlabManager entities.MATLABManager, sessionID entities.SessionID) *managedSession {
+	return &managedSession{
+		matlabManager: matlabManager,
+		sessionID:     sessionID,
+	}
+}
+
+func (s *managedSession) Client(ctx context.Context, logger entities.Logger) (entities.MATLABSessionClient, error) {
+	return s.matlabManager.GetMATLABSessionClient(ctx, logger, s.sessionID)
+}
+
+func (s *managedSession) Restart(ctx context.Context, logger entities.Logger) error {
+	return s.matlabManager.RestartMATLABSession(ctx, logger, s.sessionID)
+}
@@ -0,0 +1,124 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package restartmatlabsession_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/restartmatlabsession"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	restartmatlabsessionusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/restartmatlabsession"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/multisession/restartmatlabsession"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	tool := restartmatlabsession.New(mockLoggerFactory, mockUsecase, mockMATLABManager)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const sessionID = 3
+	preservedVariables := []string{"a"}
+
+	mockMATLABManager.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), entities.SessionID(sessionID)).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		RestartMATLABSession(ctx, mockLogger.AsMockArg(), entities.SessionID(sessionID)).
+		Return(nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mock.Anything, restartmatlabsessionusecase.Args{
+			PreservedVariables: preservedVariables,
+		}).
+		RunAndReturn(func(ctx context.Context, logger entities.Logger, session restartmatlabsessionusecase.Session, _ restartmatlabsessionusecase.Args) (restartmatlabsessionusecase.ReturnArgs, error) {
+			client, err := session.Client(ctx, logger)
+			require.NoError(t, err)
+			assert.Equal(t, mockMATLABSessionClient, client)
+
+			require.NoError(t, session.Restart(ctx, logger))
+
+			return restartmatlabsessionusecase.ReturnArgs{
+				PreservedVariables: preservedVariables,
+			}, nil
+		}).
+		Once()
+
+	// Act
+	result, err := restartmatlabsession.Handler(mockUsecase, mockMATLABManager)(ctx, mockLogger, restartmatlabsession.Args{
+		SessionID:          sessionID,
+		PreservedVariables: preservedVariables,
+	})
+
+	// Assert
+	require.NoError(t, err, "Handler should not return an error")
+	assert.Equal(t, "MATLAB session restarted successfully.", result.ResponseText)
+	assert.Equal(t, preservedVariables, result.PreservedVariables)
+}
+
+func TestTool_Handler_UsecaseReturnsError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedError := assert.AnError
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mock.Anything, restartmatlabsessionusecase.Args{}).
+		Return(restartmatlabsessionusecase.ReturnArgs{}, expectedError).
+		Once()
+
+	// Act
+	result, err := restartmatlabsession.Handler(mockUsecase, mockMATLABManager)(ctx, mockLogger, restartmatlabsession.Args{SessionID: 3})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, result)
+}
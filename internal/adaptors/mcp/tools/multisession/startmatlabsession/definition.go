@@ -9,14 +9,28 @@ const (
 )
 
 type Args struct {
-	MATLABRoot string `json:"matlab_root" jsonschema:"MATLAB root directory for session."`
+	MATLABRoot string `json:"matlab_root" audit:"path" jsonschema:"MATLAB root directory for session."`
 }
 
 type ReturnArgs struct {
-	ResponseText string `json:"response_text"  jsonschema:"A message indicating the result of the operation."`
-	SessionID    int    `json:"session_id"     jsonschema:"The ID of the newly started MATLAB session."`
-	VerOutput    string `json:"ver_output"     jsonschema:"Output of the ver command, listing installed MATLAB Toolboxes."`
-	AddOnsOutput string `json:"add_ons_output" jsonschema:"List of installed Add-Ons, other than MATLAB Toolboxes (e.g. Support Packages, community Add-Ons)."`
+	ResponseText string        `json:"response_text" jsonschema:"A message indicating the result of the operation."`
+	SessionID    int           `json:"session_id"    jsonschema:"The ID of the newly started MATLAB session."`
+	Products     []ProductInfo `json:"products"      jsonschema:"The installed MathWorks products, including MATLAB Toolboxes."`
+	AddOns       []AddOnInfo   `json:"add_ons"       jsonschema:"The installed Add-Ons, other than MATLAB Toolboxes (e.g. Support Packages, community Add-Ons)."`
+}
+
+type ProductInfo struct {
+	Name       string `json:"name"       jsonschema:"The product name."`
+	Version    string `json:"version"    jsonschema:"The product version."`
+	Release    string `json:"release"    jsonschema:"The MATLAB release of the product, for example R2024b."`
+	Identifier string `json:"identifier" jsonschema:"The product identifier."`
+}
+
+type AddOnInfo struct {
+	Name       string `json:"name"       jsonschema:"The Add-On name."`
+	Version    string `json:"version"    jsonschema:"The Add-On version."`
+	Enabled    bool   `json:"enabled"    jsonschema:"Whether the Add-On is enabled."`
+	Identifier string `json:"identifier" jsonschema:"The Add-On identifier."`
 }
 
 const (
@@ -8,6 +8,7 @@ import (
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/startmatlabsession"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/installedproducts"
 )
 
 type Usecase interface {
@@ -50,7 +51,33 @@ func convertToAnnotatedEquivalentType(response startmatlabsession.ReturnArgs) Re
 	return ReturnArgs{
 		ResponseText: responseTextIfMATLABSessionStartedSuccesfully,
 		SessionID:    int(response.SessionID),
-		VerOutput:    response.VerOutput,
-		AddOnsOutput: response.AddOnsOutput,
+		Products:     convertProducts(response.Products),
+		AddOns:       convertAddOns(response.AddOns),
 	}
 }
+
+func convertProducts(products []installedproducts.Product) []ProductInfo {
+	convertedProducts := make([]ProductInfo, len(products))
+	for i, product := range products {
+		convertedProducts[i] = ProductInfo{
+			Name:       product.Name,
+			Version:    product.Version,
+			Release:    product.Release,
+			Identifier: product.Identifier,
+		}
+	}
+	return convertedProducts
+}
+
+func convertAddOns(addOns []installedproducts.AddOn) []AddOnInfo {
+	convertedAddOns := make([]AddOnInfo, len(addOns))
+	for i, addOn := range addOns {
+		convertedAddOns[i] = AddOnInfo{
+			Name:       addOn.Name,
+			Version:    addOn.Version,
+			Enabled:    addOn.Enabled,
+			Identifier: addOn.Identifier,
+		}
+	}
+	return convertedAddOns
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
 	startmatlabsessionusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/startmatlabsession"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/installedproducts"
 	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
 	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/multisession/startmatlabsession"
 	"github.com/stretchr/testify/assert"
@@ -46,12 +47,14 @@ func TestTool_Handler_HappyPath(t *testing.T) {
 	ctx := t.Context()
 	const matlabRoot = "/path/to/matlab"
 	const expectedSessionID = entities.SessionID(123)
-	const expectedVerOutput = "MATLAB Version: R2023a"
-	const expectedAddOnsOutput = "Installed Add-Ons: Toolbox1, Toolbox2"
 	expectedResponse := startmatlabsessionusecase.ReturnArgs{
-		SessionID:    expectedSessionID,
-		VerOutput:    expectedVerOutput,
-		AddOnsOutput: expectedAddOnsOutput,
+		SessionID: expectedSessionID,
+		Products: []installedproducts.Product{
+			{Name: "MATLAB", Version: "9.14", Release: "R2023a", Identifier: "ML"},
+		},
+		AddOns: []installedproducts.AddOn{
+			{Name: "Toolbox1", Version: "1.0", Enabled: true, Identifier: "toolbox1"},
+		},
 	}
 
 	localSessionDetails := entities.LocalSessionDetails{
@@ -73,8 +76,12 @@ func TestTool_Handler_HappyPath(t *testing.T) {
 	// Assert
 	require.NoError(t, err, "Handler should not return an error")
 	assert.Equal(t, int(expectedSessionID), result.SessionID, "Session ID should match")
-	assert.Equal(t, expectedVerOutput, result.VerOutput, "Ver output should match")
-	assert.Equal(t, expectedAddOnsOutput, result.AddOnsOutput, "AddOns output should match")
+	assert.Equal(t, []startmatlabsession.ProductInfo{
+		{Name: "MATLAB", Version: "9.14", Release: "R2023a", Identifier: "ML"},
+	}, result.Products, "Products should match")
+	assert.Equal(t, []startmatlabsession.AddOnInfo{
+		{Name: "Toolbox1", Version: "1.0", Enabled: true, Identifier: "toolbox1"},
+	}, result.AddOns, "AddOns should match")
 }
 
 func TestTool_Handler_UsecaseError(t *testing.T) {
@@ -0,0 +1,27 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package analyzematlabdependencies
+
+const (
+	name        = "analyze_matlab_dependencies"
+	title       = "Analyze MATLAB Dependencies"
+	description = "Determine the files and MathWorks products required to run MATLAB code (`path`) using MATLAB's matlab.codetools.requiredFilesAndProducts function in an existing MATLAB session. The path can be a single MATLAB code file or a folder, in which case all the .m, .mlx and .mlapp files it contains, including those in subfolders, are analyzed. Returns the required user files, the required products, and the required products which are not installed in the current MATLAB session. This is a non-destructive, read-only operation that does not execute the code."
+)
+
+type Args struct {
+	Path string `json:"path" audit:"path" jsonschema:"The full absolute path to the MATLAB code file or folder to analyze - Must exist - Files are not modified during analysis - Example: C:\\Users\\username\\matlab\\myProject or /home/user/scripts/analysis.m."`
+}
+
+type RequiredProduct struct {
+	Name          string `json:"name" jsonschema:"The name of the product."`
+	Version       string `json:"version" jsonschema:"The version of the product required by the code."`
+	ProductNumber int    `json:"product_number" jsonschema:"The MathWorks product number."`
+	Certain       bool   `json:"certain" jsonschema:"Whether the product is certainly required, or only possibly required."`
+	Installed     bool   `json:"installed" jsonschema:"Whether the product is installed in the current MATLAB session."`
+}
+
+type ReturnArgs struct {
+	RequiredFiles    []string          `json:"required_files" jsonschema:"The user files required to run the code, including the analyzed files."`
+	RequiredProducts []RequiredProduct `json:"required_products" jsonschema:"The MathWorks products required to run the code."`
+	MissingProducts  []string          `json:"missing_products" jsonschema:"The names of the required products which are not installed in the current MATLAB session."`
+}
@@ -0,0 +1,75 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package analyzematlabdependencies
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/analyzematlabdependencies"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request analyzematlabdependencies.Args) (analyzematlabdependencies.ReturnArgs, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase, globalMATLAB)),
+	}
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing Analyze MATLAB dependencies tool")
+		defer sessionLogger.Info("Done - Executing Analyze MATLAB dependencies tool")
+
+		// Not returning nil for empty slices, to comply with MCP spec.
+		mcpCompliantZeroValue := ReturnArgs{
+			RequiredFiles:    []string{},
+			RequiredProducts: []RequiredProduct{},
+			MissingProducts:  []string{},
+		}
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return mcpCompliantZeroValue, err
+		}
+
+		response, err := usecase.Execute(ctx, sessionLogger, client, analyzematlabdependencies.Args{
+			Path: inputs.Path,
+		})
+		if err != nil {
+			return mcpCompliantZeroValue, err
+		}
+
+		return ReturnArgs{
+			RequiredFiles:    response.RequiredFiles,
+			RequiredProducts: convertRequiredProducts(response.RequiredProducts),
+			MissingProducts:  response.MissingProducts,
+		}, nil
+	}
+}
+
+func convertRequiredProducts(products []analyzematlabdependencies.RequiredProduct) []RequiredProduct {
+	result := make([]RequiredProduct, 0, len(products))
+	for _, product := range products {
+		result = append(result, RequiredProduct{
+			Name:          product.Name,
+			Version:       product.Version,
+			ProductNumber: product.ProductNumber,
+			Certain:       product.Certain,
+			Installed:     product.Installed,
+		})
+	}
+	return result
+}
@@ -0,0 +1,160 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package analyzematlabdependencies_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/analyzematlabdependencies"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	analyzematlabdependenciesusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/analyzematlabdependencies"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/singlesession/analyzematlabdependencies"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	tool := analyzematlabdependencies.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const path = "/path/to/project"
+	args := analyzematlabdependencies.Args{
+		Path: path,
+	}
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, analyzematlabdependenciesusecase.Args{Path: path}).
+		Return(analyzematlabdependenciesusecase.ReturnArgs{
+			RequiredFiles: []string{"/path/to/project/main.m"},
+			RequiredProducts: []analyzematlabdependenciesusecase.RequiredProduct{
+				{Name: "MATLAB", Version: "24.2", ProductNumber: 1, Certain: true, Installed: true},
+				{Name: "Signal Processing Toolbox", Version: "24.2", ProductNumber: 8, Certain: false, Installed: false},
+			},
+			MissingProducts: []string{"Signal Processing Toolbox"},
+		}, nil).
+		Once()
+
+	// Act
+	result, err := analyzematlabdependencies.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+
+	// Assert
+	require.NoError(t, err, "Handler should not return an error")
+	assert.Equal(t, analyzematlabdependencies.ReturnArgs{
+		RequiredFiles: []string{"/path/to/project/main.m"},
+		RequiredProducts: []analyzematlabdependencies.RequiredProduct{
+			{Name: "MATLAB", Version: "24.2", ProductNumber: 1, Certain: true, Installed: true},
+			{Name: "Signal Processing Toolbox", Version: "24.2", ProductNumber: 8, Certain: false, Installed: false},
+		},
+		MissingProducts: []string{"Signal Processing Toolbox"},
+	}, result)
+}
+
+func TestTool_Handler_ClientError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedError := assert.AnError
+	args := analyzematlabdependencies.Args{
+		Path: "/path/to/project",
+	}
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(nil, expectedError).
+		Once()
+
+	// Act
+	result, err := analyzematlabdependencies.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Handler should return an error")
+	assert.NotNil(t, result.RequiredFiles, "Required files should not be nil")
+	assert.NotNil(t, result.RequiredProducts, "Required products should not be nil")
+	assert.NotNil(t, result.MissingProducts, "Missing products should not be nil")
+}
+
+func TestTool_Handler_UsecaseError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const path = "/path/to/project"
+	expectedError := assert.AnError
+	args := analyzematlabdependencies.Args{
+		Path: path,
+	}
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, analyzematlabdependenciesusecase.Args{Path: path}).
+		Return(analyzematlabdependenciesusecase.ReturnArgs{}, expectedError).
+		Once()
+
+	// Act
+	result, err := analyzematlabdependencies.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Handler should return an error")
+	assert.NotNil(t, result.RequiredFiles, "Required files should not be nil")
+	assert.NotNil(t, result.RequiredProducts, "Required products should not be nil")
+	assert.NotNil(t, result.MissingProducts, "Missing products should not be nil")
+}
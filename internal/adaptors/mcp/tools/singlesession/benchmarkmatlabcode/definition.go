@@ -0,0 +1,40 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package benchmarkmatlabcode
+
+const (
+	name        = "benchmark_matlab_code"
+	title       = "Benchmark MATLAB Code"
+	description = "Time MATLAB code (`code`) within a project directory (`project_path`) over repeated runs, after warmup runs, and return the median, minimum, maximum, mean and standard deviation of the run times, in seconds. The code is either a snippet, or an expression returning a function handle taking no inputs, such as `@() myFunction(x)`. Optionally times the code with MATLAB's timeit function too (`use_timeit`), and compares it side by side with another implementation (`compare_code`), returning the fastest one and the ratio of the median times. Setup code (`setup_code`), such as creating the inputs, runs before each implementation and is not timed. The change in the memory MATLAB uses across a run is returned where MATLAB can measure it, on Windows only."
+)
+
+type Args struct {
+	ProjectPath string `json:"project_path" audit:"path" jsonschema:"The full path to the project directory - Becomes MATLAB's working directory during execution - Folder must exist - Example: C:\\Users\\username\\matlab-project or /home/user/research."`
+	Code        string `json:"code" audit:"code" jsonschema:"The MATLAB code to benchmark - Either a snippet or an expression returning a function handle taking no inputs - Example: @() myFunction(x)."`
+	CompareCode string `json:"compare_code,omitempty" audit:"code" jsonschema:"Another implementation to compare the code with, in the same form as the code."`
+	SetupCode   string `json:"setup_code,omitempty" audit:"code" jsonschema:"MATLAB code to run before each implementation, without timing it - Example: x = rand(1, 1e6);."`
+	WarmupRuns  *int   `json:"warmup_runs,omitempty" jsonschema:"The number of untimed runs before the timed runs - 0 runs no warmup - At most 100 - Defaults to 1."`
+	Runs        int    `json:"runs,omitempty" jsonschema:"The number of timed runs - At most 1000 - Defaults to 10."`
+	UseTimeit   bool   `json:"use_timeit,omitempty" jsonschema:"Whether to also time the code with MATLAB's timeit function."`
+}
+
+type Result struct {
+	Label           string  `json:"label" jsonschema:"Which implementation the result is for: code or compare_code."`
+	Code            string  `json:"code" jsonschema:"The code of the implementation."`
+	Error           string  `json:"error,omitempty" jsonschema:"The error thrown by the setup code or the implementation, if any."`
+	Runs            int     `json:"runs" jsonschema:"The number of timed runs."`
+	Median          float64 `json:"median" jsonschema:"The median run time."`
+	Min             float64 `json:"min" jsonschema:"The minimum run time."`
+	Max             float64 `json:"max" jsonschema:"The maximum run time."`
+	Mean            float64 `json:"mean" jsonschema:"The mean run time."`
+	StdDev          float64 `json:"std_dev" jsonschema:"The sample standard deviation of the run times."`
+	TimeitTime      float64 `json:"timeit_time,omitempty" jsonschema:"The run time estimated by MATLAB's timeit function, when requested."`
+	MemoryAvailable bool    `json:"memory_available" jsonschema:"Whether MATLAB could measure the memory it uses, which is only the case on Windows."`
+	MemoryBytes     float64 `json:"memory_bytes,omitempty" jsonschema:"The change in bytes of the memory MATLAB uses across a run, when available."`
+}
+
+type ReturnArgs struct {
+	Results []Result `json:"results" jsonschema:"The results of the code, then of the code to compare it with, if any."`
+	Fastest string   `json:"fastest,omitempty" jsonschema:"The label of the implementation with the lowest median time, when comparing two implementations which ran without error."`
+	Speedup float64  `json:"speedup,omitempty" jsonschema:"The median time of the slowest implementation divided by the one of the fastest, when comparing two implementations which ran without error."`
+}
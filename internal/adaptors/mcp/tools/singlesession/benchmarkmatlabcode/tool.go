@@ -0,0 +1,94 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package benchmarkmatlabcode
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/benchmarkmatlabcode"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request benchmarkmatlabcode.Args) (benchmarkmatlabcode.ReturnArgs, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase, globalMATLAB)),
+	}
+}
+
+func (Tool) Name() string {
+	return name
+}
+
+func (Tool) Description() string {
+	return description
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing benchmark MATLAB code tool")
+		defer sessionLogger.Info("Done - Executing benchmark MATLAB code tool")
+
+		// Not returning nil for empty slices, to comply with MCP spec.
+		mcpCompliantZeroValue := ReturnArgs{
+			Results: []Result{},
+		}
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return mcpCompliantZeroValue, err
+		}
+
+		response, err := usecase.Execute(ctx, sessionLogger, client, benchmarkmatlabcode.Args{
+			ProjectPath: inputs.ProjectPath,
+			SetupCode:   inputs.SetupCode,
+			Code:        inputs.Code,
+			CompareCode: inputs.CompareCode,
+			WarmupRuns:  inputs.WarmupRuns,
+			Runs:        inputs.Runs,
+			UseTimeit:   inputs.UseTimeit,
+		})
+		if err != nil {
+			return mcpCompliantZeroValue, err
+		}
+
+		return ReturnArgs{
+			Results: convertResults(response.Results),
+			Fastest: response.Fastest,
+			Speedup: response.Speedup,
+		}, nil
+	}
+}
+
+func convertResults(results []benchmarkmatlabcode.Result) []Result {
+	converted := make([]Result, 0, len(results))
+	for _, result := range results {
+		converted = append(converted, Result{
+			Label:           result.Label,
+			Code:            result.Code,
+			Error:           result.Error,
+			Runs:            result.Runs,
+			Median:          result.Median,
+			Min:             result.Min,
+			Max:             result.Max,
+			Mean:            result.Mean,
+			StdDev:          result.StdDev,
+			TimeitTime:      result.TimeitTime,
+			MemoryAvailable: result.MemoryAvailable,
+			MemoryBytes:     result.MemoryBytes,
+		})
+	}
+	return converted
+}
@@ -0,0 +1,167 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package benchmarkmatlabcode_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/benchmarkmatlabcode"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	benchmarkmatlabcodeusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/benchmarkmatlabcode"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/singlesession/benchmarkmatlabcode"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	tool := benchmarkmatlabcode.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.NotNil(t, tool)
+	assert.Equal(t, "benchmark_matlab_code", tool.Name())
+	assert.NotEmpty(t, tool.Description())
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	warmupRuns := 2
+	args := benchmarkmatlabcode.Args{
+		ProjectPath: "/home/user/project",
+		Code:        "total = 0; for value = x, total = total + value; end",
+		CompareCode: "@() sum(x)",
+		SetupCode:   "x = rand(1, 1e6);",
+		WarmupRuns:  &warmupRuns,
+		Runs:        20,
+		UseTimeit:   true,
+	}
+	usecaseResponse := benchmarkmatlabcodeusecase.ReturnArgs{
+		Results: []benchmarkmatlabcodeusecase.Result{
+			{Label: "code", Code: args.Code, Runs: 20, Median: 0.02, Min: 0.018, Max: 0.03, Mean: 0.021, StdDev: 0.002, TimeitTime: 0.019},
+			{Label: "compare_code", Code: args.CompareCode, Runs: 20, Median: 0.001, Min: 0.0009, Max: 0.002, Mean: 0.0011, StdDev: 0.0001, TimeitTime: 0.001, MemoryAvailable: true, MemoryBytes: 8},
+		},
+		Fastest: "compare_code",
+		Speedup: 20,
+	}
+	expectedResult := benchmarkmatlabcode.ReturnArgs{
+		Results: []benchmarkmatlabcode.Result{
+			{Label: "code", Code: args.Code, Runs: 20, Median: 0.02, Min: 0.018, Max: 0.03, Mean: 0.021, StdDev: 0.002, TimeitTime: 0.019},
+			{Label: "compare_code", Code: args.CompareCode, Runs: 20, Median: 0.001, Min: 0.0009, Max: 0.002, Mean: 0.0011, StdDev: 0.0001, TimeitTime: 0.001, MemoryAvailable: true, MemoryBytes: 8},
+		},
+		Fastest: "compare_code",
+		Speedup: 20,
+	}
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, benchmarkmatlabcodeusecase.Args{
+			ProjectPath: "/home/user/project",
+			Code:        "total = 0; for value = x, total = total + value; end",
+			CompareCode: "@() sum(x)",
+			SetupCode:   "x = rand(1, 1e6);",
+			WarmupRuns:  &warmupRuns,
+			Runs:        20,
+			UseTimeit:   true,
+		}).
+		Return(usecaseResponse, nil).
+		Once()
+
+	// Act
+	result, err := benchmarkmatlabcode.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+
+	// Assert
+	require.NoError(t, err, "Handler should not return an error")
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestTool_Handler_ClientReturnsError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedError := assert.AnError
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(nil, expectedError).
+		Once()
+
+	// Act
+	result, err := benchmarkmatlabcode.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, benchmarkmatlabcode.Args{})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Handler should return an error")
+	assert.NotNil(t, result.Results, "Results should not be nil")
+}
+
+func TestTool_Handler_UsecaseError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedError := assert.AnError
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, benchmarkmatlabcodeusecase.Args{}).
+		Return(benchmarkmatlabcodeusecase.ReturnArgs{}, expectedError).
+		Once()
+
+	// Act
+	result, err := benchmarkmatlabcode.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, benchmarkmatlabcode.Args{})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.NotNil(t, result.Results, "Results should not be nil")
+}
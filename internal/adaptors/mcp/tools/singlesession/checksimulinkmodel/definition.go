@@ -0,0 +1,31 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package checksimulinkmodel
+
+const (
+	name        = "check_simulink_model"
+	title       = "Check Simulink Model"
+	description = "Check a Simulink model loaded with `load_simulink_model` (`model`) with Model Advisor, running either the checks with the given IDs (`check_ids`), or the checks of a Model Advisor configuration file (`configuration_file`). Returns the number of checks which passed, failed, warned or did not run, the status of each check, and the path to the HTML report with the details. Requires Simulink to be installed in the MATLAB session."
+)
+
+type Args struct {
+	Model             string   `json:"model" jsonschema:"The name of the loaded model - Example: vdp."`
+	CheckIDs          []string `json:"check_ids,omitempty" jsonschema:"The IDs of the Model Advisor checks to run - Required unless configuration_file is set - Example: mathworks.design.UnconnectedLinesPorts."`
+	ConfigurationFile string   `json:"configuration_file,omitempty" audit:"path" jsonschema:"The full absolute path to a Model Advisor configuration file, whose checks to run - Required unless check_ids is set."`
+}
+
+type Check struct {
+	ID     string `json:"id" jsonschema:"The ID of the check."`
+	Name   string `json:"name" jsonschema:"The title of the check."`
+	Status string `json:"status" jsonschema:"The status of the check, such as Pass, Warning, Fail or NotRun."`
+}
+
+type ReturnArgs struct {
+	System   string  `json:"system" jsonschema:"The checked system."`
+	Passed   int     `json:"passed" jsonschema:"The number of checks which passed."`
+	Failed   int     `json:"failed" jsonschema:"The number of checks which failed."`
+	Warnings int     `json:"warnings" jsonschema:"The number of checks which warned."`
+	NotRun   int     `json:"not_run" jsonschema:"The number of checks which did not run."`
+	Report   string  `json:"report" jsonschema:"The full path to the HTML report of Model Advisor."`
+	Checks   []Check `json:"checks" jsonschema:"The status of each check."`
+}
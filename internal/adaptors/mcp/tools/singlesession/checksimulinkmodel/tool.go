@@ -0,0 +1,82 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package checksimulinkmodel
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/checksimulinkmodel"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request checksimulinkmodel.Args) (checksimulinkmodel.ReturnArgs, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase, globalMATLAB)),
+	}
+}
+
+func (Tool) Name() string {
+	return name
+}
+
+func (Tool) Description() string {
+	return description
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing check Simulink model tool")
+		defer sessionLogger.Info("Done - Executing check Simulink model tool")
+
+		// Not returning nil for empty slices, to comply with MCP spec.
+		mcpCompliantZeroValue := ReturnArgs{
+			Checks: []Check{},
+		}
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return mcpCompliantZeroValue, err
+		}
+
+		response, err := usecase.Execute(ctx, sessionLogger, client, checksimulinkmodel.Args{
+			Model:             inputs.Model,
+			CheckIDs:          inputs.CheckIDs,
+			ConfigurationFile: inputs.ConfigurationFile,
+		})
+		if err != nil {
+			return mcpCompliantZeroValue, err
+		}
+
+		checks := make([]Check, 0, len(response.Checks))
+		for _, check := range response.Checks {
+			checks = append(checks, Check{
+				ID:     check.ID,
+				Name:   check.Name,
+				Status: check.Status,
+			})
+		}
+
+		return ReturnArgs{
+			System:   response.System,
+			Passed:   response.Passed,
+			Failed:   response.Failed,
+			Warnings: response.Warnings,
+			NotRun:   response.NotRun,
+			Report:   response.Report,
+			Checks:   checks,
+		}, nil
+	}
+}
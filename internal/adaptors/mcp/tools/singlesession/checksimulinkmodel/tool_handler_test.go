@@ -0,0 +1,157 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package checksimulinkmodel_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/checksimulinkmodel"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	checksimulinkmodelusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/checksimulinkmodel"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/singlesession/checksimulinkmodel"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	tool := checksimulinkmodel.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.NotNil(t, tool)
+	assert.Equal(t, "check_simulink_model", tool.Name())
+	assert.NotEmpty(t, tool.Description())
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	args := checksimulinkmodel.Args{
+		Model:    "vdp",
+		CheckIDs: []string{"mathworks.design.UnconnectedLinesPorts"},
+	}
+	usecaseArgs := checksimulinkmodelusecase.Args{
+		Model:    "vdp",
+		CheckIDs: []string{"mathworks.design.UnconnectedLinesPorts"},
+	}
+	usecaseResponse := checksimulinkmodelusecase.ReturnArgs{
+		System: "vdp",
+		Failed: 1,
+		Report: "/home/user/slprj/modeladvisor/vdp/report.html",
+		Checks: []checksimulinkmodelusecase.Check{
+			{ID: "mathworks.design.UnconnectedLinesPorts", Name: "Check for unconnected lines", Status: "Fail"},
+		},
+	}
+	expectedResult := checksimulinkmodel.ReturnArgs{
+		System: "vdp",
+		Failed: 1,
+		Report: "/home/user/slprj/modeladvisor/vdp/report.html",
+		Checks: []checksimulinkmodel.Check{
+			{ID: "mathworks.design.UnconnectedLinesPorts", Name: "Check for unconnected lines", Status: "Fail"},
+		},
+	}
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, usecaseArgs).
+		Return(usecaseResponse, nil).
+		Once()
+
+	// Act
+	result, err := checksimulinkmodel.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+
+	// Assert
+	require.NoError(t, err, "Handler should not return an error")
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestTool_Handler_ClientReturnsError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedError := assert.AnError
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(nil, expectedError).
+		Once()
+
+	// Act
+	result, err := checksimulinkmodel.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, checksimulinkmodel.Args{})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Handler should return an error")
+	assert.NotNil(t, result.Checks, "Checks should not be nil")
+}
+
+func TestTool_Handler_UsecaseError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedError := assert.AnError
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, checksimulinkmodelusecase.Args{}).
+		Return(checksimulinkmodelusecase.ReturnArgs{}, expectedError).
+		Once()
+
+	// Act
+	result, err := checksimulinkmodel.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, checksimulinkmodel.Args{})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.NotNil(t, result.Checks, "Checks should not be nil")
+}
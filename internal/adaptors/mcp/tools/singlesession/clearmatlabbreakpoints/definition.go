@@ -0,0 +1,18 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package clearmatlabbreakpoints
+
+import "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/utils/debugconverter"
+
+const (
+	name        = "clear_matlab_breakpoints"
+	title       = "Clear MATLAB Breakpoints"
+	description = "Clear the MATLAB breakpoint at a line (`line`) of a file (`file_path`), all the breakpoints of the file when no line is given, or all the breakpoints, including pausing on errors, when no file is given. Returns the remaining breakpoints."
+)
+
+type Args struct {
+	FilePath string `json:"file_path,omitempty" audit:"path" jsonschema:"The full absolute path to the MATLAB file whose breakpoints to clear - Must be a .m file - Example: C:\\Users\\username\\matlab\\analysis.m or /home/user/matlab/analysis.m."`
+	Line     int    `json:"line,omitempty"      jsonschema:"The line of the breakpoint to clear. Requires file_path."`
+}
+
+type ReturnArgs = debugconverter.Breakpoints
@@ -0,0 +1,61 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package clearmatlabbreakpoints
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/utils/debugconverter"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/clearmatlabbreakpoints"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/breakpoints"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request clearmatlabbreakpoints.Args) (breakpoints.Breakpoints, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase, globalMATLAB)),
+	}
+}
+
+func (Tool) Name() string {
+	return name
+}
+
+func (Tool) Description() string {
+	return description
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing clear MATLAB breakpoints tool")
+		defer sessionLogger.Info("Done - Executing clear MATLAB breakpoints tool")
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		response, err := usecase.Execute(ctx, sessionLogger, client, clearmatlabbreakpoints.Args{
+			FilePath: inputs.FilePath,
+			Line:     inputs.Line,
+		})
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		return debugconverter.ConvertBreakpoints(response), nil
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package clearmatlabbreakpoints_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/clearmatlabbreakpoints"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/utils/debugconverter"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	clearmatlabbreakpointsusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/clearmatlabbreakpoints"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/breakpoints"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/singlesession/clearmatlabbreakpoints"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	tool := clearmatlabbreakpoints.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.NotNil(t, tool)
+	assert.Equal(t, "clear_matlab_breakpoints", tool.Name())
+	assert.NotEmpty(t, tool.Description())
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	args := clearmatlabbreakpoints.Args{
+		FilePath: "/home/user/analysis.m",
+		Line:     20,
+	}
+	usecaseResponse := breakpoints.Breakpoints{
+		Breakpoints: []breakpoints.Breakpoint{
+			{File: "/home/user/analysis.m", Line: 12, Condition: "x > 3"},
+		},
+	}
+	expectedResult := clearmatlabbreakpoints.ReturnArgs{
+		Breakpoints: []debugconverter.Breakpoint{
+			{File: "/home/user/analysis.m", Line: 12, Condition: "x > 3"},
+		},
+	}
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, clearmatlabbreakpointsusecase.Args{
+			FilePath: "/home/user/analysis.m",
+			Line:     20,
+		}).
+		Return(usecaseResponse, nil).
+		Once()
+
+	// Act
+	result, err := clearmatlabbreakpoints.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+
+	// Assert
+	require.NoError(t, err, "Handler should not return an error")
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestTool_Handler_ClientReturnsError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedError := assert.AnError
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(nil, expectedError).
+		Once()
+
+	// Act
+	result, err := clearmatlabbreakpoints.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, clearmatlabbreakpoints.Args{})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Handler should return an error")
+	assert.Empty(t, result, "Result should be empty in an error case")
+}
+
+func TestTool_Handler_UsecaseError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedError := assert.AnError
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, clearmatlabbreakpointsusecase.Args{}).
+		Return(breakpoints.Breakpoints{}, expectedError).
+		Once()
+
+	// Act
+	result, err := clearmatlabbreakpoints.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, clearmatlabbreakpoints.Args{})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, result, "Result should be empty in an error case")
+}
@@ -0,0 +1,17 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package controlmatlabdebugger
+
+import "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/utils/debugconverter"
+
+const (
+	name        = "control_matlab_debugger"
+	title       = "Control MATLAB Debugger"
+	description = "Control the MATLAB code paused in the debugger by `debug_matlab_code`: step to the next line (`step`), into (`step_in`) or out of (`step_out`) a function, continue to the next breakpoint (`continue`), or quit debugging (`quit`). `status` returns the state without resuming the code. Returns the state once the code is paused again or done, like `debug_matlab_code`."
+)
+
+type Args struct {
+	Action string `json:"action" jsonschema:"The debugger action - One of step, step_in, step_out, continue, quit or status."`
+}
+
+type ReturnArgs = debugconverter.DebugState
@@ -0,0 +1,18 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package debugmatlabcode
+
+import "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/utils/debugconverter"
+
+const (
+	name        = "debug_matlab_code"
+	title       = "Debug MATLAB Code"
+	description = "Run MATLAB code (`code`) within a project directory (`project_path`) until it pauses at a breakpoint set with `set_matlab_breakpoint`, or is done. While paused, returns the stack, innermost frame first, with the variables of the innermost frame; once done, returns the command window output. Use `control_matlab_debugger` to step, continue or quit the paused code: the other tools are refused until the code is done."
+)
+
+type Args struct {
+	ProjectPath string `json:"project_path" audit:"path" jsonschema:"The full path to the project directory - Becomes MATLAB's working directory during execution - Folder must exist - Example: C:\\Users\\username\\matlab-project or /home/user/research."`
+	Code        string `json:"code"         audit:"code" jsonschema:"The MATLAB code to run in the debugger."`
+}
+
+type ReturnArgs = debugconverter.DebugState
@@ -0,0 +1,164 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package debugmatlabcode_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/debugmatlabcode"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/utils/debugconverter"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	debugmatlabcodeusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/debugmatlabcode"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/debugsession"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/singlesession/debugmatlabcode"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	tool := debugmatlabcode.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.NotNil(t, tool)
+	assert.Equal(t, "debug_matlab_code", tool.Name())
+	assert.NotEmpty(t, tool.Description())
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	args := debugmatlabcode.Args{
+		ProjectPath: "/home/user/project",
+		Code:        "analysis(42)",
+	}
+	usecaseResponse := debugsession.Status{
+		State: debugsession.StatePaused,
+		Frames: []debugsession.Frame{
+			{
+				Name:      "analysis",
+				File:      "/home/user/analysis.m",
+				Line:      12,
+				Variables: []debugsession.Variable{{Name: "x", Size: []int{1, 1}, Class: "double", Value: "42"}},
+			},
+		},
+	}
+	expectedResult := debugmatlabcode.ReturnArgs{
+		State: "paused",
+		Frames: []debugconverter.Frame{
+			{
+				Name:      "analysis",
+				File:      "/home/user/analysis.m",
+				Line:      12,
+				Variables: []debugconverter.Variable{{Name: "x", Size: []int{1, 1}, Class: "double", Value: "42"}},
+			},
+		},
+	}
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, debugmatlabcodeusecase.Args{
+			ProjectPath: "/home/user/project",
+			Code:        "analysis(42)",
+		}).
+		Return(usecaseResponse, nil).
+		Once()
+
+	// Act
+	result, err := debugmatlabcode.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+
+	// Assert
+	require.NoError(t, err, "Handler should not return an error")
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestTool_Handler_ClientReturnsError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedError := assert.AnError
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(nil, expectedError).
+		Once()
+
+	// Act
+	result, err := debugmatlabcode.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, debugmatlabcode.Args{})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Handler should return an error")
+	assert.Empty(t, result, "Result should be empty in an error case")
+}
+
+func TestTool_Handler_UsecaseError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedError := assert.AnError
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, debugmatlabcodeusecase.Args{}).
+		Return(debugsession.Status{}, expectedError).
+		Once()
+
+	// Act
+	result, err := debugmatlabcode.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, debugmatlabcode.Args{})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, result, "Result should be empty in an error case")
+}
@@ -5,12 +5,27 @@ package detectmatlabtoolboxes
 const (
 	name        = "detect_matlab_toolboxes"
 	title       = "Detect MATLAB Toolboxes"
-	description = "List installed MATLAB toolboxes with their versions and installation status."
+	description = "List installed MATLAB toolboxes with their versions, releases and product identifiers, and other installed Add-Ons (e.g. Support Packages, community Add-Ons) with their versions and whether they are enabled."
 )
 
 type Args struct {
 }
 
 type ReturnArgs struct {
-	InstallationInfo string `json:"installation_info" jsonschema:"Output of the 'ver' command showing installed MATLAB toolboxes."`
+	Products []ProductInfo `json:"products" jsonschema:"The installed MathWorks products, including MATLAB Toolboxes."`
+	AddOns   []AddOnInfo   `json:"add_ons"  jsonschema:"The installed Add-Ons, other than MATLAB Toolboxes (e.g. Support Packages, community Add-Ons)."`
+}
+
+type ProductInfo struct {
+	Name       string `json:"name"       jsonschema:"The product name."`
+	Version    string `json:"version"    jsonschema:"The product version."`
+	Release    string `json:"release"    jsonschema:"The MATLAB release of the product, for example R2024b."`
+	Identifier string `json:"identifier" jsonschema:"The product identifier."`
+}
+
+type AddOnInfo struct {
+	Name       string `json:"name"       jsonschema:"The Add-On name."`
+	Version    string `json:"version"    jsonschema:"The Add-On version."`
+	Enabled    bool   `json:"enabled"    jsonschema:"Whether the Add-On is enabled."`
+	Identifier string `json:"identifier" jsonschema:"The Add-On identifier."`
 }
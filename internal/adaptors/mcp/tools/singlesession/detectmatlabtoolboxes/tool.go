@@ -8,6 +8,7 @@ import (
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/detectmatlabtoolboxes"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/installedproducts"
 )
 
 type Usecase interface {
@@ -53,7 +54,34 @@ func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.Handl
 		}
 
 		return ReturnArgs{
-			InstallationInfo: tbxInfo.Toolboxes,
+			Products: convertProducts(tbxInfo.Products),
+			AddOns:   convertAddOns(tbxInfo.AddOns),
 		}, nil
 	}
 }
+
+func convertProducts(products []installedproducts.Product) []ProductInfo {
+	convertedProducts := make([]ProductInfo, len(products))
+	for i, product := range products {
+		convertedProducts[i] = ProductInfo{
+			Name:       product.Name,
+			Version:    product.Version,
+			Release:    product.Release,
+			Identifier: product.Identifier,
+		}
+	}
+	return convertedProducts
+}
+
+func convertAddOns(addOns []installedproducts.AddOn) []AddOnInfo {
+	convertedAddOns := make([]AddOnInfo, len(addOns))
+	for i, addOn := range addOns {
+		convertedAddOns[i] = AddOnInfo{
+			Name:       addOn.Name,
+			Version:    addOn.Version,
+			Enabled:    addOn.Enabled,
+			Identifier: addOn.Identifier,
+		}
+	}
+	return convertedAddOns
+}
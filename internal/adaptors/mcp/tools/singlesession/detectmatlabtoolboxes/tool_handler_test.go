@@ -8,6 +8,7 @@ import (
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/detectmatlabtoolboxes"
 	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
 	detectmatlabtoolboxesusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/detectmatlabtoolboxes"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/installedproducts"
 	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
 	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/singlesession/detectmatlabtoolboxes"
 	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
@@ -54,7 +55,12 @@ func TestTool_Handler_HappyPath(t *testing.T) {
 	mockLogger := testutils.NewInspectableLogger()
 	ctx := t.Context()
 	expectedResponse := detectmatlabtoolboxesusecase.ReturnArgs{
-		Toolboxes: "Toolbox list",
+		Products: []installedproducts.Product{
+			{Name: "MATLAB", Version: "24.2", Release: "R2024b", Identifier: "ML"},
+		},
+		AddOns: []installedproducts.AddOn{
+			{Name: "GUI Layout Toolbox", Version: "2.3.6", Enabled: true, Identifier: "layout"},
+		},
 	}
 	args := detectmatlabtoolboxes.Args{}
 
@@ -73,7 +79,12 @@ func TestTool_Handler_HappyPath(t *testing.T) {
 
 	// Assert
 	require.NoError(t, err, "Handler should not return an error")
-	assert.Equal(t, expectedResponse.Toolboxes, result.InstallationInfo, "Text content should match")
+	assert.Equal(t, []detectmatlabtoolboxes.ProductInfo{
+		{Name: "MATLAB", Version: "24.2", Release: "R2024b", Identifier: "ML"},
+	}, result.Products, "Products should match")
+	assert.Equal(t, []detectmatlabtoolboxes.AddOnInfo{
+		{Name: "GUI Layout Toolbox", Version: "2.3.6", Enabled: true, Identifier: "layout"},
+	}, result.AddOns, "AddOns should match")
 }
 
 func TestTool_Handler_ClientReturnsError(t *testing.T) {
@@ -136,5 +147,6 @@ func TestTool_Handler_UsecaseError(t *testing.T) {
 
 	// Assert
 	require.ErrorIs(t, err, expectedError)
-	assert.Empty(t, result.InstallationInfo, "InstallationInfo text should be empty on error")
+	assert.Empty(t, result.Products, "Products should be empty on error")
+	assert.Empty(t, result.AddOns, "AddOns should be empty on error")
 }
@@ -9,6 +9,6 @@ const (
 )
 
 type Args struct {
-	ProjectPath string `json:"project_path" jsonschema:"The full path to the project directory - Becomes MATLAB's working directory during execution - Folder must exist - Example: C:\\Users\\username\\matlab-project or /home/user/research."`
-	Code        string `json:"code"         jsonschema:"The MATLAB code to evaluate."`
+	ProjectPath string `json:"project_path" audit:"path" jsonschema:"The full path to the project directory - Becomes MATLAB's working directory during execution - Folder must exist - Example: C:\\Users\\username\\matlab-project or /home/user/research."`
+	Code        string `json:"code"         audit:"code" jsonschema:"The MATLAB code to evaluate."`
 }
@@ -0,0 +1,34 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package listsimulinkblocks
+
+const (
+	name        = "list_simulink_blocks"
+	title       = "List Simulink Blocks"
+	description = "List the blocks of a Simulink model loaded with `load_simulink_model`, or of one of its subsystems (`system`), looking under the masks and into the library links. By default, lists the blocks of all the nested subsystems; set `search_depth` to 1 to only list the blocks directly in the system. Returns the path, name, parent, type and mask type of each block, whether it is a subsystem, and, when requested (`include_parameters`), its dialog parameters with their values. This is a read-only operation. Requires Simulink to be installed in the MATLAB session."
+)
+
+type Args struct {
+	System            string `json:"system" jsonschema:"The name of a loaded model, or the path of one of its subsystems - Example: vdp or vdp/Controller."`
+	SearchDepth       int    `json:"search_depth,omitempty" jsonschema:"How many levels of subsystems to list the blocks of - 1 lists the blocks directly in the system - Defaults to all the levels."`
+	IncludeParameters bool   `json:"include_parameters,omitempty" jsonschema:"Whether to return the dialog parameters of each block with their values."`
+}
+
+type Parameter struct {
+	Name  string `json:"name" jsonschema:"The name of the parameter, to use with set_simulink_block_parameters."`
+	Value string `json:"value" jsonschema:"The value of the parameter, usually a MATLAB expression."`
+}
+
+type Block struct {
+	Path        string      `json:"path" jsonschema:"The path of the block, to use with the other Simulink tools."`
+	Name        string      `json:"name" jsonschema:"The name of the block."`
+	Parent      string      `json:"parent" jsonschema:"The path of the system the block is in."`
+	Type        string      `json:"type" jsonschema:"The type of the block, such as Gain, Integrator or SubSystem."`
+	MaskType    string      `json:"mask_type" jsonschema:"The mask type of the block, if it is masked."`
+	IsSubsystem bool        `json:"is_subsystem" jsonschema:"Whether the block is a subsystem."`
+	Parameters  []Parameter `json:"parameters,omitempty" jsonschema:"The dialog parameters of the block, when requested."`
+}
+
+type ReturnArgs struct {
+	Blocks []Block `json:"blocks" jsonschema:"The blocks of the system."`
+}
@@ -0,0 +1,91 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package listsimulinkblocks
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listsimulinkblocks"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request listsimulinkblocks.Args) (listsimulinkblocks.ReturnArgs, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase, globalMATLAB)),
+	}
+}
+
+func (Tool) Name() string {
+	return name
+}
+
+func (Tool) Description() string {
+	return description
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing list Simulink blocks tool")
+		defer sessionLogger.Info("Done - Executing list Simulink blocks tool")
+
+		// Not returning nil for empty slices, to comply with MCP spec.
+		mcpCompliantZeroValue := ReturnArgs{
+			Blocks: []Block{},
+		}
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return mcpCompliantZeroValue, err
+		}
+
+		response, err := usecase.Execute(ctx, sessionLogger, client, listsimulinkblocks.Args{
+			System:            inputs.System,
+			SearchDepth:       inputs.SearchDepth,
+			IncludeParameters: inputs.IncludeParameters,
+		})
+		if err != nil {
+			return mcpCompliantZeroValue, err
+		}
+
+		return ReturnArgs{
+			Blocks: convertBlocks(response.Blocks),
+		}, nil
+	}
+}
+
+func convertBlocks(blocks []listsimulinkblocks.Block) []Block {
+	result := make([]Block, 0, len(blocks))
+	for _, block := range blocks {
+		var parameters []Parameter
+		for _, parameter := range block.Parameters {
+			parameters = append(parameters, Parameter{
+				Name:  parameter.Name,
+				Value: parameter.Value,
+			})
+		}
+
+		result = append(result, Block{
+			Path:        block.Path,
+			Name:        block.Name,
+			Parent:      block.Parent,
+			Type:        block.Type,
+			MaskType:    block.MaskType,
+			IsSubsystem: block.IsSubsystem,
+			Parameters:  parameters,
+		})
+	}
+	return result
+}
@@ -0,0 +1,155 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package listsimulinkblocks_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/listsimulinkblocks"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	listsimulinkblocksusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/listsimulinkblocks"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/singlesession/listsimulinkblocks"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	tool := listsimulinkblocks.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.NotNil(t, tool)
+	assert.Equal(t, "list_simulink_blocks", tool.Name())
+	assert.NotEmpty(t, tool.Description())
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	args := listsimulinkblocks.Args{
+		System:            "vdp",
+		SearchDepth:       1,
+		IncludeParameters: true,
+	}
+	usecaseArgs := listsimulinkblocksusecase.Args{
+		System:            "vdp",
+		SearchDepth:       1,
+		IncludeParameters: true,
+	}
+	usecaseResponse := listsimulinkblocksusecase.ReturnArgs{
+		Blocks: []listsimulinkblocksusecase.Block{
+			{Path: "vdp/Mu", Name: "Mu", Parent: "vdp", Type: "Gain", Parameters: []listsimulinkblocksusecase.Parameter{{Name: "Gain", Value: "1"}}},
+			{Path: "vdp/Controller", Name: "Controller", Parent: "vdp", Type: "SubSystem", IsSubsystem: true, Parameters: []listsimulinkblocksusecase.Parameter{}},
+		},
+	}
+	expectedResult := listsimulinkblocks.ReturnArgs{
+		Blocks: []listsimulinkblocks.Block{
+			{Path: "vdp/Mu", Name: "Mu", Parent: "vdp", Type: "Gain", Parameters: []listsimulinkblocks.Parameter{{Name: "Gain", Value: "1"}}},
+			{Path: "vdp/Controller", Name: "Controller", Parent: "vdp", Type: "SubSystem", IsSubsystem: true},
+		},
+	}
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, usecaseArgs).
+		Return(usecaseResponse, nil).
+		Once()
+
+	// Act
+	result, err := listsimulinkblocks.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+
+	// Assert
+	require.NoError(t, err, "Handler should not return an error")
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestTool_Handler_ClientReturnsError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedError := assert.AnError
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(nil, expectedError).
+		Once()
+
+	// Act
+	result, err := listsimulinkblocks.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, listsimulinkblocks.Args{})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Handler should return an error")
+	assert.NotNil(t, result.Blocks, "Blocks should not be nil")
+}
+
+func TestTool_Handler_UsecaseError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedError := assert.AnError
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, listsimulinkblocksusecase.Args{}).
+		Return(listsimulinkblocksusecase.ReturnArgs{}, expectedError).
+		Once()
+
+	// Act
+	result, err := listsimulinkblocks.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, listsimulinkblocks.Args{})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.NotNil(t, result.Blocks, "Blocks should not be nil")
+}
@@ -0,0 +1,22 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package loadsimulinkmodel
+
+const (
+	name        = "load_simulink_model"
+	title       = "Load Simulink Model"
+	description = "Load a Simulink model file (`model_path`) into memory in an existing MATLAB session, without opening it, so that the other Simulink tools can use it by its name. Returns the name of the model, its file, solver, start and stop times, and number of blocks. Requires Simulink to be installed in the MATLAB session."
+)
+
+type Args struct {
+	ModelPath string `json:"model_path" audit:"path" jsonschema:"The full absolute path to the Simulink model file - Must be a .slx or .mdl file - Example: C:\\Users\\username\\models\\controller.slx or /home/user/models/controller.slx."`
+}
+
+type ReturnArgs struct {
+	Name       string `json:"name" jsonschema:"The name of the model, to use with the other Simulink tools."`
+	File       string `json:"file" jsonschema:"The full path to the model file."`
+	Solver     string `json:"solver" jsonschema:"The solver of the model."`
+	StartTime  string `json:"start_time" jsonschema:"The start time of the simulation, as a MATLAB expression."`
+	StopTime   string `json:"stop_time" jsonschema:"The stop time of the simulation, as a MATLAB expression."`
+	BlockCount int    `json:"block_count" jsonschema:"The number of blocks of the model, including the blocks of its subsystems."`
+}
@@ -0,0 +1,65 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package loadsimulinkmodel
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/loadsimulinkmodel"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request loadsimulinkmodel.Args) (loadsimulinkmodel.ReturnArgs, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase, globalMATLAB)),
+	}
+}
+
+func (Tool) Name() string {
+	return name
+}
+
+func (Tool) Description() string {
+	return description
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing load Simulink model tool")
+		defer sessionLogger.Info("Done - Executing load Simulink model tool")
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		response, err := usecase.Execute(ctx, sessionLogger, client, loadsimulinkmodel.Args{
+			ModelPath: inputs.ModelPath,
+		})
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		return ReturnArgs{
+			Name:       response.Name,
+			File:       response.File,
+			Solver:     response.Solver,
+			StartTime:  response.StartTime,
+			StopTime:   response.StopTime,
+			BlockCount: response.BlockCount,
+		}, nil
+	}
+}
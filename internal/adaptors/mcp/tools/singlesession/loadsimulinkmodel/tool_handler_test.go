@@ -0,0 +1,155 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package loadsimulinkmodel_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/loadsimulinkmodel"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	loadsimulinkmodelusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/loadsimulinkmodel"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/singlesession/loadsimulinkmodel"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	tool := loadsimulinkmodel.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.NotNil(t, tool)
+	assert.Equal(t, "load_simulink_model", tool.Name())
+	assert.NotEmpty(t, tool.Description())
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	args := loadsimulinkmodel.Args{
+		ModelPath: "/home/user/models/vdp.slx",
+	}
+	usecaseArgs := loadsimulinkmodelusecase.Args{
+		ModelPath: "/home/user/models/vdp.slx",
+	}
+	usecaseResponse := loadsimulinkmodelusecase.ReturnArgs{
+		Name:       "vdp",
+		File:       "/home/user/models/vdp.slx",
+		Solver:     "ode45",
+		StartTime:  "0.0",
+		StopTime:   "20",
+		BlockCount: 12,
+	}
+	expectedResult := loadsimulinkmodel.ReturnArgs{
+		Name:       "vdp",
+		File:       "/home/user/models/vdp.slx",
+		Solver:     "ode45",
+		StartTime:  "0.0",
+		StopTime:   "20",
+		BlockCount: 12,
+	}
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, usecaseArgs).
+		Return(usecaseResponse, nil).
+		Once()
+
+	// Act
+	result, err := loadsimulinkmodel.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+
+	// Assert
+	require.NoError(t, err, "Handler should not return an error")
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestTool_Handler_ClientReturnsError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedError := assert.AnError
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(nil, expectedError).
+		Once()
+
+	// Act
+	result, err := loadsimulinkmodel.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, loadsimulinkmodel.Args{})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Handler should return an error")
+	assert.Empty(t, result, "Result should be empty in an error case")
+}
+
+func TestTool_Handler_UsecaseError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedError := assert.AnError
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, loadsimulinkmodelusecase.Args{}).
+		Return(loadsimulinkmodelusecase.ReturnArgs{}, expectedError).
+		Once()
+
+	// Act
+	result, err := loadsimulinkmodel.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, loadsimulinkmodel.Args{})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, result, "Result should be empty in an error case")
+}
@@ -0,0 +1,48 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package profilematlabcode
+
+const (
+	name        = "profile_matlab_code"
+	title       = "Profile MATLAB Code"
+	description = "Run MATLAB code (`code`) within a project directory (`project_path`) under the MATLAB profiler, and return its hot spots: the functions taking the most time, by self time and by total time, with their call counts, and the slowest lines of each file. Optionally returns the call stacks in the collapsed stack format (`collapsed_stacks`), one stack per line followed by its self time in microseconds, as expected by flame graph tools. Times are in seconds."
+)
+
+type Args struct {
+	ProjectPath     string `json:"project_path" audit:"path" jsonschema:"The full path to the project directory - Becomes MATLAB's working directory during execution - Folder must exist - Example: C:\\Users\\username\\matlab-project or /home/user/research."`
+	Code            string `json:"code" audit:"code" jsonschema:"The MATLAB code to profile."`
+	MaxFunctions    int    `json:"max_functions,omitempty" jsonschema:"The maximum number of functions to return in each list. Defaults to 10."`
+	MaxLinesPerFile int    `json:"max_lines_per_file,omitempty" jsonschema:"The maximum number of slowest lines to return for each file. Defaults to 5."`
+	CollapsedStacks bool   `json:"collapsed_stacks,omitempty" jsonschema:"Whether to also return the call stacks in the collapsed stack format, for flame graphs."`
+}
+
+type Function struct {
+	Name      string  `json:"name" jsonschema:"The name of the function."`
+	File      string  `json:"file" jsonschema:"The file defining the function, empty for built-in functions."`
+	Type      string  `json:"type" jsonschema:"The type of the function, such as M-function, M-subfunction or Builtin."`
+	Calls     int     `json:"calls" jsonschema:"The number of calls to the function."`
+	TotalTime float64 `json:"total_time" jsonschema:"The time spent in the function, including the functions it called."`
+	SelfTime  float64 `json:"self_time" jsonschema:"The time spent in the function itself, excluding the functions it called."`
+}
+
+type Line struct {
+	Function string  `json:"function" jsonschema:"The name of the function the line belongs to."`
+	Line     int     `json:"line" jsonschema:"The line number."`
+	Calls    int     `json:"calls" jsonschema:"The number of times the line ran."`
+	Time     float64 `json:"time" jsonschema:"The time spent on the line."`
+}
+
+type FileLines struct {
+	File  string `json:"file" jsonschema:"The full path to the file."`
+	Lines []Line `json:"lines" jsonschema:"The slowest lines of the file, the slowest first."`
+}
+
+type ReturnArgs struct {
+	Output               string      `json:"output" jsonschema:"The command window output of the code."`
+	Error                string      `json:"error,omitempty" jsonschema:"The error thrown by the code, if any."`
+	TotalTime            float64     `json:"total_time" jsonschema:"The time the code took to run."`
+	FunctionsBySelfTime  []Function  `json:"functions_by_self_time" jsonschema:"The functions with the highest self time, the slowest first."`
+	FunctionsByTotalTime []Function  `json:"functions_by_total_time" jsonschema:"The functions with the highest total time, the slowest first."`
+	SlowestLines         []FileLines `json:"slowest_lines" jsonschema:"The slowest lines of each file, the file with the slowest line first."`
+	CollapsedStacks      string      `json:"collapsed_stacks,omitempty" jsonschema:"The call stacks in the collapsed stack format, when requested."`
+}
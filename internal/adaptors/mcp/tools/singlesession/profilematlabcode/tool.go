@@ -0,0 +1,113 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package profilematlabcode
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/profilematlabcode"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request profilematlabcode.Args) (profilematlabcode.ReturnArgs, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase, globalMATLAB)),
+	}
+}
+
+func (Tool) Name() string {
+	return name
+}
+
+func (Tool) Description() string {
+	return description
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing profile MATLAB code tool")
+		defer sessionLogger.Info("Done - Executing profile MATLAB code tool")
+
+		// Not returning nil for empty slices, to comply with MCP spec.
+		mcpCompliantZeroValue := ReturnArgs{
+			FunctionsBySelfTime:  []Function{},
+			FunctionsByTotalTime: []Function{},
+			SlowestLines:         []FileLines{},
+		}
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return mcpCompliantZeroValue, err
+		}
+
+		response, err := usecase.Execute(ctx, sessionLogger, client, profilematlabcode.Args{
+			Code:            inputs.Code,
+			ProjectPath:     inputs.ProjectPath,
+			MaxFunctions:    inputs.MaxFunctions,
+			MaxLinesPerFile: inputs.MaxLinesPerFile,
+			CollapsedStacks: inputs.CollapsedStacks,
+		})
+		if err != nil {
+			return mcpCompliantZeroValue, err
+		}
+
+		return ReturnArgs{
+			Output:               response.Output,
+			Error:                response.Error,
+			TotalTime:            response.TotalTime,
+			FunctionsBySelfTime:  convertFunctions(response.FunctionsBySelfTime),
+			FunctionsByTotalTime: convertFunctions(response.FunctionsByTotalTime),
+			SlowestLines:         convertFileLines(response.SlowestLines),
+			CollapsedStacks:      response.CollapsedStacks,
+		}, nil
+	}
+}
+
+func convertFunctions(functions []profilematlabcode.Function) []Function {
+	result := make([]Function, 0, len(functions))
+	for _, function := range functions {
+		result = append(result, Function{
+			Name:      function.Name,
+			File:      function.File,
+			Type:      function.Type,
+			Calls:     function.Calls,
+			TotalTime: function.TotalTime,
+			SelfTime:  function.SelfTime,
+		})
+	}
+	return result
+}
+
+func convertFileLines(files []profilematlabcode.FileLines) []FileLines {
+	result := make([]FileLines, 0, len(files))
+	for _, file := range files {
+		lines := make([]Line, 0, len(file.Lines))
+		for _, line := range file.Lines {
+			lines = append(lines, Line{
+				Function: line.Function,
+				Line:     line.Line,
+				Calls:    line.Calls,
+				Time:     line.Time,
+			})
+		}
+
+		result = append(result, FileLines{
+			File:  file.File,
+			Lines: lines,
+		})
+	}
+	return result
+}
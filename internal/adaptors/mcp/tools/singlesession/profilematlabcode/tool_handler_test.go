@@ -0,0 +1,184 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package profilematlabcode_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/profilematlabcode"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	profilematlabcodeusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/profilematlabcode"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/singlesession/profilematlabcode"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	tool := profilematlabcode.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.NotNil(t, tool)
+	assert.Equal(t, "profile_matlab_code", tool.Name())
+	assert.NotEmpty(t, tool.Description())
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	args := profilematlabcode.Args{
+		ProjectPath:     "/home/user/project",
+		Code:            "analysis(42)",
+		MaxFunctions:    3,
+		MaxLinesPerFile: 2,
+		CollapsedStacks: true,
+	}
+	usecaseResponse := profilematlabcodeusecase.ReturnArgs{
+		Output:    "ans = 84",
+		TotalTime: 1.5,
+		FunctionsBySelfTime: []profilematlabcodeusecase.Function{
+			{Name: "analysis>compute", File: "/home/user/project/analysis.m", Type: "M-subfunction", Calls: 42, TotalTime: 1.2, SelfTime: 1.2},
+		},
+		FunctionsByTotalTime: []profilematlabcodeusecase.Function{
+			{Name: "analysis", File: "/home/user/project/analysis.m", Type: "M-function", Calls: 1, TotalTime: 1.4, SelfTime: 0.2},
+		},
+		SlowestLines: []profilematlabcodeusecase.FileLines{
+			{
+				File:  "/home/user/project/analysis.m",
+				Lines: []profilematlabcodeusecase.Line{{Function: "analysis>compute", Line: 12, Calls: 42, Time: 1.1}},
+			},
+		},
+		CollapsedStacks: "analysis 200000\nanalysis;analysis>compute 1200000",
+	}
+	expectedResult := profilematlabcode.ReturnArgs{
+		Output:    "ans = 84",
+		TotalTime: 1.5,
+		FunctionsBySelfTime: []profilematlabcode.Function{
+			{Name: "analysis>compute", File: "/home/user/project/analysis.m", Type: "M-subfunction", Calls: 42, TotalTime: 1.2, SelfTime: 1.2},
+		},
+		FunctionsByTotalTime: []profilematlabcode.Function{
+			{Name: "analysis", File: "/home/user/project/analysis.m", Type: "M-function", Calls: 1, TotalTime: 1.4, SelfTime: 0.2},
+		},
+		SlowestLines: []profilematlabcode.FileLines{
+			{
+				File:  "/home/user/project/analysis.m",
+				Lines: []profilematlabcode.Line{{Function: "analysis>compute", Line: 12, Calls: 42, Time: 1.1}},
+			},
+		},
+		CollapsedStacks: "analysis 200000\nanalysis;analysis>compute 1200000",
+	}
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, profilematlabcodeusecase.Args{
+			ProjectPath:     "/home/user/project",
+			Code:            "analysis(42)",
+			MaxFunctions:    3,
+			MaxLinesPerFile: 2,
+			CollapsedStacks: true,
+		}).
+		Return(usecaseResponse, nil).
+		Once()
+
+	// Act
+	result, err := profilematlabcode.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+
+	// Assert
+	require.NoError(t, err, "Handler should not return an error")
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestTool_Handler_ClientReturnsError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedError := assert.AnError
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(nil, expectedError).
+		Once()
+
+	// Act
+	result, err := profilematlabcode.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, profilematlabcode.Args{})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Handler should return an error")
+	assert.NotNil(t, result.FunctionsBySelfTime, "Functions by self time should not be nil")
+	assert.NotNil(t, result.FunctionsByTotalTime, "Functions by total time should not be nil")
+	assert.NotNil(t, result.SlowestLines, "Slowest lines should not be nil")
+}
+
+func TestTool_Handler_UsecaseError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedError := assert.AnError
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, profilematlabcodeusecase.Args{}).
+		Return(profilematlabcodeusecase.ReturnArgs{}, expectedError).
+		Once()
+
+	// Act
+	result, err := profilematlabcode.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, profilematlabcode.Args{})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.NotNil(t, result.FunctionsBySelfTime, "Functions by self time should not be nil")
+	assert.NotNil(t, result.FunctionsByTotalTime, "Functions by total time should not be nil")
+	assert.NotNil(t, result.SlowestLines, "Slowest lines should not be nil")
+}
@@ -0,0 +1,56 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package resetmatlabstate
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/resetmatlabstate"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request resetmatlabstate.Args) error
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase, globalMATLAB)),
+	}
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing reset MATLAB state tool")
+		defer sessionLogger.Info("Done - Executing reset MATLAB state tool")
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		err = usecase.Execute(ctx, sessionLogger, client, resetmatlabstate.Args{
+			ClearVariables:            inputs.ClearVariables,
+			CloseFigures:              inputs.CloseFigures,
+			ClearFunctions:            inputs.ClearFunctions,
+			RestoreDefaultPath:        inputs.RestoreDefaultPath,
+			ChangeToStartingDirectory: inputs.ChangeToStartingDirectory,
+		})
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		return ReturnArgs{
+			ResponseText: responseTextIfMATLABStateResetSuccessfully,
+		}, nil
+	}
+}
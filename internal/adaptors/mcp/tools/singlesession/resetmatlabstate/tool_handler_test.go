@@ -0,0 +1,142 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package resetmatlabstate_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/resetmatlabstate"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	resetmatlabstateusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/resetmatlabstate"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/singlesession/resetmatlabstate"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	tool := resetmatlabstate.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, resetmatlabstateusecase.Args{
+			ClearVariables:            true,
+			CloseFigures:              true,
+			ClearFunctions:            false,
+			RestoreDefaultPath:        true,
+			ChangeToStartingDirectory: true,
+		}).
+		Return(nil).
+		Once()
+
+	// Act
+	result, err := resetmatlabstate.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, resetmatlabstate.Args{
+		ClearVariables:            true,
+		CloseFigures:              true,
+		RestoreDefaultPath:        true,
+		ChangeToStartingDirectory: true,
+	})
+
+	// Assert
+	require.NoError(t, err, "Handler should not return an error")
+	assert.Equal(t, "MATLAB state reset successfully.", result.ResponseText)
+}
+
+func TestTool_Handler_ClientReturnsError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedError := assert.AnError
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(nil, expectedError).
+		Once()
+
+	// Act
+	result, err := resetmatlabstate.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, resetmatlabstate.Args{ClearVariables: true})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, result)
+}
+
+func TestTool_Handler_UsecaseReturnsError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedError := assert.AnError
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, resetmatlabstateusecase.Args{ClearVariables: true}).
+		Return(expectedError).
+		Once()
+
+	// Act
+	result, err := resetmatlabstate.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, resetmatlabstate.Args{ClearVariables: true})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, result)
+}
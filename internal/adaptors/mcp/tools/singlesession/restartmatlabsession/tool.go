@@ -0,0 +1,68 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package restartmatlabsession
+
+import (
+	"context"
+	"errors"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/restartmatlabsession"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/debugsession"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, session restartmatlabsession.Session, request restartmatlabsession.Args) (restartmatlabsession.ReturnArgs, error)
+}
+
+// ErrPreservingVariablesWhileDebugging is returned when restarting with preserved variables while code runs in the debugger,
+// as MATLAB would save them from the workspace of the paused code, or wait for the code to be done.
+var ErrPreservingVariablesWhileDebugging = errors.New("the variables cannot be preserved while the MATLAB code runs in the debugger, quit the debugging first or restart without preserving variables")
+
+type DebugSession interface {
+	State() debugsession.State
+	Reset()
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+	debugSession DebugSession,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase, globalMATLAB, debugSession)),
+	}
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB, debugSession DebugSession) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing restart MATLAB session tool")
+		defer sessionLogger.Info("Done - Executing restart MATLAB session tool")
+
+		if len(inputs.PreservedVariables) > 0 && debugSession.State() != debugsession.StateIdle {
+			return ReturnArgs{}, ErrPreservingVariablesWhileDebugging
+		}
+
+		response, err := usecase.Execute(ctx, sessionLogger, globalMATLAB, restartmatlabsession.Args{
+			PreservedVariables: inputs.PreservedVariables,
+		})
+
+		// The code run in the debugger, if any, ended with the MATLAB session, even when the restart failed to start a new one
+		debugSession.Reset()
+
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		return ReturnArgs{
+			ResponseText:       responseTextIfMATLABSessionRestartedSuccessfully,
+			PreservedVariables: response.PreservedVariables,
+		}, nil
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package restartmatlabsession_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/restartmatlabsession"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	restartmatlabsessionusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/restartmatlabsession"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/debugsession"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/singlesession/restartmatlabsession"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockDebugSession := &mocks.MockDebugSession{}
+	defer mockDebugSession.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	tool := restartmatlabsession.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB, mockDebugSession)
+
+	// Assert
+	assert.NotNil(t, tool)
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockDebugSession := &mocks.MockDebugSession{}
+	defer mockDebugSession.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	preservedVariables := []string{"a", "b"}
+
+	mockDebugSession.EXPECT().
+		State().
+		Return(debugsession.StateIdle).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockGlobalMATLAB, restartmatlabsessionusecase.Args{
+			PreservedVariables: preservedVariables,
+		}).
+		Return(restartmatlabsessionusecase.ReturnArgs{
+			PreservedVariables: preservedVariables,
+		}, nil).
+		Once()
+
+	mockDebugSession.EXPECT().
+		Reset().
+		Return().
+		Once()
+
+	// Act
+	result, err := restartmatlabsession.Handler(mockUsecase, mockGlobalMATLAB, mockDebugSession)(ctx, mockLogger, restartmatlabsession.Args{
+		PreservedVariables: preservedVariables,
+	})
+
+	// Assert
+	require.NoError(t, err, "Handler should not return an error")
+	assert.Equal(t, "MATLAB session restarted successfully.", result.ResponseText)
+	assert.Equal(t, preservedVariables, result.PreservedVariables)
+}
+
+func TestTool_Handler_UsecaseReturnsError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockDebugSession := &mocks.MockDebugSession{}
+	defer mockDebugSession.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedError := assert.AnError
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockGlobalMATLAB, restartmatlabsessionusecase.Args{}).
+		Return(restartmatlabsessionusecase.ReturnArgs{}, expectedError).
+		Once()
+
+	mockDebugSession.EXPECT().
+		Reset().
+		Return().
+		Once()
+
+	// Act
+	result, err := restartmatlabsession.Handler(mockUsecase, mockGlobalMATLAB, mockDebugSession)(ctx, mockLogger, restartmatlabsession.Args{})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, result)
+}
+
+func TestTool_Handler_PreservedVariablesWhileDebugging(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockDebugSession := &mocks.MockDebugSession{}
+	defer mockDebugSession.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockDebugSession.EXPECT().
+		State().
+		Return(debugsession.StatePaused).
+		Once()
+
+	// Act
+	result, err := restartmatlabsession.Handler(mockUsecase, mockGlobalMATLAB, mockDebugSession)(t.Context(), mockLogger, restartmatlabsession.Args{
+		PreservedVariables: []string{"a"},
+	})
+
+	// Assert
+	require.ErrorIs(t, err, restartmatlabsession.ErrPreservingVariablesWhileDebugging)
+	assert.Empty(t, result)
+}
+
+func TestTool_Handler_WhileDebugging(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockDebugSession := &mocks.MockDebugSession{}
+	defer mockDebugSession.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockGlobalMATLAB, restartmatlabsessionusecase.Args{}).
+		Return(restartmatlabsessionusecase.ReturnArgs{}, nil).
+		Once()
+
+	mockDebugSession.EXPECT().
+		Reset().
+		Return().
+		Once()
+
+	// Act
+	result, err := restartmatlabsession.Handler(mockUsecase, mockGlobalMATLAB, mockDebugSession)(ctx, mockLogger, restartmatlabsession.Args{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "MATLAB session restarted successfully.", result.ResponseText)
+}
@@ -9,5 +9,5 @@ const (
 )
 
 type Args struct {
-	ScriptPath string `json:"script_path" jsonschema:"The full absolute path to the MATLAB script file to execute - Must be a .m file that exists - Example: C:\\Users\\username\\projects\\analysis.m or /home/user/matlab/simulation.m."`
+	ScriptPath string `json:"script_path" audit:"path" jsonschema:"The full absolute path to the MATLAB script file to execute - Must be a .m file that exists - Example: C:\\Users\\username\\projects\\analysis.m or /home/user/matlab/simulation.m."`
 }
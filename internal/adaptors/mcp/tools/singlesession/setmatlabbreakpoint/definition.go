@@ -0,0 +1,20 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package setmatlabbreakpoint
+
+import "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/utils/debugconverter"
+
+const (
+	name        = "set_matlab_breakpoint"
+	title       = "Set MATLAB Breakpoint"
+	description = "Set a breakpoint pausing the MATLAB code at a line (`line`) of a file (`file_path`), optionally only when a condition (`condition`) is true, or pausing the code whenever an error is thrown (`on_error`, like `dbstop if error`). Use `debug_matlab_code` to then run code until it pauses. Returns all the breakpoints."
+)
+
+type Args struct {
+	FilePath  string `json:"file_path,omitempty" audit:"path" jsonschema:"The full absolute path to the MATLAB file to pause in - Must be a .m file - Required unless on_error is set - Example: C:\\Users\\username\\matlab\\analysis.m or /home/user/matlab/analysis.m."`
+	Line      int    `json:"line,omitempty"      jsonschema:"The line of the file to pause at. Required with file_path."`
+	Condition string `json:"condition,omitempty" audit:"code" jsonschema:"A MATLAB expression - The code only pauses at the line when it is true - Example: x > 3."`
+	OnError   bool   `json:"on_error,omitempty"  jsonschema:"Whether to pause the code whenever an error is thrown, instead of at a line of a file."`
+}
+
+type ReturnArgs = debugconverter.Breakpoints
@@ -0,0 +1,23 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package setsimulinkblockparameters
+
+const (
+	name        = "set_simulink_block_parameters"
+	title       = "Set Simulink Block Parameters"
+	description = "Set parameters (`parameters`) of a block (`block`) of a Simulink model loaded with `load_simulink_model`, in order. The model is changed in memory only, not saved to its file. Use `list_simulink_blocks` with `include_parameters` to find the names of the parameters. Returns the values of the parameters once set. Requires Simulink to be installed in the MATLAB session."
+)
+
+type Parameter struct {
+	Name  string `json:"name" jsonschema:"The name of the parameter - Example: Gain."`
+	Value string `json:"value" jsonschema:"The value of the parameter, usually a MATLAB expression - Example: 2.5 or [1 2 3]."`
+}
+
+type Args struct {
+	Block      string      `json:"block" jsonschema:"The path of the block - Example: vdp/Mu."`
+	Parameters []Parameter `json:"parameters" jsonschema:"The parameters to set, in order."`
+}
+
+type ReturnArgs struct {
+	Parameters []Parameter `json:"parameters" jsonschema:"The values of the parameters once set."`
+}
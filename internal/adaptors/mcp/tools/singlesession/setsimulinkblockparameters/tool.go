@@ -0,0 +1,82 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package setsimulinkblockparameters
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/setsimulinkblockparameters"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request setsimulinkblockparameters.Args) (setsimulinkblockparameters.ReturnArgs, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase, globalMATLAB)),
+	}
+}
+
+func (Tool) Name() string {
+	return name
+}
+
+func (Tool) Description() string {
+	return description
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing set Simulink block parameters tool")
+		defer sessionLogger.Info("Done - Executing set Simulink block parameters tool")
+
+		// Not returning nil for empty slices, to comply with MCP spec.
+		mcpCompliantZeroValue := ReturnArgs{
+			Parameters: []Parameter{},
+		}
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return mcpCompliantZeroValue, err
+		}
+
+		parameters := make([]setsimulinkblockparameters.Parameter, 0, len(inputs.Parameters))
+		for _, parameter := range inputs.Parameters {
+			parameters = append(parameters, setsimulinkblockparameters.Parameter{
+				Name:  parameter.Name,
+				Value: parameter.Value,
+			})
+		}
+
+		response, err := usecase.Execute(ctx, sessionLogger, client, setsimulinkblockparameters.Args{
+			Block:      inputs.Block,
+			Parameters: parameters,
+		})
+		if err != nil {
+			return mcpCompliantZeroValue, err
+		}
+
+		result := make([]Parameter, 0, len(response.Parameters))
+		for _, parameter := range response.Parameters {
+			result = append(result, Parameter{
+				Name:  parameter.Name,
+				Value: parameter.Value,
+			})
+		}
+
+		return ReturnArgs{
+			Parameters: result,
+		}, nil
+	}
+}
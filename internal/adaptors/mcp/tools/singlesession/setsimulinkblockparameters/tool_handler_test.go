@@ -0,0 +1,147 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package setsimulinkblockparameters_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/setsimulinkblockparameters"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	setsimulinkblockparametersusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/setsimulinkblockparameters"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/singlesession/setsimulinkblockparameters"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	tool := setsimulinkblockparameters.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.NotNil(t, tool)
+	assert.Equal(t, "set_simulink_block_parameters", tool.Name())
+	assert.NotEmpty(t, tool.Description())
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	args := setsimulinkblockparameters.Args{
+		Block:      "vdp/Mu",
+		Parameters: []setsimulinkblockparameters.Parameter{{Name: "Gain", Value: "2.5"}},
+	}
+	usecaseArgs := setsimulinkblockparametersusecase.Args{
+		Block:      "vdp/Mu",
+		Parameters: []setsimulinkblockparametersusecase.Parameter{{Name: "Gain", Value: "2.5"}},
+	}
+	usecaseResponse := setsimulinkblockparametersusecase.ReturnArgs{
+		Parameters: []setsimulinkblockparametersusecase.Parameter{{Name: "Gain", Value: "2.5"}},
+	}
+	expectedResult := setsimulinkblockparameters.ReturnArgs{
+		Parameters: []setsimulinkblockparameters.Parameter{{Name: "Gain", Value: "2.5"}},
+	}
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, usecaseArgs).
+		Return(usecaseResponse, nil).
+		Once()
+
+	// Act
+	result, err := setsimulinkblockparameters.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+
+	// Assert
+	require.NoError(t, err, "Handler should not return an error")
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestTool_Handler_ClientReturnsError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedError := assert.AnError
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(nil, expectedError).
+		Once()
+
+	// Act
+	result, err := setsimulinkblockparameters.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, setsimulinkblockparameters.Args{})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Handler should return an error")
+	assert.NotNil(t, result.Parameters, "Parameters should not be nil")
+}
+
+func TestTool_Handler_UsecaseError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedError := assert.AnError
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, setsimulinkblockparametersusecase.Args{Parameters: []setsimulinkblockparametersusecase.Parameter{}}).
+		Return(setsimulinkblockparametersusecase.ReturnArgs{}, expectedError).
+		Once()
+
+	// Act
+	result, err := setsimulinkblockparameters.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, setsimulinkblockparameters.Args{})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.NotNil(t, result.Parameters, "Parameters should not be nil")
+}
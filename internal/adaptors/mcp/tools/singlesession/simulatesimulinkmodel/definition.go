@@ -0,0 +1,30 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package simulatesimulinkmodel
+
+const (
+	name        = "simulate_simulink_model"
+	title       = "Simulate Simulink Model"
+	description = "Simulate a Simulink model loaded with `load_simulink_model` (`model`) using MATLAB's sim function, until the stop time (`stop_time`), which defaults to the one of the model. Returns the signals logged by signal logging and output logging, each with its source block, times, and the values of each of its channels, downsampled to at most `max_points` samples. The elements of bus signals are returned as separate signals. Errors of the simulation are returned along with the signals logged until then. Requires Simulink to be installed in the MATLAB session."
+)
+
+type Args struct {
+	Model     string `json:"model" jsonschema:"The name of the loaded model - Example: vdp."`
+	StopTime  string `json:"stop_time,omitempty" audit:"code" jsonschema:"The stop time of the simulation, as a MATLAB expression - Defaults to the stop time of the model - Example: 10."`
+	MaxPoints int    `json:"max_points,omitempty" jsonschema:"The maximum number of samples to return for each signal. Defaults to 1000."`
+}
+
+type Signal struct {
+	Name      string      `json:"name" jsonschema:"The name of the signal, with the names of the bus elements separated by dots."`
+	Source    string      `json:"source" jsonschema:"The variable the signal was logged to, such as logsout for signal logging or yout for output logging."`
+	BlockPath string      `json:"block_path" jsonschema:"The path of the block the signal comes from."`
+	PortIndex int         `json:"port_index" jsonschema:"The index of the port of the block the signal comes from."`
+	Time      []float64   `json:"time" jsonschema:"The times of the samples."`
+	Channels  [][]float64 `json:"channels" jsonschema:"The values of each channel of the signal, in the same order as the times."`
+}
+
+type ReturnArgs struct {
+	Error          string   `json:"error,omitempty" jsonschema:"The error of the simulation, if any."`
+	SimulationTime float64  `json:"simulation_time" jsonschema:"How long the simulation took to run, in seconds."`
+	Signals        []Signal `json:"signals" jsonschema:"The logged signals."`
+}
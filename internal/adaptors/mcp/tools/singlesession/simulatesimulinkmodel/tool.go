@@ -0,0 +1,94 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package simulatesimulinkmodel
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/simulatesimulinkmodel"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request simulatesimulinkmodel.Args) (simulatesimulinkmodel.ReturnArgs, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+	globalMATLAB entities.GlobalMATLAB,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase, globalMATLAB)),
+	}
+}
+
+func (Tool) Name() string {
+	return name
+}
+
+func (Tool) Description() string {
+	return description
+}
+
+func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing simulate Simulink model tool")
+		defer sessionLogger.Info("Done - Executing simulate Simulink model tool")
+
+		// Not returning nil for empty slices, to comply with MCP spec.
+		mcpCompliantZeroValue := ReturnArgs{
+			Signals: []Signal{},
+		}
+
+		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		if err != nil {
+			return mcpCompliantZeroValue, err
+		}
+
+		response, err := usecase.Execute(ctx, sessionLogger, client, simulatesimulinkmodel.Args{
+			Model:     inputs.Model,
+			StopTime:  inputs.StopTime,
+			MaxPoints: inputs.MaxPoints,
+		})
+		if err != nil {
+			return mcpCompliantZeroValue, err
+		}
+
+		return ReturnArgs{
+			Error:          response.Error,
+			SimulationTime: response.SimulationTime,
+			Signals:        convertSignals(response.Signals),
+		}, nil
+	}
+}
+
+func convertSignals(signals []simulatesimulinkmodel.Signal) []Signal {
+	result := make([]Signal, 0, len(signals))
+	for _, signal := range signals {
+		time := signal.Time
+		if time == nil {
+			time = []float64{}
+		}
+
+		channels := signal.Channels
+		if channels == nil {
+			channels = [][]float64{}
+		}
+
+		result = append(result, Signal{
+			Name:      signal.Name,
+			Source:    signal.Source,
+			BlockPath: signal.BlockPath,
+			PortIndex: signal.PortIndex,
+			Time:      time,
+			Channels:  channels,
+		})
+	}
+	return result
+}
@@ -0,0 +1,157 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package simulatesimulinkmodel_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/simulatesimulinkmodel"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	simulatesimulinkmodelusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/simulatesimulinkmodel"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/singlesession/simulatesimulinkmodel"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	tool := simulatesimulinkmodel.New(mockLoggerFactory, mockUsecase, mockGlobalMATLAB)
+
+	// Assert
+	assert.NotNil(t, tool)
+	assert.Equal(t, "simulate_simulink_model", tool.Name())
+	assert.NotEmpty(t, tool.Description())
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	args := simulatesimulinkmodel.Args{
+		Model:     "vdp",
+		StopTime:  "10",
+		MaxPoints: 50,
+	}
+	usecaseArgs := simulatesimulinkmodelusecase.Args{
+		Model:     "vdp",
+		StopTime:  "10",
+		MaxPoints: 50,
+	}
+	usecaseResponse := simulatesimulinkmodelusecase.ReturnArgs{
+		SimulationTime: 0.42,
+		Signals: []simulatesimulinkmodelusecase.Signal{
+			{Name: "x", Source: "yout", BlockPath: "vdp/Out1", PortIndex: 1, Time: []float64{0, 5, 10}, Channels: [][]float64{{2, -1.5, 1.8}}},
+			{Name: "empty", Source: "logsout", BlockPath: "vdp/Mu", PortIndex: 1},
+		},
+	}
+	expectedResult := simulatesimulinkmodel.ReturnArgs{
+		SimulationTime: 0.42,
+		Signals: []simulatesimulinkmodel.Signal{
+			{Name: "x", Source: "yout", BlockPath: "vdp/Out1", PortIndex: 1, Time: []float64{0, 5, 10}, Channels: [][]float64{{2, -1.5, 1.8}}},
+			{Name: "empty", Source: "logsout", BlockPath: "vdp/Mu", PortIndex: 1, Time: []float64{}, Channels: [][]float64{}},
+		},
+	}
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, usecaseArgs).
+		Return(usecaseResponse, nil).
+		Once()
+
+	// Act
+	result, err := simulatesimulinkmodel.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, args)
+
+	// Assert
+	require.NoError(t, err, "Handler should not return an error")
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestTool_Handler_ClientReturnsError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedError := assert.AnError
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(nil, expectedError).
+		Once()
+
+	// Act
+	result, err := simulatesimulinkmodel.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, simulatesimulinkmodel.Args{})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Handler should return an error")
+	assert.NotNil(t, result.Signals, "Signals should not be nil")
+}
+
+func TestTool_Handler_UsecaseError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockGlobalMATLAB := &entitiesmocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLAB.AssertExpectations(t)
+
+	mockMATLABSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockMATLABSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	expectedError := assert.AnError
+
+	mockGlobalMATLAB.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockMATLABSessionClient, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), mockMATLABSessionClient, simulatesimulinkmodelusecase.Args{}).
+		Return(simulatesimulinkmodelusecase.ReturnArgs{}, expectedError).
+		Once()
+
+	// Act
+	result, err := simulatesimulinkmodel.Handler(mockUsecase, mockGlobalMATLAB)(ctx, mockLogger, simulatesimulinkmodel.Args{})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.NotNil(t, result.Signals, "Signals should not be nil")
+}
@@ -3,6 +3,9 @@
 package tools
 
 import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
@@ -16,8 +19,29 @@ type RichContent struct {
 	ImageContent []PNGImageData
 }
 
+// ToolCall describes a call to a tool, for the middlewares.
+// The arguments are the tool inputs, decoded as JSON.
+// The code and path arguments name the arguments holding MATLAB code and file system paths, as the tool declares them.
+type ToolCall struct {
+	ToolName      string
+	Session       *mcp.ServerSession
+	Arguments     map[string]any
+	CodeArguments []string
+	PathArguments []string
+}
+
+// ToolCallHandler handles a tool call, and returns the output of the tool.
+type ToolCallHandler func(ctx context.Context) (any, error)
+
+// Middleware runs around the handler of the tool calls, for instance to ask the user for approval.
+// It must call next to proceed with the tool call.
+type Middleware interface {
+	Handle(ctx context.Context, logger entities.Logger, call ToolCall, next ToolCallHandler) (any, error)
+}
+
 type Tool interface {
-	AddToServer(server *mcp.Server) error
+	Name() string
+	AddToServer(server *mcp.Server, middlewares ...Middleware) error
 }
 
 type ToolWithUnstructuredContentOutput[ToolInput any] interface {
@@ -0,0 +1,90 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package debugconverter
+
+import (
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/breakpoints"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/debugsession"
+)
+
+type DebugState struct {
+	State  string  `json:"state"            jsonschema:"The state of the debugging: paused at a breakpoint, running, or idle once the code is done."`
+	Frames []Frame `json:"frames"           jsonschema:"The stack of the paused code, innermost frame first. Empty unless paused."`
+	Output string  `json:"output,omitempty" jsonschema:"The command window output of the code, once it is done."`
+	Error  string  `json:"error,omitempty"  jsonschema:"The error of the code, if any, once it is done."`
+}
+
+type Frame struct {
+	Name      string     `json:"name"      jsonschema:"The name of the function or script of the frame."`
+	File      string     `json:"file"      jsonschema:"The full path to the file of the frame."`
+	Line      int        `json:"line"      jsonschema:"The line the frame is paused at."`
+	Variables []Variable `json:"variables" jsonschema:"The variables of the frame. Only listed for the innermost frame."`
+}
+
+type Variable struct {
+	Name  string `json:"name"  jsonschema:"The variable name."`
+	Size  []int  `json:"size"  jsonschema:"The size of the variable, e.g. [1,3]."`
+	Class string `json:"class" jsonschema:"The class of the variable, e.g. double."`
+	Value string `json:"value" jsonschema:"The displayed value of the variable. Empty for large or non-numeric and non-text variables."`
+}
+
+type Breakpoints struct {
+	Breakpoints []Breakpoint `json:"breakpoints"   jsonschema:"The breakpoints at lines of files."`
+	StopOnError bool         `json:"stop_on_error" jsonschema:"Whether the code is paused when an error is thrown."`
+}
+
+type Breakpoint struct {
+	File      string `json:"file"      jsonschema:"The full path to the file of the breakpoint."`
+	Line      int    `json:"line"      jsonschema:"The line of the breakpoint."`
+	Condition string `json:"condition" jsonschema:"The condition of the breakpoint. Empty when it always pauses."`
+}
+
+func ConvertStatusToDebugState(status debugsession.Status) DebugState {
+	frames := make([]Frame, len(status.Frames))
+	for i, frame := range status.Frames {
+		variables := make([]Variable, len(frame.Variables))
+		for j, variable := range frame.Variables {
+			size := variable.Size
+			if size == nil {
+				size = []int{}
+			}
+
+			variables[j] = Variable{
+				Name:  variable.Name,
+				Size:  size,
+				Class: variable.Class,
+				Value: variable.Value,
+			}
+		}
+
+		frames[i] = Frame{
+			Name:      frame.Name,
+			File:      frame.File,
+			Line:      frame.Line,
+			Variables: variables,
+		}
+	}
+
+	return DebugState{
+		State:  string(status.State),
+		Frames: frames,
+		Output: status.Output,
+		Error:  status.Error,
+	}
+}
+
+func ConvertBreakpoints(list breakpoints.Breakpoints) Breakpoints {
+	converted := make([]Breakpoint, len(list.Breakpoints))
+	for i, breakpoint := range list.Breakpoints {
+		converted[i] = Breakpoint{
+			File:      breakpoint.File,
+			Line:      breakpoint.Line,
+			Condition: breakpoint.Condition,
+		}
+	}
+
+	return Breakpoints{
+		Breakpoints: converted,
+		StopOnError: list.StopOnError,
+	}
+}
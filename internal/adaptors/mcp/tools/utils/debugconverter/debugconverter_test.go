@@ -0,0 +1,106 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package debugconverter_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/utils/debugconverter"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/breakpoints"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/debugsession"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertStatusToDebugState_Paused(t *testing.T) {
+	// Arrange
+	status := debugsession.Status{
+		State: debugsession.StatePaused,
+		Frames: []debugsession.Frame{
+			{
+				Name: "analysis",
+				File: "/home/user/analysis.m",
+				Line: 12,
+				Variables: []debugsession.Variable{
+					{Name: "x", Size: []int{1, 1}, Class: "double", Value: "42"},
+					{Name: "s", Class: "struct"},
+				},
+			},
+		},
+	}
+
+	expectedState := debugconverter.DebugState{
+		State: "paused",
+		Frames: []debugconverter.Frame{
+			{
+				Name: "analysis",
+				File: "/home/user/analysis.m",
+				Line: 12,
+				Variables: []debugconverter.Variable{
+					{Name: "x", Size: []int{1, 1}, Class: "double", Value: "42"},
+					{Name: "s", Size: []int{}, Class: "struct"},
+				},
+			},
+		},
+	}
+
+	// Act
+	state := debugconverter.ConvertStatusToDebugState(status)
+
+	// Assert
+	assert.Equal(t, expectedState, state)
+}
+
+func TestConvertStatusToDebugState_Done(t *testing.T) {
+	// Arrange
+	status := debugsession.Status{
+		State:  debugsession.StateIdle,
+		Output: "ans = 84",
+		Error:  "Undefined variable y.",
+	}
+
+	expectedState := debugconverter.DebugState{
+		State:  "idle",
+		Frames: []debugconverter.Frame{},
+		Output: "ans = 84",
+		Error:  "Undefined variable y.",
+	}
+
+	// Act
+	state := debugconverter.ConvertStatusToDebugState(status)
+
+	// Assert
+	assert.Equal(t, expectedState, state)
+}
+
+func TestConvertBreakpoints_HappyPath(t *testing.T) {
+	// Arrange
+	list := breakpoints.Breakpoints{
+		Breakpoints: []breakpoints.Breakpoint{
+			{File: "/home/user/analysis.m", Line: 12, Condition: "x > 3"},
+		},
+		StopOnError: true,
+	}
+
+	expectedBreakpoints := debugconverter.Breakpoints{
+		Breakpoints: []debugconverter.Breakpoint{
+			{File: "/home/user/analysis.m", Line: 12, Condition: "x > 3"},
+		},
+		StopOnError: true,
+	}
+
+	// Act
+	result := debugconverter.ConvertBreakpoints(list)
+
+	// Assert
+	assert.Equal(t, expectedBreakpoints, result)
+}
+
+func TestConvertBreakpoints_NoBreakpoints(t *testing.T) {
+	// Arrange
+
+	// Act
+	result := debugconverter.ConvertBreakpoints(breakpoints.Breakpoints{})
+
+	// Assert
+	assert.Equal(t, debugconverter.Breakpoints{Breakpoints: []debugconverter.Breakpoint{}}, result)
+}
@@ -0,0 +1,12 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package entities
+
+// CodeSafetyAction is the action to take when MATLAB code to evaluate matches one of the code safety patterns.
+type CodeSafetyAction string
+
+const (
+	CodeSafetyActionAllow   CodeSafetyAction = "allow"
+	CodeSafetyActionConfirm CodeSafetyAction = "confirm"
+	CodeSafetyActionBlock   CodeSafetyAction = "block"
+)
@@ -6,4 +6,5 @@ import "context"
 
 type GlobalMATLAB interface {
 	Client(ctx context.Context, logger Logger) (MATLABSessionClient, error)
+	Restart(ctx context.Context, logger Logger) error
 }
@@ -2,7 +2,14 @@
 
 package entities
 
-import "context"
+import (
+	"context"
+	"errors"
+)
+
+// ErrSessionNotOwned is returned when restarting a shared or remote MATLAB session, which the server did not start,
+// as it can only reconnect to the same MATLAB, which keeps its workspace and state.
+var ErrSessionNotOwned = errors.New("the MATLAB session is a shared or remote session, which the server does not own, so it cannot be restarted, restart it in MATLAB instead")
 
 type MATLABSessionClient interface {
 	Eval(ctx context.Context, sessionLogger Logger, request EvalRequest) (EvalResponse, error)
@@ -15,12 +22,23 @@ type MATLABManager interface {
 	ListEnvironments(ctx context.Context, sessionLogger Logger) []EnvironmentInfo
 	StartMATLABSession(ctx context.Context, sessionLogger Logger, startRequest SessionDetails) (SessionID, error)
 	StopMATLABSession(ctx context.Context, sessionLogger Logger, sessionID SessionID) error
+	RestartMATLABSession(ctx context.Context, sessionLogger Logger, sessionID SessionID) error
 	GetMATLABSessionClient(ctx context.Context, sessionLogger Logger, sessionID SessionID) (MATLABSessionClient, error)
+	GetMATLABSessionDirectory(ctx context.Context, sessionLogger Logger, sessionID SessionID) (string, error)
 }
 
 type EnvironmentInfo struct {
-	MATLABRoot string
-	Version    string
+	MATLABRoot   string
+	Version      string
+	UpdateLevel  int
+	IsPrerelease bool
+	Architecture string
+	Products     []ProductInfo
+}
+
+type ProductInfo struct {
+	Name    string
+	Version string
 }
 
 type SessionID int
@@ -39,6 +57,28 @@ type LocalSessionDetails struct {
 
 func (l LocalSessionDetails) interfacelock() {}
 
+type SharedSessionDetails struct {
+	DiscoveryFolder string
+}
+
+func (s SharedSessionDetails) interfacelock() {}
+
+type RemoteSessionDetails struct {
+	Host            string
+	Port            string
+	APIKeyFile      string
+	CertificateFile string
+}
+
+func (r RemoteSessionDetails) interfacelock() {}
+
+type MATLABSessionPoolStatus struct {
+	MATLABRoot       string
+	TargetSize       int
+	IdleSessions     int
+	StartingSessions int
+}
+
 type EvalRequest struct {
 	Code string
 }
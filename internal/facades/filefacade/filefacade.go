@@ -15,3 +15,8 @@ func New() *FileFacade {
 func (ff *FileFacade) EvalSymlinks(path string) (string, error) {
 	return filepath.EvalSymlinks(path)
 }
+
+// Glob wraps the filepath.Glob function to return the names of all files matching the given pattern.
+func (ff *FileFacade) Glob(pattern string) ([]string, error) {
+	return filepath.Glob(pattern)
+}
@@ -74,6 +74,15 @@ func (osw *OsFacade) Open(path string) (File, error) {
 	return &FileWrapper{file}, nil
 }
 
+// OpenFile wraps the os.OpenFile function to open a file with the given flags, such as os.O_APPEND.
+func (osw *OsFacade) OpenFile(name string, flag int, perm os.FileMode) (File, error) {
+	file, err := os.OpenFile(name, flag, perm) //nolint:gosec // Intentional os.OpenFile usage in facade
+	if err != nil {
+		return nil, err
+	}
+	return &FileWrapper{file}, nil
+}
+
 // MkdirTemp wraps the os.MkdirTemp function to create a temp directory.
 func (osw *OsFacade) MkdirTemp(dir string, pattern string) (string, error) {
 	return os.MkdirTemp(dir, pattern)
@@ -109,6 +118,11 @@ func (osw *OsFacade) UserHomeDir() (string, error) {
 	return os.UserHomeDir()
 }
 
+// UserConfigDir wraps the os.UserConfigDir function to get the user's configuration directory
+func (osw *OsFacade) UserConfigDir() (string, error) {
+	return os.UserConfigDir()
+}
+
 // Create wraps the os.Create function to create a file.
 func (osw *OsFacade) Create(name string) (File, error) {
 	file, err := os.Create(name) //nolint:gosec // Intentional os.Create usage in facade
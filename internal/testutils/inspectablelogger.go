@@ -116,7 +116,7 @@ func (il *InspectableLogger) With(key string, value interface{}) entities.Logger
 		errorLogs: il.errorLogs,
 		Fields:    newFields,
 
-		lock: new(sync.Mutex),
+		lock: il.lock,
 	}
 }
 
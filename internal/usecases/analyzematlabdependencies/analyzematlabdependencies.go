@@ -0,0 +1,107 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package analyzematlabdependencies
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+const analyzeDependenciesFunction = "matlab_mcp.analyzeDependencies"
+
+type Args struct {
+	Path string
+}
+
+type RequiredProduct struct {
+	Name          string `json:"name"`
+	Version       string `json:"version"`
+	ProductNumber int    `json:"product_number"`
+	Certain       bool   `json:"certain"`
+	Installed     bool   `json:"installed"`
+}
+
+type ReturnArgs struct {
+	RequiredFiles    []string
+	RequiredProducts []RequiredProduct
+	MissingProducts  []string
+}
+
+type PathValidator interface {
+	ValidateExistingPath(filePath string) (string, error)
+}
+
+type Usecase struct {
+	pathValidator PathValidator
+}
+
+func New(
+	pathValidator PathValidator,
+) *Usecase {
+	return &Usecase{
+		pathValidator: pathValidator,
+	}
+}
+
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering AnalyzeMATLABDependencies Usecase")
+	defer sessionLogger.Debug("Exiting AnalyzeMATLABDependencies Usecase")
+
+	validatedPath, err := u.pathValidator.ValidateExistingPath(request.Path)
+	if err != nil {
+		return ReturnArgs{}, fmt.Errorf("path validation failed: %w", err)
+	}
+
+	response, err := client.FEval(ctx, sessionLogger, entities.FEvalRequest{
+		Function:   analyzeDependenciesFunction,
+		Arguments:  []string{validatedPath},
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	if len(response.Outputs) != 1 {
+		return ReturnArgs{}, fmt.Errorf("unexpected number of outputs when analyzing the dependencies: %d", len(response.Outputs))
+	}
+
+	encodedDependencies, ok := response.Outputs[0].(string)
+	if !ok {
+		return ReturnArgs{}, fmt.Errorf("unexpected output type when analyzing the dependencies: %T", response.Outputs[0])
+	}
+
+	var dependencies struct {
+		Files    []string          `json:"files"`
+		Products []RequiredProduct `json:"products"`
+	}
+	if err := json.Unmarshal([]byte(encodedDependencies), &dependencies); err != nil {
+		return ReturnArgs{}, fmt.Errorf("failed to decode the dependencies: %w", err)
+	}
+
+	result := ReturnArgs{
+		RequiredFiles:    []string{},
+		RequiredProducts: []RequiredProduct{},
+		MissingProducts:  []string{},
+	}
+
+	if dependencies.Files != nil {
+		result.RequiredFiles = dependencies.Files
+	}
+
+	for _, product := range dependencies.Products {
+		result.RequiredProducts = append(result.RequiredProducts, product)
+
+		if !product.Installed {
+			result.MissingProducts = append(result.MissingProducts, product.Name)
+		}
+	}
+
+	if len(result.MissingProducts) > 0 {
+		sessionLogger.With("missing_products", result.MissingProducts).Warn("Required products are not installed in the MATLAB session")
+	}
+
+	return result, nil
+}
@@ -0,0 +1,255 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package analyzematlabdependencies_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/analyzematlabdependencies"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	analyzematlabdependenciesmocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/analyzematlabdependencies"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockPathValidator := &analyzematlabdependenciesmocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	// Act
+	usecase := analyzematlabdependencies.New(mockPathValidator)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &analyzematlabdependenciesmocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	inputPath := filepath.Join("path", "to", "project")
+	validatedPath := filepath.Join("validated", "path", "to", "project")
+
+	encodedDependencies := `{
+		"files": ["/validated/path/to/project/main.m", "/validated/path/to/project/helper.m"],
+		"products": [
+			{"name": "MATLAB", "version": "24.2", "product_number": 1, "certain": true, "installed": true},
+			{"name": "Signal Processing Toolbox", "version": "24.2", "product_number": 8, "certain": true, "installed": false}
+		]
+	}`
+
+	mockPathValidator.EXPECT().
+		ValidateExistingPath(inputPath).
+		Return(validatedPath, nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.analyzeDependencies",
+			Arguments:  []string{validatedPath},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{encodedDependencies}}, nil).
+		Once()
+
+	usecase := analyzematlabdependencies.New(mockPathValidator)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, analyzematlabdependencies.Args{Path: inputPath})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, analyzematlabdependencies.ReturnArgs{
+		RequiredFiles: []string{"/validated/path/to/project/main.m", "/validated/path/to/project/helper.m"},
+		RequiredProducts: []analyzematlabdependencies.RequiredProduct{
+			{Name: "MATLAB", Version: "24.2", ProductNumber: 1, Certain: true, Installed: true},
+			{Name: "Signal Processing Toolbox", Version: "24.2", ProductNumber: 8, Certain: true, Installed: false},
+		},
+		MissingProducts: []string{"Signal Processing Toolbox"},
+	}, result)
+
+	fields, found := mockLogger.WarnLogs()["Required products are not installed in the MATLAB session"]
+	require.True(t, found, "Expected a warning about the missing products")
+	assert.Equal(t, []string{"Signal Processing Toolbox"}, fields["missing_products"])
+}
+
+func TestUsecase_Execute_HappyPath_NoDependencies(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &analyzematlabdependenciesmocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	inputPath := filepath.Join("path", "to", "empty")
+
+	mockPathValidator.EXPECT().
+		ValidateExistingPath(inputPath).
+		Return(inputPath, nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.analyzeDependencies",
+			Arguments:  []string{inputPath},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{`{"files": [], "products": []}`}}, nil).
+		Once()
+
+	usecase := analyzematlabdependencies.New(mockPathValidator)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, analyzematlabdependencies.Args{Path: inputPath})
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotNil(t, result.RequiredFiles)
+	assert.Empty(t, result.RequiredFiles)
+	assert.NotNil(t, result.RequiredProducts)
+	assert.Empty(t, result.RequiredProducts)
+	assert.NotNil(t, result.MissingProducts)
+	assert.Empty(t, result.MissingProducts)
+	assert.Empty(t, mockLogger.WarnLogs())
+}
+
+func TestUsecase_Execute_PathValidationError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &analyzematlabdependenciesmocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	inputPath := filepath.Join("path", "to", "missing")
+
+	mockPathValidator.EXPECT().
+		ValidateExistingPath(inputPath).
+		Return("", assert.AnError).
+		Once()
+
+	usecase := analyzematlabdependencies.New(mockPathValidator)
+
+	// Act
+	result, err := usecase.Execute(t.Context(), mockLogger, mockClient, analyzematlabdependencies.Args{Path: inputPath})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_FEvalError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &analyzematlabdependenciesmocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	inputPath := filepath.Join("path", "to", "project")
+
+	mockPathValidator.EXPECT().
+		ValidateExistingPath(inputPath).
+		Return(inputPath, nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.analyzeDependencies",
+			Arguments:  []string{inputPath},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{}, assert.AnError).
+		Once()
+
+	usecase := analyzematlabdependencies.New(mockPathValidator)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, analyzematlabdependencies.Args{Path: inputPath})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_UnexpectedOutputs(t *testing.T) {
+	testCases := []struct {
+		name          string
+		outputs       []any
+		expectedError string
+	}{
+		{
+			name:          "no outputs",
+			outputs:       []any{},
+			expectedError: "unexpected number of outputs when analyzing the dependencies: 0",
+		},
+		{
+			name:          "not a string",
+			outputs:       []any{42.0},
+			expectedError: "unexpected output type when analyzing the dependencies: float64",
+		},
+		{
+			name:          "invalid JSON",
+			outputs:       []any{"not JSON"},
+			expectedError: "failed to decode the dependencies: invalid character 'o' in literal null (expecting 'u')",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockPathValidator := &analyzematlabdependenciesmocks.MockPathValidator{}
+			defer mockPathValidator.AssertExpectations(t)
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			ctx := t.Context()
+			inputPath := filepath.Join("path", "to", "project")
+
+			mockPathValidator.EXPECT().
+				ValidateExistingPath(inputPath).
+				Return(inputPath, nil).
+				Once()
+
+			mockClient.EXPECT().
+				FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+					Function:   "matlab_mcp.analyzeDependencies",
+					Arguments:  []string{inputPath},
+					NumOutputs: 1,
+				}).
+				Return(entities.FEvalResponse{Outputs: tc.outputs}, nil).
+				Once()
+
+			usecase := analyzematlabdependencies.New(mockPathValidator)
+
+			// Act
+			result, err := usecase.Execute(ctx, mockLogger, mockClient, analyzematlabdependencies.Args{Path: inputPath})
+
+			// Assert
+			require.EqualError(t, err, tc.expectedError)
+			assert.Empty(t, result)
+		})
+	}
+}
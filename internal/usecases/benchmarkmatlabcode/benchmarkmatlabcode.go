@@ -0,0 +1,267 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package benchmarkmatlabcode
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+const benchmarkCodeFunction = "matlab_mcp.benchmarkCode"
+
+const (
+	defaultWarmupRuns = 1
+	defaultRuns       = 10
+
+	// MaxWarmupRuns and MaxRuns bound how long a benchmark keeps MATLAB busy.
+	MaxWarmupRuns = 100
+	MaxRuns       = 1000
+)
+
+const (
+	CodeLabel        = "code"
+	CompareCodeLabel = "compare_code"
+)
+
+// Args holds the code to benchmark, and optionally the code to compare it with.
+// Each of them is either a snippet, or an expression returning a function handle taking no inputs.
+// WarmupRuns is nil to use the default number of warmup runs, as 0 runs no warmup, while Runs is 0 to use the default number of runs.
+type Args struct {
+	ProjectPath string
+	SetupCode   string
+	Code        string
+	CompareCode string
+	WarmupRuns  *int
+	Runs        int
+	UseTimeit   bool
+}
+
+// Result holds the statistics of the runs of an implementation. The times are in seconds.
+type Result struct {
+	Label      string
+	Code       string
+	Error      string
+	Runs       int
+	Median     float64
+	Min        float64
+	Max        float64
+	Mean       float64
+	StdDev     float64
+	TimeitTime float64
+	// MemoryAvailable is false where MATLAB cannot measure the memory it uses, which is everywhere but on Windows.
+	MemoryAvailable bool
+	MemoryBytes     float64
+}
+
+type ReturnArgs struct {
+	Results []Result
+	// Fastest is the label of the implementation with the lowest median time,
+	// and Speedup the ratio of the median times, only set when two implementations ran without error.
+	Fastest string
+	Speedup float64
+}
+
+type PathValidator interface {
+	ValidateFolderPath(filePath string) (string, error)
+}
+
+type CodeSafetyPolicy interface {
+	Check(ctx context.Context, sessionLogger entities.Logger, code string) error
+}
+
+type Usecase struct {
+	pathValidator    PathValidator
+	codeSafetyPolicy CodeSafetyPolicy
+}
+
+func New(
+	pathValidator PathValidator,
+	codeSafetyPolicy CodeSafetyPolicy,
+) *Usecase {
+	return &Usecase{
+		pathValidator:    pathValidator,
+		codeSafetyPolicy: codeSafetyPolicy,
+	}
+}
+
+type implementation struct {
+	label string
+	code  string
+}
+
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering BenchmarkMATLABCode Usecase")
+	defer sessionLogger.Debug("Exiting BenchmarkMATLABCode Usecase")
+
+	warmupRuns := defaultWarmupRuns
+	if request.WarmupRuns != nil {
+		warmupRuns = *request.WarmupRuns
+	}
+
+	if warmupRuns < 0 {
+		return ReturnArgs{}, fmt.Errorf("the number of warmup runs must not be negative, got %d", warmupRuns)
+	}
+
+	if warmupRuns > MaxWarmupRuns {
+		return ReturnArgs{}, fmt.Errorf("the number of warmup runs must be at most %d, got %d", MaxWarmupRuns, warmupRuns)
+	}
+
+	if request.Runs < 0 {
+		return ReturnArgs{}, fmt.Errorf("the number of runs must not be negative, got %d", request.Runs)
+	}
+
+	if request.Runs > MaxRuns {
+		return ReturnArgs{}, fmt.Errorf("the number of runs must be at most %d, got %d", MaxRuns, request.Runs)
+	}
+
+	validatedPath, err := u.pathValidator.ValidateFolderPath(request.ProjectPath)
+	if err != nil {
+		sessionLogger.WithError(err).With("path", request.ProjectPath).Warn("Path validation failed")
+		return ReturnArgs{}, fmt.Errorf("path validation failed: %w", err)
+	}
+
+	for _, code := range []string{request.SetupCode, request.Code, request.CompareCode} {
+		if code == "" {
+			continue
+		}
+
+		if err := u.codeSafetyPolicy.Check(ctx, sessionLogger, code); err != nil {
+			return ReturnArgs{}, fmt.Errorf("code safety check failed: %w", err)
+		}
+	}
+
+	cdRequest := entities.EvalRequest{
+		Code: fmt.Sprintf("cd('%s')", strings.ReplaceAll(validatedPath, "'", "''")), // Escape single quotes
+	}
+	if _, err := client.Eval(ctx, sessionLogger, cdRequest); err != nil {
+		return ReturnArgs{}, err
+	}
+
+	runs := request.Runs
+	if runs == 0 {
+		runs = defaultRuns
+	}
+
+	implementations := []implementation{
+		{label: CodeLabel, code: request.Code},
+	}
+	if request.CompareCode != "" {
+		implementations = append(implementations, implementation{label: CompareCodeLabel, code: request.CompareCode})
+	}
+
+	result := ReturnArgs{
+		Results: make([]Result, 0, len(implementations)),
+	}
+
+	for _, implementation := range implementations {
+		// The setup code runs before each implementation, so that they all start from the same workspace
+		benchmark, err := benchmarkCode(ctx, sessionLogger, client, request.SetupCode, implementation.code, warmupRuns, runs, request.UseTimeit)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		benchmark.Label = implementation.label
+		benchmark.Code = implementation.code
+		result.Results = append(result.Results, benchmark)
+	}
+
+	if len(result.Results) == 2 && result.Results[0].Error == "" && result.Results[1].Error == "" {
+		result.Fastest, result.Speedup = compare(result.Results[0], result.Results[1])
+	}
+
+	return result, nil
+}
+
+func benchmarkCode(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, setupCode string, code string, warmupRuns int, runs int, useTimeit bool) (Result, error) {
+	response, err := client.FEval(ctx, sessionLogger, entities.FEvalRequest{
+		Function:   benchmarkCodeFunction,
+		Arguments:  []string{setupCode, code, strconv.Itoa(warmupRuns), strconv.Itoa(runs), strconv.FormatBool(useTimeit)},
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return Result{}, err
+	}
+
+	if len(response.Outputs) != 1 {
+		return Result{}, fmt.Errorf("unexpected number of outputs when benchmarking the code: %d", len(response.Outputs))
+	}
+
+	encodedBenchmark, ok := response.Outputs[0].(string)
+	if !ok {
+		return Result{}, fmt.Errorf("unexpected output type when benchmarking the code: %T", response.Outputs[0])
+	}
+
+	var benchmark struct {
+		Error           string    `json:"error"`
+		Times           []float64 `json:"times"`
+		TimeitTime      float64   `json:"timeit_time"`
+		MemoryAvailable bool      `json:"memory_available"`
+		MemoryBytes     float64   `json:"memory_bytes"`
+	}
+	if err := json.Unmarshal([]byte(encodedBenchmark), &benchmark); err != nil {
+		return Result{}, fmt.Errorf("failed to decode the benchmark: %w", err)
+	}
+
+	result := Result{
+		Error:           benchmark.Error,
+		Runs:            len(benchmark.Times),
+		TimeitTime:      benchmark.TimeitTime,
+		MemoryAvailable: benchmark.MemoryAvailable,
+		MemoryBytes:     benchmark.MemoryBytes,
+	}
+
+	if len(benchmark.Times) > 0 {
+		result.Median, result.Min, result.Max, result.Mean, result.StdDev = statistics(benchmark.Times)
+	}
+
+	return result, nil
+}
+
+// statistics returns the median, minimum, maximum, mean and sample standard deviation of the times.
+func statistics(times []float64) (median, minimum, maximum, mean, stdDev float64) {
+	sorted := slices.Clone(times)
+	slices.Sort(sorted)
+
+	count := len(sorted)
+	if count%2 == 1 {
+		median = sorted[count/2]
+	} else {
+		median = (sorted[count/2-1] + sorted[count/2]) / 2
+	}
+
+	sum := 0.0
+	for _, time := range sorted {
+		sum += time
+	}
+	mean = sum / float64(count)
+
+	if count > 1 {
+		squares := 0.0
+		for _, time := range sorted {
+			squares += (time - mean) * (time - mean)
+		}
+		stdDev = math.Sqrt(squares / float64(count-1))
+	}
+
+	return median, sorted[0], sorted[count-1], mean, stdDev
+}
+
+func compare(first Result, second Result) (string, float64) {
+	fastest, slowest := first, second
+	if second.Median < first.Median {
+		fastest, slowest = second, first
+	}
+
+	if fastest.Median <= 0 {
+		return fastest.Label, 0
+	}
+
+	return fastest.Label, slowest.Median / fastest.Median
+}
@@ -0,0 +1,520 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package benchmarkmatlabcode_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/benchmarkmatlabcode"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/benchmarkmatlabcode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	setupCode   = "x = rand(1, 1e6);"
+	code        = "y = 0; for i = 1:numel(x), y = y + x(i); end"
+	compareCode = "@() sum(x)"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+	defer mockCodeSafetyPolicy.AssertExpectations(t)
+
+	// Act
+	usecase := benchmarkmatlabcode.New(mockPathValidator, mockCodeSafetyPolicy)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+	defer mockCodeSafetyPolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	projectPath := filepath.Join("some", "path")
+	ctx := t.Context()
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(projectPath).
+		Return(projectPath, nil).
+		Once()
+
+	mockCodeSafetyPolicy.EXPECT().
+		Check(ctx, mockLogger.AsMockArg(), code).
+		Return(nil).
+		Once()
+
+	expectCd(t, mockLogger, mockClient, projectPath)
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.benchmarkCode",
+			Arguments:  []string{"", code, "1", "10", "false"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{`{"error":"","times":[0.3,0.1,0.2,0.4],"timeit_time":0,"memory_available":true,"memory_bytes":8000000}`}}, nil).
+		Once()
+
+	usecase := benchmarkmatlabcode.New(mockPathValidator, mockCodeSafetyPolicy)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, benchmarkmatlabcode.Args{
+		ProjectPath: projectPath,
+		Code:        code,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, result.Results, 1)
+
+	benchmark := result.Results[0]
+	assert.Equal(t, benchmarkmatlabcode.CodeLabel, benchmark.Label)
+	assert.Equal(t, code, benchmark.Code)
+	assert.Empty(t, benchmark.Error)
+	assert.Equal(t, 4, benchmark.Runs)
+	assert.InDelta(t, 0.25, benchmark.Median, 1e-9)
+	assert.InDelta(t, 0.1, benchmark.Min, 1e-9)
+	assert.InDelta(t, 0.4, benchmark.Max, 1e-9)
+	assert.InDelta(t, 0.25, benchmark.Mean, 1e-9)
+	assert.InDelta(t, 0.129099, benchmark.StdDev, 1e-6)
+	assert.True(t, benchmark.MemoryAvailable)
+	assert.InDelta(t, 8000000, benchmark.MemoryBytes, 1e-9)
+	assert.Empty(t, result.Fastest, "A single implementation should not be compared")
+	assert.Zero(t, result.Speedup)
+}
+
+func TestUsecase_Execute_Comparison(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+	defer mockCodeSafetyPolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	projectPath := filepath.Join("some", "path")
+	ctx := t.Context()
+	warmupRuns := 2
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(projectPath).
+		Return(projectPath, nil).
+		Once()
+
+	for _, checkedCode := range []string{setupCode, code, compareCode} {
+		mockCodeSafetyPolicy.EXPECT().
+			Check(ctx, mockLogger.AsMockArg(), checkedCode).
+			Return(nil).
+			Once()
+	}
+
+	expectCd(t, mockLogger, mockClient, projectPath)
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.benchmarkCode",
+			Arguments:  []string{setupCode, code, "2", "3", "true"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{`{"error":"","times":[0.4,0.2,0.3],"timeit_time":0.29,"memory_available":false,"memory_bytes":0}`}}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.benchmarkCode",
+			Arguments:  []string{setupCode, compareCode, "2", "3", "true"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{`{"error":"","times":[0.1,0.15,0.2],"timeit_time":0.14,"memory_available":false,"memory_bytes":0}`}}, nil).
+		Once()
+
+	usecase := benchmarkmatlabcode.New(mockPathValidator, mockCodeSafetyPolicy)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, benchmarkmatlabcode.Args{
+		ProjectPath: projectPath,
+		SetupCode:   setupCode,
+		Code:        code,
+		CompareCode: compareCode,
+		WarmupRuns:  &warmupRuns,
+		Runs:        3,
+		UseTimeit:   true,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, result.Results, 2)
+	assert.Equal(t, benchmarkmatlabcode.CodeLabel, result.Results[0].Label)
+	assert.InDelta(t, 0.3, result.Results[0].Median, 1e-9)
+	assert.InDelta(t, 0.29, result.Results[0].TimeitTime, 1e-9)
+	assert.Equal(t, benchmarkmatlabcode.CompareCodeLabel, result.Results[1].Label)
+	assert.Equal(t, compareCode, result.Results[1].Code)
+	assert.InDelta(t, 0.15, result.Results[1].Median, 1e-9)
+	assert.InDelta(t, 0.14, result.Results[1].TimeitTime, 1e-9)
+	assert.False(t, result.Results[1].MemoryAvailable)
+	assert.Equal(t, benchmarkmatlabcode.CompareCodeLabel, result.Fastest)
+	assert.InDelta(t, 2, result.Speedup, 1e-9)
+}
+
+func TestUsecase_Execute_NoWarmupRuns(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+	defer mockCodeSafetyPolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	projectPath := filepath.Join("some", "path")
+	ctx := t.Context()
+	warmupRuns := 0
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(projectPath).
+		Return(projectPath, nil).
+		Once()
+
+	mockCodeSafetyPolicy.EXPECT().
+		Check(ctx, mockLogger.AsMockArg(), code).
+		Return(nil).
+		Once()
+
+	expectCd(t, mockLogger, mockClient, projectPath)
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.benchmarkCode",
+			Arguments:  []string{"", code, "0", "10", "false"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{`{"error":"","times":[0.1],"timeit_time":0,"memory_available":false,"memory_bytes":0}`}}, nil).
+		Once()
+
+	usecase := benchmarkmatlabcode.New(mockPathValidator, mockCodeSafetyPolicy)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, benchmarkmatlabcode.Args{
+		ProjectPath: projectPath,
+		Code:        code,
+		WarmupRuns:  &warmupRuns,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, result.Results, 1)
+	assert.Equal(t, 1, result.Results[0].Runs)
+}
+
+func TestUsecase_Execute_CodeError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+	defer mockCodeSafetyPolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	projectPath := filepath.Join("some", "path")
+	ctx := t.Context()
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(projectPath).
+		Return(projectPath, nil).
+		Once()
+
+	for _, checkedCode := range []string{code, compareCode} {
+		mockCodeSafetyPolicy.EXPECT().
+			Check(ctx, mockLogger.AsMockArg(), checkedCode).
+			Return(nil).
+			Once()
+	}
+
+	expectCd(t, mockLogger, mockClient, projectPath)
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.benchmarkCode",
+			Arguments:  []string{"", code, "1", "10", "false"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{`{"error":"Unrecognized function or variable 'x'.","times":[],"timeit_time":0,"memory_available":false,"memory_bytes":0}`}}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.benchmarkCode",
+			Arguments:  []string{"", compareCode, "1", "10", "false"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{`{"error":"","times":[0.1],"timeit_time":0,"memory_available":false,"memory_bytes":0}`}}, nil).
+		Once()
+
+	usecase := benchmarkmatlabcode.New(mockPathValidator, mockCodeSafetyPolicy)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, benchmarkmatlabcode.Args{
+		ProjectPath: projectPath,
+		Code:        code,
+		CompareCode: compareCode,
+	})
+
+	// Assert
+	require.NoError(t, err, "Errors of the code should be returned as a result")
+	require.Len(t, result.Results, 2)
+	assert.Equal(t, "Unrecognized function or variable 'x'.", result.Results[0].Error)
+	assert.Zero(t, result.Results[0].Runs)
+	assert.InDelta(t, 0.1, result.Results[1].Median, 1e-9)
+	assert.Zero(t, result.Results[1].StdDev, "A single run should have no standard deviation")
+	assert.Empty(t, result.Fastest, "Implementations should not be compared when one of them failed")
+}
+
+func TestUsecase_Execute_InvalidRuns(t *testing.T) {
+	tooManyWarmupRuns := benchmarkmatlabcode.MaxWarmupRuns + 1
+	negativeWarmupRuns := -1
+
+	testCases := []struct {
+		name          string
+		args          benchmarkmatlabcode.Args
+		expectedError string
+	}{
+		{
+			name:          "too many warmup runs",
+			args:          benchmarkmatlabcode.Args{WarmupRuns: &tooManyWarmupRuns},
+			expectedError: "the number of warmup runs must be at most 100, got 101",
+		},
+		{
+			name:          "negative warmup runs",
+			args:          benchmarkmatlabcode.Args{WarmupRuns: &negativeWarmupRuns},
+			expectedError: "the number of warmup runs must not be negative, got -1",
+		},
+		{
+			name:          "too many runs",
+			args:          benchmarkmatlabcode.Args{Runs: benchmarkmatlabcode.MaxRuns + 1},
+			expectedError: "the number of runs must be at most 1000, got 1001",
+		},
+		{
+			name:          "negative runs",
+			args:          benchmarkmatlabcode.Args{Runs: -5},
+			expectedError: "the number of runs must not be negative, got -5",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockPathValidator := &mocks.MockPathValidator{}
+			defer mockPathValidator.AssertExpectations(t)
+
+			mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+			defer mockCodeSafetyPolicy.AssertExpectations(t)
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			tc.args.ProjectPath = filepath.Join("some", "path")
+			tc.args.Code = code
+
+			usecase := benchmarkmatlabcode.New(mockPathValidator, mockCodeSafetyPolicy)
+
+			// Act
+			result, err := usecase.Execute(t.Context(), mockLogger, mockClient, tc.args)
+
+			// Assert
+			require.EqualError(t, err, tc.expectedError)
+			assert.Empty(t, result)
+		})
+	}
+}
+
+func TestUsecase_Execute_ValidatePathError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+	defer mockCodeSafetyPolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	projectPath := filepath.Join("some", "path")
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(projectPath).
+		Return("", assert.AnError).
+		Once()
+
+	usecase := benchmarkmatlabcode.New(mockPathValidator, mockCodeSafetyPolicy)
+
+	// Act
+	result, err := usecase.Execute(t.Context(), mockLogger, mockClient, benchmarkmatlabcode.Args{ProjectPath: projectPath, Code: code})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_CodeSafetyError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+	defer mockCodeSafetyPolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	projectPath := filepath.Join("some", "path")
+	ctx := t.Context()
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(projectPath).
+		Return(projectPath, nil).
+		Once()
+
+	mockCodeSafetyPolicy.EXPECT().
+		Check(ctx, mockLogger.AsMockArg(), code).
+		Return(nil).
+		Once()
+
+	mockCodeSafetyPolicy.EXPECT().
+		Check(ctx, mockLogger.AsMockArg(), compareCode).
+		Return(assert.AnError).
+		Once()
+
+	usecase := benchmarkmatlabcode.New(mockPathValidator, mockCodeSafetyPolicy)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, benchmarkmatlabcode.Args{
+		ProjectPath: projectPath,
+		Code:        code,
+		CompareCode: compareCode,
+	})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_BenchmarkErrors(t *testing.T) {
+	testCases := []struct {
+		name          string
+		response      entities.FEvalResponse
+		err           error
+		expectedError string
+	}{
+		{
+			name:          "FEval error",
+			err:           assert.AnError,
+			expectedError: assert.AnError.Error(),
+		},
+		{
+			name:          "unexpected number of outputs",
+			response:      entities.FEvalResponse{Outputs: []any{}},
+			expectedError: "unexpected number of outputs when benchmarking the code: 0",
+		},
+		{
+			name:          "unexpected output type",
+			response:      entities.FEvalResponse{Outputs: []any{42}},
+			expectedError: "unexpected output type when benchmarking the code: int",
+		},
+		{
+			name:          "invalid JSON",
+			response:      entities.FEvalResponse{Outputs: []any{"not JSON"}},
+			expectedError: "failed to decode the benchmark",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockPathValidator := &mocks.MockPathValidator{}
+			defer mockPathValidator.AssertExpectations(t)
+
+			mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+			defer mockCodeSafetyPolicy.AssertExpectations(t)
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			projectPath := filepath.Join("some", "path")
+			ctx := t.Context()
+
+			mockPathValidator.EXPECT().
+				ValidateFolderPath(projectPath).
+				Return(projectPath, nil).
+				Once()
+
+			mockCodeSafetyPolicy.EXPECT().
+				Check(ctx, mockLogger.AsMockArg(), code).
+				Return(nil).
+				Once()
+
+			expectCd(t, mockLogger, mockClient, projectPath)
+
+			mockClient.EXPECT().
+				FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+					Function:   "matlab_mcp.benchmarkCode",
+					Arguments:  []string{"", code, "1", "10", "false"},
+					NumOutputs: 1,
+				}).
+				Return(testCase.response, testCase.err).
+				Once()
+
+			usecase := benchmarkmatlabcode.New(mockPathValidator, mockCodeSafetyPolicy)
+
+			// Act
+			result, err := usecase.Execute(ctx, mockLogger, mockClient, benchmarkmatlabcode.Args{ProjectPath: projectPath, Code: code})
+
+			// Assert
+			require.ErrorContains(t, err, testCase.expectedError)
+			assert.Empty(t, result)
+		})
+	}
+}
+
+func expectCd(t *testing.T, mockLogger *testutils.InspectableLogger, mockClient *entitiesmocks.MockMATLABSessionClient, projectPath string) {
+	mockClient.EXPECT().
+		Eval(t.Context(), mockLogger.AsMockArg(), entities.EvalRequest{Code: "cd('" + projectPath + "')"}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+}
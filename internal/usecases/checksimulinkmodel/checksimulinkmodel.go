@@ -0,0 +1,135 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package checksimulinkmodel
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+const checkSimulinkModelFunction = "matlab_mcp.checkSimulinkModel"
+
+var (
+	ErrMissingModel      = errors.New("the model to check is missing")
+	ErrMissingChecks     = errors.New("either the IDs of the Model Advisor checks to run or a Model Advisor configuration file is required")
+	ErrConflictingChecks = errors.New("the IDs of the Model Advisor checks to run and a Model Advisor configuration file cannot be given together")
+)
+
+// Args holds the loaded model to check, and either the IDs of the Model Advisor checks to run,
+// such as mathworks.design.UnconnectedLinesPorts, or a Model Advisor configuration file.
+type Args struct {
+	Model             string
+	CheckIDs          []string
+	ConfigurationFile string
+}
+
+type Check struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+type ReturnArgs struct {
+	System   string  `json:"system"`
+	Passed   int     `json:"passed"`
+	Failed   int     `json:"failed"`
+	Warnings int     `json:"warnings"`
+	NotRun   int     `json:"not_run"`
+	Report   string  `json:"report"`
+	Checks   []Check `json:"checks"`
+}
+
+type PathValidator interface {
+	ValidateExistingPath(filePath string) (string, error)
+}
+
+type SimulinkDetector interface {
+	Check(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient) error
+}
+
+type Usecase struct {
+	pathValidator    PathValidator
+	simulinkDetector SimulinkDetector
+}
+
+func New(
+	pathValidator PathValidator,
+	simulinkDetector SimulinkDetector,
+) *Usecase {
+	return &Usecase{
+		pathValidator:    pathValidator,
+		simulinkDetector: simulinkDetector,
+	}
+}
+
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering CheckSimulinkModel Usecase")
+	defer sessionLogger.Debug("Exiting CheckSimulinkModel Usecase")
+
+	if request.Model == "" {
+		return ReturnArgs{}, ErrMissingModel
+	}
+
+	var configurationFile string
+	switch {
+	case len(request.CheckIDs) > 0 && request.ConfigurationFile != "":
+		return ReturnArgs{}, ErrConflictingChecks
+	case len(request.CheckIDs) == 0 && request.ConfigurationFile == "":
+		return ReturnArgs{}, ErrMissingChecks
+	case request.ConfigurationFile != "":
+		validatedPath, err := u.pathValidator.ValidateExistingPath(request.ConfigurationFile)
+		if err != nil {
+			sessionLogger.WithError(err).With("path", request.ConfigurationFile).Warn("Path validation failed")
+			return ReturnArgs{}, fmt.Errorf("path validation failed: %w", err)
+		}
+
+		configurationFile = validatedPath
+	}
+
+	if err := u.simulinkDetector.Check(ctx, sessionLogger, client); err != nil {
+		return ReturnArgs{}, err
+	}
+
+	checkIDs := request.CheckIDs
+	if checkIDs == nil {
+		checkIDs = []string{}
+	}
+
+	encodedCheckIDs, err := json.Marshal(checkIDs)
+	if err != nil {
+		return ReturnArgs{}, fmt.Errorf("failed to encode the check IDs: %w", err)
+	}
+
+	response, err := client.FEval(ctx, sessionLogger, entities.FEvalRequest{
+		Function:   checkSimulinkModelFunction,
+		Arguments:  []string{request.Model, string(encodedCheckIDs), configurationFile},
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	if len(response.Outputs) != 1 {
+		return ReturnArgs{}, fmt.Errorf("unexpected number of outputs when checking the model: %d", len(response.Outputs))
+	}
+
+	encodedResult, ok := response.Outputs[0].(string)
+	if !ok {
+		return ReturnArgs{}, fmt.Errorf("unexpected output type when checking the model: %T", response.Outputs[0])
+	}
+
+	var result ReturnArgs
+	if err := json.Unmarshal([]byte(encodedResult), &result); err != nil {
+		return ReturnArgs{}, fmt.Errorf("failed to decode the Model Advisor results: %w", err)
+	}
+
+	if result.Checks == nil {
+		result.Checks = []Check{}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,323 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package checksimulinkmodel_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/checksimulinkmodel"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/simulinkdetector"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/checksimulinkmodel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	model             = "vdp"
+	checkID           = "mathworks.design.UnconnectedLinesPorts"
+	configurationFile = "/home/user/models/checks.json"
+	encodedResult     = `{"system":"vdp","passed":1,"failed":0,"warnings":0,"not_run":0,"report":"/home/user/slprj/modeladvisor/vdp/report.html","checks":[{"id":"mathworks.design.UnconnectedLinesPorts","name":"Check for unconnected lines","status":"Pass"}]}`
+)
+
+var expectedResult = checksimulinkmodel.ReturnArgs{
+	System: "vdp",
+	Passed: 1,
+	Report: "/home/user/slprj/modeladvisor/vdp/report.html",
+	Checks: []checksimulinkmodel.Check{
+		{ID: checkID, Name: "Check for unconnected lines", Status: "Pass"},
+	},
+}
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockSimulinkDetector := &mocks.MockSimulinkDetector{}
+	defer mockSimulinkDetector.AssertExpectations(t)
+
+	// Act
+	usecase := checksimulinkmodel.New(mockPathValidator, mockSimulinkDetector)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_CheckIDs(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockSimulinkDetector := &mocks.MockSimulinkDetector{}
+	defer mockSimulinkDetector.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockSimulinkDetector.EXPECT().
+		Check(ctx, mockLogger.AsMockArg(), mockClient).
+		Return(nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.checkSimulinkModel",
+			Arguments:  []string{model, `["mathworks.design.UnconnectedLinesPorts"]`, ""},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{encodedResult}}, nil).
+		Once()
+
+	usecase := checksimulinkmodel.New(mockPathValidator, mockSimulinkDetector)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, checksimulinkmodel.Args{
+		Model:    model,
+		CheckIDs: []string{checkID},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestUsecase_Execute_ConfigurationFile(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockSimulinkDetector := &mocks.MockSimulinkDetector{}
+	defer mockSimulinkDetector.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockPathValidator.EXPECT().
+		ValidateExistingPath(configurationFile).
+		Return(configurationFile, nil).
+		Once()
+
+	mockSimulinkDetector.EXPECT().
+		Check(ctx, mockLogger.AsMockArg(), mockClient).
+		Return(nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.checkSimulinkModel",
+			Arguments:  []string{model, "[]", configurationFile},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{encodedResult}}, nil).
+		Once()
+
+	usecase := checksimulinkmodel.New(mockPathValidator, mockSimulinkDetector)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, checksimulinkmodel.Args{
+		Model:             model,
+		ConfigurationFile: configurationFile,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestUsecase_Execute_InvalidArgs(t *testing.T) {
+	testCases := []struct {
+		name          string
+		args          checksimulinkmodel.Args
+		expectedError error
+	}{
+		{
+			name:          "missing model",
+			args:          checksimulinkmodel.Args{CheckIDs: []string{checkID}},
+			expectedError: checksimulinkmodel.ErrMissingModel,
+		},
+		{
+			name:          "missing checks",
+			args:          checksimulinkmodel.Args{Model: model},
+			expectedError: checksimulinkmodel.ErrMissingChecks,
+		},
+		{
+			name:          "conflicting checks",
+			args:          checksimulinkmodel.Args{Model: model, CheckIDs: []string{checkID}, ConfigurationFile: configurationFile},
+			expectedError: checksimulinkmodel.ErrConflictingChecks,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockPathValidator := &mocks.MockPathValidator{}
+			defer mockPathValidator.AssertExpectations(t)
+
+			mockSimulinkDetector := &mocks.MockSimulinkDetector{}
+			defer mockSimulinkDetector.AssertExpectations(t)
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			usecase := checksimulinkmodel.New(mockPathValidator, mockSimulinkDetector)
+
+			// Act
+			result, err := usecase.Execute(t.Context(), mockLogger, mockClient, testCase.args)
+
+			// Assert
+			require.ErrorIs(t, err, testCase.expectedError)
+			assert.Empty(t, result)
+		})
+	}
+}
+
+func TestUsecase_Execute_ValidatePathError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockSimulinkDetector := &mocks.MockSimulinkDetector{}
+	defer mockSimulinkDetector.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockPathValidator.EXPECT().
+		ValidateExistingPath(configurationFile).
+		Return("", assert.AnError).
+		Once()
+
+	usecase := checksimulinkmodel.New(mockPathValidator, mockSimulinkDetector)
+
+	// Act
+	result, err := usecase.Execute(t.Context(), mockLogger, mockClient, checksimulinkmodel.Args{
+		Model:             model,
+		ConfigurationFile: configurationFile,
+	})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_SimulinkNotDetected(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockSimulinkDetector := &mocks.MockSimulinkDetector{}
+	defer mockSimulinkDetector.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockSimulinkDetector.EXPECT().
+		Check(ctx, mockLogger.AsMockArg(), mockClient).
+		Return(simulinkdetector.ErrSimulinkNotDetected).
+		Once()
+
+	usecase := checksimulinkmodel.New(mockPathValidator, mockSimulinkDetector)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, checksimulinkmodel.Args{
+		Model:    model,
+		CheckIDs: []string{checkID},
+	})
+
+	// Assert
+	require.ErrorIs(t, err, simulinkdetector.ErrSimulinkNotDetected)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_CheckErrors(t *testing.T) {
+	testCases := []struct {
+		name          string
+		response      entities.FEvalResponse
+		err           error
+		expectedError string
+	}{
+		{
+			name:          "FEval error",
+			err:           assert.AnError,
+			expectedError: assert.AnError.Error(),
+		},
+		{
+			name:          "unexpected number of outputs",
+			response:      entities.FEvalResponse{Outputs: []any{}},
+			expectedError: "unexpected number of outputs when checking the model: 0",
+		},
+		{
+			name:          "unexpected output type",
+			response:      entities.FEvalResponse{Outputs: []any{42}},
+			expectedError: "unexpected output type when checking the model: int",
+		},
+		{
+			name:          "invalid JSON",
+			response:      entities.FEvalResponse{Outputs: []any{"not JSON"}},
+			expectedError: "failed to decode the Model Advisor results",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockPathValidator := &mocks.MockPathValidator{}
+			defer mockPathValidator.AssertExpectations(t)
+
+			mockSimulinkDetector := &mocks.MockSimulinkDetector{}
+			defer mockSimulinkDetector.AssertExpectations(t)
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			ctx := t.Context()
+
+			mockSimulinkDetector.EXPECT().
+				Check(ctx, mockLogger.AsMockArg(), mockClient).
+				Return(nil).
+				Once()
+
+			mockClient.EXPECT().
+				FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+					Function:   "matlab_mcp.checkSimulinkModel",
+					Arguments:  []string{model, `["mathworks.design.UnconnectedLinesPorts"]`, ""},
+					NumOutputs: 1,
+				}).
+				Return(testCase.response, testCase.err).
+				Once()
+
+			usecase := checksimulinkmodel.New(mockPathValidator, mockSimulinkDetector)
+
+			// Act
+			result, err := usecase.Execute(ctx, mockLogger, mockClient, checksimulinkmodel.Args{
+				Model:    model,
+				CheckIDs: []string{checkID},
+			})
+
+			// Assert
+			require.ErrorContains(t, err, testCase.expectedError)
+			assert.Empty(t, result)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package clearmatlabbreakpoints
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/breakpoints"
+)
+
+const clearBreakpointsFunction = "matlab_mcp.clearBreakpoints"
+
+var ErrLineWithoutFile = errors.New("a line requires the file of the breakpoint")
+
+// Args selects the breakpoints to clear: the one at the line of the file, all the ones of the file without a line,
+// or all the breakpoints, including stopping on errors, without a file.
+type Args struct {
+	FilePath string
+	Line     int
+}
+
+type PathValidator interface {
+	ValidateMATLABScript(filePath string) (string, error)
+}
+
+type Usecase struct {
+	pathValidator PathValidator
+}
+
+func New(
+	pathValidator PathValidator,
+) *Usecase {
+	return &Usecase{
+		pathValidator: pathValidator,
+	}
+}
+
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) (breakpoints.Breakpoints, error) {
+	sessionLogger.Debug("Entering ClearMATLABBreakpoints Usecase")
+	defer sessionLogger.Debug("Exiting ClearMATLABBreakpoints Usecase")
+
+	arguments := []string{"", ""}
+
+	if request.FilePath == "" && request.Line != 0 {
+		return breakpoints.Breakpoints{}, ErrLineWithoutFile
+	}
+
+	if request.FilePath != "" {
+		validatedPath, err := u.pathValidator.ValidateMATLABScript(request.FilePath)
+		if err != nil {
+			return breakpoints.Breakpoints{}, err
+		}
+
+		arguments[0] = validatedPath
+		if request.Line > 0 {
+			arguments[1] = strconv.Itoa(request.Line)
+		}
+	}
+
+	response, err := client.FEval(ctx, sessionLogger, entities.FEvalRequest{
+		Function:   clearBreakpointsFunction,
+		Arguments:  arguments,
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return breakpoints.Breakpoints{}, err
+	}
+
+	return breakpoints.Decode(response)
+}
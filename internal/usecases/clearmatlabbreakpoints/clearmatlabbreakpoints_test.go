@@ -0,0 +1,176 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package clearmatlabbreakpoints_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/clearmatlabbreakpoints"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/breakpoints"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/clearmatlabbreakpoints"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const noBreakpoints = `{"breakpoints":[],"stop_on_error":false}`
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	// Act
+	usecase := clearmatlabbreakpoints.New(mockPathValidator)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	filePath := filepath.Join("home", "user", "analysis.m")
+	validatedPath := filepath.Join("/", "home", "user", "analysis.m")
+
+	testCases := []struct {
+		name              string
+		args              clearmatlabbreakpoints.Args
+		expectedArguments []string
+	}{
+		{
+			name:              "all breakpoints",
+			args:              clearmatlabbreakpoints.Args{},
+			expectedArguments: []string{"", ""},
+		},
+		{
+			name:              "breakpoints of a file",
+			args:              clearmatlabbreakpoints.Args{FilePath: filePath},
+			expectedArguments: []string{validatedPath, ""},
+		},
+		{
+			name:              "breakpoint at a line",
+			args:              clearmatlabbreakpoints.Args{FilePath: filePath, Line: 12},
+			expectedArguments: []string{validatedPath, "12"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockPathValidator := &mocks.MockPathValidator{}
+			defer mockPathValidator.AssertExpectations(t)
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			ctx := t.Context()
+
+			if testCase.args.FilePath != "" {
+				mockPathValidator.EXPECT().
+					ValidateMATLABScript(filePath).
+					Return(validatedPath, nil).
+					Once()
+			}
+
+			mockClient.EXPECT().
+				FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+					Function:   "matlab_mcp.clearBreakpoints",
+					Arguments:  testCase.expectedArguments,
+					NumOutputs: 1,
+				}).
+				Return(entities.FEvalResponse{Outputs: []any{noBreakpoints}}, nil).
+				Once()
+
+			usecase := clearmatlabbreakpoints.New(mockPathValidator)
+
+			// Act
+			result, err := usecase.Execute(ctx, mockLogger, mockClient, testCase.args)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, breakpoints.Breakpoints{Breakpoints: []breakpoints.Breakpoint{}}, result)
+		})
+	}
+}
+
+func TestUsecase_Execute_LineWithoutFile(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	usecase := clearmatlabbreakpoints.New(mockPathValidator)
+
+	// Act
+	result, err := usecase.Execute(t.Context(), mockLogger, mockClient, clearmatlabbreakpoints.Args{Line: 12})
+
+	// Assert
+	require.ErrorIs(t, err, clearmatlabbreakpoints.ErrLineWithoutFile)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_ValidatePathError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	filePath := filepath.Join("home", "user", "analysis.txt")
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABScript(filePath).
+		Return("", assert.AnError).
+		Once()
+
+	usecase := clearmatlabbreakpoints.New(mockPathValidator)
+
+	// Act
+	result, err := usecase.Execute(t.Context(), mockLogger, mockClient, clearmatlabbreakpoints.Args{FilePath: filePath})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_FEvalError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.clearBreakpoints",
+			Arguments:  []string{"", ""},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{}, assert.AnError).
+		Once()
+
+	usecase := clearmatlabbreakpoints.New(mockPathValidator)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, clearmatlabbreakpoints.Args{})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
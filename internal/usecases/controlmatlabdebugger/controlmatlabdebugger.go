@@ -0,0 +1,70 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package controlmatlabdebugger
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/debugsession"
+)
+
+// Action is what to do with the code run in the debugger.
+type Action string
+
+const (
+	Status   Action = "status"
+	Step     Action = "step"
+	StepIn   Action = "step_in"
+	StepOut  Action = "step_out"
+	Continue Action = "continue"
+	Quit     Action = "quit"
+)
+
+// commands are the MATLAB debugger commands of the actions resuming the paused code.
+var commands = map[Action]entities.FEvalRequest{
+	Step:     {Function: "dbstep"},
+	StepIn:   {Function: "dbstep", Arguments: []string{"in"}},
+	StepOut:  {Function: "dbstep", Arguments: []string{"out"}},
+	Continue: {Function: "dbcont"},
+	Quit:     {Function: "dbquit"},
+}
+
+type Args struct {
+	Action Action
+}
+
+type DebugSession interface {
+	Resume(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, command entities.FEvalRequest) (debugsession.Status, error)
+	Wait(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient) (debugsession.Status, error)
+}
+
+type Usecase struct {
+	debugSession DebugSession
+}
+
+func New(
+	debugSession DebugSession,
+) *Usecase {
+	return &Usecase{
+		debugSession: debugSession,
+	}
+}
+
+// Execute returns the status of the debugging, after resuming the paused code for the actions other than status.
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) (debugsession.Status, error) {
+	sessionLogger.Debug("Entering ControlMATLABDebugger Usecase")
+	defer sessionLogger.Debug("Exiting ControlMATLABDebugger Usecase")
+
+	if request.Action == Status {
+		return u.debugSession.Wait(ctx, sessionLogger, client)
+	}
+
+	command, ok := commands[request.Action]
+	if !ok {
+		return debugsession.Status{}, fmt.Errorf("invalid action: %q", request.Action)
+	}
+
+	return u.debugSession.Resume(ctx, sessionLogger, client, command)
+}
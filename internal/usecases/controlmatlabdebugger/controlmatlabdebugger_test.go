@@ -0,0 +1,151 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package controlmatlabdebugger_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/controlmatlabdebugger"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/debugsession"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/controlmatlabdebugger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var pausedStatus = debugsession.Status{
+	State: debugsession.StatePaused,
+	Frames: []debugsession.Frame{
+		{Name: "analysis", File: "/home/user/analysis.m", Line: 13, Variables: []debugsession.Variable{}},
+	},
+}
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockDebugSession := &mocks.MockDebugSession{}
+	defer mockDebugSession.AssertExpectations(t)
+
+	// Act
+	usecase := controlmatlabdebugger.New(mockDebugSession)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_Resume(t *testing.T) {
+	testCases := []struct {
+		action          controlmatlabdebugger.Action
+		expectedCommand entities.FEvalRequest
+	}{
+		{action: controlmatlabdebugger.Step, expectedCommand: entities.FEvalRequest{Function: "dbstep"}},
+		{action: controlmatlabdebugger.StepIn, expectedCommand: entities.FEvalRequest{Function: "dbstep", Arguments: []string{"in"}}},
+		{action: controlmatlabdebugger.StepOut, expectedCommand: entities.FEvalRequest{Function: "dbstep", Arguments: []string{"out"}}},
+		{action: controlmatlabdebugger.Continue, expectedCommand: entities.FEvalRequest{Function: "dbcont"}},
+		{action: controlmatlabdebugger.Quit, expectedCommand: entities.FEvalRequest{Function: "dbquit"}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(string(testCase.action), func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockDebugSession := &mocks.MockDebugSession{}
+			defer mockDebugSession.AssertExpectations(t)
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			ctx := t.Context()
+
+			mockDebugSession.EXPECT().
+				Resume(ctx, mockLogger.AsMockArg(), mockClient, testCase.expectedCommand).
+				Return(pausedStatus, nil).
+				Once()
+
+			usecase := controlmatlabdebugger.New(mockDebugSession)
+
+			// Act
+			status, err := usecase.Execute(ctx, mockLogger, mockClient, controlmatlabdebugger.Args{Action: testCase.action})
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, pausedStatus, status)
+		})
+	}
+}
+
+func TestUsecase_Execute_Status(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockDebugSession := &mocks.MockDebugSession{}
+	defer mockDebugSession.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockDebugSession.EXPECT().
+		Wait(ctx, mockLogger.AsMockArg(), mockClient).
+		Return(pausedStatus, nil).
+		Once()
+
+	usecase := controlmatlabdebugger.New(mockDebugSession)
+
+	// Act
+	status, err := usecase.Execute(ctx, mockLogger, mockClient, controlmatlabdebugger.Args{Action: controlmatlabdebugger.Status})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, pausedStatus, status)
+}
+
+func TestUsecase_Execute_InvalidAction(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockDebugSession := &mocks.MockDebugSession{}
+	defer mockDebugSession.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	usecase := controlmatlabdebugger.New(mockDebugSession)
+
+	// Act
+	status, err := usecase.Execute(t.Context(), mockLogger, mockClient, controlmatlabdebugger.Args{Action: "rewind"})
+
+	// Assert
+	require.EqualError(t, err, `invalid action: "rewind"`)
+	assert.Empty(t, status)
+}
+
+func TestUsecase_Execute_ResumeError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockDebugSession := &mocks.MockDebugSession{}
+	defer mockDebugSession.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockDebugSession.EXPECT().
+		Resume(ctx, mockLogger.AsMockArg(), mockClient, entities.FEvalRequest{Function: "dbcont"}).
+		Return(debugsession.Status{}, debugsession.ErrNotPaused).
+		Once()
+
+	usecase := controlmatlabdebugger.New(mockDebugSession)
+
+	// Act
+	status, err := usecase.Execute(ctx, mockLogger, mockClient, controlmatlabdebugger.Args{Action: controlmatlabdebugger.Continue})
+
+	// Assert
+	require.ErrorIs(t, err, debugsession.ErrNotPaused)
+	assert.Empty(t, status)
+}
@@ -0,0 +1,72 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package debugmatlabcode
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/debugsession"
+)
+
+type Args struct {
+	Code        string
+	ProjectPath string
+}
+
+type PathValidator interface {
+	ValidateFolderPath(filePath string) (string, error)
+}
+
+type CodeSafetyPolicy interface {
+	Check(ctx context.Context, sessionLogger entities.Logger, code string) error
+}
+
+type DebugSession interface {
+	Run(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, code string) (debugsession.Status, error)
+}
+
+type Usecase struct {
+	pathValidator    PathValidator
+	codeSafetyPolicy CodeSafetyPolicy
+	debugSession     DebugSession
+}
+
+func New(
+	pathValidator PathValidator,
+	codeSafetyPolicy CodeSafetyPolicy,
+	debugSession DebugSession,
+) *Usecase {
+	return &Usecase{
+		pathValidator:    pathValidator,
+		codeSafetyPolicy: codeSafetyPolicy,
+		debugSession:     debugSession,
+	}
+}
+
+// Execute runs the code in the project folder until it is paused at a breakpoint or done.
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) (debugsession.Status, error) {
+	sessionLogger.Debug("Entering DebugMATLABCode Usecase")
+	defer sessionLogger.Debug("Exiting DebugMATLABCode Usecase")
+
+	validatedPath, err := u.pathValidator.ValidateFolderPath(request.ProjectPath)
+	if err != nil {
+		sessionLogger.WithError(err).With("path", request.ProjectPath).Warn("Path validation failed")
+		return debugsession.Status{}, fmt.Errorf("path validation failed: %w", err)
+	}
+
+	if err := u.codeSafetyPolicy.Check(ctx, sessionLogger, request.Code); err != nil {
+		return debugsession.Status{}, fmt.Errorf("code safety check failed: %w", err)
+	}
+
+	cdRequest := entities.EvalRequest{
+		Code: fmt.Sprintf("cd('%s')", strings.ReplaceAll(validatedPath, "'", "''")), // Escape single quotes
+	}
+	if _, err := client.Eval(ctx, sessionLogger, cdRequest); err != nil {
+		return debugsession.Status{}, err
+	}
+
+	return u.debugSession.Run(ctx, sessionLogger, client, request.Code)
+}
@@ -0,0 +1,214 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package debugmatlabcode_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/debugmatlabcode"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/debugsession"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/debugmatlabcode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const code = "analysis(42)"
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+	defer mockCodeSafetyPolicy.AssertExpectations(t)
+
+	mockDebugSession := &mocks.MockDebugSession{}
+	defer mockDebugSession.AssertExpectations(t)
+
+	// Act
+	usecase := debugmatlabcode.New(mockPathValidator, mockCodeSafetyPolicy, mockDebugSession)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+	defer mockCodeSafetyPolicy.AssertExpectations(t)
+
+	mockDebugSession := &mocks.MockDebugSession{}
+	defer mockDebugSession.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	projectPath := filepath.Join("some", "path")
+	ctx := t.Context()
+
+	expectedStatus := debugsession.Status{
+		State: debugsession.StatePaused,
+		Frames: []debugsession.Frame{
+			{Name: "analysis", File: "/some/path/analysis.m", Line: 12, Variables: []debugsession.Variable{}},
+		},
+	}
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(projectPath).
+		Return(projectPath, nil).
+		Once()
+
+	mockCodeSafetyPolicy.EXPECT().
+		Check(ctx, mockLogger.AsMockArg(), code).
+		Return(nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: "cd('" + projectPath + "')"}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	mockDebugSession.EXPECT().
+		Run(ctx, mockLogger.AsMockArg(), mockClient, code).
+		Return(expectedStatus, nil).
+		Once()
+
+	usecase := debugmatlabcode.New(mockPathValidator, mockCodeSafetyPolicy, mockDebugSession)
+
+	// Act
+	status, err := usecase.Execute(ctx, mockLogger, mockClient, debugmatlabcode.Args{Code: code, ProjectPath: projectPath})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedStatus, status)
+}
+
+func TestUsecase_Execute_ValidatePathError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+	defer mockCodeSafetyPolicy.AssertExpectations(t)
+
+	mockDebugSession := &mocks.MockDebugSession{}
+	defer mockDebugSession.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	projectPath := filepath.Join("some", "path")
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(projectPath).
+		Return("", assert.AnError).
+		Once()
+
+	usecase := debugmatlabcode.New(mockPathValidator, mockCodeSafetyPolicy, mockDebugSession)
+
+	// Act
+	status, err := usecase.Execute(t.Context(), mockLogger, mockClient, debugmatlabcode.Args{Code: code, ProjectPath: projectPath})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, status)
+}
+
+func TestUsecase_Execute_CodeSafetyError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+	defer mockCodeSafetyPolicy.AssertExpectations(t)
+
+	mockDebugSession := &mocks.MockDebugSession{}
+	defer mockDebugSession.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	projectPath := filepath.Join("some", "path")
+	ctx := t.Context()
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(projectPath).
+		Return(projectPath, nil).
+		Once()
+
+	mockCodeSafetyPolicy.EXPECT().
+		Check(ctx, mockLogger.AsMockArg(), code).
+		Return(assert.AnError).
+		Once()
+
+	usecase := debugmatlabcode.New(mockPathValidator, mockCodeSafetyPolicy, mockDebugSession)
+
+	// Act
+	status, err := usecase.Execute(ctx, mockLogger, mockClient, debugmatlabcode.Args{Code: code, ProjectPath: projectPath})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, status)
+}
+
+func TestUsecase_Execute_RunError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+	defer mockCodeSafetyPolicy.AssertExpectations(t)
+
+	mockDebugSession := &mocks.MockDebugSession{}
+	defer mockDebugSession.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	projectPath := filepath.Join("some", "path")
+	ctx := t.Context()
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(projectPath).
+		Return(projectPath, nil).
+		Once()
+
+	mockCodeSafetyPolicy.EXPECT().
+		Check(ctx, mockLogger.AsMockArg(), code).
+		Return(nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: "cd('" + projectPath + "')"}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	mockDebugSession.EXPECT().
+		Run(ctx, mockLogger.AsMockArg(), mockClient, code).
+		Return(debugsession.Status{}, debugsession.ErrAlreadyDebugging).
+		Once()
+
+	usecase := debugmatlabcode.New(mockPathValidator, mockCodeSafetyPolicy, mockDebugSession)
+
+	// Act
+	status, err := usecase.Execute(ctx, mockLogger, mockClient, debugmatlabcode.Args{Code: code, ProjectPath: projectPath})
+
+	// Assert
+	require.ErrorIs(t, err, debugsession.ErrAlreadyDebugging)
+	assert.Empty(t, status)
+}
@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/installedproducts"
 )
 
 type Usecase struct {
@@ -16,23 +17,21 @@ func New() *Usecase {
 }
 
 type ReturnArgs struct {
-	Toolboxes string
+	Products []installedproducts.Product
+	AddOns   []installedproducts.AddOn
 }
 
 func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient) (ReturnArgs, error) {
 	sessionLogger.Debug("Entering DetectMATLABToolboxes Usecase")
 	defer sessionLogger.Debug("Exiting DetectMATLABToolboxes Usecase")
 
-	verRequest := entities.EvalRequest{
-		Code: "ver",
-	}
-
-	ver, err := client.Eval(ctx, sessionLogger, verRequest)
+	installed, err := installedproducts.Get(ctx, sessionLogger, client)
 	if err != nil {
 		return ReturnArgs{}, err
 	}
 
 	return ReturnArgs{
-		Toolboxes: ver.ConsoleOutput,
+		Products: installed.Products,
+		AddOns:   installed.AddOns,
 	}, nil
 }
@@ -8,11 +8,17 @@ import (
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/detectmatlabtoolboxes"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/installedproducts"
 	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
+var listInstalledProductsRequest = entities.FEvalRequest{
+	Function:   "matlab_mcp.listInstalledProducts",
+	NumOutputs: 1,
+}
+
 func TestNew_HappyPath(t *testing.T) {
 	// Arrange
 
@@ -30,24 +36,25 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
-	evalRequest := entities.EvalRequest{
-		Code: "ver",
-	}
-
-	evalResponse := entities.EvalResponse{
-		ConsoleOutput: "Toolbox list",
-		Images:        nil,
+	fevalResponse := entities.FEvalResponse{
+		Outputs: []any{`{"products":[{"name":"MATLAB","version":"24.2","release":"R2024b","identifier":"ML"},{"name":"Simulink","version":"24.2","release":"R2024b","identifier":"SL"}],"addons":[{"name":"GUI Layout Toolbox","version":"2.3.6","enabled":false,"identifier":"layout"}]}`},
 	}
 
 	expectedResponse := detectmatlabtoolboxes.ReturnArgs{
-		Toolboxes: evalResponse.ConsoleOutput,
+		Products: []installedproducts.Product{
+			{Name: "MATLAB", Version: "24.2", Release: "R2024b", Identifier: "ML"},
+			{Name: "Simulink", Version: "24.2", Release: "R2024b", Identifier: "SL"},
+		},
+		AddOns: []installedproducts.AddOn{
+			{Name: "GUI Layout Toolbox", Version: "2.3.6", Enabled: false, Identifier: "layout"},
+		},
 	}
 
 	ctx := t.Context()
 
 	mockClient.EXPECT().
-		Eval(ctx, mockLogger.AsMockArg(), evalRequest).
-		Return(evalResponse, nil).
+		FEval(ctx, mockLogger.AsMockArg(), listInstalledProductsRequest).
+		Return(fevalResponse, nil).
 		Once()
 
 	usecase := detectmatlabtoolboxes.New()
@@ -60,24 +67,20 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 	assert.Equal(t, expectedResponse, response, "Response should match expected value")
 }
 
-func TestUsecase_Execute_EvalError(t *testing.T) {
+func TestUsecase_Execute_FEvalError(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
 
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
-	evalRequest := entities.EvalRequest{
-		Code: "ver",
-	}
-
 	expectedError := assert.AnError
 
 	ctx := t.Context()
 
 	mockClient.EXPECT().
-		Eval(ctx, mockLogger.AsMockArg(), evalRequest).
-		Return(entities.EvalResponse{ConsoleOutput: "some output that shouldn't be because there's an error"}, expectedError).
+		FEval(ctx, mockLogger.AsMockArg(), listInstalledProductsRequest).
+		Return(entities.FEvalResponse{Outputs: []any{"some output that shouldn't be because there's an error"}}, expectedError).
 		Once()
 
 	usecase := detectmatlabtoolboxes.New()
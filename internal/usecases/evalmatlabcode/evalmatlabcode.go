@@ -19,15 +19,22 @@ type PathValidator interface {
 	ValidateFolderPath(filePath string) (string, error)
 }
 
+type CodeSafetyPolicy interface {
+	Check(ctx context.Context, sessionLogger entities.Logger, code string) error
+}
+
 type Usecase struct {
-	pathValidator PathValidator
+	pathValidator    PathValidator
+	codeSafetyPolicy CodeSafetyPolicy
 }
 
 func New(
 	pathValidator PathValidator,
+	codeSafetyPolicy CodeSafetyPolicy,
 ) *Usecase {
 	return &Usecase{
-		pathValidator: pathValidator,
+		pathValidator:    pathValidator,
+		codeSafetyPolicy: codeSafetyPolicy,
 	}
 }
 
@@ -41,6 +48,10 @@ func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, cl
 		return entities.EvalResponse{}, fmt.Errorf("path validation failed: %w", err)
 	}
 
+	if err := u.codeSafetyPolicy.Check(ctx, sessionLogger, request.Code); err != nil {
+		return entities.EvalResponse{}, fmt.Errorf("code safety check failed: %w", err)
+	}
+
 	cdRequest := entities.EvalRequest{
 		Code: fmt.Sprintf("cd('%s')", strings.ReplaceAll(validatedPath, "'", "''")), // Escape single quotes
 	}
@@ -20,8 +20,11 @@ func TestNew_HappyPath(t *testing.T) {
 	mockPathValidator := &mocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
+	mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+	defer mockCodeSafetyPolicy.AssertExpectations(t)
+
 	// Act
-	usecase := evalmatlabcode.New(mockPathValidator)
+	usecase := evalmatlabcode.New(mockPathValidator, mockCodeSafetyPolicy)
 
 	// Assert
 	assert.NotNil(t, usecase, "Usecase should not be nil")
@@ -34,6 +37,9 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 	mockPathValidator := &mocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
+	mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+	defer mockCodeSafetyPolicy.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
@@ -57,6 +63,11 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 		Return(validatedProjectPath, nil).
 		Once()
 
+	mockCodeSafetyPolicy.EXPECT().
+		Check(ctx, mockLogger.AsMockArg(), evalRequest.Code).
+		Return(nil).
+		Once()
+
 	mockClient.EXPECT().
 		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{
 			Code: "cd('" + validatedProjectPath + "')",
@@ -69,7 +80,7 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 		Return(expectedResponse, nil).
 		Once()
 
-	usecase := evalmatlabcode.New(mockPathValidator)
+	usecase := evalmatlabcode.New(mockPathValidator, mockCodeSafetyPolicy)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient, evalRequest)
@@ -86,6 +97,9 @@ func TestUsecase_Execute_ValidatePathError(t *testing.T) {
 	mockPathValidator := &mocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
+	mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+	defer mockCodeSafetyPolicy.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
@@ -103,7 +117,7 @@ func TestUsecase_Execute_ValidatePathError(t *testing.T) {
 		Return("", expectedError).
 		Once()
 
-	usecase := evalmatlabcode.New(mockPathValidator)
+	usecase := evalmatlabcode.New(mockPathValidator, mockCodeSafetyPolicy)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient, evalRequest)
@@ -120,6 +134,9 @@ func TestUsecase_Execute_CDEvalError(t *testing.T) {
 	mockPathValidator := &mocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
+	mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+	defer mockCodeSafetyPolicy.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
@@ -140,6 +157,11 @@ func TestUsecase_Execute_CDEvalError(t *testing.T) {
 		Return(validatedProjectPath, nil).
 		Once()
 
+	mockCodeSafetyPolicy.EXPECT().
+		Check(ctx, mockLogger.AsMockArg(), evalRequest.Code).
+		Return(nil).
+		Once()
+
 	mockClient.EXPECT().
 		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{
 			Code: "cd('" + validatedProjectPath + "')",
@@ -147,7 +169,7 @@ func TestUsecase_Execute_CDEvalError(t *testing.T) {
 		Return(entities.EvalResponse{}, expectedError).
 		Once()
 
-	usecase := evalmatlabcode.New(mockPathValidator)
+	usecase := evalmatlabcode.New(mockPathValidator, mockCodeSafetyPolicy)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient, evalRequest)
@@ -164,6 +186,9 @@ func TestUsecase_Execute_EvalError(t *testing.T) {
 	mockPathValidator := &mocks.MockPathValidator{}
 	defer mockPathValidator.AssertExpectations(t)
 
+	mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+	defer mockCodeSafetyPolicy.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
@@ -184,6 +209,11 @@ func TestUsecase_Execute_EvalError(t *testing.T) {
 		Return(validatedProjectPath, nil).
 		Once()
 
+	mockCodeSafetyPolicy.EXPECT().
+		Check(ctx, mockLogger.AsMockArg(), evalRequest.Code).
+		Return(nil).
+		Once()
+
 	mockClient.EXPECT().
 		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{
 			Code: "cd('" + validatedProjectPath + "')",
@@ -196,7 +226,52 @@ func TestUsecase_Execute_EvalError(t *testing.T) {
 		Return(entities.EvalResponse{ConsoleOutput: "some output that shouldn't be because there's an error"}, expectedError).
 		Once()
 
-	usecase := evalmatlabcode.New(mockPathValidator)
+	usecase := evalmatlabcode.New(mockPathValidator, mockCodeSafetyPolicy)
+
+	// Act
+	response, err := usecase.Execute(ctx, mockLogger, mockClient, evalRequest)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Error should be the original error")
+	assert.Empty(t, response, "Response should be empty when there's an error")
+}
+
+func TestUsecase_Execute_CodeSafetyCheckError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+	defer mockCodeSafetyPolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	projectPath := filepath.Join("some", "path")
+	validatedProjectPath := filepath.Join("some", "path")
+
+	evalRequest := evalmatlabcode.Args{
+		Code:        "system('ls')",
+		ProjectPath: projectPath,
+	}
+
+	expectedError := assert.AnError
+
+	ctx := t.Context()
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(projectPath).
+		Return(validatedProjectPath, nil).
+		Once()
+
+	mockCodeSafetyPolicy.EXPECT().
+		Check(ctx, mockLogger.AsMockArg(), evalRequest.Code).
+		Return(expectedError).
+		Once()
+
+	usecase := evalmatlabcode.New(mockPathValidator, mockCodeSafetyPolicy)
 
 	// Act
 	response, err := usecase.Execute(ctx, mockLogger, mockClient, evalRequest)
@@ -0,0 +1,67 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package findmatlabfunctions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+const findFunctionsFunction = "matlab_mcp.findFunctions"
+
+// prefixPattern matches the beginning of the names of functions and classes, including their package name, e.g. matlab.unittest.Te
+var prefixPattern = regexp.MustCompile(`^([A-Za-z][A-Za-z0-9_]*\.)*[A-Za-z0-9_]*$`)
+
+type Args struct {
+	Prefix string
+}
+
+type ReturnArgs struct {
+	FunctionNames []string
+}
+
+type Usecase struct{}
+
+func New() *Usecase {
+	return &Usecase{}
+}
+
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering FindMATLABFunctions Usecase")
+	defer sessionLogger.Debug("Exiting FindMATLABFunctions Usecase")
+
+	if !prefixPattern.MatchString(request.Prefix) {
+		return ReturnArgs{}, fmt.Errorf("invalid function name prefix: %q", request.Prefix)
+	}
+
+	response, err := client.FEval(ctx, sessionLogger, entities.FEvalRequest{
+		Function:   findFunctionsFunction,
+		Arguments:  []string{request.Prefix},
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	if len(response.Outputs) != 1 {
+		return ReturnArgs{}, fmt.Errorf("unexpected number of outputs when finding the functions: %d", len(response.Outputs))
+	}
+
+	encodedFunctionNames, ok := response.Outputs[0].(string)
+	if !ok {
+		return ReturnArgs{}, fmt.Errorf("unexpected output type when finding the functions: %T", response.Outputs[0])
+	}
+
+	functionNames := []string{}
+	if err := json.Unmarshal([]byte(encodedFunctionNames), &functionNames); err != nil {
+		return ReturnArgs{}, fmt.Errorf("failed to decode the function names: %w", err)
+	}
+
+	return ReturnArgs{
+		FunctionNames: functionNames,
+	}, nil
+}
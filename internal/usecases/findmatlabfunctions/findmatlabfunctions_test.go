@@ -0,0 +1,153 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package findmatlabfunctions_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/findmatlabfunctions"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Act
+	usecase := findmatlabfunctions.New()
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		prefix               string
+		encodedFunctionNames string
+		expected             []string
+	}{
+		{
+			name:                 "functions on the path",
+			prefix:               "sin",
+			encodedFunctionNames: `["sin","sind","single","sinh"]`,
+			expected:             []string{"sin", "sind", "single", "sinh"},
+		},
+		{
+			name:                 "package members",
+			prefix:               "matlab.unittest.Test",
+			encodedFunctionNames: `["matlab.unittest.TestCase","matlab.unittest.TestResult","matlab.unittest.TestSuite"]`,
+			expected:             []string{"matlab.unittest.TestCase", "matlab.unittest.TestResult", "matlab.unittest.TestSuite"},
+		},
+		{
+			name:                 "no match",
+			prefix:               "zzz",
+			encodedFunctionNames: `[]`,
+			expected:             []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			ctx := t.Context()
+
+			mockClient.EXPECT().
+				FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+					Function:   "matlab_mcp.findFunctions",
+					Arguments:  []string{tc.prefix},
+					NumOutputs: 1,
+				}).
+				Return(entities.FEvalResponse{Outputs: []any{tc.encodedFunctionNames}}, nil).
+				Once()
+
+			usecase := findmatlabfunctions.New()
+
+			// Act
+			result, err := usecase.Execute(ctx, mockLogger, mockClient, findmatlabfunctions.Args{Prefix: tc.prefix})
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result.FunctionNames)
+		})
+	}
+}
+
+func TestUsecase_Execute_InvalidPrefix(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	usecase := findmatlabfunctions.New()
+
+	// Act
+	result, err := usecase.Execute(t.Context(), mockLogger, mockClient, findmatlabfunctions.Args{Prefix: "sin'); system('ls"})
+
+	// Assert
+	require.ErrorContains(t, err, "invalid function name prefix")
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_FEvalError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.findFunctions",
+			Arguments:  []string{"sin"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{}, assert.AnError).
+		Once()
+
+	usecase := findmatlabfunctions.New()
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, findmatlabfunctions.Args{Prefix: "sin"})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_InvalidJSON(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.findFunctions",
+			Arguments:  []string{"sin"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{"not json"}}, nil).
+		Once()
+
+	usecase := findmatlabfunctions.New()
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, findmatlabfunctions.Args{Prefix: "sin"})
+
+	// Assert
+	require.ErrorContains(t, err, "failed to decode the function names")
+	assert.Empty(t, result)
+}
@@ -0,0 +1,66 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package getmatlabdocumentation
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+const helpFunction = "help"
+
+// functionNamePattern matches the names of functions and classes, including their package name, e.g. matlab.unittest.TestCase
+var functionNamePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*(\.[A-Za-z][A-Za-z0-9_]*)*$`)
+
+type Args struct {
+	FunctionName string
+}
+
+type ReturnArgs struct {
+	Text string
+}
+
+type Usecase struct{}
+
+func New() *Usecase {
+	return &Usecase{}
+}
+
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering GetMATLABDocumentation Usecase")
+	defer sessionLogger.Debug("Exiting GetMATLABDocumentation Usecase")
+
+	if !functionNamePattern.MatchString(request.FunctionName) {
+		return ReturnArgs{}, fmt.Errorf("invalid function name: %q", request.FunctionName)
+	}
+
+	response, err := client.FEval(ctx, sessionLogger, entities.FEvalRequest{
+		Function:   helpFunction,
+		Arguments:  []string{request.FunctionName},
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	if len(response.Outputs) != 1 {
+		return ReturnArgs{}, fmt.Errorf("unexpected number of outputs when getting the documentation: %d", len(response.Outputs))
+	}
+
+	text, ok := response.Outputs[0].(string)
+	if !ok {
+		return ReturnArgs{}, fmt.Errorf("unexpected output type when getting the documentation: %T", response.Outputs[0])
+	}
+
+	if strings.TrimSpace(text) == "" {
+		return ReturnArgs{}, fmt.Errorf("no documentation found for %s", request.FunctionName)
+	}
+
+	return ReturnArgs{
+		Text: text,
+	}, nil
+}
@@ -0,0 +1,166 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package getmatlabdocumentation_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getmatlabdocumentation"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Act
+	usecase := getmatlabdocumentation.New()
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	expectedText := " sin    Sine of argument in radians.\n    sin(X) is the sine of the elements of X.\n"
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "help",
+			Arguments:  []string{"sin"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{expectedText}}, nil).
+		Once()
+
+	usecase := getmatlabdocumentation.New()
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, getmatlabdocumentation.Args{FunctionName: "sin"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, getmatlabdocumentation.ReturnArgs{Text: expectedText}, result)
+}
+
+func TestUsecase_Execute_InvalidFunctionName(t *testing.T) {
+	testCases := []struct {
+		name         string
+		functionName string
+	}{
+		{name: "empty", functionName: ""},
+		{name: "command", functionName: "sin; system('ls')"},
+		{name: "leading digit", functionName: "2sin"},
+		{name: "trailing dot", functionName: "matlab.unittest."},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			usecase := getmatlabdocumentation.New()
+
+			// Act
+			result, err := usecase.Execute(t.Context(), mockLogger, mockClient, getmatlabdocumentation.Args{FunctionName: tc.functionName})
+
+			// Assert
+			require.ErrorContains(t, err, "invalid function name")
+			assert.Empty(t, result)
+		})
+	}
+}
+
+func TestUsecase_Execute_NoDocumentation(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "help",
+			Arguments:  []string{"notAFunction"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{"\n"}}, nil).
+		Once()
+
+	usecase := getmatlabdocumentation.New()
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, getmatlabdocumentation.Args{FunctionName: "notAFunction"})
+
+	// Assert
+	require.EqualError(t, err, "no documentation found for notAFunction")
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_FEvalError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "help",
+			Arguments:  []string{"sin"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{}, assert.AnError).
+		Once()
+
+	usecase := getmatlabdocumentation.New()
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, getmatlabdocumentation.Args{FunctionName: "sin"})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_UnexpectedOutputType(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "help",
+			Arguments:  []string{"sin"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{42.0}}, nil).
+		Once()
+
+	usecase := getmatlabdocumentation.New()
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, getmatlabdocumentation.Args{FunctionName: "sin"})
+
+	// Assert
+	require.EqualError(t, err, "unexpected output type when getting the documentation: float64")
+	assert.Empty(t, result)
+}
@@ -0,0 +1,64 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package getmatlabfigure
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+const getFigureFunction = "matlab_mcp.getFigure"
+
+type Args struct {
+	FigureNumber int
+}
+
+type ReturnArgs struct {
+	PNG []byte
+}
+
+type Usecase struct{}
+
+func New() *Usecase {
+	return &Usecase{}
+}
+
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering GetMATLABFigure Usecase")
+	defer sessionLogger.Debug("Exiting GetMATLABFigure Usecase")
+
+	if request.FigureNumber < 1 {
+		return ReturnArgs{}, fmt.Errorf("invalid figure number: %d", request.FigureNumber)
+	}
+
+	response, err := client.FEval(ctx, sessionLogger, entities.FEvalRequest{
+		Function:   getFigureFunction,
+		Arguments:  []string{strconv.Itoa(request.FigureNumber)},
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	if len(response.Outputs) != 1 {
+		return ReturnArgs{}, fmt.Errorf("unexpected number of outputs when getting the figure: %d", len(response.Outputs))
+	}
+
+	encodedPNG, ok := response.Outputs[0].(string)
+	if !ok {
+		return ReturnArgs{}, fmt.Errorf("unexpected output type when getting the figure: %T", response.Outputs[0])
+	}
+
+	png, err := base64.StdEncoding.DecodeString(encodedPNG)
+	if err != nil {
+		return ReturnArgs{}, fmt.Errorf("failed to decode the figure image: %w", err)
+	}
+
+	return ReturnArgs{
+		PNG: png,
+	}, nil
+}
@@ -0,0 +1,125 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package getmatlabfigure_test
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getmatlabfigure"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Act
+	usecase := getmatlabfigure.New()
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	expectedPNG := []byte{0x89, 'P', 'N', 'G', 0x0d, 0x0a, 0x1a, 0x0a}
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.getFigure",
+			Arguments:  []string{"2"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{base64.StdEncoding.EncodeToString(expectedPNG)}}, nil).
+		Once()
+
+	usecase := getmatlabfigure.New()
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, getmatlabfigure.Args{FigureNumber: 2})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedPNG, result.PNG)
+}
+
+func TestUsecase_Execute_InvalidFigureNumber(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	usecase := getmatlabfigure.New()
+
+	// Act
+	result, err := usecase.Execute(t.Context(), mockLogger, mockClient, getmatlabfigure.Args{FigureNumber: 0})
+
+	// Assert
+	require.EqualError(t, err, "invalid figure number: 0")
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_FEvalError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.getFigure",
+			Arguments:  []string{"1"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{}, assert.AnError).
+		Once()
+
+	usecase := getmatlabfigure.New()
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, getmatlabfigure.Args{FigureNumber: 1})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_InvalidBase64(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.getFigure",
+			Arguments:  []string{"1"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{"not base64!"}}, nil).
+		Once()
+
+	usecase := getmatlabfigure.New()
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, getmatlabfigure.Args{FigureNumber: 1})
+
+	// Assert
+	require.ErrorContains(t, err, "failed to decode the figure image")
+	assert.Empty(t, result)
+}
@@ -3,7 +3,9 @@
 package listavailablematlabs
 
 import (
+	"cmp"
 	"context"
+	"slices"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 )
@@ -26,5 +28,22 @@ func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger) Re
 	sessionLogger.Debug("Entering ListAvailableMATLABs Usecase")
 	defer sessionLogger.Debug("Exiting ListAvailableMATLABs Usecase")
 
-	return u.matlabManager.ListEnvironments(ctx, sessionLogger)
+	environments := slices.Clone(u.matlabManager.ListEnvironments(ctx, sessionLogger))
+
+	// List the most recent releases first, with the prereleases after the general release of the same family,
+	// keeping the discovery order for the same release
+	slices.SortStableFunc(environments, func(a, b entities.EnvironmentInfo) int {
+		if a.Version != b.Version {
+			return cmp.Compare(b.Version, a.Version)
+		}
+		if a.IsPrerelease != b.IsPrerelease {
+			if a.IsPrerelease {
+				return 1
+			}
+			return -1
+		}
+		return cmp.Compare(b.UpdateLevel, a.UpdateLevel)
+	})
+
+	return environments
 }
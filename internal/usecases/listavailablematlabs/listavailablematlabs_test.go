@@ -64,3 +64,30 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 		assert.Equal(t, mockEnvironments[i].Version, result[i].Version, "Output MATLAB version does not match input dummy MATLAB version")
 	}
 }
+
+func TestUsecase_Execute_SortsByRelease(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	r2023b := entities.EnvironmentInfo{MATLABRoot: filepath.Join("usr", "local", "MATLAB", "R2023b"), Version: "R2023b"}
+	r2024b := entities.EnvironmentInfo{MATLABRoot: filepath.Join("opt", "MATLAB", "R2024b"), Version: "R2024b"}
+	r2024bUpdate := entities.EnvironmentInfo{MATLABRoot: filepath.Join("home", "user", "MATLAB", "R2024b"), Version: "R2024b", UpdateLevel: 2}
+	r2025aPrerelease := entities.EnvironmentInfo{MATLABRoot: filepath.Join("opt", "MATLAB", "R2025a_Prerelease"), Version: "R2025a", IsPrerelease: true, UpdateLevel: 1}
+	r2025a := entities.EnvironmentInfo{MATLABRoot: filepath.Join("opt", "MATLAB", "R2025a"), Version: "R2025a"}
+
+	mockMATLABManager.EXPECT().
+		ListEnvironments(mock.Anything, mockLogger.AsMockArg()).
+		Return([]entities.EnvironmentInfo{r2023b, r2025aPrerelease, r2024b, r2024bUpdate, r2025a}).
+		Once()
+
+	usecase := listavailablematlabs.New(mockMATLABManager)
+
+	// Act
+	result := usecase.Execute(t.Context(), mockLogger)
+
+	// Assert
+	assert.Equal(t, listavailablematlabs.ReturnArgs{r2025a, r2025aPrerelease, r2024bUpdate, r2024b, r2023b}, result)
+}
@@ -0,0 +1,79 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package listmatlabworkspace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+const listWorkspaceFunction = "matlab_mcp.listWorkspace"
+
+type Args struct{}
+
+type Variable struct {
+	Name  string `json:"name"`
+	Size  []int  `json:"size"`
+	Class string `json:"class"`
+	Bytes int64  `json:"bytes"`
+}
+
+type ReturnArgs struct {
+	Variables     []Variable
+	FigureNumbers []int
+}
+
+type Usecase struct{}
+
+func New() *Usecase {
+	return &Usecase{}
+}
+
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, _ Args) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering ListMATLABWorkspace Usecase")
+	defer sessionLogger.Debug("Exiting ListMATLABWorkspace Usecase")
+
+	response, err := client.FEval(ctx, sessionLogger, entities.FEvalRequest{
+		Function:   listWorkspaceFunction,
+		Arguments:  []string{},
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	if len(response.Outputs) != 1 {
+		return ReturnArgs{}, fmt.Errorf("unexpected number of outputs when listing the workspace: %d", len(response.Outputs))
+	}
+
+	encodedWorkspace, ok := response.Outputs[0].(string)
+	if !ok {
+		return ReturnArgs{}, fmt.Errorf("unexpected output type when listing the workspace: %T", response.Outputs[0])
+	}
+
+	var workspace struct {
+		Variables []Variable `json:"variables"`
+		Figures   []int      `json:"figures"`
+	}
+	if err := json.Unmarshal([]byte(encodedWorkspace), &workspace); err != nil {
+		return ReturnArgs{}, fmt.Errorf("failed to decode the workspace: %w", err)
+	}
+
+	result := ReturnArgs{
+		Variables:     []Variable{},
+		FigureNumbers: []int{},
+	}
+
+	if workspace.Variables != nil {
+		result.Variables = workspace.Variables
+	}
+
+	if workspace.Figures != nil {
+		result.FigureNumbers = workspace.Figures
+	}
+
+	return result, nil
+}
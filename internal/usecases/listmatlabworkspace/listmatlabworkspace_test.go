@@ -0,0 +1,136 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package listmatlabworkspace_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listmatlabworkspace"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Act
+	usecase := listmatlabworkspace.New()
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	testCases := []struct {
+		name              string
+		encodedWorkspace  string
+		expectedWorkspace listmatlabworkspace.ReturnArgs
+	}{
+		{
+			name:             "variables and figures",
+			encodedWorkspace: `{"variables":[{"name":"x","size":[1,10],"class":"double","bytes":80},{"name":"s","size":[1,1],"class":"string","bytes":166}],"figures":[1,3]}`,
+			expectedWorkspace: listmatlabworkspace.ReturnArgs{
+				Variables: []listmatlabworkspace.Variable{
+					{Name: "x", Size: []int{1, 10}, Class: "double", Bytes: 80},
+					{Name: "s", Size: []int{1, 1}, Class: "string", Bytes: 166},
+				},
+				FigureNumbers: []int{1, 3},
+			},
+		},
+		{
+			name:             "empty workspace",
+			encodedWorkspace: `{"variables":[],"figures":[]}`,
+			expectedWorkspace: listmatlabworkspace.ReturnArgs{
+				Variables:     []listmatlabworkspace.Variable{},
+				FigureNumbers: []int{},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			ctx := t.Context()
+
+			mockClient.EXPECT().
+				FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+					Function:   "matlab_mcp.listWorkspace",
+					Arguments:  []string{},
+					NumOutputs: 1,
+				}).
+				Return(entities.FEvalResponse{Outputs: []any{tc.encodedWorkspace}}, nil).
+				Once()
+
+			usecase := listmatlabworkspace.New()
+
+			// Act
+			result, err := usecase.Execute(ctx, mockLogger, mockClient, listmatlabworkspace.Args{})
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedWorkspace, result)
+		})
+	}
+}
+
+func TestUsecase_Execute_FEvalError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.listWorkspace",
+			Arguments:  []string{},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{}, assert.AnError).
+		Once()
+
+	usecase := listmatlabworkspace.New()
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, listmatlabworkspace.Args{})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_InvalidJSON(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.listWorkspace",
+			Arguments:  []string{},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{"not json"}}, nil).
+		Once()
+
+	usecase := listmatlabworkspace.New()
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, listmatlabworkspace.Args{})
+
+	// Assert
+	require.ErrorContains(t, err, "failed to decode the workspace")
+	assert.Empty(t, result)
+}
@@ -0,0 +1,107 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package listsimulinkblocks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+const listSimulinkBlocksFunction = "matlab_mcp.listSimulinkBlocks"
+
+var ErrMissingSystem = errors.New("the model or subsystem to list the blocks of is missing")
+
+// Args holds the system to list the blocks of, either a loaded model or a subsystem of it, such as vdp/Subsystem.
+// A search depth of 0 lists the blocks of all the nested subsystems.
+type Args struct {
+	System            string
+	SearchDepth       int
+	IncludeParameters bool
+}
+
+type Parameter struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type Block struct {
+	Path        string `json:"path"`
+	Name        string `json:"name"`
+	Parent      string `json:"parent"`
+	Type        string `json:"type"`
+	MaskType    string `json:"mask_type"`
+	IsSubsystem bool   `json:"is_subsystem"`
+	// Parameters holds the dialog parameters of the block, only when requested.
+	Parameters []Parameter `json:"parameters"`
+}
+
+type ReturnArgs struct {
+	Blocks []Block
+}
+
+type SimulinkDetector interface {
+	Check(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient) error
+}
+
+type Usecase struct {
+	simulinkDetector SimulinkDetector
+}
+
+func New(
+	simulinkDetector SimulinkDetector,
+) *Usecase {
+	return &Usecase{
+		simulinkDetector: simulinkDetector,
+	}
+}
+
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering ListSimulinkBlocks Usecase")
+	defer sessionLogger.Debug("Exiting ListSimulinkBlocks Usecase")
+
+	if request.System == "" {
+		return ReturnArgs{}, ErrMissingSystem
+	}
+
+	if err := u.simulinkDetector.Check(ctx, sessionLogger, client); err != nil {
+		return ReturnArgs{}, err
+	}
+
+	response, err := client.FEval(ctx, sessionLogger, entities.FEvalRequest{
+		Function:   listSimulinkBlocksFunction,
+		Arguments:  []string{request.System, strconv.Itoa(max(request.SearchDepth, 0)), strconv.FormatBool(request.IncludeParameters)},
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	if len(response.Outputs) != 1 {
+		return ReturnArgs{}, fmt.Errorf("unexpected number of outputs when listing the blocks: %d", len(response.Outputs))
+	}
+
+	encodedBlocks, ok := response.Outputs[0].(string)
+	if !ok {
+		return ReturnArgs{}, fmt.Errorf("unexpected output type when listing the blocks: %T", response.Outputs[0])
+	}
+
+	var blocks struct {
+		Blocks []Block `json:"blocks"`
+	}
+	if err := json.Unmarshal([]byte(encodedBlocks), &blocks); err != nil {
+		return ReturnArgs{}, fmt.Errorf("failed to decode the blocks: %w", err)
+	}
+
+	if blocks.Blocks == nil {
+		blocks.Blocks = []Block{}
+	}
+
+	return ReturnArgs{
+		Blocks: blocks.Blocks,
+	}, nil
+}
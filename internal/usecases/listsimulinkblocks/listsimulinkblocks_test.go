@@ -0,0 +1,250 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package listsimulinkblocks_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listsimulinkblocks"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/simulinkdetector"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/listsimulinkblocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockSimulinkDetector := &mocks.MockSimulinkDetector{}
+	defer mockSimulinkDetector.AssertExpectations(t)
+
+	// Act
+	usecase := listsimulinkblocks.New(mockSimulinkDetector)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	testCases := []struct {
+		name              string
+		args              listsimulinkblocks.Args
+		expectedArguments []string
+	}{
+		{
+			name:              "all nested blocks",
+			args:              listsimulinkblocks.Args{System: "vdp"},
+			expectedArguments: []string{"vdp", "0", "false"},
+		},
+		{
+			name:              "with search depth and parameters",
+			args:              listsimulinkblocks.Args{System: "vdp", SearchDepth: 1, IncludeParameters: true},
+			expectedArguments: []string{"vdp", "1", "true"},
+		},
+		{
+			name:              "negative search depth",
+			args:              listsimulinkblocks.Args{System: "vdp", SearchDepth: -1},
+			expectedArguments: []string{"vdp", "0", "false"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockSimulinkDetector := &mocks.MockSimulinkDetector{}
+			defer mockSimulinkDetector.AssertExpectations(t)
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			ctx := t.Context()
+
+			mockSimulinkDetector.EXPECT().
+				Check(ctx, mockLogger.AsMockArg(), mockClient).
+				Return(nil).
+				Once()
+
+			mockClient.EXPECT().
+				FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+					Function:   "matlab_mcp.listSimulinkBlocks",
+					Arguments:  testCase.expectedArguments,
+					NumOutputs: 1,
+				}).
+				Return(entities.FEvalResponse{Outputs: []any{`{"blocks":[{"path":"vdp/Mu","name":"Mu","parent":"vdp","type":"Gain","mask_type":"","is_subsystem":false,"parameters":[{"name":"Gain","value":"1"}]}]}`}}, nil).
+				Once()
+
+			usecase := listsimulinkblocks.New(mockSimulinkDetector)
+
+			// Act
+			result, err := usecase.Execute(ctx, mockLogger, mockClient, testCase.args)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, []listsimulinkblocks.Block{
+				{
+					Path:       "vdp/Mu",
+					Name:       "Mu",
+					Parent:     "vdp",
+					Type:       "Gain",
+					Parameters: []listsimulinkblocks.Parameter{{Name: "Gain", Value: "1"}},
+				},
+			}, result.Blocks)
+		})
+	}
+}
+
+func TestUsecase_Execute_NoBlocks(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockSimulinkDetector := &mocks.MockSimulinkDetector{}
+	defer mockSimulinkDetector.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockSimulinkDetector.EXPECT().
+		Check(ctx, mockLogger.AsMockArg(), mockClient).
+		Return(nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.listSimulinkBlocks",
+			Arguments:  []string{"empty", "0", "false"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{`{"blocks":[]}`}}, nil).
+		Once()
+
+	usecase := listsimulinkblocks.New(mockSimulinkDetector)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, listsimulinkblocks.Args{System: "empty"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotNil(t, result.Blocks, "Blocks should not be nil")
+	assert.Empty(t, result.Blocks)
+}
+
+func TestUsecase_Execute_MissingSystem(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockSimulinkDetector := &mocks.MockSimulinkDetector{}
+	defer mockSimulinkDetector.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	usecase := listsimulinkblocks.New(mockSimulinkDetector)
+
+	// Act
+	result, err := usecase.Execute(t.Context(), mockLogger, mockClient, listsimulinkblocks.Args{})
+
+	// Assert
+	require.ErrorIs(t, err, listsimulinkblocks.ErrMissingSystem)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_SimulinkNotDetected(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockSimulinkDetector := &mocks.MockSimulinkDetector{}
+	defer mockSimulinkDetector.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockSimulinkDetector.EXPECT().
+		Check(ctx, mockLogger.AsMockArg(), mockClient).
+		Return(simulinkdetector.ErrSimulinkNotDetected).
+		Once()
+
+	usecase := listsimulinkblocks.New(mockSimulinkDetector)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, listsimulinkblocks.Args{System: "vdp"})
+
+	// Assert
+	require.ErrorIs(t, err, simulinkdetector.ErrSimulinkNotDetected)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_ListErrors(t *testing.T) {
+	testCases := []struct {
+		name          string
+		response      entities.FEvalResponse
+		err           error
+		expectedError string
+	}{
+		{
+			name:          "FEval error",
+			err:           assert.AnError,
+			expectedError: assert.AnError.Error(),
+		},
+		{
+			name:          "unexpected number of outputs",
+			response:      entities.FEvalResponse{Outputs: []any{}},
+			expectedError: "unexpected number of outputs when listing the blocks: 0",
+		},
+		{
+			name:          "unexpected output type",
+			response:      entities.FEvalResponse{Outputs: []any{42}},
+			expectedError: "unexpected output type when listing the blocks: int",
+		},
+		{
+			name:          "invalid JSON",
+			response:      entities.FEvalResponse{Outputs: []any{"not JSON"}},
+			expectedError: "failed to decode the blocks",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockSimulinkDetector := &mocks.MockSimulinkDetector{}
+			defer mockSimulinkDetector.AssertExpectations(t)
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			ctx := t.Context()
+
+			mockSimulinkDetector.EXPECT().
+				Check(ctx, mockLogger.AsMockArg(), mockClient).
+				Return(nil).
+				Once()
+
+			mockClient.EXPECT().
+				FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+					Function:   "matlab_mcp.listSimulinkBlocks",
+					Arguments:  []string{"vdp", "0", "false"},
+					NumOutputs: 1,
+				}).
+				Return(testCase.response, testCase.err).
+				Once()
+
+			usecase := listsimulinkblocks.New(mockSimulinkDetector)
+
+			// Act
+			result, err := usecase.Execute(ctx, mockLogger, mockClient, listsimulinkblocks.Args{System: "vdp"})
+
+			// Assert
+			require.ErrorContains(t, err, testCase.expectedError)
+			assert.Empty(t, result)
+		})
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package loadsimulinkmodel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+const loadSimulinkModelFunction = "matlab_mcp.loadSimulinkModel"
+
+type Args struct {
+	ModelPath string
+}
+
+type ReturnArgs struct {
+	Name       string `json:"name"`
+	File       string `json:"file"`
+	Solver     string `json:"solver"`
+	StartTime  string `json:"start_time"`
+	StopTime   string `json:"stop_time"`
+	BlockCount int    `json:"block_count"`
+}
+
+type PathValidator interface {
+	ValidateSimulinkModel(filePath string) (string, error)
+}
+
+type SimulinkDetector interface {
+	Check(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient) error
+}
+
+type Usecase struct {
+	pathValidator    PathValidator
+	simulinkDetector SimulinkDetector
+}
+
+func New(
+	pathValidator PathValidator,
+	simulinkDetector SimulinkDetector,
+) *Usecase {
+	return &Usecase{
+		pathValidator:    pathValidator,
+		simulinkDetector: simulinkDetector,
+	}
+}
+
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering LoadSimulinkModel Usecase")
+	defer sessionLogger.Debug("Exiting LoadSimulinkModel Usecase")
+
+	validatedPath, err := u.pathValidator.ValidateSimulinkModel(request.ModelPath)
+	if err != nil {
+		sessionLogger.WithError(err).With("path", request.ModelPath).Warn("Path validation failed")
+		return ReturnArgs{}, fmt.Errorf("path validation failed: %w", err)
+	}
+
+	if err := u.simulinkDetector.Check(ctx, sessionLogger, client); err != nil {
+		return ReturnArgs{}, err
+	}
+
+	response, err := client.FEval(ctx, sessionLogger, entities.FEvalRequest{
+		Function:   loadSimulinkModelFunction,
+		Arguments:  []string{validatedPath},
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	if len(response.Outputs) != 1 {
+		return ReturnArgs{}, fmt.Errorf("unexpected number of outputs when loading the model: %d", len(response.Outputs))
+	}
+
+	encodedModel, ok := response.Outputs[0].(string)
+	if !ok {
+		return ReturnArgs{}, fmt.Errorf("unexpected output type when loading the model: %T", response.Outputs[0])
+	}
+
+	var model ReturnArgs
+	if err := json.Unmarshal([]byte(encodedModel), &model); err != nil {
+		return ReturnArgs{}, fmt.Errorf("failed to decode the model: %w", err)
+	}
+
+	return model, nil
+}
@@ -0,0 +1,221 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package loadsimulinkmodel_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/loadsimulinkmodel"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/simulinkdetector"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/loadsimulinkmodel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const modelPath = "/home/user/models/vdp.slx"
+
+var loadSimulinkModelRequest = entities.FEvalRequest{
+	Function:   "matlab_mcp.loadSimulinkModel",
+	Arguments:  []string{modelPath},
+	NumOutputs: 1,
+}
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockSimulinkDetector := &mocks.MockSimulinkDetector{}
+	defer mockSimulinkDetector.AssertExpectations(t)
+
+	// Act
+	usecase := loadsimulinkmodel.New(mockPathValidator, mockSimulinkDetector)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockSimulinkDetector := &mocks.MockSimulinkDetector{}
+	defer mockSimulinkDetector.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockPathValidator.EXPECT().
+		ValidateSimulinkModel(modelPath).
+		Return(modelPath, nil).
+		Once()
+
+	mockSimulinkDetector.EXPECT().
+		Check(ctx, mockLogger.AsMockArg(), mockClient).
+		Return(nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), loadSimulinkModelRequest).
+		Return(entities.FEvalResponse{Outputs: []any{`{"name":"vdp","file":"/home/user/models/vdp.slx","solver":"ode45","start_time":"0.0","stop_time":"20","block_count":12}`}}, nil).
+		Once()
+
+	usecase := loadsimulinkmodel.New(mockPathValidator, mockSimulinkDetector)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, loadsimulinkmodel.Args{ModelPath: modelPath})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, loadsimulinkmodel.ReturnArgs{
+		Name:       "vdp",
+		File:       "/home/user/models/vdp.slx",
+		Solver:     "ode45",
+		StartTime:  "0.0",
+		StopTime:   "20",
+		BlockCount: 12,
+	}, result)
+}
+
+func TestUsecase_Execute_ValidatePathError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockSimulinkDetector := &mocks.MockSimulinkDetector{}
+	defer mockSimulinkDetector.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockPathValidator.EXPECT().
+		ValidateSimulinkModel(modelPath).
+		Return("", assert.AnError).
+		Once()
+
+	usecase := loadsimulinkmodel.New(mockPathValidator, mockSimulinkDetector)
+
+	// Act
+	result, err := usecase.Execute(t.Context(), mockLogger, mockClient, loadsimulinkmodel.Args{ModelPath: modelPath})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_SimulinkNotDetected(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockSimulinkDetector := &mocks.MockSimulinkDetector{}
+	defer mockSimulinkDetector.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockPathValidator.EXPECT().
+		ValidateSimulinkModel(modelPath).
+		Return(modelPath, nil).
+		Once()
+
+	mockSimulinkDetector.EXPECT().
+		Check(ctx, mockLogger.AsMockArg(), mockClient).
+		Return(simulinkdetector.ErrSimulinkNotDetected).
+		Once()
+
+	usecase := loadsimulinkmodel.New(mockPathValidator, mockSimulinkDetector)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, loadsimulinkmodel.Args{ModelPath: modelPath})
+
+	// Assert
+	require.ErrorIs(t, err, simulinkdetector.ErrSimulinkNotDetected)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_LoadErrors(t *testing.T) {
+	testCases := []struct {
+		name          string
+		response      entities.FEvalResponse
+		err           error
+		expectedError string
+	}{
+		{
+			name:          "FEval error",
+			err:           assert.AnError,
+			expectedError: assert.AnError.Error(),
+		},
+		{
+			name:          "unexpected number of outputs",
+			response:      entities.FEvalResponse{Outputs: []any{}},
+			expectedError: "unexpected number of outputs when loading the model: 0",
+		},
+		{
+			name:          "unexpected output type",
+			response:      entities.FEvalResponse{Outputs: []any{42}},
+			expectedError: "unexpected output type when loading the model: int",
+		},
+		{
+			name:          "invalid JSON",
+			response:      entities.FEvalResponse{Outputs: []any{"not JSON"}},
+			expectedError: "failed to decode the model",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockPathValidator := &mocks.MockPathValidator{}
+			defer mockPathValidator.AssertExpectations(t)
+
+			mockSimulinkDetector := &mocks.MockSimulinkDetector{}
+			defer mockSimulinkDetector.AssertExpectations(t)
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			ctx := t.Context()
+
+			mockPathValidator.EXPECT().
+				ValidateSimulinkModel(modelPath).
+				Return(modelPath, nil).
+				Once()
+
+			mockSimulinkDetector.EXPECT().
+				Check(ctx, mockLogger.AsMockArg(), mockClient).
+				Return(nil).
+				Once()
+
+			mockClient.EXPECT().
+				FEval(ctx, mockLogger.AsMockArg(), loadSimulinkModelRequest).
+				Return(testCase.response, testCase.err).
+				Once()
+
+			usecase := loadsimulinkmodel.New(mockPathValidator, mockSimulinkDetector)
+
+			// Act
+			result, err := usecase.Execute(ctx, mockLogger, mockClient, loadsimulinkmodel.Args{ModelPath: modelPath})
+
+			// Assert
+			require.ErrorContains(t, err, testCase.expectedError)
+			assert.Empty(t, result)
+		})
+	}
+}
@@ -0,0 +1,315 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package profilematlabcode
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+const profileCodeFunction = "matlab_mcp.profileCode"
+
+const (
+	defaultMaxFunctions    = 10
+	defaultMaxLinesPerFile = 5
+)
+
+type Args struct {
+	Code            string
+	ProjectPath     string
+	MaxFunctions    int
+	MaxLinesPerFile int
+	CollapsedStacks bool
+}
+
+type Function struct {
+	Name      string
+	File      string
+	Type      string
+	Calls     int
+	TotalTime float64
+	SelfTime  float64
+}
+
+type Line struct {
+	Function string
+	Line     int
+	Calls    int
+	Time     float64
+}
+
+type FileLines struct {
+	File  string
+	Lines []Line
+}
+
+// ReturnArgs holds the hot spots of the code. The times are in seconds.
+type ReturnArgs struct {
+	Output               string
+	Error                string
+	TotalTime            float64
+	FunctionsBySelfTime  []Function
+	FunctionsByTotalTime []Function
+	SlowestLines         []FileLines
+	// CollapsedStacks has a line per call stack, with the function names separated by semicolons,
+	// followed by the self time of the innermost function in microseconds, as flame graph tools expect.
+	CollapsedStacks string
+}
+
+type PathValidator interface {
+	ValidateFolderPath(filePath string) (string, error)
+}
+
+type CodeSafetyPolicy interface {
+	Check(ctx context.Context, sessionLogger entities.Logger, code string) error
+}
+
+type Usecase struct {
+	pathValidator    PathValidator
+	codeSafetyPolicy CodeSafetyPolicy
+}
+
+func New(
+	pathValidator PathValidator,
+	codeSafetyPolicy CodeSafetyPolicy,
+) *Usecase {
+	return &Usecase{
+		pathValidator:    pathValidator,
+		codeSafetyPolicy: codeSafetyPolicy,
+	}
+}
+
+type profiledChild struct {
+	Index     int     `json:"index"`
+	Calls     int     `json:"calls"`
+	TotalTime float64 `json:"total_time"`
+}
+
+type profiledLine struct {
+	Line  int     `json:"line"`
+	Calls int     `json:"calls"`
+	Time  float64 `json:"time"`
+}
+
+type profiledFunction struct {
+	Index     int             `json:"index"`
+	Name      string          `json:"name"`
+	File      string          `json:"file"`
+	Type      string          `json:"type"`
+	Calls     int             `json:"calls"`
+	TotalTime float64         `json:"total_time"`
+	Children  []profiledChild `json:"children"`
+	Lines     []profiledLine  `json:"lines"`
+}
+
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering ProfileMATLABCode Usecase")
+	defer sessionLogger.Debug("Exiting ProfileMATLABCode Usecase")
+
+	validatedPath, err := u.pathValidator.ValidateFolderPath(request.ProjectPath)
+	if err != nil {
+		sessionLogger.WithError(err).With("path", request.ProjectPath).Warn("Path validation failed")
+		return ReturnArgs{}, fmt.Errorf("path validation failed: %w", err)
+	}
+
+	if err := u.codeSafetyPolicy.Check(ctx, sessionLogger, request.Code); err != nil {
+		return ReturnArgs{}, fmt.Errorf("code safety check failed: %w", err)
+	}
+
+	cdRequest := entities.EvalRequest{
+		Code: fmt.Sprintf("cd('%s')", strings.ReplaceAll(validatedPath, "'", "''")), // Escape single quotes
+	}
+	if _, err := client.Eval(ctx, sessionLogger, cdRequest); err != nil {
+		return ReturnArgs{}, err
+	}
+
+	response, err := client.FEval(ctx, sessionLogger, entities.FEvalRequest{
+		Function:   profileCodeFunction,
+		Arguments:  []string{request.Code},
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	if len(response.Outputs) != 1 {
+		return ReturnArgs{}, fmt.Errorf("unexpected number of outputs when profiling the code: %d", len(response.Outputs))
+	}
+
+	encodedProfile, ok := response.Outputs[0].(string)
+	if !ok {
+		return ReturnArgs{}, fmt.Errorf("unexpected output type when profiling the code: %T", response.Outputs[0])
+	}
+
+	var profile struct {
+		Output    string             `json:"output"`
+		Error     string             `json:"error"`
+		TotalTime float64            `json:"total_time"`
+		Functions []profiledFunction `json:"functions"`
+	}
+	if err := json.Unmarshal([]byte(encodedProfile), &profile); err != nil {
+		return ReturnArgs{}, fmt.Errorf("failed to decode the profile: %w", err)
+	}
+
+	maxFunctions := request.MaxFunctions
+	if maxFunctions <= 0 {
+		maxFunctions = defaultMaxFunctions
+	}
+
+	maxLinesPerFile := request.MaxLinesPerFile
+	if maxLinesPerFile <= 0 {
+		maxLinesPerFile = defaultMaxLinesPerFile
+	}
+
+	functions := make([]Function, len(profile.Functions))
+	for i, function := range profile.Functions {
+		functions[i] = Function{
+			Name:      function.Name,
+			File:      function.File,
+			Type:      function.Type,
+			Calls:     function.Calls,
+			TotalTime: function.TotalTime,
+			SelfTime:  selfTime(function),
+		}
+	}
+
+	result := ReturnArgs{
+		Output:               profile.Output,
+		Error:                profile.Error,
+		TotalTime:            profile.TotalTime,
+		FunctionsBySelfTime:  topFunctions(functions, maxFunctions, func(f Function) float64 { return f.SelfTime }),
+		FunctionsByTotalTime: topFunctions(functions, maxFunctions, func(f Function) float64 { return f.TotalTime }),
+		SlowestLines:         slowestLines(profile.Functions, maxLinesPerFile),
+	}
+
+	if request.CollapsedStacks {
+		result.CollapsedStacks = collapsedStacks(profile.Functions)
+	}
+
+	return result, nil
+}
+
+// selfTime is the time spent in the function itself, rather than in the functions it called.
+func selfTime(function profiledFunction) float64 {
+	childrenTime := 0.0
+	for _, child := range function.Children {
+		childrenTime += child.TotalTime
+	}
+
+	return max(function.TotalTime-childrenTime, 0)
+}
+
+func topFunctions(functions []Function, maxFunctions int, time func(Function) float64) []Function {
+	sorted := slices.Clone(functions)
+	slices.SortStableFunc(sorted, func(a, b Function) int {
+		return cmp.Compare(time(b), time(a))
+	})
+
+	return sorted[:min(len(sorted), maxFunctions)]
+}
+
+// slowestLines returns the slowest lines of each file, the file with the slowest line first.
+// The built-in functions, which have no file, are left out.
+func slowestLines(functions []profiledFunction, maxLinesPerFile int) []FileLines {
+	linesByFile := map[string][]Line{}
+	files := []string{}
+
+	for _, function := range functions {
+		if function.File == "" || len(function.Lines) == 0 {
+			continue
+		}
+
+		if _, exists := linesByFile[function.File]; !exists {
+			files = append(files, function.File)
+		}
+
+		for _, line := range function.Lines {
+			linesByFile[function.File] = append(linesByFile[function.File], Line{
+				Function: function.Name,
+				Line:     line.Line,
+				Calls:    line.Calls,
+				Time:     line.Time,
+			})
+		}
+	}
+
+	result := make([]FileLines, len(files))
+	for i, file := range files {
+		lines := linesByFile[file]
+		slices.SortStableFunc(lines, func(a, b Line) int {
+			return cmp.Compare(b.Time, a.Time)
+		})
+
+		result[i] = FileLines{
+			File:  file,
+			Lines: lines[:min(len(lines), maxLinesPerFile)],
+		}
+	}
+
+	slices.SortStableFunc(result, func(a, b FileLines) int {
+		return cmp.Compare(b.Lines[0].Time, a.Lines[0].Time)
+	})
+
+	return result
+}
+
+// collapsedStacks walks the call graph from the functions no other function called.
+// The profiler only records the total time of each caller and callee pair, so the time of a function along a stack
+// is its share of the time of its caller, and recursive calls are not expanded.
+func collapsedStacks(functions []profiledFunction) string {
+	functionsByIndex := map[int]profiledFunction{}
+	calledFunctions := map[int]bool{}
+	for _, function := range functions {
+		functionsByIndex[function.Index] = function
+		for _, child := range function.Children {
+			if child.Index != function.Index {
+				calledFunctions[child.Index] = true
+			}
+		}
+	}
+
+	lines := []string{}
+	onStack := map[int]bool{}
+
+	var walk func(function profiledFunction, stack []string, time float64)
+	walk = func(function profiledFunction, stack []string, time float64) {
+		stack = append(slices.Clip(stack), function.Name)
+
+		share := 0.0
+		if function.TotalTime > 0 {
+			share = time / function.TotalTime
+		}
+
+		if microseconds := int64(math.Round(selfTime(function) * share * 1e6)); microseconds > 0 {
+			lines = append(lines, strings.Join(stack, ";")+" "+strconv.FormatInt(microseconds, 10))
+		}
+
+		onStack[function.Index] = true
+		defer delete(onStack, function.Index)
+
+		for _, child := range function.Children {
+			childFunction, exists := functionsByIndex[child.Index]
+			if !exists || onStack[child.Index] {
+				continue
+			}
+
+			walk(childFunction, stack, child.TotalTime*share)
+		}
+	}
+
+	for _, function := range functions {
+		if !calledFunctions[function.Index] {
+			walk(function, nil, function.TotalTime)
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
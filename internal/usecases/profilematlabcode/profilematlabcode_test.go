@@ -0,0 +1,367 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package profilematlabcode_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/profilematlabcode"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/profilematlabcode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const code = "main()"
+
+const encodedProfile = `{
+	"output": "done",
+	"error": "",
+	"total_time": 1.2,
+	"functions": [
+		{"index": 1, "name": "main", "file": "/project/main.m", "type": "M-function", "calls": 1, "total_time": 1.0,
+			"children": [{"index": 2, "calls": 1, "total_time": 0.6}, {"index": 3, "calls": 2, "total_time": 0.1}],
+			"lines": [{"line": 5, "calls": 1, "time": 0.6}, {"line": 6, "calls": 2, "time": 0.1}, {"line": 7, "calls": 1, "time": 0.01}]},
+		{"index": 2, "name": "main>helper", "file": "/project/main.m", "type": "M-subfunction", "calls": 1, "total_time": 0.6,
+			"children": [{"index": 3, "calls": 1, "total_time": 0.2}],
+			"lines": [{"line": 12, "calls": 1, "time": 0.55}]},
+		{"index": 3, "name": "compute", "file": "/project/compute.m", "type": "M-function", "calls": 3, "total_time": 0.3,
+			"children": [],
+			"lines": [{"line": 3, "calls": 3, "time": 0.25}]},
+		{"index": 5, "name": "sum", "file": "", "type": "Builtin", "calls": 4, "total_time": 0.05,
+			"children": [],
+			"lines": []}
+	]
+}`
+
+var (
+	mainFunction    = profilematlabcode.Function{Name: "main", File: "/project/main.m", Type: "M-function", Calls: 1, TotalTime: 1.0, SelfTime: 0.30000000000000004}
+	helperFunction  = profilematlabcode.Function{Name: "main>helper", File: "/project/main.m", Type: "M-subfunction", Calls: 1, TotalTime: 0.6, SelfTime: 0.39999999999999997}
+	computeFunction = profilematlabcode.Function{Name: "compute", File: "/project/compute.m", Type: "M-function", Calls: 3, TotalTime: 0.3, SelfTime: 0.3}
+	sumFunction     = profilematlabcode.Function{Name: "sum", Type: "Builtin", Calls: 4, TotalTime: 0.05, SelfTime: 0.05}
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+	defer mockCodeSafetyPolicy.AssertExpectations(t)
+
+	// Act
+	usecase := profilematlabcode.New(mockPathValidator, mockCodeSafetyPolicy)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+	defer mockCodeSafetyPolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	projectPath := filepath.Join("some", "path")
+	ctx := t.Context()
+
+	expectProfile(t, mockLogger, mockPathValidator, mockCodeSafetyPolicy, mockClient, projectPath, encodedProfile)
+
+	usecase := profilematlabcode.New(mockPathValidator, mockCodeSafetyPolicy)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, profilematlabcode.Args{
+		Code:            code,
+		ProjectPath:     projectPath,
+		MaxFunctions:    3,
+		MaxLinesPerFile: 2,
+		CollapsedStacks: true,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "done", result.Output)
+	assert.Empty(t, result.Error)
+	assert.InDelta(t, 1.2, result.TotalTime, 1e-9)
+	assert.Equal(t, []profilematlabcode.Function{helperFunction, mainFunction, computeFunction}, result.FunctionsBySelfTime)
+	assert.Equal(t, []profilematlabcode.Function{mainFunction, helperFunction, computeFunction}, result.FunctionsByTotalTime)
+	assert.Equal(t, []profilematlabcode.FileLines{
+		{
+			File: "/project/main.m",
+			Lines: []profilematlabcode.Line{
+				{Function: "main", Line: 5, Calls: 1, Time: 0.6},
+				{Function: "main>helper", Line: 12, Calls: 1, Time: 0.55},
+			},
+		},
+		{
+			File: "/project/compute.m",
+			Lines: []profilematlabcode.Line{
+				{Function: "compute", Line: 3, Calls: 3, Time: 0.25},
+			},
+		},
+	}, result.SlowestLines)
+	assert.Equal(t, "main 300000\nmain;main>helper 400000\nmain;main>helper;compute 200000\nmain;compute 100000\nsum 50000", result.CollapsedStacks)
+}
+
+func TestUsecase_Execute_Defaults(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+	defer mockCodeSafetyPolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	projectPath := filepath.Join("some", "path")
+	ctx := t.Context()
+
+	expectProfile(t, mockLogger, mockPathValidator, mockCodeSafetyPolicy, mockClient, projectPath, encodedProfile)
+
+	usecase := profilematlabcode.New(mockPathValidator, mockCodeSafetyPolicy)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, profilematlabcode.Args{
+		Code:        code,
+		ProjectPath: projectPath,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []profilematlabcode.Function{mainFunction, helperFunction, computeFunction, sumFunction}, result.FunctionsByTotalTime)
+	require.Len(t, result.SlowestLines, 2)
+	assert.Len(t, result.SlowestLines[0].Lines, 4)
+	assert.Empty(t, result.CollapsedStacks, "Collapsed stacks should only be returned on request")
+}
+
+func TestUsecase_Execute_CodeError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+	defer mockCodeSafetyPolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	projectPath := filepath.Join("some", "path")
+	ctx := t.Context()
+
+	expectProfile(t, mockLogger, mockPathValidator, mockCodeSafetyPolicy, mockClient, projectPath, `{"output":"","error":"Undefined function 'main'.","total_time":0.01,"functions":[]}`)
+
+	usecase := profilematlabcode.New(mockPathValidator, mockCodeSafetyPolicy)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, profilematlabcode.Args{
+		Code:            code,
+		ProjectPath:     projectPath,
+		CollapsedStacks: true,
+	})
+
+	// Assert
+	require.NoError(t, err, "Errors of the code should be returned as a result")
+	assert.Equal(t, "Undefined function 'main'.", result.Error)
+	assert.Empty(t, result.FunctionsBySelfTime)
+	assert.NotNil(t, result.FunctionsBySelfTime)
+	assert.Empty(t, result.SlowestLines)
+	assert.NotNil(t, result.SlowestLines)
+	assert.Empty(t, result.CollapsedStacks)
+}
+
+func TestUsecase_Execute_ValidatePathError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+	defer mockCodeSafetyPolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	projectPath := filepath.Join("some", "path")
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(projectPath).
+		Return("", assert.AnError).
+		Once()
+
+	usecase := profilematlabcode.New(mockPathValidator, mockCodeSafetyPolicy)
+
+	// Act
+	result, err := usecase.Execute(t.Context(), mockLogger, mockClient, profilematlabcode.Args{Code: code, ProjectPath: projectPath})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_CodeSafetyError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+	defer mockCodeSafetyPolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	projectPath := filepath.Join("some", "path")
+	ctx := t.Context()
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(projectPath).
+		Return(projectPath, nil).
+		Once()
+
+	mockCodeSafetyPolicy.EXPECT().
+		Check(ctx, mockLogger.AsMockArg(), code).
+		Return(assert.AnError).
+		Once()
+
+	usecase := profilematlabcode.New(mockPathValidator, mockCodeSafetyPolicy)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, profilematlabcode.Args{Code: code, ProjectPath: projectPath})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_ProfileErrors(t *testing.T) {
+	testCases := []struct {
+		name          string
+		response      entities.FEvalResponse
+		err           error
+		expectedError string
+	}{
+		{
+			name:          "FEval error",
+			err:           assert.AnError,
+			expectedError: assert.AnError.Error(),
+		},
+		{
+			name:          "unexpected number of outputs",
+			response:      entities.FEvalResponse{Outputs: []any{}},
+			expectedError: "unexpected number of outputs when profiling the code: 0",
+		},
+		{
+			name:          "unexpected output type",
+			response:      entities.FEvalResponse{Outputs: []any{42}},
+			expectedError: "unexpected output type when profiling the code: int",
+		},
+		{
+			name:          "invalid JSON",
+			response:      entities.FEvalResponse{Outputs: []any{"not JSON"}},
+			expectedError: "failed to decode the profile",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockPathValidator := &mocks.MockPathValidator{}
+			defer mockPathValidator.AssertExpectations(t)
+
+			mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+			defer mockCodeSafetyPolicy.AssertExpectations(t)
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			projectPath := filepath.Join("some", "path")
+			ctx := t.Context()
+
+			mockPathValidator.EXPECT().
+				ValidateFolderPath(projectPath).
+				Return(projectPath, nil).
+				Once()
+
+			mockCodeSafetyPolicy.EXPECT().
+				Check(ctx, mockLogger.AsMockArg(), code).
+				Return(nil).
+				Once()
+
+			mockClient.EXPECT().
+				Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: "cd('" + projectPath + "')"}).
+				Return(entities.EvalResponse{}, nil).
+				Once()
+
+			mockClient.EXPECT().
+				FEval(ctx, mockLogger.AsMockArg(), profileCodeRequest).
+				Return(testCase.response, testCase.err).
+				Once()
+
+			usecase := profilematlabcode.New(mockPathValidator, mockCodeSafetyPolicy)
+
+			// Act
+			result, err := usecase.Execute(ctx, mockLogger, mockClient, profilematlabcode.Args{Code: code, ProjectPath: projectPath})
+
+			// Assert
+			require.ErrorContains(t, err, testCase.expectedError)
+			assert.Empty(t, result)
+		})
+	}
+}
+
+var profileCodeRequest = entities.FEvalRequest{
+	Function:   "matlab_mcp.profileCode",
+	Arguments:  []string{code},
+	NumOutputs: 1,
+}
+
+func expectProfile(
+	t *testing.T,
+	mockLogger *testutils.InspectableLogger,
+	mockPathValidator *mocks.MockPathValidator,
+	mockCodeSafetyPolicy *mocks.MockCodeSafetyPolicy,
+	mockClient *entitiesmocks.MockMATLABSessionClient,
+	projectPath string,
+	profile string,
+) {
+	ctx := t.Context()
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(projectPath).
+		Return(projectPath, nil).
+		Once()
+
+	mockCodeSafetyPolicy.EXPECT().
+		Check(ctx, mockLogger.AsMockArg(), code).
+		Return(nil).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: "cd('" + projectPath + "')"}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), profileCodeRequest).
+		Return(entities.FEvalResponse{Outputs: []any{profile}}, nil).
+		Once()
+}
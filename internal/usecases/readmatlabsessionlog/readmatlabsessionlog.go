@@ -0,0 +1,71 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package readmatlabsessionlog
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// Stream is the output stream of MATLAB whose log to read.
+type Stream string
+
+const (
+	StandardOutput Stream = "stdout"
+	StandardError  Stream = "stderr"
+)
+
+type OSLayer interface {
+	ReadFile(filePath string) ([]byte, error)
+}
+
+type Args struct {
+	SessionDir string
+	Stream     Stream
+}
+
+type ReturnArgs struct {
+	Text string
+}
+
+// Usecase reads the logs directly from the session directory, rather than through MATLAB,
+// so that they can be read when MATLAB is busy, unresponsive or has exited.
+type Usecase struct {
+	osLayer OSLayer
+}
+
+func New(
+	osLayer OSLayer,
+) *Usecase {
+	return &Usecase{
+		osLayer: osLayer,
+	}
+}
+
+func (u *Usecase) Execute(sessionLogger entities.Logger, request Args) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering ReadMATLABSessionLog Usecase")
+	defer sessionLogger.Debug("Exiting ReadMATLABSessionLog Usecase")
+
+	if request.Stream != StandardOutput && request.Stream != StandardError {
+		return ReturnArgs{}, fmt.Errorf("invalid stream: %q", request.Stream)
+	}
+
+	// The MATLAB process launcher redirects the output streams of MATLAB to these files
+	logFile := filepath.Join(request.SessionDir, "matlab_"+string(request.Stream)+".log")
+
+	content, err := u.osLayer.ReadFile(logFile)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return ReturnArgs{}, fmt.Errorf("the MATLAB session has no %s log", request.Stream)
+		}
+		return ReturnArgs{}, fmt.Errorf("failed to read the session log: %w", err)
+	}
+
+	return ReturnArgs{
+		Text: string(content),
+	}, nil
+}
@@ -0,0 +1,130 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package readmatlabsessionlog_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/readmatlabsessionlog"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/readmatlabsessionlog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	// Act
+	usecase := readmatlabsessionlog.New(mockOSLayer)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	testCases := []struct {
+		name         string
+		stream       readmatlabsessionlog.Stream
+		expectedFile string
+	}{
+		{name: "standard output", stream: readmatlabsessionlog.StandardOutput, expectedFile: "matlab_stdout.log"},
+		{name: "standard error", stream: readmatlabsessionlog.StandardError, expectedFile: "matlab_stderr.log"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockOSLayer := &mocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			sessionDir := filepath.Join("tmp", "session")
+			const expectedText = "Some MATLAB output\n"
+
+			mockOSLayer.EXPECT().
+				ReadFile(filepath.Join(sessionDir, tc.expectedFile)).
+				Return([]byte(expectedText), nil).
+				Once()
+
+			usecase := readmatlabsessionlog.New(mockOSLayer)
+
+			// Act
+			result, err := usecase.Execute(mockLogger, readmatlabsessionlog.Args{SessionDir: sessionDir, Stream: tc.stream})
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, expectedText, result.Text)
+		})
+	}
+}
+
+func TestUsecase_Execute_InvalidStream(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	usecase := readmatlabsessionlog.New(mockOSLayer)
+
+	// Act
+	result, err := usecase.Execute(mockLogger, readmatlabsessionlog.Args{SessionDir: filepath.Join("tmp", "session"), Stream: "stdin"})
+
+	// Assert
+	require.EqualError(t, err, `invalid stream: "stdin"`)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_NoLogFile(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	sessionDir := filepath.Join("tmp", "session")
+
+	mockOSLayer.EXPECT().
+		ReadFile(filepath.Join(sessionDir, "matlab_stderr.log")).
+		Return(nil, os.ErrNotExist).
+		Once()
+
+	usecase := readmatlabsessionlog.New(mockOSLayer)
+
+	// Act
+	result, err := usecase.Execute(mockLogger, readmatlabsessionlog.Args{SessionDir: sessionDir, Stream: readmatlabsessionlog.StandardError})
+
+	// Assert
+	require.EqualError(t, err, "the MATLAB session has no stderr log")
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_ReadFileError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	sessionDir := filepath.Join("tmp", "session")
+
+	mockOSLayer.EXPECT().
+		ReadFile(filepath.Join(sessionDir, "matlab_stdout.log")).
+		Return(nil, assert.AnError).
+		Once()
+
+	usecase := readmatlabsessionlog.New(mockOSLayer)
+
+	// Act
+	result, err := usecase.Execute(mockLogger, readmatlabsessionlog.Args{SessionDir: sessionDir, Stream: readmatlabsessionlog.StandardOutput})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
@@ -0,0 +1,41 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package resetmatlabstate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+type Args struct {
+	ClearVariables            bool
+	CloseFigures              bool
+	ClearFunctions            bool
+	RestoreDefaultPath        bool
+	ChangeToStartingDirectory bool
+}
+
+type Usecase struct {
+}
+
+func New() *Usecase {
+	return &Usecase{}
+}
+
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) error {
+	sessionLogger.Debug("Entering ResetMATLABState Usecase")
+	defer sessionLogger.Debug("Exiting ResetMATLABState Usecase")
+
+	_, err := client.Eval(ctx, sessionLogger, entities.EvalRequest{
+		Code: fmt.Sprintf("matlab_mcp.resetState(%t, %t, %t, %t, %t)",
+			request.ClearVariables,
+			request.CloseFigures,
+			request.ClearFunctions,
+			request.RestoreDefaultPath,
+			request.ChangeToStartingDirectory,
+		),
+	})
+	return err
+}
@@ -0,0 +1,114 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package resetmatlabstate_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/resetmatlabstate"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+
+	// Act
+	usecase := resetmatlabstate.New()
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	testCases := []struct {
+		name         string
+		args         resetmatlabstate.Args
+		expectedCode string
+	}{
+		{
+			name: "All options",
+			args: resetmatlabstate.Args{
+				ClearVariables:            true,
+				CloseFigures:              true,
+				ClearFunctions:            true,
+				RestoreDefaultPath:        true,
+				ChangeToStartingDirectory: true,
+			},
+			expectedCode: "matlab_mcp.resetState(true, true, true, true, true)",
+		},
+		{
+			name:         "No options",
+			args:         resetmatlabstate.Args{},
+			expectedCode: "matlab_mcp.resetState(false, false, false, false, false)",
+		},
+		{
+			name: "Clear variables and close figures",
+			args: resetmatlabstate.Args{
+				ClearVariables: true,
+				CloseFigures:   true,
+			},
+			expectedCode: "matlab_mcp.resetState(true, true, false, false, false)",
+		},
+		{
+			name: "Restore path and starting directory",
+			args: resetmatlabstate.Args{
+				RestoreDefaultPath:        true,
+				ChangeToStartingDirectory: true,
+			},
+			expectedCode: "matlab_mcp.resetState(false, false, false, true, true)",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			ctx := t.Context()
+
+			mockClient.EXPECT().
+				Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: tc.expectedCode}).
+				Return(entities.EvalResponse{}, nil).
+				Once()
+
+			usecase := resetmatlabstate.New()
+
+			// Act
+			err := usecase.Execute(ctx, mockLogger, mockClient, tc.args)
+
+			// Assert
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestUsecase_Execute_EvalError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	expectedError := assert.AnError
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: "matlab_mcp.resetState(true, false, false, false, false)"}).
+		Return(entities.EvalResponse{}, expectedError).
+		Once()
+
+	usecase := resetmatlabstate.New()
+
+	// Act
+	err := usecase.Execute(ctx, mockLogger, mockClient, resetmatlabstate.Args{ClearVariables: true})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+}
@@ -0,0 +1,114 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package restartmatlabsession
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+const (
+	saveVariablesFunction = "matlab_mcp.saveVariables"
+	loadVariablesFunction = "matlab_mcp.loadVariables"
+)
+
+var validVariableName = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+type Args struct {
+	PreservedVariables []string
+}
+
+type ReturnArgs struct {
+	PreservedVariables []string
+}
+
+// Session is the MATLAB session being restarted, either the global MATLAB session or a session from the MATLAB manager.
+type Session interface {
+	Client(ctx context.Context, logger entities.Logger) (entities.MATLABSessionClient, error)
+	Restart(ctx context.Context, logger entities.Logger) error
+}
+
+type Usecase struct{}
+
+func New() *Usecase {
+	return &Usecase{}
+}
+
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, session Session, request Args) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering RestartMATLABSession Usecase")
+	defer sessionLogger.Debug("Exiting RestartMATLABSession Usecase")
+
+	for _, variable := range request.PreservedVariables {
+		if !validVariableName.MatchString(variable) {
+			return ReturnArgs{}, fmt.Errorf("invalid MATLAB variable name: %q", variable)
+		}
+	}
+
+	var preservedVariables string
+	if len(request.PreservedVariables) > 0 {
+		encodedVariables, err := saveVariables(ctx, sessionLogger, session, request.PreservedVariables)
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+		preservedVariables = encodedVariables
+	}
+
+	if err := session.Restart(ctx, sessionLogger); err != nil {
+		return ReturnArgs{}, err
+	}
+
+	if preservedVariables != "" {
+		if err := loadVariables(ctx, sessionLogger, session, preservedVariables); err != nil {
+			return ReturnArgs{}, err
+		}
+	}
+
+	return ReturnArgs{
+		PreservedVariables: request.PreservedVariables,
+	}, nil
+}
+
+// saveVariables returns the variables as a MAT file encoded as base64.
+// The MAT file goes through the MATLAB session client rather than the file system, as the session may run on another machine.
+func saveVariables(ctx context.Context, sessionLogger entities.Logger, session Session, variables []string) (string, error) {
+	client, err := session.Client(ctx, sessionLogger)
+	if err != nil {
+		return "", err
+	}
+
+	response, err := client.FEval(ctx, sessionLogger, entities.FEvalRequest{
+		Function:   saveVariablesFunction,
+		Arguments:  variables,
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if len(response.Outputs) != 1 {
+		return "", fmt.Errorf("unexpected number of outputs when saving the variables: %d", len(response.Outputs))
+	}
+
+	encodedVariables, ok := response.Outputs[0].(string)
+	if !ok {
+		return "", fmt.Errorf("unexpected output type when saving the variables: %T", response.Outputs[0])
+	}
+
+	return encodedVariables, nil
+}
+
+func loadVariables(ctx context.Context, sessionLogger entities.Logger, session Session, encodedVariables string) error {
+	client, err := session.Client(ctx, sessionLogger)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.FEval(ctx, sessionLogger, entities.FEvalRequest{
+		Function:  loadVariablesFunction,
+		Arguments: []string{encodedVariables},
+	})
+	return err
+}
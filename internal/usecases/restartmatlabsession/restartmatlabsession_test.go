@@ -0,0 +1,217 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package restartmatlabsession_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/restartmatlabsession"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/restartmatlabsession"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+
+	// Act
+	usecase := restartmatlabsession.New()
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_NoPreservedVariables(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockSession := &mocks.MockSession{}
+	defer mockSession.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockSession.EXPECT().
+		Restart(ctx, mockLogger.AsMockArg()).
+		Return(nil).
+		Once()
+
+	usecase := restartmatlabsession.New()
+
+	// Act
+	response, err := usecase.Execute(ctx, mockLogger, mockSession, restartmatlabsession.Args{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, response.PreservedVariables)
+}
+
+func TestUsecase_Execute_PreservedVariables(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockSession := &mocks.MockSession{}
+	defer mockSession.AssertExpectations(t)
+
+	mockOldClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockOldClient.AssertExpectations(t)
+
+	mockNewClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockNewClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	encodedVariables := "TUFUIGZpbGUgY29udGVudA=="
+	preservedVariables := []string{"a", "myData"}
+
+	mockSession.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockOldClient, nil).
+		Once()
+
+	mockOldClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.saveVariables",
+			Arguments:  preservedVariables,
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{encodedVariables}}, nil).
+		Once()
+
+	mockSession.EXPECT().
+		Restart(ctx, mockLogger.AsMockArg()).
+		Return(nil).
+		Once()
+
+	mockSession.EXPECT().
+		Client(ctx, mockLogger.AsMockArg()).
+		Return(mockNewClient, nil).
+		Once()
+
+	mockNewClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:  "matlab_mcp.loadVariables",
+			Arguments: []string{encodedVariables},
+		}).
+		Return(entities.FEvalResponse{}, nil).
+		Once()
+
+	usecase := restartmatlabsession.New()
+
+	// Act
+	response, err := usecase.Execute(ctx, mockLogger, mockSession, restartmatlabsession.Args{
+		PreservedVariables: preservedVariables,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, preservedVariables, response.PreservedVariables)
+}
+
+func TestUsecase_Execute_InvalidVariableName(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockSession := &mocks.MockSession{}
+	defer mockSession.AssertExpectations(t)
+
+	usecase := restartmatlabsession.New()
+
+	// Act
+	_, err := usecase.Execute(t.Context(), mockLogger, mockSession, restartmatlabsession.Args{
+		PreservedVariables: []string{"a'); system('rm -rf /"},
+	})
+
+	// Assert
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid MATLAB variable name")
+}
+
+func TestUsecase_Execute_SaveErrorDoesNotRestart(t *testing.T) {
+	testCases := []struct {
+		name          string
+		response      entities.FEvalResponse
+		err           error
+		expectedError string
+	}{
+		{
+			name:          "FEval error",
+			err:           assert.AnError,
+			expectedError: assert.AnError.Error(),
+		},
+		{
+			name:          "unexpected number of outputs",
+			response:      entities.FEvalResponse{Outputs: []any{}},
+			expectedError: "unexpected number of outputs when saving the variables: 0",
+		},
+		{
+			name:          "unexpected output type",
+			response:      entities.FEvalResponse{Outputs: []any{42}},
+			expectedError: "unexpected output type when saving the variables: int",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockSession := &mocks.MockSession{}
+			defer mockSession.AssertExpectations(t)
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			ctx := t.Context()
+
+			mockSession.EXPECT().
+				Client(ctx, mockLogger.AsMockArg()).
+				Return(mockClient, nil).
+				Once()
+
+			mockClient.EXPECT().
+				FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+					Function:   "matlab_mcp.saveVariables",
+					Arguments:  []string{"missing"},
+					NumOutputs: 1,
+				}).
+				Return(testCase.response, testCase.err).
+				Once()
+
+			usecase := restartmatlabsession.New()
+
+			// Act
+			_, err := usecase.Execute(ctx, mockLogger, mockSession, restartmatlabsession.Args{
+				PreservedVariables: []string{"missing"},
+			})
+
+			// Assert
+			require.ErrorContains(t, err, testCase.expectedError)
+		})
+	}
+}
+
+func TestUsecase_Execute_RestartError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockSession := &mocks.MockSession{}
+	defer mockSession.AssertExpectations(t)
+
+	ctx := t.Context()
+	expectedError := assert.AnError
+
+	mockSession.EXPECT().
+		Restart(ctx, mockLogger.AsMockArg()).
+		Return(expectedError).
+		Once()
+
+	usecase := restartmatlabsession.New()
+
+	// Act
+	_, err := usecase.Execute(ctx, mockLogger, mockSession, restartmatlabsession.Args{})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+}
@@ -0,0 +1,95 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package setmatlabbreakpoint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/breakpoints"
+)
+
+const setBreakpointFunction = "matlab_mcp.setBreakpoint"
+
+var (
+	ErrMissingLocation     = errors.New("either a file and a line, or stopping on errors, is required")
+	ErrConflictingLocation = errors.New("a file and a line cannot be combined with stopping on errors")
+	ErrInvalidLine         = errors.New("the line must be a positive number")
+)
+
+// Args describes the breakpoint, either at a line of a file, with an optional condition, or on errors.
+type Args struct {
+	FilePath  string
+	Line      int
+	Condition string
+	OnError   bool
+}
+
+type PathValidator interface {
+	ValidateMATLABScript(filePath string) (string, error)
+}
+
+type CodeSafetyPolicy interface {
+	Check(ctx context.Context, sessionLogger entities.Logger, code string) error
+}
+
+type Usecase struct {
+	pathValidator    PathValidator
+	codeSafetyPolicy CodeSafetyPolicy
+}
+
+func New(
+	pathValidator PathValidator,
+	codeSafetyPolicy CodeSafetyPolicy,
+) *Usecase {
+	return &Usecase{
+		pathValidator:    pathValidator,
+		codeSafetyPolicy: codeSafetyPolicy,
+	}
+}
+
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) (breakpoints.Breakpoints, error) {
+	sessionLogger.Debug("Entering SetMATLABBreakpoint Usecase")
+	defer sessionLogger.Debug("Exiting SetMATLABBreakpoint Usecase")
+
+	arguments := []string{"", "", ""}
+
+	switch {
+	case request.OnError && request.FilePath != "":
+		return breakpoints.Breakpoints{}, ErrConflictingLocation
+	case !request.OnError && request.FilePath == "":
+		return breakpoints.Breakpoints{}, ErrMissingLocation
+	case request.FilePath != "":
+		if request.Line <= 0 {
+			return breakpoints.Breakpoints{}, ErrInvalidLine
+		}
+
+		validatedPath, err := u.pathValidator.ValidateMATLABScript(request.FilePath)
+		if err != nil {
+			return breakpoints.Breakpoints{}, err
+		}
+
+		// MATLAB evaluates the condition each time the line runs, so it is checked like any other code
+		if request.Condition != "" {
+			if err := u.codeSafetyPolicy.Check(ctx, sessionLogger, request.Condition); err != nil {
+				return breakpoints.Breakpoints{}, fmt.Errorf("code safety check failed: %w", err)
+			}
+		}
+
+		arguments = []string{validatedPath, strconv.Itoa(request.Line), request.Condition}
+	}
+
+	response, err := client.FEval(ctx, sessionLogger, entities.FEvalRequest{
+		Function:   setBreakpointFunction,
+		Arguments:  arguments,
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return breakpoints.Breakpoints{}, err
+	}
+
+	return breakpoints.Decode(response)
+}
@@ -0,0 +1,279 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package setmatlabbreakpoint_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/setmatlabbreakpoint"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/breakpoints"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/setmatlabbreakpoint"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const encodedBreakpoints = `{"breakpoints":[{"file":"/home/user/analysis.m","line":12,"condition":"x > 3"}],"stop_on_error":false}`
+
+var expectedBreakpoints = breakpoints.Breakpoints{
+	Breakpoints: []breakpoints.Breakpoint{
+		{File: "/home/user/analysis.m", Line: 12, Condition: "x > 3"},
+	},
+}
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+	defer mockCodeSafetyPolicy.AssertExpectations(t)
+
+	// Act
+	usecase := setmatlabbreakpoint.New(mockPathValidator, mockCodeSafetyPolicy)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_AtLine(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+	defer mockCodeSafetyPolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	filePath := filepath.Join("home", "user", "analysis.m")
+	validatedPath := filepath.Join("/", "home", "user", "analysis.m")
+	ctx := t.Context()
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABScript(filePath).
+		Return(validatedPath, nil).
+		Once()
+
+	mockCodeSafetyPolicy.EXPECT().
+		Check(ctx, mockLogger.AsMockArg(), "x > 3").
+		Return(nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.setBreakpoint",
+			Arguments:  []string{validatedPath, "12", "x > 3"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{encodedBreakpoints}}, nil).
+		Once()
+
+	usecase := setmatlabbreakpoint.New(mockPathValidator, mockCodeSafetyPolicy)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, setmatlabbreakpoint.Args{
+		FilePath:  filePath,
+		Line:      12,
+		Condition: "x > 3",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedBreakpoints, result)
+}
+
+func TestUsecase_Execute_OnError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+	defer mockCodeSafetyPolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.setBreakpoint",
+			Arguments:  []string{"", "", ""},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{`{"breakpoints":[],"stop_on_error":true}`}}, nil).
+		Once()
+
+	usecase := setmatlabbreakpoint.New(mockPathValidator, mockCodeSafetyPolicy)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, setmatlabbreakpoint.Args{OnError: true})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, breakpoints.Breakpoints{Breakpoints: []breakpoints.Breakpoint{}, StopOnError: true}, result)
+}
+
+func TestUsecase_Execute_InvalidArgs(t *testing.T) {
+	testCases := []struct {
+		name          string
+		args          setmatlabbreakpoint.Args
+		expectedError error
+	}{
+		{
+			name:          "no location",
+			args:          setmatlabbreakpoint.Args{},
+			expectedError: setmatlabbreakpoint.ErrMissingLocation,
+		},
+		{
+			name:          "file and on error",
+			args:          setmatlabbreakpoint.Args{FilePath: "analysis.m", Line: 12, OnError: true},
+			expectedError: setmatlabbreakpoint.ErrConflictingLocation,
+		},
+		{
+			name:          "no line",
+			args:          setmatlabbreakpoint.Args{FilePath: "analysis.m"},
+			expectedError: setmatlabbreakpoint.ErrInvalidLine,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockPathValidator := &mocks.MockPathValidator{}
+			defer mockPathValidator.AssertExpectations(t)
+
+			mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+			defer mockCodeSafetyPolicy.AssertExpectations(t)
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			usecase := setmatlabbreakpoint.New(mockPathValidator, mockCodeSafetyPolicy)
+
+			// Act
+			result, err := usecase.Execute(t.Context(), mockLogger, mockClient, testCase.args)
+
+			// Assert
+			require.ErrorIs(t, err, testCase.expectedError)
+			assert.Empty(t, result)
+		})
+	}
+}
+
+func TestUsecase_Execute_ValidatePathError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+	defer mockCodeSafetyPolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	filePath := filepath.Join("home", "user", "analysis.txt")
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABScript(filePath).
+		Return("", assert.AnError).
+		Once()
+
+	usecase := setmatlabbreakpoint.New(mockPathValidator, mockCodeSafetyPolicy)
+
+	// Act
+	result, err := usecase.Execute(t.Context(), mockLogger, mockClient, setmatlabbreakpoint.Args{FilePath: filePath, Line: 12})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_CodeSafetyError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+	defer mockCodeSafetyPolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	filePath := filepath.Join("home", "user", "analysis.m")
+	validatedPath := filepath.Join("/", "home", "user", "analysis.m")
+	condition := "system('rm -rf ~') == 0"
+	ctx := t.Context()
+
+	mockPathValidator.EXPECT().
+		ValidateMATLABScript(filePath).
+		Return(validatedPath, nil).
+		Once()
+
+	mockCodeSafetyPolicy.EXPECT().
+		Check(ctx, mockLogger.AsMockArg(), condition).
+		Return(assert.AnError).
+		Once()
+
+	usecase := setmatlabbreakpoint.New(mockPathValidator, mockCodeSafetyPolicy)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, setmatlabbreakpoint.Args{
+		FilePath:  filePath,
+		Line:      12,
+		Condition: condition,
+	})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_FEvalError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+	defer mockCodeSafetyPolicy.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.setBreakpoint",
+			Arguments:  []string{"", "", ""},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{}, assert.AnError).
+		Once()
+
+	usecase := setmatlabbreakpoint.New(mockPathValidator, mockCodeSafetyPolicy)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, setmatlabbreakpoint.Args{OnError: true})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
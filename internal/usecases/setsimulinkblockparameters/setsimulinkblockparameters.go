@@ -0,0 +1,120 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package setsimulinkblockparameters
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+const setSimulinkBlockParametersFunction = "matlab_mcp.setSimulinkBlockParameters"
+
+var (
+	ErrMissingBlock      = errors.New("the path of the block to set the parameters of is missing")
+	ErrMissingParameters = errors.New("at least one parameter to set is required")
+)
+
+type Parameter struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// Args holds the block of a loaded model, such as vdp/Mu, and the parameters to set.
+type Args struct {
+	Block      string
+	Parameters []Parameter
+}
+
+// ReturnArgs holds the values of the parameters once set, as Simulink stores them.
+type ReturnArgs struct {
+	Parameters []Parameter
+}
+
+type CodeSafetyPolicy interface {
+	Check(ctx context.Context, sessionLogger entities.Logger, code string) error
+}
+
+type SimulinkDetector interface {
+	Check(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient) error
+}
+
+type Usecase struct {
+	codeSafetyPolicy CodeSafetyPolicy
+	simulinkDetector SimulinkDetector
+}
+
+func New(
+	codeSafetyPolicy CodeSafetyPolicy,
+	simulinkDetector SimulinkDetector,
+) *Usecase {
+	return &Usecase{
+		codeSafetyPolicy: codeSafetyPolicy,
+		simulinkDetector: simulinkDetector,
+	}
+}
+
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering SetSimulinkBlockParameters Usecase")
+	defer sessionLogger.Debug("Exiting SetSimulinkBlockParameters Usecase")
+
+	if request.Block == "" {
+		return ReturnArgs{}, ErrMissingBlock
+	}
+
+	if len(request.Parameters) == 0 {
+		return ReturnArgs{}, ErrMissingParameters
+	}
+
+	// Simulink evaluates the values of most parameters as MATLAB expressions
+	for _, parameter := range request.Parameters {
+		if err := u.codeSafetyPolicy.Check(ctx, sessionLogger, parameter.Value); err != nil {
+			return ReturnArgs{}, fmt.Errorf("code safety check failed: %w", err)
+		}
+	}
+
+	if err := u.simulinkDetector.Check(ctx, sessionLogger, client); err != nil {
+		return ReturnArgs{}, err
+	}
+
+	encodedParametersToSet, err := json.Marshal(request.Parameters)
+	if err != nil {
+		return ReturnArgs{}, fmt.Errorf("failed to encode the parameters: %w", err)
+	}
+
+	response, err := client.FEval(ctx, sessionLogger, entities.FEvalRequest{
+		Function:   setSimulinkBlockParametersFunction,
+		Arguments:  []string{request.Block, string(encodedParametersToSet)},
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	if len(response.Outputs) != 1 {
+		return ReturnArgs{}, fmt.Errorf("unexpected number of outputs when setting the block parameters: %d", len(response.Outputs))
+	}
+
+	encodedParameters, ok := response.Outputs[0].(string)
+	if !ok {
+		return ReturnArgs{}, fmt.Errorf("unexpected output type when setting the block parameters: %T", response.Outputs[0])
+	}
+
+	var parameters struct {
+		Parameters []Parameter `json:"parameters"`
+	}
+	if err := json.Unmarshal([]byte(encodedParameters), &parameters); err != nil {
+		return ReturnArgs{}, fmt.Errorf("failed to decode the block parameters: %w", err)
+	}
+
+	if parameters.Parameters == nil {
+		parameters.Parameters = []Parameter{}
+	}
+
+	return ReturnArgs{
+		Parameters: parameters.Parameters,
+	}, nil
+}
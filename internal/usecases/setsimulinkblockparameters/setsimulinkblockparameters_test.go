@@ -0,0 +1,279 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package setsimulinkblockparameters_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/setsimulinkblockparameters"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/simulinkdetector"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/setsimulinkblockparameters"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const block = "vdp/Mu"
+
+var (
+	parameters = []setsimulinkblockparameters.Parameter{
+		{Name: "Gain", Value: "2*k"},
+		{Name: "SampleTime", Value: "-1"},
+	}
+
+	setSimulinkBlockParametersRequest = entities.FEvalRequest{
+		Function:   "matlab_mcp.setSimulinkBlockParameters",
+		Arguments:  []string{block, `[{"name":"Gain","value":"2*k"},{"name":"SampleTime","value":"-1"}]`},
+		NumOutputs: 1,
+	}
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+	defer mockCodeSafetyPolicy.AssertExpectations(t)
+
+	mockSimulinkDetector := &mocks.MockSimulinkDetector{}
+	defer mockSimulinkDetector.AssertExpectations(t)
+
+	// Act
+	usecase := setsimulinkblockparameters.New(mockCodeSafetyPolicy, mockSimulinkDetector)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+	defer mockCodeSafetyPolicy.AssertExpectations(t)
+
+	mockSimulinkDetector := &mocks.MockSimulinkDetector{}
+	defer mockSimulinkDetector.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	expectParameterValuesChecked(t, mockLogger, mockCodeSafetyPolicy)
+
+	mockSimulinkDetector.EXPECT().
+		Check(ctx, mockLogger.AsMockArg(), mockClient).
+		Return(nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), setSimulinkBlockParametersRequest).
+		Return(entities.FEvalResponse{Outputs: []any{`{"parameters":[{"name":"Gain","value":"2*k"},{"name":"SampleTime","value":"-1"}]}`}}, nil).
+		Once()
+
+	usecase := setsimulinkblockparameters.New(mockCodeSafetyPolicy, mockSimulinkDetector)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, setsimulinkblockparameters.Args{
+		Block:      block,
+		Parameters: parameters,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, parameters, result.Parameters)
+}
+
+func TestUsecase_Execute_InvalidArgs(t *testing.T) {
+	testCases := []struct {
+		name          string
+		args          setsimulinkblockparameters.Args
+		expectedError error
+	}{
+		{
+			name:          "missing block",
+			args:          setsimulinkblockparameters.Args{Parameters: parameters},
+			expectedError: setsimulinkblockparameters.ErrMissingBlock,
+		},
+		{
+			name:          "missing parameters",
+			args:          setsimulinkblockparameters.Args{Block: block},
+			expectedError: setsimulinkblockparameters.ErrMissingParameters,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+			defer mockCodeSafetyPolicy.AssertExpectations(t)
+
+			mockSimulinkDetector := &mocks.MockSimulinkDetector{}
+			defer mockSimulinkDetector.AssertExpectations(t)
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			usecase := setsimulinkblockparameters.New(mockCodeSafetyPolicy, mockSimulinkDetector)
+
+			// Act
+			result, err := usecase.Execute(t.Context(), mockLogger, mockClient, testCase.args)
+
+			// Assert
+			require.ErrorIs(t, err, testCase.expectedError)
+			assert.Empty(t, result)
+		})
+	}
+}
+
+func TestUsecase_Execute_CodeSafetyError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+	defer mockCodeSafetyPolicy.AssertExpectations(t)
+
+	mockSimulinkDetector := &mocks.MockSimulinkDetector{}
+	defer mockSimulinkDetector.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockCodeSafetyPolicy.EXPECT().
+		Check(ctx, mockLogger.AsMockArg(), "2*k").
+		Return(assert.AnError).
+		Once()
+
+	usecase := setsimulinkblockparameters.New(mockCodeSafetyPolicy, mockSimulinkDetector)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, setsimulinkblockparameters.Args{
+		Block:      block,
+		Parameters: parameters,
+	})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_SimulinkNotDetected(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+	defer mockCodeSafetyPolicy.AssertExpectations(t)
+
+	mockSimulinkDetector := &mocks.MockSimulinkDetector{}
+	defer mockSimulinkDetector.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	expectParameterValuesChecked(t, mockLogger, mockCodeSafetyPolicy)
+
+	mockSimulinkDetector.EXPECT().
+		Check(ctx, mockLogger.AsMockArg(), mockClient).
+		Return(simulinkdetector.ErrSimulinkNotDetected).
+		Once()
+
+	usecase := setsimulinkblockparameters.New(mockCodeSafetyPolicy, mockSimulinkDetector)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, setsimulinkblockparameters.Args{
+		Block:      block,
+		Parameters: parameters,
+	})
+
+	// Assert
+	require.ErrorIs(t, err, simulinkdetector.ErrSimulinkNotDetected)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_SetErrors(t *testing.T) {
+	testCases := []struct {
+		name          string
+		response      entities.FEvalResponse
+		err           error
+		expectedError string
+	}{
+		{
+			name:          "FEval error",
+			err:           assert.AnError,
+			expectedError: assert.AnError.Error(),
+		},
+		{
+			name:          "unexpected number of outputs",
+			response:      entities.FEvalResponse{Outputs: []any{}},
+			expectedError: "unexpected number of outputs when setting the block parameters: 0",
+		},
+		{
+			name:          "unexpected output type",
+			response:      entities.FEvalResponse{Outputs: []any{42}},
+			expectedError: "unexpected output type when setting the block parameters: int",
+		},
+		{
+			name:          "invalid JSON",
+			response:      entities.FEvalResponse{Outputs: []any{"not JSON"}},
+			expectedError: "failed to decode the block parameters",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+			defer mockCodeSafetyPolicy.AssertExpectations(t)
+
+			mockSimulinkDetector := &mocks.MockSimulinkDetector{}
+			defer mockSimulinkDetector.AssertExpectations(t)
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			ctx := t.Context()
+
+			expectParameterValuesChecked(t, mockLogger, mockCodeSafetyPolicy)
+
+			mockSimulinkDetector.EXPECT().
+				Check(ctx, mockLogger.AsMockArg(), mockClient).
+				Return(nil).
+				Once()
+
+			mockClient.EXPECT().
+				FEval(ctx, mockLogger.AsMockArg(), setSimulinkBlockParametersRequest).
+				Return(testCase.response, testCase.err).
+				Once()
+
+			usecase := setsimulinkblockparameters.New(mockCodeSafetyPolicy, mockSimulinkDetector)
+
+			// Act
+			result, err := usecase.Execute(ctx, mockLogger, mockClient, setsimulinkblockparameters.Args{
+				Block:      block,
+				Parameters: parameters,
+			})
+
+			// Assert
+			require.ErrorContains(t, err, testCase.expectedError)
+			assert.Empty(t, result)
+		})
+	}
+}
+
+func expectParameterValuesChecked(t *testing.T, mockLogger *testutils.InspectableLogger, mockCodeSafetyPolicy *mocks.MockCodeSafetyPolicy) {
+	for _, parameter := range parameters {
+		mockCodeSafetyPolicy.EXPECT().
+			Check(t.Context(), mockLogger.AsMockArg(), parameter.Value).
+			Return(nil).
+			Once()
+	}
+}
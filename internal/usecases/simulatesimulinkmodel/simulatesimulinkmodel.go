@@ -0,0 +1,129 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package simulatesimulinkmodel
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+const simulateSimulinkModelFunction = "matlab_mcp.simulateSimulinkModel"
+
+const defaultMaxPoints = 1000
+
+var ErrMissingModel = errors.New("the model to simulate is missing")
+
+// Args holds the loaded model to simulate, and the stop time, as a MATLAB expression, which defaults to the one of the model.
+type Args struct {
+	Model     string
+	StopTime  string
+	MaxPoints int
+}
+
+// Signal holds the values of a logged signal, with a channel per element of the signal.
+// The values of the channels are in the same order as the times.
+type Signal struct {
+	Name      string      `json:"name"`
+	Source    string      `json:"source"`
+	BlockPath string      `json:"block_path"`
+	PortIndex int         `json:"port_index"`
+	Time      []float64   `json:"time"`
+	Channels  [][]float64 `json:"channels"`
+}
+
+// ReturnArgs holds the signals logged by signal logging and output logging.
+// Errors of the simulation are returned as a result, along with the signals logged until then.
+type ReturnArgs struct {
+	Error          string
+	SimulationTime float64
+	Signals        []Signal
+}
+
+type CodeSafetyPolicy interface {
+	Check(ctx context.Context, sessionLogger entities.Logger, code string) error
+}
+
+type SimulinkDetector interface {
+	Check(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient) error
+}
+
+type Usecase struct {
+	codeSafetyPolicy CodeSafetyPolicy
+	simulinkDetector SimulinkDetector
+}
+
+func New(
+	codeSafetyPolicy CodeSafetyPolicy,
+	simulinkDetector SimulinkDetector,
+) *Usecase {
+	return &Usecase{
+		codeSafetyPolicy: codeSafetyPolicy,
+		simulinkDetector: simulinkDetector,
+	}
+}
+
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient, request Args) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering SimulateSimulinkModel Usecase")
+	defer sessionLogger.Debug("Exiting SimulateSimulinkModel Usecase")
+
+	if request.Model == "" {
+		return ReturnArgs{}, ErrMissingModel
+	}
+
+	if request.StopTime != "" {
+		if err := u.codeSafetyPolicy.Check(ctx, sessionLogger, request.StopTime); err != nil {
+			return ReturnArgs{}, fmt.Errorf("code safety check failed: %w", err)
+		}
+	}
+
+	if err := u.simulinkDetector.Check(ctx, sessionLogger, client); err != nil {
+		return ReturnArgs{}, err
+	}
+
+	maxPoints := request.MaxPoints
+	if maxPoints <= 0 {
+		maxPoints = defaultMaxPoints
+	}
+
+	response, err := client.FEval(ctx, sessionLogger, entities.FEvalRequest{
+		Function:   simulateSimulinkModelFunction,
+		Arguments:  []string{request.Model, request.StopTime, strconv.Itoa(maxPoints)},
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	if len(response.Outputs) != 1 {
+		return ReturnArgs{}, fmt.Errorf("unexpected number of outputs when simulating the model: %d", len(response.Outputs))
+	}
+
+	encodedSimulation, ok := response.Outputs[0].(string)
+	if !ok {
+		return ReturnArgs{}, fmt.Errorf("unexpected output type when simulating the model: %T", response.Outputs[0])
+	}
+
+	var simulation struct {
+		Error          string   `json:"error"`
+		SimulationTime float64  `json:"simulation_time"`
+		Signals        []Signal `json:"signals"`
+	}
+	if err := json.Unmarshal([]byte(encodedSimulation), &simulation); err != nil {
+		return ReturnArgs{}, fmt.Errorf("failed to decode the simulation output: %w", err)
+	}
+
+	if simulation.Signals == nil {
+		simulation.Signals = []Signal{}
+	}
+
+	return ReturnArgs{
+		Error:          simulation.Error,
+		SimulationTime: simulation.SimulationTime,
+		Signals:        simulation.Signals,
+	}, nil
+}
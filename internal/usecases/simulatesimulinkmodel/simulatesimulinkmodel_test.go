@@ -0,0 +1,289 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package simulatesimulinkmodel_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/simulatesimulinkmodel"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/simulinkdetector"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/simulatesimulinkmodel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const model = "vdp"
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+	defer mockCodeSafetyPolicy.AssertExpectations(t)
+
+	mockSimulinkDetector := &mocks.MockSimulinkDetector{}
+	defer mockSimulinkDetector.AssertExpectations(t)
+
+	// Act
+	usecase := simulatesimulinkmodel.New(mockCodeSafetyPolicy, mockSimulinkDetector)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+	defer mockCodeSafetyPolicy.AssertExpectations(t)
+
+	mockSimulinkDetector := &mocks.MockSimulinkDetector{}
+	defer mockSimulinkDetector.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockCodeSafetyPolicy.EXPECT().
+		Check(ctx, mockLogger.AsMockArg(), "10").
+		Return(nil).
+		Once()
+
+	mockSimulinkDetector.EXPECT().
+		Check(ctx, mockLogger.AsMockArg(), mockClient).
+		Return(nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.simulateSimulinkModel",
+			Arguments:  []string{model, "10", "50"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{`{"error":"","simulation_time":0.42,"signals":[{"source":"yout","block_path":"vdp/Out1","port_index":1,"name":"x","time":[0,5,10],"channels":[[2,-1.5,1.8],[0,0.3,-0.2]]}]}`}}, nil).
+		Once()
+
+	usecase := simulatesimulinkmodel.New(mockCodeSafetyPolicy, mockSimulinkDetector)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, simulatesimulinkmodel.Args{
+		Model:     model,
+		StopTime:  "10",
+		MaxPoints: 50,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, simulatesimulinkmodel.ReturnArgs{
+		SimulationTime: 0.42,
+		Signals: []simulatesimulinkmodel.Signal{
+			{
+				Name:      "x",
+				Source:    "yout",
+				BlockPath: "vdp/Out1",
+				PortIndex: 1,
+				Time:      []float64{0, 5, 10},
+				Channels:  [][]float64{{2, -1.5, 1.8}, {0, 0.3, -0.2}},
+			},
+		},
+	}, result)
+}
+
+func TestUsecase_Execute_DefaultStopTimeAndMaxPoints(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+	defer mockCodeSafetyPolicy.AssertExpectations(t)
+
+	mockSimulinkDetector := &mocks.MockSimulinkDetector{}
+	defer mockSimulinkDetector.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockSimulinkDetector.EXPECT().
+		Check(ctx, mockLogger.AsMockArg(), mockClient).
+		Return(nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+			Function:   "matlab_mcp.simulateSimulinkModel",
+			Arguments:  []string{model, "", "1000"},
+			NumOutputs: 1,
+		}).
+		Return(entities.FEvalResponse{Outputs: []any{`{"error":"Algebraic loop in vdp/Sum.","simulation_time":0.01,"signals":[]}`}}, nil).
+		Once()
+
+	usecase := simulatesimulinkmodel.New(mockCodeSafetyPolicy, mockSimulinkDetector)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, simulatesimulinkmodel.Args{Model: model})
+
+	// Assert
+	require.NoError(t, err, "Errors of the simulation should be returned as a result")
+	assert.Equal(t, "Algebraic loop in vdp/Sum.", result.Error)
+	assert.NotNil(t, result.Signals, "Signals should not be nil")
+	assert.Empty(t, result.Signals)
+}
+
+func TestUsecase_Execute_MissingModel(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+	defer mockCodeSafetyPolicy.AssertExpectations(t)
+
+	mockSimulinkDetector := &mocks.MockSimulinkDetector{}
+	defer mockSimulinkDetector.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	usecase := simulatesimulinkmodel.New(mockCodeSafetyPolicy, mockSimulinkDetector)
+
+	// Act
+	result, err := usecase.Execute(t.Context(), mockLogger, mockClient, simulatesimulinkmodel.Args{})
+
+	// Assert
+	require.ErrorIs(t, err, simulatesimulinkmodel.ErrMissingModel)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_CodeSafetyError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+	defer mockCodeSafetyPolicy.AssertExpectations(t)
+
+	mockSimulinkDetector := &mocks.MockSimulinkDetector{}
+	defer mockSimulinkDetector.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	stopTime := "tEnd"
+
+	mockCodeSafetyPolicy.EXPECT().
+		Check(ctx, mockLogger.AsMockArg(), stopTime).
+		Return(assert.AnError).
+		Once()
+
+	usecase := simulatesimulinkmodel.New(mockCodeSafetyPolicy, mockSimulinkDetector)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, simulatesimulinkmodel.Args{Model: model, StopTime: stopTime})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_SimulinkNotDetected(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+	defer mockCodeSafetyPolicy.AssertExpectations(t)
+
+	mockSimulinkDetector := &mocks.MockSimulinkDetector{}
+	defer mockSimulinkDetector.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockSimulinkDetector.EXPECT().
+		Check(ctx, mockLogger.AsMockArg(), mockClient).
+		Return(simulinkdetector.ErrSimulinkNotDetected).
+		Once()
+
+	usecase := simulatesimulinkmodel.New(mockCodeSafetyPolicy, mockSimulinkDetector)
+
+	// Act
+	result, err := usecase.Execute(ctx, mockLogger, mockClient, simulatesimulinkmodel.Args{Model: model})
+
+	// Assert
+	require.ErrorIs(t, err, simulinkdetector.ErrSimulinkNotDetected)
+	assert.Empty(t, result)
+}
+
+func TestUsecase_Execute_SimulateErrors(t *testing.T) {
+	testCases := []struct {
+		name          string
+		response      entities.FEvalResponse
+		err           error
+		expectedError string
+	}{
+		{
+			name:          "FEval error",
+			err:           assert.AnError,
+			expectedError: assert.AnError.Error(),
+		},
+		{
+			name:          "unexpected number of outputs",
+			response:      entities.FEvalResponse{Outputs: []any{}},
+			expectedError: "unexpected number of outputs when simulating the model: 0",
+		},
+		{
+			name:          "unexpected output type",
+			response:      entities.FEvalResponse{Outputs: []any{42}},
+			expectedError: "unexpected output type when simulating the model: int",
+		},
+		{
+			name:          "invalid JSON",
+			response:      entities.FEvalResponse{Outputs: []any{"not JSON"}},
+			expectedError: "failed to decode the simulation output",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockCodeSafetyPolicy := &mocks.MockCodeSafetyPolicy{}
+			defer mockCodeSafetyPolicy.AssertExpectations(t)
+
+			mockSimulinkDetector := &mocks.MockSimulinkDetector{}
+			defer mockSimulinkDetector.AssertExpectations(t)
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			ctx := t.Context()
+
+			mockSimulinkDetector.EXPECT().
+				Check(ctx, mockLogger.AsMockArg(), mockClient).
+				Return(nil).
+				Once()
+
+			mockClient.EXPECT().
+				FEval(ctx, mockLogger.AsMockArg(), entities.FEvalRequest{
+					Function:   "matlab_mcp.simulateSimulinkModel",
+					Arguments:  []string{model, "", "1000"},
+					NumOutputs: 1,
+				}).
+				Return(testCase.response, testCase.err).
+				Once()
+
+			usecase := simulatesimulinkmodel.New(mockCodeSafetyPolicy, mockSimulinkDetector)
+
+			// Act
+			result, err := usecase.Execute(ctx, mockLogger, mockClient, simulatesimulinkmodel.Args{Model: model})
+
+			// Assert
+			require.ErrorContains(t, err, testCase.expectedError)
+			assert.Empty(t, result)
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/installedproducts"
 )
 
 type Usecase struct {
@@ -13,9 +14,9 @@ type Usecase struct {
 }
 
 type ReturnArgs struct {
-	SessionID    entities.SessionID
-	VerOutput    string
-	AddOnsOutput string
+	SessionID entities.SessionID
+	Products  []installedproducts.Product
+	AddOns    []installedproducts.AddOn
 }
 
 func New(
@@ -43,21 +44,15 @@ func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, re
 		return ReturnArgs{}, err
 	}
 
-	sessionLogger.Debug("Evaluating ver")
-	verResponse, err := client.Eval(ctx, sessionLogger, entities.EvalRequest{Code: "ver"})
-	if err != nil {
-		return ReturnArgs{}, err
-	}
-
-	sessionLogger.Debug("Evaluating Add-Ons")
-	AddOnsResponse, err := client.Eval(ctx, sessionLogger, entities.EvalRequest{Code: "matlab.addons.installedAddons()"})
+	sessionLogger.Debug("Listing installed products and Add-Ons")
+	installed, err := installedproducts.Get(ctx, sessionLogger, client)
 	if err != nil {
 		return ReturnArgs{}, err
 	}
 
 	return ReturnArgs{
-		SessionID:    sessionID,
-		VerOutput:    verResponse.ConsoleOutput,
-		AddOnsOutput: AddOnsResponse.ConsoleOutput,
+		SessionID: sessionID,
+		Products:  installed.Products,
+		AddOns:    installed.AddOns,
 	}, nil
 }
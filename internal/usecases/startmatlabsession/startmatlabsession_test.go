@@ -9,15 +9,16 @@ import (
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/startmatlabsession"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/installedproducts"
 	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
-const (
-	verCode    = "ver"
-	addOnsCode = "matlab.addons.installedAddons()"
-)
+var listInstalledProductsRequest = entities.FEvalRequest{
+	Function:   "matlab_mcp.listInstalledProducts",
+	NumOutputs: 1,
+}
 
 func TestNew_HappyPath(t *testing.T) {
 	// Arrange
@@ -47,8 +48,7 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 
 	ctx := t.Context()
 	const expectedSessionID = entities.SessionID(123)
-	const expectedVerOutput = "MATLAB Version: X (R2024b)"
-	const expectedAddOnsOutput = "GUI Layout Toolbox"
+	const encodedInstalledProducts = `{"products":[{"name":"MATLAB","version":"24.2","release":"R2024b","identifier":"ML"}],"addons":[{"name":"GUI Layout Toolbox","version":"2.3.6","enabled":true,"identifier":"layout"}]}`
 
 	mockMATLABManager.EXPECT().
 		StartMATLABSession(ctx, mockLogger.AsMockArg(), startSessionRequest).
@@ -60,15 +60,9 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 		Return(mockClient, nil).
 		Once()
 
-	// Mock the EvalInMATLABSession calls
 	mockClient.EXPECT().
-		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: verCode}).
-		Return(entities.EvalResponse{ConsoleOutput: expectedVerOutput}, nil).
-		Once()
-
-	mockClient.EXPECT().
-		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: addOnsCode}).
-		Return(entities.EvalResponse{ConsoleOutput: expectedAddOnsOutput}, nil).
+		FEval(ctx, mockLogger.AsMockArg(), listInstalledProductsRequest).
+		Return(entities.FEvalResponse{Outputs: []any{encodedInstalledProducts}}, nil).
 		Once()
 
 	usecase := startmatlabsession.New(mockMATLABManager)
@@ -79,8 +73,12 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 	// Assert
 	require.NoError(t, err, "Execute should not return an error")
 	assert.Equal(t, expectedSessionID, response.SessionID, "Session ID should match expected value")
-	assert.Equal(t, expectedVerOutput, response.VerOutput, "Ver output should match expected value")
-	assert.Equal(t, expectedAddOnsOutput, response.AddOnsOutput, "AddOns output should match expected value")
+	assert.Equal(t, []installedproducts.Product{
+		{Name: "MATLAB", Version: "24.2", Release: "R2024b", Identifier: "ML"},
+	}, response.Products, "Products should match expected value")
+	assert.Equal(t, []installedproducts.AddOn{
+		{Name: "GUI Layout Toolbox", Version: "2.3.6", Enabled: true, Identifier: "layout"},
+	}, response.AddOns, "AddOns should match expected value")
 }
 
 func TestUsecase_Execute_StartSessionError(t *testing.T) {
@@ -150,7 +148,7 @@ func TestUsecase_Execute_GetMATLABSessionClientError(t *testing.T) {
 	assert.ErrorIs(t, err, expectedError, "Error should be the original error")
 }
 
-func TestUsecase_Execute_VerEvalError(t *testing.T) {
+func TestUsecase_Execute_ListInstalledProductsError(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
 
@@ -179,59 +177,8 @@ func TestUsecase_Execute_VerEvalError(t *testing.T) {
 		Once()
 
 	mockClient.EXPECT().
-		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: verCode}).
-		Return(entities.EvalResponse{}, expectedError).
-		Once()
-
-	usecase := startmatlabsession.New(mockMATLABManager)
-
-	// Act
-	response, err := usecase.Execute(ctx, mockLogger, startSessionRequest)
-
-	// Assert
-	require.Error(t, err, "Execute should return an error")
-	assert.Empty(t, response, "Response should be empty when there's an error")
-	assert.ErrorIs(t, err, expectedError, "Error should be the original error")
-}
-
-func TestUsecase_Execute_AddOnsEvalError(t *testing.T) {
-	// Arrange
-	mockLogger := testutils.NewInspectableLogger()
-
-	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
-	defer mockMATLABManager.AssertExpectations(t)
-
-	mockClient := &entitiesmocks.MockMATLABSessionClient{}
-	defer mockClient.AssertExpectations(t)
-
-	startSessionRequest := entities.LocalSessionDetails{
-		MATLABRoot: filepath.Join("path", "to", "matlab", "R2023a"),
-	}
-
-	ctx := t.Context()
-	const expectedSessionID = entities.SessionID(123)
-	const expectedVerOutput = "MATLAB Version: X (R2024b)"
-	expectedError := assert.AnError
-
-	mockMATLABManager.EXPECT().
-		StartMATLABSession(ctx, mockLogger.AsMockArg(), startSessionRequest).
-		Return(expectedSessionID, nil).
-		Once()
-
-	mockMATLABManager.EXPECT().
-		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), expectedSessionID).
-		Return(mockClient, nil).
-		Once()
-
-	mockClient.EXPECT().
-		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: verCode}).
-		Return(entities.EvalResponse{ConsoleOutput: expectedVerOutput}, nil).
-		Once()
-
-	// Mock the second EvalInMATLABSession call to fail
-	mockClient.EXPECT().
-		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: addOnsCode}).
-		Return(entities.EvalResponse{}, expectedError).
+		FEval(ctx, mockLogger.AsMockArg(), listInstalledProductsRequest).
+		Return(entities.FEvalResponse{}, expectedError).
 		Once()
 
 	usecase := startmatlabsession.New(mockMATLABManager)
@@ -0,0 +1,44 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package breakpoints
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+type Breakpoint struct {
+	File      string `json:"file"`
+	Line      int    `json:"line"`
+	Condition string `json:"condition"`
+}
+
+type Breakpoints struct {
+	Breakpoints []Breakpoint `json:"breakpoints"`
+	StopOnError bool         `json:"stop_on_error"`
+}
+
+// Decode decodes the breakpoints returned by the MATLAB functions which set or clear breakpoints.
+func Decode(response entities.FEvalResponse) (Breakpoints, error) {
+	if len(response.Outputs) != 1 {
+		return Breakpoints{}, fmt.Errorf("unexpected number of outputs when listing the breakpoints: %d", len(response.Outputs))
+	}
+
+	encodedBreakpoints, ok := response.Outputs[0].(string)
+	if !ok {
+		return Breakpoints{}, fmt.Errorf("unexpected output type when listing the breakpoints: %T", response.Outputs[0])
+	}
+
+	var breakpoints Breakpoints
+	if err := json.Unmarshal([]byte(encodedBreakpoints), &breakpoints); err != nil {
+		return Breakpoints{}, fmt.Errorf("failed to decode the breakpoints: %w", err)
+	}
+
+	if breakpoints.Breakpoints == nil {
+		breakpoints.Breakpoints = []Breakpoint{}
+	}
+
+	return breakpoints, nil
+}
@@ -0,0 +1,82 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package breakpoints_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/breakpoints"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecode_HappyPath(t *testing.T) {
+	// Arrange
+	response := entities.FEvalResponse{
+		Outputs: []any{`{"breakpoints":[{"file":"/home/user/analysis.m","line":12,"condition":""},{"file":"/home/user/analysis.m","line":20,"condition":"x > 3"}],"stop_on_error":true}`},
+	}
+
+	expectedBreakpoints := breakpoints.Breakpoints{
+		Breakpoints: []breakpoints.Breakpoint{
+			{File: "/home/user/analysis.m", Line: 12},
+			{File: "/home/user/analysis.m", Line: 20, Condition: "x > 3"},
+		},
+		StopOnError: true,
+	}
+
+	// Act
+	result, err := breakpoints.Decode(response)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedBreakpoints, result)
+}
+
+func TestDecode_NoBreakpoints(t *testing.T) {
+	// Arrange
+	response := entities.FEvalResponse{
+		Outputs: []any{`{"breakpoints":[],"stop_on_error":false}`},
+	}
+
+	// Act
+	result, err := breakpoints.Decode(response)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, breakpoints.Breakpoints{Breakpoints: []breakpoints.Breakpoint{}}, result)
+}
+
+func TestDecode_Errors(t *testing.T) {
+	testCases := []struct {
+		name          string
+		outputs       []any
+		expectedError string
+	}{
+		{
+			name:          "no outputs",
+			outputs:       []any{},
+			expectedError: "unexpected number of outputs when listing the breakpoints: 0",
+		},
+		{
+			name:          "unexpected output type",
+			outputs:       []any{42},
+			expectedError: "unexpected output type when listing the breakpoints: int",
+		},
+		{
+			name:          "invalid JSON",
+			outputs:       []any{"not JSON"},
+			expectedError: "failed to decode the breakpoints",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Act
+			_, err := breakpoints.Decode(entities.FEvalResponse{Outputs: testCase.outputs})
+
+			// Assert
+			require.ErrorContains(t, err, testCase.expectedError)
+		})
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package codesafety
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+type Config interface {
+	CodeSafetyAction() entities.CodeSafetyAction
+	CodeSafetyPatterns() []string
+}
+
+type Confirmer interface {
+	Confirm(ctx context.Context, sessionLogger entities.Logger, message string) (bool, error)
+}
+
+// Policy screens MATLAB code before it is evaluated, and blocks it or asks the user for confirmation
+// when it uses one of the configured patterns, such as system or delete.
+type Policy struct {
+	config    Config
+	confirmer Confirmer
+}
+
+func New(
+	config Config,
+	confirmer Confirmer,
+) *Policy {
+	return &Policy{
+		config:    config,
+		confirmer: confirmer,
+	}
+}
+
+// Check returns an error if the code must not be evaluated.
+func (p *Policy) Check(ctx context.Context, sessionLogger entities.Logger, code string) error {
+	action := p.config.CodeSafetyAction()
+	if action == entities.CodeSafetyActionAllow {
+		return nil
+	}
+
+	matchedPatterns := findPatterns(code, p.config.CodeSafetyPatterns())
+	if len(matchedPatterns) == 0 {
+		return nil
+	}
+
+	logger := sessionLogger.With("matched_patterns", matchedPatterns)
+	usedPatterns := strings.Join(matchedPatterns, ", ")
+
+	if action == entities.CodeSafetyActionBlock {
+		logger.Warn("Code blocked by the code safety policy")
+		return fmt.Errorf("the code uses %s, which the code safety policy blocks", usedPatterns)
+	}
+
+	message := fmt.Sprintf("The MATLAB code to evaluate uses %s:\n\n%s\n\nDo you want to run it?", usedPatterns, code)
+
+	confirmed, err := p.confirmer.Confirm(ctx, sessionLogger, message)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to ask the user to confirm the code")
+		return fmt.Errorf("the code uses %s, which requires the user's confirmation, but the confirmation failed: %w", usedPatterns, err)
+	}
+
+	if !confirmed {
+		logger.Info("User declined to run the code")
+		return fmt.Errorf("the code uses %s, and the user declined to run it", usedPatterns)
+	}
+
+	logger.Info("User confirmed to run the code")
+	return nil
+}
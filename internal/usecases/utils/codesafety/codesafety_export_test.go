@@ -0,0 +1,7 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package codesafety
+
+func FindPatterns(code string, patterns []string) []string {
+	return findPatterns(code, patterns)
+}
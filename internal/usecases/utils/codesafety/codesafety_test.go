@@ -0,0 +1,218 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package codesafety_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/codesafety"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/utils/codesafety"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const unsafeCode = "[status, output] = system('ls');"
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfirmer := &mocks.MockConfirmer{}
+	defer mockConfirmer.AssertExpectations(t)
+
+	// Act
+	policy := codesafety.New(mockConfig, mockConfirmer)
+
+	// Assert
+	assert.NotNil(t, policy)
+}
+
+func TestPolicy_Check_SafeCode(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfirmer := &mocks.MockConfirmer{}
+	defer mockConfirmer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		CodeSafetyAction().
+		Return(entities.CodeSafetyActionBlock).
+		Once()
+
+	mockConfig.EXPECT().
+		CodeSafetyPatterns().
+		Return(defaultPatterns).
+		Once()
+
+	policy := codesafety.New(mockConfig, mockConfirmer)
+
+	// Act
+	err := policy.Check(t.Context(), mockLogger, "disp('Hello, World!')")
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestPolicy_Check_Allow(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfirmer := &mocks.MockConfirmer{}
+	defer mockConfirmer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		CodeSafetyAction().
+		Return(entities.CodeSafetyActionAllow).
+		Once()
+
+	policy := codesafety.New(mockConfig, mockConfirmer)
+
+	// Act
+	err := policy.Check(t.Context(), mockLogger, unsafeCode)
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestPolicy_Check_Block(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfirmer := &mocks.MockConfirmer{}
+	defer mockConfirmer.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		CodeSafetyAction().
+		Return(entities.CodeSafetyActionBlock).
+		Once()
+
+	mockConfig.EXPECT().
+		CodeSafetyPatterns().
+		Return(defaultPatterns).
+		Once()
+
+	policy := codesafety.New(mockConfig, mockConfirmer)
+
+	// Act
+	err := policy.Check(t.Context(), mockLogger, unsafeCode)
+
+	// Assert
+	require.EqualError(t, err, "the code uses system, which the code safety policy blocks")
+
+	warnLogs := mockLogger.WarnLogs()
+	fields, found := warnLogs["Code blocked by the code safety policy"]
+	require.True(t, found, "Expected warning not found")
+	assert.Equal(t, []string{"system"}, fields["matched_patterns"])
+}
+
+func TestPolicy_Check_Confirm(t *testing.T) {
+	testCases := []struct {
+		name          string
+		confirmed     bool
+		expectedError string
+	}{
+		{
+			name:      "user confirms",
+			confirmed: true,
+		},
+		{
+			name:          "user declines",
+			confirmed:     false,
+			expectedError: "the code uses system, and the user declined to run it",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockConfirmer := &mocks.MockConfirmer{}
+			defer mockConfirmer.AssertExpectations(t)
+
+			ctx := t.Context()
+
+			mockConfig.EXPECT().
+				CodeSafetyAction().
+				Return(entities.CodeSafetyActionConfirm).
+				Once()
+
+			mockConfig.EXPECT().
+				CodeSafetyPatterns().
+				Return(defaultPatterns).
+				Once()
+
+			mockConfirmer.EXPECT().
+				Confirm(ctx, mockLogger.AsMockArg(), mock.MatchedBy(func(message string) bool {
+					return assert.Contains(t, message, "uses system") && assert.Contains(t, message, unsafeCode)
+				})).
+				Return(tc.confirmed, nil).
+				Once()
+
+			policy := codesafety.New(mockConfig, mockConfirmer)
+
+			// Act
+			err := policy.Check(ctx, mockLogger, unsafeCode)
+
+			// Assert
+			if tc.expectedError != "" {
+				require.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestPolicy_Check_ConfirmError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfirmer := &mocks.MockConfirmer{}
+	defer mockConfirmer.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockConfig.EXPECT().
+		CodeSafetyAction().
+		Return(entities.CodeSafetyActionConfirm).
+		Once()
+
+	mockConfig.EXPECT().
+		CodeSafetyPatterns().
+		Return(defaultPatterns).
+		Once()
+
+	mockConfirmer.EXPECT().
+		Confirm(ctx, mockLogger.AsMockArg(), mock.Anything).
+		Return(false, assert.AnError).
+		Once()
+
+	policy := codesafety.New(mockConfig, mockConfirmer)
+
+	// Act
+	err := policy.Check(ctx, mockLogger, unsafeCode)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.ErrorContains(t, err, "the code uses system, which requires the user's confirmation, but the confirmation failed")
+}
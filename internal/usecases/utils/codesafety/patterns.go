@@ -0,0 +1,218 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package codesafety
+
+import (
+	"slices"
+	"strings"
+)
+
+const shellEscapePattern = "!"
+
+type tokenKind int
+
+const (
+	identifierToken tokenKind = iota
+	stringToken
+	shellEscapeToken
+)
+
+type token struct {
+	kind tokenKind
+	text string
+}
+
+// findPatterns returns the patterns used by the MATLAB code, in order of first use.
+// A pattern is either a function or class name, such as system or java.lang.Runtime,
+// which matches the name and its members, or ! which matches shell escapes.
+// Names in comments are ignored, while strings are screened when they start with a pattern,
+// as in eval('system(...)') or feval("delete", ...).
+func findPatterns(code string, patterns []string) []string {
+	matchedPatterns := []string{}
+
+	for _, token := range tokenize(code) {
+		for _, pattern := range patterns {
+			if slices.Contains(matchedPatterns, pattern) || !tokenMatches(token, pattern) {
+				continue
+			}
+			matchedPatterns = append(matchedPatterns, pattern)
+		}
+	}
+
+	return matchedPatterns
+}
+
+func tokenMatches(token token, pattern string) bool {
+	switch token.kind {
+	case shellEscapeToken:
+		return pattern == shellEscapePattern
+	case identifierToken:
+		return token.text == pattern || strings.HasPrefix(token.text, pattern+".")
+	case stringToken:
+		stringTokens := tokenize(token.text)
+		return len(stringTokens) > 0 && stringTokens[0].kind != stringToken && tokenMatches(stringTokens[0], pattern)
+	default:
+		return false
+	}
+}
+
+// tokenize extracts the names, strings and shell escapes of the MATLAB code.
+// Operators, numbers and comments are skipped.
+func tokenize(code string) []token {
+	tokens := []token{}
+	lines := strings.Split(code, "\n")
+
+	inBlockComment := false
+	for _, line := range lines {
+		trimmedLine := strings.TrimSpace(line)
+
+		if inBlockComment {
+			inBlockComment = trimmedLine != "%}"
+			continue
+		}
+
+		if trimmedLine == "%{" {
+			inBlockComment = true
+			continue
+		}
+
+		tokens = append(tokens, tokenizeLine(line)...)
+	}
+
+	return tokens
+}
+
+func tokenizeLine(line string) []token {
+	tokens := []token{}
+
+	atStatementStart := true
+	// A quote after a name, a number, a closing bracket or another transpose is a transpose, not a string
+	quoteIsTranspose := false
+
+	for i := 0; i < len(line); {
+		char := line[i]
+
+		switch {
+		case char == ' ' || char == '\t' || char == '\r':
+			i++
+			continue
+
+		case char == '%' || strings.HasPrefix(line[i:], "..."):
+			// Comments and line continuations run to the end of the line
+			return tokens
+
+		case char == '!' && atStatementStart:
+			// The rest of the line is a command for the operating system shell
+			return append(tokens, token{kind: shellEscapeToken, text: strings.TrimSpace(line[i+1:])})
+
+		case char == '\'' && quoteIsTranspose:
+			i++
+
+		case char == '\'' || char == '"':
+			text, end := readString(line, i)
+			tokens = append(tokens, token{kind: stringToken, text: text})
+			i = end
+			atStatementStart = false
+			quoteIsTranspose = false
+			continue
+
+		case isLetter(char):
+			end := readName(line, i)
+			tokens = append(tokens, token{kind: identifierToken, text: line[i:end]})
+			i = end
+			atStatementStart = false
+			quoteIsTranspose = true
+			continue
+
+		case isDigit(char) || (char == '.' && i+1 < len(line) && isDigit(line[i+1])):
+			i = readNumber(line, i)
+			atStatementStart = false
+			quoteIsTranspose = true
+			continue
+
+		case char == ')' || char == ']' || char == '}':
+			i++
+			atStatementStart = false
+			quoteIsTranspose = true
+			continue
+
+		case char == ';' || char == ',':
+			i++
+			atStatementStart = true
+			quoteIsTranspose = false
+			continue
+
+		default:
+			i++
+		}
+
+		atStatementStart = false
+		quoteIsTranspose = char == '\'' || (char == '.' && i < len(line) && line[i] == '\'')
+	}
+
+	return tokens
+}
+
+// readString returns the content of the string starting at start, with doubled quotes unescaped,
+// and the index after the closing quote. Unterminated strings run to the end of the line.
+func readString(line string, start int) (string, int) {
+	quote := line[start]
+
+	var content strings.Builder
+	for i := start + 1; i < len(line); i++ {
+		if line[i] != quote {
+			content.WriteByte(line[i])
+			continue
+		}
+
+		if i+1 < len(line) && line[i+1] == quote {
+			content.WriteByte(quote)
+			i++
+			continue
+		}
+
+		return content.String(), i + 1
+	}
+
+	return content.String(), len(line)
+}
+
+// readName returns the index after the name starting at start, including package and member names, such as java.lang.Runtime.
+func readName(line string, start int) int {
+	i := start
+	for {
+		for i < len(line) && (isLetter(line[i]) || isDigit(line[i]) || line[i] == '_') {
+			i++
+		}
+
+		if i+1 < len(line) && line[i] == '.' && isLetter(line[i+1]) {
+			i++
+			continue
+		}
+
+		return i
+	}
+}
+
+func readNumber(line string, start int) int {
+	i := start
+	for i < len(line) && (isDigit(line[i]) || isLetter(line[i]) || line[i] == '.') {
+		if line[i] == '.' && strings.HasPrefix(line[i:], "...") {
+			break
+		}
+		// The dot of an element-wise operator, as in 2.^x or 2.*x, is not part of the number
+		if line[i] == '.' && i+1 < len(line) && strings.ContainsRune("*/\\^'", rune(line[i+1])) {
+			break
+		}
+		i++
+	}
+	return i
+}
+
+func isLetter(char byte) bool {
+	return ('a' <= char && char <= 'z') || ('A' <= char && char <= 'Z')
+}
+
+func isDigit(char byte) bool {
+	return '0' <= char && char <= '9'
+}
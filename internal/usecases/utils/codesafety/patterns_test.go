@@ -0,0 +1,142 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package codesafety_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/codesafety"
+	"github.com/stretchr/testify/assert"
+)
+
+var defaultPatterns = []string{"system", "dos", "unix", "!", "delete", "rmdir", "web", "java.lang.Runtime"}
+
+func TestFindPatterns(t *testing.T) {
+	testCases := []struct {
+		name     string
+		code     string
+		expected []string
+	}{
+		{
+			name:     "safe code",
+			code:     "x = linspace(0, 1, 10);\ny = x.^2;\nplot(x, y)",
+			expected: []string{},
+		},
+		{
+			name:     "function call",
+			code:     "[status, output] = system('ls -la');",
+			expected: []string{"system"},
+		},
+		{
+			name:     "command syntax",
+			code:     "delete results.mat",
+			expected: []string{"delete"},
+		},
+		{
+			name:     "several patterns in order of use",
+			code:     "rmdir('build', 's');\nweb('https://example.com')\nrmdir old",
+			expected: []string{"rmdir", "web"},
+		},
+		{
+			name:     "shell escape",
+			code:     "x = 1; !rm -rf /tmp/data",
+			expected: []string{"!"},
+		},
+		{
+			name:     "shell escape after indentation",
+			code:     "if true\n    !ls\nend",
+			expected: []string{"!"},
+		},
+		{
+			name:     "not operator is not a shell escape",
+			code:     "x = 1; y = x ~= 2;",
+			expected: []string{},
+		},
+		{
+			name:     "Java class members",
+			code:     "runtime = java.lang.Runtime.getRuntime();\nruntime.exec('ls');",
+			expected: []string{"java.lang.Runtime"},
+		},
+		{
+			name:     "function handle",
+			code:     "f = @system; f('ls')",
+			expected: []string{"system"},
+		},
+		{
+			name:     "string evaluated as code",
+			code:     "eval('system(''ls'')')",
+			expected: []string{"system"},
+		},
+		{
+			name:     "function name in a string",
+			code:     `feval("delete", "results.mat")`,
+			expected: []string{"delete"},
+		},
+		{
+			name:     "shell escape in a string",
+			code:     "evalc('!ls')",
+			expected: []string{"!"},
+		},
+		{
+			name:     "pattern in the middle of a string",
+			code:     "disp('Do not delete the results')",
+			expected: []string{},
+		},
+		{
+			name:     "other names containing a pattern",
+			code:     "systemInfo = 1; mywebsite = 2; s.delete = 3;",
+			expected: []string{},
+		},
+		{
+			name:     "line comment",
+			code:     "x = 1; % system('ls')\n% delete all",
+			expected: []string{},
+		},
+		{
+			name:     "block comment",
+			code:     "%{\nsystem('ls')\n%}\nx = 1;",
+			expected: []string{},
+		},
+		{
+			name:     "line continuation comment",
+			code:     "x = [1, 2, ... delete\n3];",
+			expected: []string{},
+		},
+		{
+			name:     "transpose is not a string",
+			code:     "y = x'; z = x.' + y'; system('ls')",
+			expected: []string{"system"},
+		},
+		{
+			name:     "escaped quotes in strings",
+			code:     "s = 'it''s fine'; t = \"say \"\"hi\"\"\"; web(s)",
+			expected: []string{"web"},
+		},
+		{
+			name:     "element-wise operators after numbers",
+			code:     "y = 2.^x + 3.*x; dos('dir')",
+			expected: []string{"dos"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			result := codesafety.FindPatterns(tc.code, defaultPatterns)
+
+			// Assert
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestFindPatterns_CustomPatterns(t *testing.T) {
+	// Arrange
+	code := "system('ls'); movefile('a.txt', 'b.txt');"
+
+	// Act
+	result := codesafety.FindPatterns(code, []string{"movefile"})
+
+	// Assert
+	assert.Equal(t, []string{"movefile"}, result)
+}
@@ -0,0 +1,238 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package debugsession
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// getDebugStateFunction relies on MATLAB running the requests one after the other:
+// while the code runs, it only returns once the code is done, with no frames,
+// and while the code is paused, it runs in the workspace of the innermost frame, whose variables it lists.
+const getDebugStateFunction = "matlab_mcp.getDebugState"
+
+// pollInterval is how long to wait for the code to be done before checking again whether it is paused,
+// as the request checking the state can reach MATLAB before the one running the code.
+const pollInterval = 500 * time.Millisecond
+
+type State string
+
+const (
+	StateIdle    State = "idle"
+	StateRunning State = "running"
+	StatePaused  State = "paused"
+)
+
+var (
+	ErrAlreadyDebugging = errors.New("MATLAB is already debugging code, continue or quit the debugging first")
+	ErrNotPaused        = errors.New("MATLAB is not paused in the debugger")
+)
+
+type Variable struct {
+	Name  string `json:"name"`
+	Size  []int  `json:"size"`
+	Class string `json:"class"`
+	Value string `json:"value"`
+}
+
+// Frame is a frame of the stack of the paused code. Only the innermost frame has its variables listed.
+type Frame struct {
+	Name      string     `json:"name"`
+	File      string     `json:"file"`
+	Line      int        `json:"line"`
+	Variables []Variable `json:"variables"`
+}
+
+// Status is the state of the debugging.
+// The frames, innermost first, are only set while paused, and the output and error only once the code is done.
+type Status struct {
+	State  State
+	Frames []Frame
+	Output string
+	Error  string
+}
+
+type runResult struct {
+	response entities.EvalResponse
+	err      error
+}
+
+// Session tracks the state of the code run in the debugger:
+// idle when no code runs, running while the code runs, and paused when the code is stopped at a breakpoint.
+type Session struct {
+	operationLock *sync.Mutex
+
+	lock      *sync.Mutex
+	state     State
+	runResult chan runResult
+}
+
+func New() *Session {
+	return &Session{
+		operationLock: new(sync.Mutex),
+
+		lock:  new(sync.Mutex),
+		state: StateIdle,
+	}
+}
+
+func (s *Session) State() State {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.state
+}
+
+// Reset forgets the code run in the debugger, once the MATLAB session running it is restarted.
+// The result of the code, which fails as MATLAB stops, is then ignored.
+func (s *Session) Reset() {
+	s.setState(StateIdle)
+}
+
+// Run runs the code, and returns once it is paused at a breakpoint or done.
+// The code keeps running after the context is done, and Wait then returns its status.
+func (s *Session) Run(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, code string) (Status, error) {
+	s.operationLock.Lock()
+	defer s.operationLock.Unlock()
+
+	s.lock.Lock()
+	if s.state != StateIdle {
+		s.lock.Unlock()
+		return Status{}, ErrAlreadyDebugging
+	}
+
+	result := make(chan runResult, 1)
+	s.state = StateRunning
+	s.runResult = result
+	s.lock.Unlock()
+
+	logger.Debug("Running code in the debugger")
+
+	go func() {
+		// The code outlives the request starting it when it is paused
+		response, err := client.Eval(context.WithoutCancel(ctx), logger, entities.EvalRequest{Code: code})
+		result <- runResult{response: response, err: err}
+	}()
+
+	return s.wait(ctx, logger, client)
+}
+
+// Resume sends the debugger command, such as dbstep, dbcont or dbquit, to the paused code,
+// and returns once it is paused again or done.
+func (s *Session) Resume(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, command entities.FEvalRequest) (Status, error) {
+	s.operationLock.Lock()
+	defer s.operationLock.Unlock()
+
+	if s.State() != StatePaused {
+		return Status{}, ErrNotPaused
+	}
+
+	logger.With("command", command.Function).Debug("Sending debugger command")
+
+	if _, err := client.FEval(ctx, logger, command); err != nil {
+		return Status{}, fmt.Errorf("failed to send the %s debugger command: %w", command.Function, err)
+	}
+
+	s.setState(StateRunning)
+
+	return s.wait(ctx, logger, client)
+}
+
+// Wait returns the status of the debugging, once the code is paused or done.
+func (s *Session) Wait(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient) (Status, error) {
+	s.operationLock.Lock()
+	defer s.operationLock.Unlock()
+
+	if s.State() == StateIdle {
+		return Status{State: StateIdle}, nil
+	}
+
+	return s.wait(ctx, logger, client)
+}
+
+func (s *Session) wait(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient) (Status, error) {
+	for {
+		frames, err := getFrames(ctx, logger, client)
+		if err != nil {
+			return Status{}, err
+		}
+
+		if len(frames) > 0 {
+			logger.With("file", frames[0].File).With("line", frames[0].Line).Debug("Code is paused")
+			s.setState(StatePaused)
+			return Status{State: StatePaused, Frames: frames}, nil
+		}
+
+		select {
+		case result := <-s.runResult:
+			logger.Debug("Code is done")
+			s.setState(StateIdle)
+			return doneStatus(result), nil
+		case <-ctx.Done():
+			return Status{}, ctx.Err()
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+func (s *Session) setState(state State) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.state = state
+}
+
+func doneStatus(result runResult) Status {
+	status := Status{
+		State:  StateIdle,
+		Output: result.response.ConsoleOutput,
+	}
+
+	if result.err != nil {
+		status.Error = result.err.Error()
+	}
+
+	return status
+}
+
+func getFrames(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient) ([]Frame, error) {
+	response, err := client.FEval(ctx, logger, entities.FEvalRequest{
+		Function:   getDebugStateFunction,
+		Arguments:  []string{},
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(response.Outputs) != 1 {
+		return nil, fmt.Errorf("unexpected number of outputs when getting the debugger state: %d", len(response.Outputs))
+	}
+
+	encodedState, ok := response.Outputs[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("unexpected output type when getting the debugger state: %T", response.Outputs[0])
+	}
+
+	var state struct {
+		Frames []Frame `json:"frames"`
+	}
+	if err := json.Unmarshal([]byte(encodedState), &state); err != nil {
+		return nil, fmt.Errorf("failed to decode the debugger state: %w", err)
+	}
+
+	for i := range state.Frames {
+		if state.Frames[i].Variables == nil {
+			state.Frames[i].Variables = []Variable{}
+		}
+	}
+
+	return state.Frames, nil
+}
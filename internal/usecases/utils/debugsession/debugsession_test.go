@@ -0,0 +1,364 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package debugsession_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/debugsession"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const code = "analysis(42)"
+
+var getDebugStateRequest = entities.FEvalRequest{
+	Function:   "matlab_mcp.getDebugState",
+	Arguments:  []string{},
+	NumOutputs: 1,
+}
+
+const pausedState = `{"frames":[{"name":"analysis","file":"/home/user/analysis.m","line":12,"variables":[{"name":"x","size":[1,1],"class":"double","value":"42"}]},{"name":"base","file":"","line":0,"variables":[]}]}`
+
+var pausedFrames = []debugsession.Frame{
+	{
+		Name: "analysis",
+		File: "/home/user/analysis.m",
+		Line: 12,
+		Variables: []debugsession.Variable{
+			{Name: "x", Size: []int{1, 1}, Class: "double", Value: "42"},
+		},
+	},
+	{
+		Name:      "base",
+		Variables: []debugsession.Variable{},
+	},
+}
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+
+	// Act
+	session := debugsession.New()
+
+	// Assert
+	require.NotNil(t, session)
+	assert.Equal(t, debugsession.StateIdle, session.State())
+}
+
+func TestSession_Run_Done(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), entities.EvalRequest{Code: code}).
+		Return(entities.EvalResponse{ConsoleOutput: "ans = 84"}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), getDebugStateRequest).
+		Return(entities.FEvalResponse{Outputs: []any{`{"frames":[]}`}}, nil)
+
+	session := debugsession.New()
+
+	// Act
+	status, err := session.Run(ctx, mockLogger, mockClient, code)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, debugsession.Status{State: debugsession.StateIdle, Output: "ans = 84"}, status)
+	assert.Equal(t, debugsession.StateIdle, session.State())
+}
+
+func TestSession_Run_PausedThenQuit(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	quitRequest := entities.FEvalRequest{Function: "dbquit"}
+	quit := make(chan struct{})
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), entities.EvalRequest{Code: code}).
+		Run(func(_ context.Context, _ entities.Logger, _ entities.EvalRequest) {
+			<-quit
+		}).
+		Return(entities.EvalResponse{}, assert.AnError).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), getDebugStateRequest).
+		Return(entities.FEvalResponse{Outputs: []any{pausedState}}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), quitRequest).
+		Run(func(_ context.Context, _ entities.Logger, _ entities.FEvalRequest) {
+			close(quit)
+		}).
+		Return(entities.FEvalResponse{}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), getDebugStateRequest).
+		Return(entities.FEvalResponse{Outputs: []any{`{"frames":[]}`}}, nil)
+
+	session := debugsession.New()
+
+	// Act
+	pausedStatus, err := session.Run(ctx, mockLogger, mockClient, code)
+	require.NoError(t, err)
+	stateWhilePaused := session.State()
+
+	doneStatus, err := session.Resume(ctx, mockLogger, mockClient, quitRequest)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, debugsession.Status{State: debugsession.StatePaused, Frames: pausedFrames}, pausedStatus)
+	assert.Equal(t, debugsession.StatePaused, stateWhilePaused)
+	assert.Equal(t, debugsession.Status{State: debugsession.StateIdle, Error: assert.AnError.Error()}, doneStatus)
+	assert.Equal(t, debugsession.StateIdle, session.State())
+}
+
+func TestSession_Run_AlreadyDebugging(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), entities.EvalRequest{Code: code}).
+		Return(entities.EvalResponse{}, nil).
+		Maybe()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), getDebugStateRequest).
+		Return(entities.FEvalResponse{Outputs: []any{pausedState}}, nil).
+		Once()
+
+	session := debugsession.New()
+	_, err := session.Run(ctx, mockLogger, mockClient, code)
+	require.NoError(t, err)
+
+	// Act
+	status, err := session.Run(ctx, mockLogger, mockClient, code)
+
+	// Assert
+	require.ErrorIs(t, err, debugsession.ErrAlreadyDebugging)
+	assert.Empty(t, status)
+	assert.Equal(t, debugsession.StatePaused, session.State())
+}
+
+func TestSession_Run_GetDebugStateErrors(t *testing.T) {
+	testCases := []struct {
+		name          string
+		response      entities.FEvalResponse
+		err           error
+		expectedError string
+	}{
+		{
+			name:          "FEval error",
+			err:           assert.AnError,
+			expectedError: assert.AnError.Error(),
+		},
+		{
+			name:          "unexpected number of outputs",
+			response:      entities.FEvalResponse{Outputs: []any{}},
+			expectedError: "unexpected number of outputs when getting the debugger state: 0",
+		},
+		{
+			name:          "unexpected output type",
+			response:      entities.FEvalResponse{Outputs: []any{42}},
+			expectedError: "unexpected output type when getting the debugger state: int",
+		},
+		{
+			name:          "invalid JSON",
+			response:      entities.FEvalResponse{Outputs: []any{"not JSON"}},
+			expectedError: "failed to decode the debugger state",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			ctx := t.Context()
+
+			mockClient.EXPECT().
+				Eval(mock.Anything, mockLogger.AsMockArg(), entities.EvalRequest{Code: code}).
+				Return(entities.EvalResponse{}, nil).
+				Maybe()
+
+			mockClient.EXPECT().
+				FEval(ctx, mockLogger.AsMockArg(), getDebugStateRequest).
+				Return(testCase.response, testCase.err).
+				Once()
+
+			session := debugsession.New()
+
+			// Act
+			status, err := session.Run(ctx, mockLogger, mockClient, code)
+
+			// Assert
+			require.ErrorContains(t, err, testCase.expectedError)
+			assert.Empty(t, status)
+		})
+	}
+}
+
+func TestSession_Resume_NotPaused(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	session := debugsession.New()
+
+	// Act
+	status, err := session.Resume(t.Context(), mockLogger, mockClient, entities.FEvalRequest{Function: "dbcont"})
+
+	// Assert
+	require.ErrorIs(t, err, debugsession.ErrNotPaused)
+	assert.Empty(t, status)
+}
+
+func TestSession_Resume_CommandError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	continueRequest := entities.FEvalRequest{Function: "dbcont"}
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), entities.EvalRequest{Code: code}).
+		Return(entities.EvalResponse{}, nil).
+		Maybe()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), getDebugStateRequest).
+		Return(entities.FEvalResponse{Outputs: []any{pausedState}}, nil).
+		Once()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), continueRequest).
+		Return(entities.FEvalResponse{}, assert.AnError).
+		Once()
+
+	session := debugsession.New()
+	_, err := session.Run(ctx, mockLogger, mockClient, code)
+	require.NoError(t, err)
+
+	// Act
+	status, err := session.Resume(ctx, mockLogger, mockClient, continueRequest)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, status)
+	assert.Equal(t, debugsession.StatePaused, session.State())
+}
+
+func TestSession_Wait_Idle(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	session := debugsession.New()
+
+	// Act
+	status, err := session.Wait(t.Context(), mockLogger, mockClient)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, debugsession.Status{State: debugsession.StateIdle}, status)
+}
+
+func TestSession_Wait_Paused(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), entities.EvalRequest{Code: code}).
+		Return(entities.EvalResponse{}, nil).
+		Maybe()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), getDebugStateRequest).
+		Return(entities.FEvalResponse{Outputs: []any{pausedState}}, nil).
+		Times(2)
+
+	session := debugsession.New()
+	_, err := session.Run(ctx, mockLogger, mockClient, code)
+	require.NoError(t, err)
+
+	// Act
+	status, err := session.Wait(ctx, mockLogger, mockClient)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, debugsession.Status{State: debugsession.StatePaused, Frames: pausedFrames}, status)
+}
+
+func TestSession_Reset_Paused(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), entities.EvalRequest{Code: code}).
+		Return(entities.EvalResponse{}, nil).
+		Maybe()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), getDebugStateRequest).
+		Return(entities.FEvalResponse{Outputs: []any{pausedState}}, nil).
+		Once()
+
+	session := debugsession.New()
+	_, err := session.Run(ctx, mockLogger, mockClient, code)
+	require.NoError(t, err)
+
+	// Act
+	session.Reset()
+
+	// Assert
+	assert.Equal(t, debugsession.StateIdle, session.State())
+
+	status, err := session.Wait(ctx, mockLogger, mockClient)
+	require.NoError(t, err)
+	assert.Equal(t, debugsession.Status{State: debugsession.StateIdle}, status)
+}
@@ -0,0 +1,67 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package installedproducts
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+const listInstalledProductsFunction = "matlab_mcp.listInstalledProducts"
+
+type Product struct {
+	Name       string `json:"name"`
+	Version    string `json:"version"`
+	Release    string `json:"release"`
+	Identifier string `json:"identifier"`
+}
+
+type AddOn struct {
+	Name       string `json:"name"`
+	Version    string `json:"version"`
+	Enabled    bool   `json:"enabled"`
+	Identifier string `json:"identifier"`
+}
+
+type InstalledProducts struct {
+	Products []Product `json:"products"`
+	AddOns   []AddOn   `json:"addons"`
+}
+
+// Get lists the MathWorks products and the other add-ons installed in the MATLAB session.
+func Get(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient) (InstalledProducts, error) {
+	response, err := client.FEval(ctx, sessionLogger, entities.FEvalRequest{
+		Function:   listInstalledProductsFunction,
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return InstalledProducts{}, err
+	}
+
+	if len(response.Outputs) != 1 {
+		return InstalledProducts{}, fmt.Errorf("unexpected number of outputs when listing the installed products: %d", len(response.Outputs))
+	}
+
+	encodedInstalledProducts, ok := response.Outputs[0].(string)
+	if !ok {
+		return InstalledProducts{}, fmt.Errorf("unexpected output type when listing the installed products: %T", response.Outputs[0])
+	}
+
+	var installedProducts InstalledProducts
+	if err := json.Unmarshal([]byte(encodedInstalledProducts), &installedProducts); err != nil {
+		return InstalledProducts{}, fmt.Errorf("failed to decode the installed products: %w", err)
+	}
+
+	if installedProducts.Products == nil {
+		installedProducts.Products = []Product{}
+	}
+
+	if installedProducts.AddOns == nil {
+		installedProducts.AddOns = []AddOn{}
+	}
+
+	return installedProducts, nil
+}
@@ -0,0 +1,154 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package installedproducts_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/installedproducts"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var listInstalledProductsRequest = entities.FEvalRequest{
+	Function:   "matlab_mcp.listInstalledProducts",
+	NumOutputs: 1,
+}
+
+func TestGet_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	encodedInstalledProducts := `{
+		"products": [
+			{"name": "MATLAB", "version": "24.2", "release": "R2024b", "identifier": "ML"},
+			{"name": "Signal Processing Toolbox", "version": "24.2", "release": "R2024b", "identifier": "SG"}
+		],
+		"addons": [
+			{"name": "GUI Layout Toolbox", "version": "2.3.6", "enabled": true, "identifier": "e5af5a78-4a80-11e4-9553-005056977bd0"}
+		]
+	}`
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), listInstalledProductsRequest).
+		Return(entities.FEvalResponse{Outputs: []any{encodedInstalledProducts}}, nil).
+		Once()
+
+	// Act
+	result, err := installedproducts.Get(ctx, mockLogger, mockClient)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, installedproducts.InstalledProducts{
+		Products: []installedproducts.Product{
+			{Name: "MATLAB", Version: "24.2", Release: "R2024b", Identifier: "ML"},
+			{Name: "Signal Processing Toolbox", Version: "24.2", Release: "R2024b", Identifier: "SG"},
+		},
+		AddOns: []installedproducts.AddOn{
+			{Name: "GUI Layout Toolbox", Version: "2.3.6", Enabled: true, Identifier: "e5af5a78-4a80-11e4-9553-005056977bd0"},
+		},
+	}, result)
+}
+
+func TestGet_NoAddOns(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), listInstalledProductsRequest).
+		Return(entities.FEvalResponse{Outputs: []any{`{"products": [], "addons": []}`}}, nil).
+		Once()
+
+	// Act
+	result, err := installedproducts.Get(ctx, mockLogger, mockClient)
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotNil(t, result.Products)
+	assert.Empty(t, result.Products)
+	assert.NotNil(t, result.AddOns)
+	assert.Empty(t, result.AddOns)
+}
+
+func TestGet_FEvalError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), listInstalledProductsRequest).
+		Return(entities.FEvalResponse{}, assert.AnError).
+		Once()
+
+	// Act
+	result, err := installedproducts.Get(ctx, mockLogger, mockClient)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
+
+func TestGet_UnexpectedOutputs(t *testing.T) {
+	testCases := []struct {
+		name          string
+		outputs       []any
+		expectedError string
+	}{
+		{
+			name:          "no outputs",
+			outputs:       []any{},
+			expectedError: "unexpected number of outputs when listing the installed products: 0",
+		},
+		{
+			name:          "not a string",
+			outputs:       []any{42.0},
+			expectedError: "unexpected output type when listing the installed products: float64",
+		},
+		{
+			name:          "invalid JSON",
+			outputs:       []any{"not JSON"},
+			expectedError: "failed to decode the installed products: invalid character 'o' in literal null (expecting 'u')",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			ctx := t.Context()
+
+			mockClient.EXPECT().
+				FEval(ctx, mockLogger.AsMockArg(), listInstalledProductsRequest).
+				Return(entities.FEvalResponse{Outputs: tc.outputs}, nil).
+				Once()
+
+			// Act
+			result, err := installedproducts.Get(ctx, mockLogger, mockClient)
+
+			// Assert
+			require.EqualError(t, err, tc.expectedError)
+			assert.Empty(t, result)
+		})
+	}
+}
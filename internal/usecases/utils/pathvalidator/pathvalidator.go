@@ -15,15 +15,36 @@ type OSLayer interface {
 	Stat(filePath string) (osfacade.FileInfo, error)
 }
 
+type FileLayer interface {
+	EvalSymlinks(path string) (string, error)
+}
+
+type Config interface {
+	AllowedRoots() []string
+}
+
+type ClientRoots interface {
+	Roots() ([]string, bool)
+}
+
 type PathValidator struct {
-	osLayer OSLayer
+	osLayer     OSLayer
+	fileLayer   FileLayer
+	config      Config
+	clientRoots ClientRoots
 }
 
 func New(
 	osLayer OSLayer,
+	fileLayer FileLayer,
+	config Config,
+	clientRoots ClientRoots,
 ) *PathValidator {
 	return &PathValidator{
-		osLayer: osLayer,
+		osLayer:     osLayer,
+		fileLayer:   fileLayer,
+		config:      config,
+		clientRoots: clientRoots,
 	}
 }
 
@@ -47,7 +68,30 @@ func (v *PathValidator) ValidateMATLABScript(filePath string) (string, error) {
 		return "", fmt.Errorf("path is not a file: %s", absPath)
 	}
 
-	return absPath, nil
+	return v.validateAllowedLocation(absPath)
+}
+
+// ValidateSimulinkModel checks that the path is a Simulink model file, in the .slx or .mdl format.
+func (v *PathValidator) ValidateSimulinkModel(filePath string) (string, error) {
+	absPath, err := resolveAbsolutePath(filePath)
+	if err != nil {
+		return "", err
+	}
+
+	if !strings.HasSuffix(absPath, ".slx") && !strings.HasSuffix(absPath, ".mdl") {
+		return "", fmt.Errorf("file must be a Simulink .slx or .mdl model: %s", absPath)
+	}
+
+	fileInfo, err := v.getResourceInfo(absPath)
+	if err != nil {
+		return "", err
+	}
+
+	if fileInfo.IsDir() {
+		return "", fmt.Errorf("path is not a file: %s", absPath)
+	}
+
+	return v.validateAllowedLocation(absPath)
 }
 
 func (v *PathValidator) ValidateFolderPath(filePath string) (string, error) {
@@ -65,7 +109,69 @@ func (v *PathValidator) ValidateFolderPath(filePath string) (string, error) {
 		return "", fmt.Errorf("path is not a folder: %s", absPath)
 	}
 
-	return absPath, nil
+	return v.validateAllowedLocation(absPath)
+}
+
+func (v *PathValidator) ValidateExistingPath(filePath string) (string, error) {
+	absPath, err := resolveAbsolutePath(filePath)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := v.getResourceInfo(absPath); err != nil {
+		return "", err
+	}
+
+	return v.validateAllowedLocation(absPath)
+}
+
+// ResolvePath returns the absolute path with symbolic links resolved, as it is checked against the allowed roots.
+func (v *PathValidator) ResolvePath(filePath string) (string, error) {
+	absPath, err := resolveAbsolutePath(filePath)
+	if err != nil {
+		return "", err
+	}
+
+	return v.fileLayer.EvalSymlinks(absPath)
+}
+
+// validateAllowedLocation resolves the symbolic links of the path, checks that the resolved path is inside one of the allowed roots, and returns it,
+// so that the tools use the path which was checked.
+// The configured allowed roots take precedence, so that the MCP client cannot widen them;
+// the roots provided by the MCP client only apply when no allowed roots are configured.
+// Once the MCP client supports roots, only its roots are allowed, even before it lists them, or when none of them is a local folder.
+// When neither allowed roots are configured, nor the MCP client supports roots, any location is allowed.
+func (v *PathValidator) validateAllowedLocation(absPath string) (string, error) {
+	resolvedPath, err := v.fileLayer.EvalSymlinks(absPath)
+	if err != nil {
+		return "", fmt.Errorf("error resolving path: %w", err)
+	}
+
+	allowedRoots := v.config.AllowedRoots()
+	if len(allowedRoots) == 0 {
+		clientRoots, supportsRoots := v.clientRoots.Roots()
+		if !supportsRoots {
+			return resolvedPath, nil
+		}
+		if len(clientRoots) == 0 {
+			return "", fmt.Errorf("path is outside the allowed roots: %s, as the MCP client has not provided any local folder as a root", absPath)
+		}
+		allowedRoots = clientRoots
+	}
+
+	for _, allowedRoot := range allowedRoots {
+		resolvedRoot, err := v.fileLayer.EvalSymlinks(allowedRoot)
+		if err != nil {
+			// An allowed root which cannot be resolved, e.g. because it does not exist, cannot contain the path
+			continue
+		}
+
+		if isInsideFolder(resolvedPath, resolvedRoot) {
+			return resolvedPath, nil
+		}
+	}
+
+	return "", fmt.Errorf("path is outside the allowed roots: %s", absPath)
 }
 
 func (v *PathValidator) getResourceInfo(filePath string) (osfacade.FileInfo, error) {
@@ -80,6 +186,15 @@ func (v *PathValidator) getResourceInfo(filePath string) (osfacade.FileInfo, err
 	return resourceInfo, nil
 }
 
+func isInsideFolder(filePath string, folderPath string) bool {
+	relativePath, err := filepath.Rel(folderPath, filePath)
+	if err != nil {
+		return false
+	}
+
+	return relativePath != ".." && !strings.HasPrefix(relativePath, ".."+string(filepath.Separator))
+}
+
 func resolveAbsolutePath(filePath string) (string, error) {
 	cleanPath := filepath.Clean(filePath)
 
@@ -18,9 +18,12 @@ import (
 func TestNew_HappyPath(t *testing.T) {
 	// Arrange
 	mockOsLayer := &mocks.MockOSLayer{}
+	mockFileLayer := &mocks.MockFileLayer{}
+	mockConfig := &mocks.MockConfig{}
+	mockClientRoots := &mocks.MockClientRoots{}
 
 	// Act
-	validator := pathvalidator.New(mockOsLayer)
+	validator := pathvalidator.New(mockOsLayer, mockFileLayer, mockConfig, mockClientRoots)
 
 	// Assert
 	assert.NotNil(t, validator, "New() should return a non-nil Validator")
@@ -31,10 +34,19 @@ func TestValidator_ValidateMATLABScript_HappyPath(t *testing.T) {
 	mockOsLayer := &mocks.MockOSLayer{}
 	defer mockOsLayer.AssertExpectations(t)
 
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockClientRoots := &mocks.MockClientRoots{}
+	defer mockClientRoots.AssertExpectations(t)
+
 	mockFileInfo := &osfacademocks.MockFileInfo{}
 	defer mockFileInfo.AssertExpectations(t)
 
-	validator := pathvalidator.New(mockOsLayer)
+	validator := pathvalidator.New(mockOsLayer, mockFileLayer, mockConfig, mockClientRoots)
 
 	testPath, absErr := filepath.Abs("test.m")
 	require.NoError(t, absErr)
@@ -49,6 +61,21 @@ func TestValidator_ValidateMATLABScript_HappyPath(t *testing.T) {
 		Return(false).
 		Once()
 
+	mockFileLayer.EXPECT().
+		EvalSymlinks(testPath).
+		Return(testPath, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		AllowedRoots().
+		Return(nil).
+		Once()
+
+	mockClientRoots.EXPECT().
+		Roots().
+		Return([]string{}, false).
+		Once()
+
 	// Act
 	result, err := validator.ValidateMATLABScript(testPath)
 
@@ -85,7 +112,16 @@ func TestValidator_ValidateMATLABScript_InvalidPath(t *testing.T) {
 			mockOsLayer := &mocks.MockOSLayer{}
 			defer mockOsLayer.AssertExpectations(t)
 
-			validator := pathvalidator.New(mockOsLayer)
+			mockFileLayer := &mocks.MockFileLayer{}
+			defer mockFileLayer.AssertExpectations(t)
+
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockClientRoots := &mocks.MockClientRoots{}
+			defer mockClientRoots.AssertExpectations(t)
+
+			validator := pathvalidator.New(mockOsLayer, mockFileLayer, mockConfig, mockClientRoots)
 
 			// Act
 			_, err := validator.ValidateMATLABScript(tt.filePath)
@@ -121,7 +157,16 @@ func TestValidator_ValidateMATLABScript_NotMATLABScript(t *testing.T) {
 			mockOsLayer := &mocks.MockOSLayer{}
 			defer mockOsLayer.AssertExpectations(t)
 
-			validator := pathvalidator.New(mockOsLayer)
+			mockFileLayer := &mocks.MockFileLayer{}
+			defer mockFileLayer.AssertExpectations(t)
+
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockClientRoots := &mocks.MockClientRoots{}
+			defer mockClientRoots.AssertExpectations(t)
+
+			validator := pathvalidator.New(mockOsLayer, mockFileLayer, mockConfig, mockClientRoots)
 
 			filePath, absErr := filepath.Abs(tt.fileName)
 			require.NoError(t, absErr)
@@ -140,10 +185,19 @@ func TestValidator_ValidateMATLABScript_MPathIsAFolder(t *testing.T) {
 	mockOsLayer := &mocks.MockOSLayer{}
 	defer mockOsLayer.AssertExpectations(t)
 
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockClientRoots := &mocks.MockClientRoots{}
+	defer mockClientRoots.AssertExpectations(t)
+
 	mockFileInfo := &osfacademocks.MockFileInfo{}
 	defer mockFileInfo.AssertExpectations(t)
 
-	validator := pathvalidator.New(mockOsLayer)
+	validator := pathvalidator.New(mockOsLayer, mockFileLayer, mockConfig, mockClientRoots)
 
 	// path has .m extension to pass suffix check but is registered as a folder
 	testPath, absErr := filepath.Abs("folder.m")
@@ -171,6 +225,15 @@ func TestValidator_ValidateMATLABScript_StatFails(t *testing.T) {
 	mockOsLayer := &mocks.MockOSLayer{}
 	defer mockOsLayer.AssertExpectations(t)
 
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockClientRoots := &mocks.MockClientRoots{}
+	defer mockClientRoots.AssertExpectations(t)
+
 	testPath, absErr := filepath.Abs("test.m")
 	require.NoError(t, absErr)
 
@@ -178,7 +241,7 @@ func TestValidator_ValidateMATLABScript_StatFails(t *testing.T) {
 		Stat(testPath).
 		Return(nil, os.ErrNotExist)
 
-	validator := pathvalidator.New(mockOsLayer)
+	validator := pathvalidator.New(mockOsLayer, mockFileLayer, mockConfig, mockClientRoots)
 
 	// Act
 	_, err := validator.ValidateMATLABScript(testPath)
@@ -219,10 +282,19 @@ func TestValidator_ValidateMATLABScript_PathCleaning(t *testing.T) {
 			mockOsLayer := &mocks.MockOSLayer{}
 			defer mockOsLayer.AssertExpectations(t)
 
+			mockFileLayer := &mocks.MockFileLayer{}
+			defer mockFileLayer.AssertExpectations(t)
+
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockClientRoots := &mocks.MockClientRoots{}
+			defer mockClientRoots.AssertExpectations(t)
+
 			mockFileInfo := &osfacademocks.MockFileInfo{}
 			defer mockFileInfo.AssertExpectations(t)
 
-			validator := pathvalidator.New(mockOsLayer)
+			validator := pathvalidator.New(mockOsLayer, mockFileLayer, mockConfig, mockClientRoots)
 
 			mockOsLayer.EXPECT().
 				Stat(tt.expected).
@@ -234,6 +306,21 @@ func TestValidator_ValidateMATLABScript_PathCleaning(t *testing.T) {
 				Return(false).
 				Once()
 
+			mockFileLayer.EXPECT().
+				EvalSymlinks(tt.expected).
+				Return(tt.expected, nil).
+				Once()
+
+			mockConfig.EXPECT().
+				AllowedRoots().
+				Return(nil).
+				Once()
+
+			mockClientRoots.EXPECT().
+				Roots().
+				Return([]string{}, false).
+				Once()
+
 			// Act
 			result, err := validator.ValidateMATLABScript(tt.filePath)
 
@@ -244,15 +331,497 @@ func TestValidator_ValidateMATLABScript_PathCleaning(t *testing.T) {
 	}
 }
 
+func TestValidator_ValidateMATLABScript_AllowedRoots(t *testing.T) {
+	allowedRoot, absErr := filepath.Abs("allowed")
+	require.NoError(t, absErr)
+
+	clientRoot, absErr := filepath.Abs("client")
+	require.NoError(t, absErr)
+
+	outsideFolder, absErr := filepath.Abs("outside")
+	require.NoError(t, absErr)
+
+	tests := []struct {
+		name          string
+		allowedRoots  []string
+		clientRoots   []string
+		supportsRoots bool
+		filePath      string
+		resolvedPaths map[string]string
+		expectedError string
+	}{
+		{
+			name:         "Inside an allowed root",
+			allowedRoots: []string{allowedRoot},
+			clientRoots:  []string{},
+			filePath:     filepath.Join(allowedRoot, "sub", "test.m"),
+			resolvedPaths: map[string]string{
+				filepath.Join(allowedRoot, "sub", "test.m"): filepath.Join(allowedRoot, "sub", "test.m"),
+				allowedRoot: allowedRoot,
+			},
+		},
+		{
+			name:          "Inside a client root",
+			allowedRoots:  nil,
+			clientRoots:   []string{clientRoot},
+			supportsRoots: true,
+			filePath:      filepath.Join(clientRoot, "test.m"),
+			resolvedPaths: map[string]string{
+				filepath.Join(clientRoot, "test.m"): filepath.Join(clientRoot, "test.m"),
+				clientRoot:                          clientRoot,
+			},
+		},
+		{
+			name:          "Outside the client roots",
+			allowedRoots:  nil,
+			clientRoots:   []string{clientRoot},
+			supportsRoots: true,
+			filePath:      filepath.Join(outsideFolder, "test.m"),
+			resolvedPaths: map[string]string{
+				filepath.Join(outsideFolder, "test.m"): filepath.Join(outsideFolder, "test.m"),
+				clientRoot:                             clientRoot,
+			},
+			expectedError: "path is outside the allowed roots: " + filepath.Join(outsideFolder, "test.m"),
+		},
+		{
+			name:          "Client supporting roots without any local folder",
+			allowedRoots:  nil,
+			clientRoots:   []string{},
+			supportsRoots: true,
+			filePath:      filepath.Join(clientRoot, "test.m"),
+			resolvedPaths: map[string]string{
+				filepath.Join(clientRoot, "test.m"): filepath.Join(clientRoot, "test.m"),
+			},
+			expectedError: "path is outside the allowed roots: " + filepath.Join(clientRoot, "test.m") + ", as the MCP client has not provided any local folder as a root",
+		},
+		{
+			name:          "Client not supporting roots",
+			allowedRoots:  nil,
+			clientRoots:   []string{},
+			supportsRoots: false,
+			filePath:      filepath.Join(outsideFolder, "test.m"),
+			resolvedPaths: map[string]string{
+				filepath.Join(outsideFolder, "test.m"): filepath.Join(outsideFolder, "test.m"),
+			},
+		},
+		{
+			name:         "Symbolic link inside the allowed root returns the resolved path",
+			allowedRoots: []string{allowedRoot},
+			filePath:     filepath.Join(allowedRoot, "link.m"),
+			resolvedPaths: map[string]string{
+				filepath.Join(allowedRoot, "link.m"): filepath.Join(allowedRoot, "sub", "test.m"),
+				allowedRoot:                          allowedRoot,
+			},
+		},
+		{
+			name:         "Outside the allowed roots",
+			allowedRoots: []string{allowedRoot},
+			clientRoots:  []string{clientRoot},
+			filePath:     filepath.Join(outsideFolder, "test.m"),
+			resolvedPaths: map[string]string{
+				filepath.Join(outsideFolder, "test.m"): filepath.Join(outsideFolder, "test.m"),
+				allowedRoot:                            allowedRoot,
+			},
+			expectedError: "path is outside the allowed roots: " + filepath.Join(outsideFolder, "test.m"),
+		},
+		{
+			name:         "Client roots do not widen the allowed roots",
+			allowedRoots: []string{allowedRoot},
+			clientRoots:  []string{clientRoot},
+			filePath:     filepath.Join(clientRoot, "test.m"),
+			resolvedPaths: map[string]string{
+				filepath.Join(clientRoot, "test.m"): filepath.Join(clientRoot, "test.m"),
+				allowedRoot:                         allowedRoot,
+			},
+			expectedError: "path is outside the allowed roots: " + filepath.Join(clientRoot, "test.m"),
+		},
+		{
+			name:         "Dot-dot segments leaving the allowed root",
+			allowedRoots: []string{allowedRoot},
+			clientRoots:  []string{},
+			filePath:     filepath.Join(allowedRoot, "..", "outside", "test.m"),
+			resolvedPaths: map[string]string{
+				filepath.Join(outsideFolder, "test.m"): filepath.Join(outsideFolder, "test.m"),
+				allowedRoot:                            allowedRoot,
+			},
+			expectedError: "path is outside the allowed roots: " + filepath.Join(outsideFolder, "test.m"),
+		},
+		{
+			name:         "Symbolic link leaving the allowed root",
+			allowedRoots: []string{allowedRoot},
+			clientRoots:  []string{},
+			filePath:     filepath.Join(allowedRoot, "link.m"),
+			resolvedPaths: map[string]string{
+				filepath.Join(allowedRoot, "link.m"): filepath.Join(outsideFolder, "test.m"),
+				allowedRoot:                          allowedRoot,
+			},
+			expectedError: "path is outside the allowed roots: " + filepath.Join(allowedRoot, "link.m"),
+		},
+		{
+			name:         "Sibling folder sharing the allowed root prefix",
+			allowedRoots: []string{allowedRoot},
+			clientRoots:  []string{},
+			filePath:     filepath.Join(allowedRoot+"-other", "test.m"),
+			resolvedPaths: map[string]string{
+				filepath.Join(allowedRoot+"-other", "test.m"): filepath.Join(allowedRoot+"-other", "test.m"),
+				allowedRoot: allowedRoot,
+			},
+			expectedError: "path is outside the allowed roots: " + filepath.Join(allowedRoot+"-other", "test.m"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockOsLayer := &mocks.MockOSLayer{}
+			defer mockOsLayer.AssertExpectations(t)
+
+			mockFileLayer := &mocks.MockFileLayer{}
+			defer mockFileLayer.AssertExpectations(t)
+
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockClientRoots := &mocks.MockClientRoots{}
+			defer mockClientRoots.AssertExpectations(t)
+
+			mockFileInfo := &osfacademocks.MockFileInfo{}
+			defer mockFileInfo.AssertExpectations(t)
+
+			validator := pathvalidator.New(mockOsLayer, mockFileLayer, mockConfig, mockClientRoots)
+
+			cleanPath := filepath.Clean(tt.filePath)
+
+			mockOsLayer.EXPECT().
+				Stat(cleanPath).
+				Return(mockFileInfo, nil).
+				Once()
+
+			mockFileInfo.EXPECT().
+				IsDir().
+				Return(false).
+				Once()
+
+			mockConfig.EXPECT().
+				AllowedRoots().
+				Return(tt.allowedRoots).
+				Once()
+
+			if len(tt.allowedRoots) == 0 {
+				mockClientRoots.EXPECT().
+					Roots().
+					Return(tt.clientRoots, tt.supportsRoots).
+					Once()
+			}
+
+			for path, resolvedPath := range tt.resolvedPaths {
+				mockFileLayer.EXPECT().
+					EvalSymlinks(path).
+					Return(resolvedPath, nil).
+					Once()
+			}
+
+			// Act
+			result, err := validator.ValidateMATLABScript(tt.filePath)
+
+			// Assert
+			if tt.expectedError != "" {
+				require.EqualError(t, err, tt.expectedError)
+				assert.Empty(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.resolvedPaths[cleanPath], result)
+		})
+	}
+}
+
+func TestValidator_ValidateMATLABScript_AllowedRootCannotBeResolved(t *testing.T) {
+	// Arrange
+	mockOsLayer := &mocks.MockOSLayer{}
+	defer mockOsLayer.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockClientRoots := &mocks.MockClientRoots{}
+	defer mockClientRoots.AssertExpectations(t)
+
+	mockFileInfo := &osfacademocks.MockFileInfo{}
+	defer mockFileInfo.AssertExpectations(t)
+
+	validator := pathvalidator.New(mockOsLayer, mockFileLayer, mockConfig, mockClientRoots)
+
+	missingRoot, absErr := filepath.Abs("missing")
+	require.NoError(t, absErr)
+
+	allowedRoot, absErr := filepath.Abs("allowed")
+	require.NoError(t, absErr)
+
+	testPath := filepath.Join(allowedRoot, "test.m")
+
+	mockOsLayer.EXPECT().
+		Stat(testPath).
+		Return(mockFileInfo, nil).
+		Once()
+
+	mockFileInfo.EXPECT().
+		IsDir().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		AllowedRoots().
+		Return([]string{missingRoot, allowedRoot}).
+		Once()
+
+	mockFileLayer.EXPECT().
+		EvalSymlinks(testPath).
+		Return(testPath, nil).
+		Once()
+
+	mockFileLayer.EXPECT().
+		EvalSymlinks(missingRoot).
+		Return("", os.ErrNotExist).
+		Once()
+
+	mockFileLayer.EXPECT().
+		EvalSymlinks(allowedRoot).
+		Return(allowedRoot, nil).
+		Once()
+
+	// Act
+	result, err := validator.ValidateMATLABScript(testPath)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, testPath, result)
+}
+
+func TestValidator_ValidateMATLABScript_EvalSymlinksFails(t *testing.T) {
+	// Arrange
+	mockOsLayer := &mocks.MockOSLayer{}
+	defer mockOsLayer.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockClientRoots := &mocks.MockClientRoots{}
+	defer mockClientRoots.AssertExpectations(t)
+
+	mockFileInfo := &osfacademocks.MockFileInfo{}
+	defer mockFileInfo.AssertExpectations(t)
+
+	validator := pathvalidator.New(mockOsLayer, mockFileLayer, mockConfig, mockClientRoots)
+
+	allowedRoot, absErr := filepath.Abs("allowed")
+	require.NoError(t, absErr)
+
+	testPath := filepath.Join(allowedRoot, "test.m")
+
+	mockOsLayer.EXPECT().
+		Stat(testPath).
+		Return(mockFileInfo, nil).
+		Once()
+
+	mockFileInfo.EXPECT().
+		IsDir().
+		Return(false).
+		Once()
+
+	mockFileLayer.EXPECT().
+		EvalSymlinks(testPath).
+		Return("", assert.AnError).
+		Once()
+
+	// Act
+	result, err := validator.ValidateMATLABScript(testPath)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, result)
+}
+
+func TestValidator_ValidateSimulinkModel_HappyPath(t *testing.T) {
+	tests := []struct {
+		name     string
+		fileName string
+	}{
+		{
+			name:     "SLX model",
+			fileName: "model.slx",
+		},
+		{
+			name:     "MDL model",
+			fileName: "model.mdl",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockOsLayer := &mocks.MockOSLayer{}
+			defer mockOsLayer.AssertExpectations(t)
+
+			mockFileLayer := &mocks.MockFileLayer{}
+			defer mockFileLayer.AssertExpectations(t)
+
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockClientRoots := &mocks.MockClientRoots{}
+			defer mockClientRoots.AssertExpectations(t)
+
+			mockFileInfo := &osfacademocks.MockFileInfo{}
+			defer mockFileInfo.AssertExpectations(t)
+
+			validator := pathvalidator.New(mockOsLayer, mockFileLayer, mockConfig, mockClientRoots)
+
+			testPath, absErr := filepath.Abs(tt.fileName)
+			require.NoError(t, absErr)
+
+			mockOsLayer.EXPECT().
+				Stat(testPath).
+				Return(mockFileInfo, nil).
+				Once()
+
+			mockFileInfo.EXPECT().
+				IsDir().
+				Return(false).
+				Once()
+
+			mockFileLayer.EXPECT().
+				EvalSymlinks(testPath).
+				Return(testPath, nil).
+				Once()
+
+			mockConfig.EXPECT().
+				AllowedRoots().
+				Return(nil).
+				Once()
+
+			mockClientRoots.EXPECT().
+				Roots().
+				Return([]string{}, false).
+				Once()
+
+			// Act
+			result, err := validator.ValidateSimulinkModel(testPath)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, testPath, result)
+		})
+	}
+}
+
+func TestValidator_ValidateSimulinkModel_NotSimulinkModel(t *testing.T) {
+	tests := []struct {
+		name     string
+		fileName string
+	}{
+		{
+			name:     "MATLAB file",
+			fileName: "script.m",
+		},
+		{
+			name:     "File without extension",
+			fileName: "model",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockOsLayer := &mocks.MockOSLayer{}
+			defer mockOsLayer.AssertExpectations(t)
+
+			mockFileLayer := &mocks.MockFileLayer{}
+			defer mockFileLayer.AssertExpectations(t)
+
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockClientRoots := &mocks.MockClientRoots{}
+			defer mockClientRoots.AssertExpectations(t)
+
+			validator := pathvalidator.New(mockOsLayer, mockFileLayer, mockConfig, mockClientRoots)
+
+			filePath, absErr := filepath.Abs(tt.fileName)
+			require.NoError(t, absErr)
+
+			// Act
+			_, err := validator.ValidateSimulinkModel(filePath)
+
+			// Assert
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestValidator_ValidateSimulinkModel_PathIsAFolder(t *testing.T) {
+	// Arrange
+	mockOsLayer := &mocks.MockOSLayer{}
+	defer mockOsLayer.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockClientRoots := &mocks.MockClientRoots{}
+	defer mockClientRoots.AssertExpectations(t)
+
+	mockFileInfo := &osfacademocks.MockFileInfo{}
+	defer mockFileInfo.AssertExpectations(t)
+
+	validator := pathvalidator.New(mockOsLayer, mockFileLayer, mockConfig, mockClientRoots)
+
+	testPath, absErr := filepath.Abs("folder.slx")
+	require.NoError(t, absErr)
+
+	mockOsLayer.EXPECT().
+		Stat(testPath).
+		Return(mockFileInfo, nil).
+		Once()
+
+	mockFileInfo.EXPECT().
+		IsDir().
+		Return(true).
+		Once()
+
+	// Act
+	_, err := validator.ValidateSimulinkModel(testPath)
+
+	// Assert
+	require.Error(t, err)
+}
+
 func TestValidator_ValidateFolderPath_HappyPath(t *testing.T) {
 	// Arrange
 	mockOsLayer := &mocks.MockOSLayer{}
 	defer mockOsLayer.AssertExpectations(t)
 
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockClientRoots := &mocks.MockClientRoots{}
+	defer mockClientRoots.AssertExpectations(t)
+
 	mockFileInfo := &osfacademocks.MockFileInfo{}
 	defer mockFileInfo.AssertExpectations(t)
 
-	validator := pathvalidator.New(mockOsLayer)
+	validator := pathvalidator.New(mockOsLayer, mockFileLayer, mockConfig, mockClientRoots)
 
 	testPath, absErr := filepath.Abs("./")
 	require.NoError(t, absErr)
@@ -267,6 +836,21 @@ func TestValidator_ValidateFolderPath_HappyPath(t *testing.T) {
 		Return(true).
 		Once()
 
+	mockFileLayer.EXPECT().
+		EvalSymlinks(testPath).
+		Return(testPath, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		AllowedRoots().
+		Return(nil).
+		Once()
+
+	mockClientRoots.EXPECT().
+		Roots().
+		Return([]string{}, false).
+		Once()
+
 	// Act
 	result, err := validator.ValidateFolderPath(testPath)
 
@@ -275,12 +859,79 @@ func TestValidator_ValidateFolderPath_HappyPath(t *testing.T) {
 	assert.Equal(t, testPath, result)
 }
 
+func TestValidator_ValidateFolderPath_OutsideAllowedRoots(t *testing.T) {
+	// Arrange
+	mockOsLayer := &mocks.MockOSLayer{}
+	defer mockOsLayer.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockClientRoots := &mocks.MockClientRoots{}
+	defer mockClientRoots.AssertExpectations(t)
+
+	mockFileInfo := &osfacademocks.MockFileInfo{}
+	defer mockFileInfo.AssertExpectations(t)
+
+	validator := pathvalidator.New(mockOsLayer, mockFileLayer, mockConfig, mockClientRoots)
+
+	allowedRoot, absErr := filepath.Abs("allowed")
+	require.NoError(t, absErr)
+
+	testPath, absErr := filepath.Abs("outside")
+	require.NoError(t, absErr)
+
+	mockOsLayer.EXPECT().
+		Stat(testPath).
+		Return(mockFileInfo, nil).
+		Once()
+
+	mockFileInfo.EXPECT().
+		IsDir().
+		Return(true).
+		Once()
+
+	mockConfig.EXPECT().
+		AllowedRoots().
+		Return([]string{allowedRoot}).
+		Once()
+
+	mockFileLayer.EXPECT().
+		EvalSymlinks(testPath).
+		Return(testPath, nil).
+		Once()
+
+	mockFileLayer.EXPECT().
+		EvalSymlinks(allowedRoot).
+		Return(allowedRoot, nil).
+		Once()
+
+	// Act
+	result, err := validator.ValidateFolderPath(testPath)
+
+	// Assert
+	require.EqualError(t, err, "path is outside the allowed roots: "+testPath)
+	assert.Empty(t, result)
+}
+
 func TestValidator_ValidateFolderPath_FailsForRelativePath(t *testing.T) {
 	// Arrange
 	mockOsLayer := &mocks.MockOSLayer{}
 	defer mockOsLayer.AssertExpectations(t)
 
-	validator := pathvalidator.New(mockOsLayer)
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockClientRoots := &mocks.MockClientRoots{}
+	defer mockClientRoots.AssertExpectations(t)
+
+	validator := pathvalidator.New(mockOsLayer, mockFileLayer, mockConfig, mockClientRoots)
 
 	testPath := filepath.Join(".", "relative", "folder")
 
@@ -296,10 +947,19 @@ func TestValidator_ValidateFolderPath_FailsForFilePath(t *testing.T) {
 	mockOsLayer := &mocks.MockOSLayer{}
 	defer mockOsLayer.AssertExpectations(t)
 
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockClientRoots := &mocks.MockClientRoots{}
+	defer mockClientRoots.AssertExpectations(t)
+
 	mockFileInfo := &osfacademocks.MockFileInfo{}
 	defer mockFileInfo.AssertExpectations(t)
 
-	validator := pathvalidator.New(mockOsLayer)
+	validator := pathvalidator.New(mockOsLayer, mockFileLayer, mockConfig, mockClientRoots)
 
 	testPath, absErr := filepath.Abs("test.m")
 	require.NoError(t, absErr)
@@ -326,6 +986,15 @@ func TestValidator_ValidateFolderPath_StatFails(t *testing.T) {
 	mockOsLayer := &mocks.MockOSLayer{}
 	defer mockOsLayer.AssertExpectations(t)
 
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockClientRoots := &mocks.MockClientRoots{}
+	defer mockClientRoots.AssertExpectations(t)
+
 	testPath, absErr := filepath.Abs("./")
 	require.NoError(t, absErr)
 
@@ -333,7 +1002,7 @@ func TestValidator_ValidateFolderPath_StatFails(t *testing.T) {
 		Stat(testPath).
 		Return(nil, os.ErrNotExist)
 
-	validator := pathvalidator.New(mockOsLayer)
+	validator := pathvalidator.New(mockOsLayer, mockFileLayer, mockConfig, mockClientRoots)
 
 	// Act
 	_, err := validator.ValidateFolderPath(testPath)
@@ -341,3 +1010,180 @@ func TestValidator_ValidateFolderPath_StatFails(t *testing.T) {
 	// Assert
 	require.Error(t, err)
 }
+
+func TestValidator_ValidateExistingPath_HappyPath(t *testing.T) {
+	testCases := []struct {
+		name  string
+		isDir bool
+	}{
+		{name: "file", isDir: false},
+		{name: "folder", isDir: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockOsLayer := &mocks.MockOSLayer{}
+			defer mockOsLayer.AssertExpectations(t)
+
+			mockFileLayer := &mocks.MockFileLayer{}
+			defer mockFileLayer.AssertExpectations(t)
+
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockClientRoots := &mocks.MockClientRoots{}
+			defer mockClientRoots.AssertExpectations(t)
+
+			mockFileInfo := &osfacademocks.MockFileInfo{}
+			defer mockFileInfo.AssertExpectations(t)
+
+			validator := pathvalidator.New(mockOsLayer, mockFileLayer, mockConfig, mockClientRoots)
+
+			testPath, absErr := filepath.Abs(tc.name)
+			require.NoError(t, absErr)
+
+			mockOsLayer.EXPECT().
+				Stat(testPath).
+				Return(mockFileInfo, nil).
+				Once()
+
+			mockFileLayer.EXPECT().
+				EvalSymlinks(testPath).
+				Return(testPath, nil).
+				Once()
+
+			mockConfig.EXPECT().
+				AllowedRoots().
+				Return(nil).
+				Once()
+
+			mockClientRoots.EXPECT().
+				Roots().
+				Return([]string{}, false).
+				Once()
+
+			// Act
+			result, err := validator.ValidateExistingPath(testPath)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, testPath, result)
+		})
+	}
+}
+
+func TestValidator_ValidateExistingPath_FailsForRelativePath(t *testing.T) {
+	// Arrange
+	mockOsLayer := &mocks.MockOSLayer{}
+	defer mockOsLayer.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockClientRoots := &mocks.MockClientRoots{}
+	defer mockClientRoots.AssertExpectations(t)
+
+	validator := pathvalidator.New(mockOsLayer, mockFileLayer, mockConfig, mockClientRoots)
+
+	testPath := filepath.Join(".", "relative", "folder")
+
+	// Act
+	_, err := validator.ValidateExistingPath(testPath)
+
+	// Assert
+	require.Error(t, err)
+}
+
+func TestValidator_ValidateExistingPath_StatFails(t *testing.T) {
+	// Arrange
+	mockOsLayer := &mocks.MockOSLayer{}
+	defer mockOsLayer.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockClientRoots := &mocks.MockClientRoots{}
+	defer mockClientRoots.AssertExpectations(t)
+
+	testPath, absErr := filepath.Abs("./")
+	require.NoError(t, absErr)
+
+	mockOsLayer.EXPECT().
+		Stat(testPath).
+		Return(nil, os.ErrNotExist).
+		Once()
+
+	validator := pathvalidator.New(mockOsLayer, mockFileLayer, mockConfig, mockClientRoots)
+
+	// Act
+	_, err := validator.ValidateExistingPath(testPath)
+
+	// Assert
+	require.ErrorContains(t, err, "resource not found")
+}
+
+func TestValidator_ResolvePath_HappyPath(t *testing.T) {
+	// Arrange
+	mockOsLayer := &mocks.MockOSLayer{}
+	defer mockOsLayer.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockClientRoots := &mocks.MockClientRoots{}
+	defer mockClientRoots.AssertExpectations(t)
+
+	folder, absErr := filepath.Abs("folder")
+	require.NoError(t, absErr)
+
+	resolvedFolder, absErr := filepath.Abs("resolved")
+	require.NoError(t, absErr)
+
+	mockFileLayer.EXPECT().
+		EvalSymlinks(filepath.Join(folder, "test.m")).
+		Return(filepath.Join(resolvedFolder, "test.m"), nil).
+		Once()
+
+	validator := pathvalidator.New(mockOsLayer, mockFileLayer, mockConfig, mockClientRoots)
+
+	// Act
+	result, err := validator.ResolvePath(filepath.Join(folder, "sub", "..", "test.m"))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(resolvedFolder, "test.m"), result)
+}
+
+func TestValidator_ResolvePath_FailsForRelativePath(t *testing.T) {
+	// Arrange
+	mockOsLayer := &mocks.MockOSLayer{}
+	defer mockOsLayer.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockClientRoots := &mocks.MockClientRoots{}
+	defer mockClientRoots.AssertExpectations(t)
+
+	validator := pathvalidator.New(mockOsLayer, mockFileLayer, mockConfig, mockClientRoots)
+
+	// Act
+	result, err := validator.ResolvePath(filepath.Join(".", "relative", "test.m"))
+
+	// Assert
+	require.Error(t, err)
+	assert.Empty(t, result)
+}
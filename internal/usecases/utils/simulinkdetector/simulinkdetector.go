@@ -0,0 +1,59 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package simulinkdetector
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+const isSimulinkAvailableFunction = "matlab_mcp.isSimulinkAvailable"
+
+var ErrSimulinkNotDetected = errors.New("the Simulink tools need Simulink, which is not installed in the MATLAB session")
+
+// Detector gates the Simulink tools on Simulink being installed in the MATLAB session.
+type Detector struct{}
+
+func New() *Detector {
+	return &Detector{}
+}
+
+// Check returns ErrSimulinkNotDetected when Simulink is not installed in the MATLAB session.
+// It runs before each call to the Simulink tools, so it only tests the Simulink license and function,
+// rather than listing all the installed products.
+func (d *Detector) Check(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient) error {
+	response, err := client.FEval(ctx, sessionLogger, entities.FEvalRequest{
+		Function:   isSimulinkAvailableFunction,
+		NumOutputs: 1,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(response.Outputs) != 1 {
+		return fmt.Errorf("unexpected number of outputs when detecting Simulink: %d", len(response.Outputs))
+	}
+
+	encodedAvailability, ok := response.Outputs[0].(string)
+	if !ok {
+		return fmt.Errorf("unexpected output type when detecting Simulink: %T", response.Outputs[0])
+	}
+
+	var availability struct {
+		Available bool `json:"available"`
+	}
+	if err := json.Unmarshal([]byte(encodedAvailability), &availability); err != nil {
+		return fmt.Errorf("failed to decode the Simulink availability: %w", err)
+	}
+
+	if !availability.Available {
+		sessionLogger.Warn("Simulink is not installed in the MATLAB session")
+		return ErrSimulinkNotDetected
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package simulinkdetector_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/simulinkdetector"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var isSimulinkAvailableRequest = entities.FEvalRequest{
+	Function:   "matlab_mcp.isSimulinkAvailable",
+	NumOutputs: 1,
+}
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+
+	// Act
+	detector := simulinkdetector.New()
+
+	// Assert
+	assert.NotNil(t, detector)
+}
+
+func TestDetector_Check_SimulinkInstalled(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), isSimulinkAvailableRequest).
+		Return(entities.FEvalResponse{Outputs: []any{`{"available":true}`}}, nil).
+		Once()
+
+	detector := simulinkdetector.New()
+
+	// Act
+	err := detector.Check(ctx, mockLogger, mockClient)
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestDetector_Check_SimulinkNotInstalled(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), isSimulinkAvailableRequest).
+		Return(entities.FEvalResponse{Outputs: []any{`{"available":false}`}}, nil).
+		Once()
+
+	detector := simulinkdetector.New()
+
+	// Act
+	err := detector.Check(ctx, mockLogger, mockClient)
+
+	// Assert
+	require.ErrorIs(t, err, simulinkdetector.ErrSimulinkNotDetected)
+}
+
+func TestDetector_Check_FEvalError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockClient.EXPECT().
+		FEval(ctx, mockLogger.AsMockArg(), isSimulinkAvailableRequest).
+		Return(entities.FEvalResponse{}, assert.AnError).
+		Once()
+
+	detector := simulinkdetector.New()
+
+	// Act
+	err := detector.Check(ctx, mockLogger, mockClient)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
+
+func TestDetector_Check_InvalidOutput(t *testing.T) {
+	testCases := []struct {
+		name          string
+		outputs       []any
+		expectedError string
+	}{
+		{
+			name:          "no output",
+			outputs:       []any{},
+			expectedError: "unexpected number of outputs when detecting Simulink: 0",
+		},
+		{
+			name:          "not a string",
+			outputs:       []any{true},
+			expectedError: "unexpected output type when detecting Simulink: bool",
+		},
+		{
+			name:          "not JSON",
+			outputs:       []any{"{"},
+			expectedError: "failed to decode the Simulink availability",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockClient := &entitiesmocks.MockMATLABSessionClient{}
+			defer mockClient.AssertExpectations(t)
+
+			ctx := t.Context()
+
+			mockClient.EXPECT().
+				FEval(ctx, mockLogger.AsMockArg(), isSimulinkAvailableRequest).
+				Return(entities.FEvalResponse{Outputs: tc.outputs}, nil).
+				Once()
+
+			detector := simulinkdetector.New()
+
+			// Act
+			err := detector.Check(ctx, mockLogger, mockClient)
+
+			// Assert
+			require.ErrorContains(t, err, tc.expectedError)
+		})
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package markdownfiles
+
+import (
+	"path/filepath"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
+)
+
+const markdownExtension = ".md"
+
+type OSLayer interface {
+	Stat(name string) (osfacade.FileInfo, error)
+}
+
+type FileLayer interface {
+	Glob(pattern string) ([]string, error)
+}
+
+// File is a file from the configuration, or a Markdown file of one of its folders.
+type File struct {
+	Path string
+	Info osfacade.FileInfo
+}
+
+// List returns the files at the given paths, with the folders expanded to the Markdown files they contain.
+// The paths which cannot be read are logged, then skipped.
+func List(logger entities.Logger, osLayer OSLayer, fileLayer FileLayer, paths []string) []File {
+	files := []File{}
+
+	for _, path := range paths {
+		info, err := osLayer.Stat(path)
+		if err != nil {
+			logger.WithError(err).With("path", path).Warn("Failed to read the file")
+			continue
+		}
+
+		if !info.IsDir() {
+			files = append(files, File{Path: path, Info: info})
+			continue
+		}
+
+		matches, err := fileLayer.Glob(filepath.Join(path, "*"+markdownExtension))
+		if err != nil {
+			logger.WithError(err).With("path", path).Warn("Failed to list the Markdown files of the folder")
+			continue
+		}
+
+		for _, match := range matches {
+			info, err := osLayer.Stat(match)
+			if err != nil {
+				logger.WithError(err).With("path", match).Warn("Failed to read the file")
+				continue
+			}
+
+			if info.IsDir() {
+				continue
+			}
+
+			files = append(files, File{Path: match, Info: info})
+		}
+	}
+
+	return files
+}
@@ -0,0 +1,170 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package markdownfiles_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/markdownfiles"
+	osfacademocks "github.com/matlab/matlab-mcp-core-server/mocks/facades/osfacade"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/utils/markdownfiles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestList_HappyPath(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockFileInfo := &osfacademocks.MockFileInfo{}
+	defer mockFileInfo.AssertExpectations(t)
+
+	mockFolderInfo := &osfacademocks.MockFileInfo{}
+	defer mockFolderInfo.AssertExpectations(t)
+
+	mockFolderFileInfo := &osfacademocks.MockFileInfo{}
+	defer mockFolderFileInfo.AssertExpectations(t)
+
+	mockSubfolderInfo := &osfacademocks.MockFileInfo{}
+	defer mockSubfolderInfo.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	filePath := filepath.Join("home", "user", "style.txt")
+	folderPath := filepath.Join("home", "user", "guidelines")
+	folderFilePath := filepath.Join(folderPath, "naming.md")
+	subfolderPath := filepath.Join(folderPath, "archive.md")
+
+	mockOSLayer.EXPECT().
+		Stat(filePath).
+		Return(mockFileInfo, nil).
+		Once()
+
+	mockFileInfo.EXPECT().IsDir().Return(false).Once()
+
+	mockOSLayer.EXPECT().
+		Stat(folderPath).
+		Return(mockFolderInfo, nil).
+		Once()
+
+	mockFolderInfo.EXPECT().IsDir().Return(true).Once()
+
+	mockFileLayer.EXPECT().
+		Glob(filepath.Join(folderPath, "*.md")).
+		Return([]string{folderFilePath, subfolderPath}, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat(folderFilePath).
+		Return(mockFolderFileInfo, nil).
+		Once()
+
+	mockFolderFileInfo.EXPECT().IsDir().Return(false).Once()
+
+	mockOSLayer.EXPECT().
+		Stat(subfolderPath).
+		Return(mockSubfolderInfo, nil).
+		Once()
+
+	mockSubfolderInfo.EXPECT().IsDir().Return(true).Once()
+
+	// Act
+	files := markdownfiles.List(mockLogger, mockOSLayer, mockFileLayer, []string{filePath, folderPath})
+
+	// Assert
+	assert.Equal(t, []markdownfiles.File{
+		{Path: filePath, Info: mockFileInfo},
+		{Path: folderFilePath, Info: mockFolderFileInfo},
+	}, files)
+	assert.Empty(t, mockLogger.WarnLogs())
+}
+
+func TestList_StatError(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockFolderInfo := &osfacademocks.MockFileInfo{}
+	defer mockFolderInfo.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	missingPath := filepath.Join("home", "user", "missing.md")
+	folderPath := filepath.Join("home", "user", "guidelines")
+	unreadablePath := filepath.Join(folderPath, "unreadable.md")
+
+	mockOSLayer.EXPECT().
+		Stat(missingPath).
+		Return(nil, assert.AnError).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat(folderPath).
+		Return(mockFolderInfo, nil).
+		Once()
+
+	mockFolderInfo.EXPECT().IsDir().Return(true).Once()
+
+	mockFileLayer.EXPECT().
+		Glob(filepath.Join(folderPath, "*.md")).
+		Return([]string{unreadablePath}, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat(unreadablePath).
+		Return(nil, assert.AnError).
+		Once()
+
+	// Act
+	files := markdownfiles.List(mockLogger, mockOSLayer, mockFileLayer, []string{missingPath, folderPath})
+
+	// Assert
+	assert.Empty(t, files)
+	warnLogs := mockLogger.WarnLogs()
+	require.Contains(t, warnLogs, "Failed to read the file")
+	assert.Equal(t, unreadablePath, warnLogs["Failed to read the file"]["path"])
+}
+
+func TestList_GlobError(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileLayer := &mocks.MockFileLayer{}
+	defer mockFileLayer.AssertExpectations(t)
+
+	mockFolderInfo := &osfacademocks.MockFileInfo{}
+	defer mockFolderInfo.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	folderPath := filepath.Join("home", "user", "guidelines")
+
+	mockOSLayer.EXPECT().
+		Stat(folderPath).
+		Return(mockFolderInfo, nil).
+		Once()
+
+	mockFolderInfo.EXPECT().IsDir().Return(true).Once()
+
+	mockFileLayer.EXPECT().
+		Glob(filepath.Join(folderPath, "*.md")).
+		Return(nil, assert.AnError).
+		Once()
+
+	// Act
+	files := markdownfiles.List(mockLogger, mockOSLayer, mockFileLayer, []string{folderPath})
+
+	// Assert
+	assert.Empty(t, files)
+	assert.Contains(t, mockLogger.WarnLogs(), "Failed to list the Markdown files of the folder")
+}
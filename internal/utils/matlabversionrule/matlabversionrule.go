@@ -0,0 +1,126 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabversionrule
+
+import (
+	"cmp"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+const (
+	latest        = "latest"
+	operatorEqual = "="
+)
+
+var rulePattern = regexp.MustCompile(`^(>=|<=|>|<|=)?\s*[Rr](\d{4})([abAB])(?:\s*[Uu]pdate\s*(\d+))?$`)
+
+// Rule selects a MATLAB installation from its release, such as "R2024b", ">=R2023a", "R2024b Update 3" or "latest".
+type Rule struct {
+	raw            string
+	operator       string
+	releaseFamily  string
+	updateLevel    int
+	hasUpdateLevel bool
+}
+
+// Parse creates a Rule from its string representation.
+func Parse(rule string) (Rule, error) {
+	trimmedRule := strings.TrimSpace(rule)
+
+	if strings.EqualFold(trimmedRule, latest) {
+		return Rule{raw: trimmedRule}, nil
+	}
+
+	matches := rulePattern.FindStringSubmatch(trimmedRule)
+	if matches == nil {
+		return Rule{}, fmt.Errorf("%q is not a valid MATLAB version, use a release such as R2024b, a comparison such as >=R2023a, or latest", rule)
+	}
+
+	parsedRule := Rule{
+		raw:           trimmedRule,
+		operator:      matches[1],
+		releaseFamily: "R" + matches[2] + strings.ToLower(matches[3]),
+	}
+
+	if parsedRule.operator == "" {
+		parsedRule.operator = operatorEqual
+	}
+
+	if matches[4] != "" {
+		updateLevel, err := strconv.Atoi(matches[4])
+		if err != nil {
+			return Rule{}, fmt.Errorf("%q has an invalid update level: %w", rule, err)
+		}
+		parsedRule.updateLevel = updateLevel
+		parsedRule.hasUpdateLevel = true
+	}
+
+	return parsedRule, nil
+}
+
+func (r Rule) String() string {
+	return r.raw
+}
+
+// Matches returns true if the MATLAB installation satisfies the rule.
+func (r Rule) Matches(environment entities.EnvironmentInfo) bool {
+	if r.operator == "" {
+		return true
+	}
+
+	comparison := strings.Compare(environment.Version, r.releaseFamily)
+	if comparison == 0 && r.hasUpdateLevel {
+		comparison = cmp.Compare(environment.UpdateLevel, r.updateLevel)
+	}
+
+	switch r.operator {
+	case ">=":
+		return comparison >= 0
+	case "<=":
+		return comparison <= 0
+	case ">":
+		return comparison > 0
+	case "<":
+		return comparison < 0
+	default:
+		return comparison == 0
+	}
+}
+
+// Select returns the most recent MATLAB installation that satisfies the rule.
+// General releases are preferred over prereleases, even of a more recent release family,
+// so a prerelease is only selected when no general release satisfies the rule.
+func (r Rule) Select(environments []entities.EnvironmentInfo) (entities.EnvironmentInfo, bool) {
+	var selected entities.EnvironmentInfo
+	found := false
+
+	for _, environment := range environments {
+		if !r.Matches(environment) {
+			continue
+		}
+
+		if !found || isMoreRecent(environment, selected) {
+			selected = environment
+			found = true
+		}
+	}
+
+	return selected, found
+}
+
+func isMoreRecent(environment entities.EnvironmentInfo, other entities.EnvironmentInfo) bool {
+	if environment.IsPrerelease != other.IsPrerelease {
+		return !environment.IsPrerelease
+	}
+
+	if environment.Version != other.Version {
+		return environment.Version > other.Version
+	}
+
+	return environment.UpdateLevel > other.UpdateLevel
+}
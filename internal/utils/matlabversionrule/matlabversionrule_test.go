@@ -0,0 +1,166 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabversionrule_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/matlabversionrule"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse_HappyPath(t *testing.T) {
+	testCases := []string{
+		"latest",
+		"LATEST",
+		"R2024b",
+		"r2024B",
+		"=R2024b",
+		">=R2023a",
+		"<= R2023a",
+		">R2023a",
+		"<R2023a",
+		"R2024b Update 3",
+		">=R2024bUpdate3",
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc, func(t *testing.T) {
+			// Act
+			rule, err := matlabversionrule.Parse(tc)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tc, rule.String())
+		})
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	testCases := []string{
+		"",
+		"2024b",
+		"R2024",
+		"R2024c",
+		"=>R2024b",
+		"R2024b Update",
+		"newest",
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc, func(t *testing.T) {
+			// Act
+			_, err := matlabversionrule.Parse(tc)
+
+			// Assert
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestRule_Matches(t *testing.T) {
+	environment := entities.EnvironmentInfo{
+		Version:     "R2024a",
+		UpdateLevel: 2,
+	}
+
+	testCases := []struct {
+		rule     string
+		expected bool
+	}{
+		{rule: "latest", expected: true},
+		{rule: "R2024a", expected: true},
+		{rule: "R2024b", expected: false},
+		{rule: ">=R2024a", expected: true},
+		{rule: ">R2024a", expected: false},
+		{rule: "<R2024b", expected: true},
+		{rule: "<=R2023b", expected: false},
+		{rule: "R2024a Update 2", expected: true},
+		{rule: "R2024a Update 3", expected: false},
+		{rule: ">=R2024a Update 3", expected: false},
+		{rule: ">R2024a Update 1", expected: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.rule, func(t *testing.T) {
+			// Arrange
+			rule, err := matlabversionrule.Parse(tc.rule)
+			require.NoError(t, err)
+
+			// Act
+			result := rule.Matches(environment)
+
+			// Assert
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestRule_Select_NoEnvironments(t *testing.T) {
+	// Arrange
+	rule, err := matlabversionrule.Parse("latest")
+	require.NoError(t, err)
+
+	// Act
+	_, found := rule.Select(nil)
+
+	// Assert
+	assert.False(t, found)
+}
+
+func TestRule_Select(t *testing.T) {
+	r2024b := entities.EnvironmentInfo{MATLABRoot: filepath.Join("opt", "MATLAB", "R2024b"), Version: "R2024b"}
+	r2025aUpdate2 := entities.EnvironmentInfo{MATLABRoot: filepath.Join("opt", "MATLAB", "R2025a"), Version: "R2025a", UpdateLevel: 2}
+	r2025aUpdate1 := entities.EnvironmentInfo{MATLABRoot: filepath.Join("home", "user", "MATLAB", "R2025a"), Version: "R2025a", UpdateLevel: 1}
+	r2025bPrerelease := entities.EnvironmentInfo{MATLABRoot: filepath.Join("opt", "MATLAB", "R2025b_Prerelease"), Version: "R2025b", IsPrerelease: true}
+
+	testCases := []struct {
+		name         string
+		rule         string
+		environments []entities.EnvironmentInfo
+		expected     entities.EnvironmentInfo
+	}{
+		{
+			name:         "latest prefers the most recent update",
+			rule:         "latest",
+			environments: []entities.EnvironmentInfo{r2024b, r2025aUpdate1, r2025aUpdate2},
+			expected:     r2025aUpdate2,
+		},
+		{
+			name:         "latest prefers a general release over a more recent prerelease",
+			rule:         "latest",
+			environments: []entities.EnvironmentInfo{r2025bPrerelease, r2024b, r2025aUpdate1},
+			expected:     r2025aUpdate1,
+		},
+		{
+			name:         "comparison prefers a general release over a more recent prerelease",
+			rule:         ">=R2025a",
+			environments: []entities.EnvironmentInfo{r2025bPrerelease, r2025aUpdate1},
+			expected:     r2025aUpdate1,
+		},
+		{
+			name:         "prerelease when no general release matches",
+			rule:         "R2025b",
+			environments: []entities.EnvironmentInfo{r2025bPrerelease, r2025aUpdate2},
+			expected:     r2025bPrerelease,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			rule, err := matlabversionrule.Parse(tc.rule)
+			require.NoError(t, err)
+
+			// Act
+			environment, found := rule.Select(tc.environments)
+
+			// Assert
+			require.True(t, found)
+			assert.Equal(t, tc.expected, environment)
+		})
+	}
+}
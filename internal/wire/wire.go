@@ -23,40 +23,104 @@ import (
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession/directorymanager/matlabfiles"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession/processdetails"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession/processlauncher"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/remotematlabsession"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/sharedmatlabsession"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/matlablocator"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/matlablocator/matlabinstallation"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/matlablocator/matlabroot"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/matlablocator/matlabversion"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionpool"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionstore"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/clientroots"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/completion"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/completion/matlabfunctions"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/completion/sessionids"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/completion/testnames"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/elicitation"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/baseprompt"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/customprompts"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/debugfailingtests"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/reviewcode"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/vectorizeloop"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/writeandtestfunction"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server/configurator"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
 	evalmatlabcodemultisessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/evalmatlabcode"
 	listavailablematlabstool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/listavailablematlabs"
+	resetmatlabstatemultisessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/resetmatlabstate"
+	restartmatlabsessionmultisessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/restartmatlabsession"
 	startmatlabsessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/startmatlabsession"
 	stopmatlabsessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/stopmatlabsession"
+	analyzematlabdependenciessinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/analyzematlabdependencies"
+	benchmarkmatlabcodesinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/benchmarkmatlabcode"
 	checkmatlabcodesinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/checkmatlabcode"
+	checksimulinkmodelsinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/checksimulinkmodel"
+	clearmatlabbreakpointssinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/clearmatlabbreakpoints"
+	controlmatlabdebuggersinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/controlmatlabdebugger"
+	debugmatlabcodesinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/debugmatlabcode"
 	detectmatlabtoolboxessinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/detectmatlabtoolboxes"
 	evalmatlabcodesinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/evalmatlabcode"
+	listsimulinkblockssinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/listsimulinkblocks"
+	loadsimulinkmodelsinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/loadsimulinkmodel"
+	profilematlabcodesinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/profilematlabcode"
+	resetmatlabstatesinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/resetmatlabstate"
+	restartmatlabsessionsinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/restartmatlabsession"
 	runmatlabfilesinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runmatlabfile"
 	runmatlabtestfilesinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runmatlabtestfile"
+	setmatlabbreakpointsinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/setmatlabbreakpoint"
+	setsimulinkblockparameterssinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/setsimulinkblockparameters"
+	simulatesimulinkmodelsinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/simulatesimulinkmodel"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/baseresource"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/baseresource/sessionresolver"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/codingguidelines"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/customcodingguidelines"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabdocumentation"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabsessionfigure"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabsessionlog"
+	matlabsessionpoolresource "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabsessionpool"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabsessionworkspace"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool/approval"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool/audit"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool/debugguard"
 	watchdogclient "github.com/matlab/matlab-mcp-core-server/internal/adaptors/watchdog"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/watchdog/process"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/facades/filefacade"
 	"github.com/matlab/matlab-mcp-core-server/internal/facades/iofacade"
 	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/analyzematlabdependencies"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/benchmarkmatlabcode"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/checkmatlabcode"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/checksimulinkmodel"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/clearmatlabbreakpoints"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/controlmatlabdebugger"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/debugmatlabcode"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/detectmatlabtoolboxes"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/evalmatlabcode"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/findmatlabfunctions"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getmatlabdocumentation"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getmatlabfigure"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listavailablematlabs"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listmatlabworkspace"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listsimulinkblocks"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/loadsimulinkmodel"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/profilematlabcode"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/readmatlabsessionlog"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/resetmatlabstate"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/restartmatlabsession"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/runmatlabfile"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/runmatlabtestfile"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/setmatlabbreakpoint"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/setsimulinkblockparameters"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/simulatesimulinkmodel"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/startmatlabsession"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/stopmatlabsession"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/codesafety"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/debugsession"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/pathvalidator"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/simulinkdetector"
 	"github.com/matlab/matlab-mcp-core-server/internal/utils/httpclientfactory"
 	"github.com/matlab/matlab-mcp-core-server/internal/utils/ossignaler"
 	"github.com/matlab/matlab-mcp-core-server/internal/utils/oswrapper"
@@ -119,6 +183,7 @@ func initializeOrchestrator() (*orchestrator.Orchestrator, error) {
 		wire.Bind(new(orchestrator.OSSignaler), new(*ossignaler.OSSignaler)),
 		wire.Bind(new(orchestrator.GlobalMATLAB), new(*globalmatlab.GlobalMATLAB)),
 		wire.Bind(new(orchestrator.Directory), new(*directory.Directory)),
+		wire.Bind(new(orchestrator.MATLABManager), new(*matlabmanager.MATLABManager)),
 
 		// Watchdog Client
 		watchdogclient.New,
@@ -138,11 +203,35 @@ func initializeOrchestrator() (*orchestrator.Orchestrator, error) {
 		// MCP Server
 		server.NewMCPSDKServer,
 		wire.Bind(new(server.ServerConfig), new(*config.Config)),
+		wire.Bind(new(server.ClientRootsHandler), new(*clientroots.ClientRoots)),
+		wire.Bind(new(server.CompletionHandler), new(*completion.Completion)),
 		server.New,
 		wire.Bind(new(server.LoggerFactory), new(*logger.Factory)),
 		wire.Bind(new(server.LifecycleSignaler), new(*lifecyclesignaler.LifecycleSignaler)),
 		wire.Bind(new(server.MCPServerConfigurator), new(*configurator.Configurator)),
 
+		// MCP Client Roots
+		clientroots.New,
+		wire.Bind(new(clientroots.LoggerFactory), new(*logger.Factory)),
+		wire.Bind(new(clientroots.Config), new(*config.Config)),
+
+		// MCP Elicitation
+		elicitation.New,
+
+		// MCP Completion
+		completion.New,
+		wire.Bind(new(completion.LoggerFactory), new(*logger.Factory)),
+		matlabfunctions.New,
+		wire.Bind(new(matlabfunctions.Config), new(*config.Config)),
+		wire.Bind(new(matlabfunctions.Usecase), new(*findmatlabfunctions.Usecase)),
+		wire.Bind(new(matlabfunctions.GlobalMATLAB), new(*globalmatlab.GlobalMATLAB)),
+		wire.Bind(new(matlabfunctions.DebugSession), new(*debugsession.Session)),
+		sessionids.New,
+		wire.Bind(new(sessionids.SessionStore), new(*matlabsessionstore.Store)),
+		testnames.New,
+		wire.Bind(new(testnames.PathValidator), new(*pathvalidator.PathValidator)),
+		wire.Bind(new(testnames.OSLayer), new(*osfacade.OsFacade)),
+
 		// MCP Server Configurator
 		configurator.New,
 		wire.Bind(new(configurator.Config), new(*config.Config)),
@@ -150,6 +239,19 @@ func initializeOrchestrator() (*orchestrator.Orchestrator, error) {
 		// Tools
 		wire.Bind(new(basetool.LoggerFactory), new(*logger.Factory)),
 
+		audit.New,
+		wire.Bind(new(audit.Config), new(*config.Config)),
+		wire.Bind(new(audit.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(audit.PathResolver), new(*pathvalidator.PathValidator)),
+		wire.Bind(new(audit.LifecycleSignaler), new(*lifecyclesignaler.LifecycleSignaler)),
+
+		approval.New,
+		wire.Bind(new(approval.Config), new(*config.Config)),
+		wire.Bind(new(approval.Confirmer), new(*elicitation.Elicitor)),
+
+		debugguard.New,
+		wire.Bind(new(debugguard.DebugSession), new(*debugsession.Session)),
+
 		listavailablematlabstool.New,
 		wire.Bind(new(listavailablematlabstool.Usecase), new(*listavailablematlabs.Usecase)),
 
@@ -162,6 +264,9 @@ func initializeOrchestrator() (*orchestrator.Orchestrator, error) {
 		evalmatlabcodemultisessiontool.New,
 		wire.Bind(new(evalmatlabcodemultisessiontool.Usecase), new(*evalmatlabcode.Usecase)),
 
+		restartmatlabsessionmultisessiontool.New,
+		wire.Bind(new(restartmatlabsessionmultisessiontool.Usecase), new(*restartmatlabsession.Usecase)),
+
 		evalmatlabcodesinglesessiontool.New,
 		wire.Bind(new(evalmatlabcodesinglesessiontool.Usecase), new(*evalmatlabcode.Usecase)),
 
@@ -177,9 +282,86 @@ func initializeOrchestrator() (*orchestrator.Orchestrator, error) {
 		runmatlabtestfilesinglesessiontool.New,
 		wire.Bind(new(runmatlabtestfilesinglesessiontool.Usecase), new(*runmatlabtestfile.Usecase)),
 
+		restartmatlabsessionsinglesessiontool.New,
+		wire.Bind(new(restartmatlabsessionsinglesessiontool.Usecase), new(*restartmatlabsession.Usecase)),
+		wire.Bind(new(restartmatlabsessionsinglesessiontool.DebugSession), new(*debugsession.Session)),
+
+		resetmatlabstatemultisessiontool.New,
+		wire.Bind(new(resetmatlabstatemultisessiontool.Usecase), new(*resetmatlabstate.Usecase)),
+
+		resetmatlabstatesinglesessiontool.New,
+		wire.Bind(new(resetmatlabstatesinglesessiontool.Usecase), new(*resetmatlabstate.Usecase)),
+
+		analyzematlabdependenciessinglesessiontool.New,
+		wire.Bind(new(analyzematlabdependenciessinglesessiontool.Usecase), new(*analyzematlabdependencies.Usecase)),
+
+		setmatlabbreakpointsinglesessiontool.New,
+		wire.Bind(new(setmatlabbreakpointsinglesessiontool.Usecase), new(*setmatlabbreakpoint.Usecase)),
+
+		clearmatlabbreakpointssinglesessiontool.New,
+		wire.Bind(new(clearmatlabbreakpointssinglesessiontool.Usecase), new(*clearmatlabbreakpoints.Usecase)),
+
+		debugmatlabcodesinglesessiontool.New,
+		wire.Bind(new(debugmatlabcodesinglesessiontool.Usecase), new(*debugmatlabcode.Usecase)),
+
+		controlmatlabdebuggersinglesessiontool.New,
+		wire.Bind(new(controlmatlabdebuggersinglesessiontool.Usecase), new(*controlmatlabdebugger.Usecase)),
+		profilematlabcodesinglesessiontool.New,
+		wire.Bind(new(profilematlabcodesinglesessiontool.Usecase), new(*profilematlabcode.Usecase)),
+		benchmarkmatlabcodesinglesessiontool.New,
+		wire.Bind(new(benchmarkmatlabcodesinglesessiontool.Usecase), new(*benchmarkmatlabcode.Usecase)),
+		loadsimulinkmodelsinglesessiontool.New,
+		wire.Bind(new(loadsimulinkmodelsinglesessiontool.Usecase), new(*loadsimulinkmodel.Usecase)),
+		listsimulinkblockssinglesessiontool.New,
+		wire.Bind(new(listsimulinkblockssinglesessiontool.Usecase), new(*listsimulinkblocks.Usecase)),
+		setsimulinkblockparameterssinglesessiontool.New,
+		wire.Bind(new(setsimulinkblockparameterssinglesessiontool.Usecase), new(*setsimulinkblockparameters.Usecase)),
+		simulatesimulinkmodelsinglesessiontool.New,
+		wire.Bind(new(simulatesimulinkmodelsinglesessiontool.Usecase), new(*simulatesimulinkmodel.Usecase)),
+		checksimulinkmodelsinglesessiontool.New,
+		wire.Bind(new(checksimulinkmodelsinglesessiontool.Usecase), new(*checksimulinkmodel.Usecase)),
+
 		// Resources
 		wire.Bind(new(baseresource.LoggerFactory), new(*logger.Factory)),
 		codingguidelines.New,
+		customcodingguidelines.New,
+		wire.Bind(new(customcodingguidelines.Config), new(*config.Config)),
+		wire.Bind(new(customcodingguidelines.LoggerFactory), new(*logger.Factory)),
+		wire.Bind(new(customcodingguidelines.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(customcodingguidelines.FileLayer), new(*filefacade.FileFacade)),
+		wire.Bind(new(customcodingguidelines.LifecycleSignaler), new(*lifecyclesignaler.LifecycleSignaler)),
+		matlabdocumentation.New,
+		wire.Bind(new(matlabdocumentation.Usecase), new(*getmatlabdocumentation.Usecase)),
+		wire.Bind(new(matlabdocumentation.SessionResolver), new(*sessionresolver.Resolver)),
+		matlabsessionpoolresource.New,
+		wire.Bind(new(matlabsessionpoolresource.SessionPool), new(*matlabsessionpool.Pool)),
+		matlabsessionlog.NewStdout,
+		matlabsessionlog.NewStderr,
+		wire.Bind(new(matlabsessionlog.Usecase), new(*readmatlabsessionlog.Usecase)),
+		wire.Bind(new(matlabsessionlog.SessionResolver), new(*sessionresolver.Resolver)),
+		matlabsessionfigure.New,
+		wire.Bind(new(matlabsessionfigure.Usecase), new(*getmatlabfigure.Usecase)),
+		wire.Bind(new(matlabsessionfigure.SessionResolver), new(*sessionresolver.Resolver)),
+		matlabsessionworkspace.New,
+		wire.Bind(new(matlabsessionworkspace.Usecase), new(*listmatlabworkspace.Usecase)),
+		wire.Bind(new(matlabsessionworkspace.SessionResolver), new(*sessionresolver.Resolver)),
+		sessionresolver.New,
+		wire.Bind(new(sessionresolver.Config), new(*config.Config)),
+		wire.Bind(new(sessionresolver.GlobalMATLAB), new(*globalmatlab.GlobalMATLAB)),
+		wire.Bind(new(sessionresolver.MATLABManager), new(*matlabmanager.MATLABManager)),
+		wire.Bind(new(sessionresolver.DebugSession), new(*debugsession.Session)),
+
+		// Prompts
+		wire.Bind(new(baseprompt.LoggerFactory), new(*logger.Factory)),
+		writeandtestfunction.New,
+		debugfailingtests.New,
+		vectorizeloop.New,
+		reviewcode.New,
+		customprompts.New,
+		wire.Bind(new(customprompts.Config), new(*config.Config)),
+		wire.Bind(new(customprompts.LoggerFactory), new(*logger.Factory)),
+		wire.Bind(new(customprompts.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(customprompts.FileLayer), new(*filefacade.FileFacade)),
 
 		// Use Cases
 		listavailablematlabs.New,
@@ -187,6 +369,7 @@ func initializeOrchestrator() (*orchestrator.Orchestrator, error) {
 		stopmatlabsession.New,
 		evalmatlabcode.New,
 		wire.Bind(new(evalmatlabcode.PathValidator), new(*pathvalidator.PathValidator)),
+		wire.Bind(new(evalmatlabcode.CodeSafetyPolicy), new(*codesafety.Policy)),
 		checkmatlabcode.New,
 		wire.Bind(new(checkmatlabcode.PathValidator), new(*pathvalidator.PathValidator)),
 		detectmatlabtoolboxes.New,
@@ -194,10 +377,59 @@ func initializeOrchestrator() (*orchestrator.Orchestrator, error) {
 		wire.Bind(new(runmatlabfile.PathValidator), new(*pathvalidator.PathValidator)),
 		runmatlabtestfile.New,
 		wire.Bind(new(runmatlabtestfile.PathValidator), new(*pathvalidator.PathValidator)),
+		restartmatlabsession.New,
+		resetmatlabstate.New,
+		analyzematlabdependencies.New,
+		wire.Bind(new(analyzematlabdependencies.PathValidator), new(*pathvalidator.PathValidator)),
+		getmatlabdocumentation.New,
+		findmatlabfunctions.New,
+		readmatlabsessionlog.New,
+		wire.Bind(new(readmatlabsessionlog.OSLayer), new(*osfacade.OsFacade)),
+		getmatlabfigure.New,
+		listmatlabworkspace.New,
+		setmatlabbreakpoint.New,
+		wire.Bind(new(setmatlabbreakpoint.PathValidator), new(*pathvalidator.PathValidator)),
+		wire.Bind(new(setmatlabbreakpoint.CodeSafetyPolicy), new(*codesafety.Policy)),
+		clearmatlabbreakpoints.New,
+		wire.Bind(new(clearmatlabbreakpoints.PathValidator), new(*pathvalidator.PathValidator)),
+		debugmatlabcode.New,
+		wire.Bind(new(debugmatlabcode.PathValidator), new(*pathvalidator.PathValidator)),
+		wire.Bind(new(debugmatlabcode.CodeSafetyPolicy), new(*codesafety.Policy)),
+		wire.Bind(new(debugmatlabcode.DebugSession), new(*debugsession.Session)),
+		controlmatlabdebugger.New,
+		wire.Bind(new(controlmatlabdebugger.DebugSession), new(*debugsession.Session)),
+		profilematlabcode.New,
+		wire.Bind(new(profilematlabcode.PathValidator), new(*pathvalidator.PathValidator)),
+		wire.Bind(new(profilematlabcode.CodeSafetyPolicy), new(*codesafety.Policy)),
+		benchmarkmatlabcode.New,
+		wire.Bind(new(benchmarkmatlabcode.PathValidator), new(*pathvalidator.PathValidator)),
+		wire.Bind(new(benchmarkmatlabcode.CodeSafetyPolicy), new(*codesafety.Policy)),
+		loadsimulinkmodel.New,
+		wire.Bind(new(loadsimulinkmodel.PathValidator), new(*pathvalidator.PathValidator)),
+		wire.Bind(new(loadsimulinkmodel.SimulinkDetector), new(*simulinkdetector.Detector)),
+		listsimulinkblocks.New,
+		wire.Bind(new(listsimulinkblocks.SimulinkDetector), new(*simulinkdetector.Detector)),
+		setsimulinkblockparameters.New,
+		wire.Bind(new(setsimulinkblockparameters.CodeSafetyPolicy), new(*codesafety.Policy)),
+		wire.Bind(new(setsimulinkblockparameters.SimulinkDetector), new(*simulinkdetector.Detector)),
+		simulatesimulinkmodel.New,
+		wire.Bind(new(simulatesimulinkmodel.CodeSafetyPolicy), new(*codesafety.Policy)),
+		wire.Bind(new(simulatesimulinkmodel.SimulinkDetector), new(*simulinkdetector.Detector)),
+		checksimulinkmodel.New,
+		wire.Bind(new(checksimulinkmodel.PathValidator), new(*pathvalidator.PathValidator)),
+		wire.Bind(new(checksimulinkmodel.SimulinkDetector), new(*simulinkdetector.Detector)),
 
 		// Use Cases Utilities
 		pathvalidator.New,
 		wire.Bind(new(pathvalidator.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(pathvalidator.FileLayer), new(*filefacade.FileFacade)),
+		wire.Bind(new(pathvalidator.Config), new(*config.Config)),
+		wire.Bind(new(pathvalidator.ClientRoots), new(*clientroots.ClientRoots)),
+		codesafety.New,
+		wire.Bind(new(codesafety.Config), new(*config.Config)),
+		wire.Bind(new(codesafety.Confirmer), new(*elicitation.Elicitor)),
+		debugsession.New,
+		simulinkdetector.New,
 
 		// Entities
 		wire.Bind(new(entities.GlobalMATLAB), new(*globalmatlab.GlobalMATLAB)),
@@ -208,6 +440,14 @@ func initializeOrchestrator() (*orchestrator.Orchestrator, error) {
 		wire.Bind(new(matlabmanager.MATLABServices), new(*matlabservices.MATLABServices)),
 		wire.Bind(new(matlabmanager.MATLABSessionStore), new(*matlabsessionstore.Store)),
 		wire.Bind(new(matlabmanager.MATLABSessionClientFactory), new(*matlabsessionclient.Factory)),
+		wire.Bind(new(matlabmanager.MATLABSessionPool), new(*matlabsessionpool.Pool)),
+
+		// MATLAB Session Pool
+		matlabsessionpool.New,
+		wire.Bind(new(matlabsessionpool.Config), new(*config.Config)),
+		wire.Bind(new(matlabsessionpool.LoggerFactory), new(*logger.Factory)),
+		wire.Bind(new(matlabsessionpool.LifecycleSignaler), new(*lifecyclesignaler.LifecycleSignaler)),
+		wire.Bind(new(matlabsessionpool.FileLayer), new(*filefacade.FileFacade)),
 
 		// MATLAB Session Store
 		matlabsessionstore.New,
@@ -223,6 +463,7 @@ func initializeOrchestrator() (*orchestrator.Orchestrator, error) {
 		wire.Bind(new(globalmatlab.MATLABManager), new(*matlabmanager.MATLABManager)),
 		wire.Bind(new(globalmatlab.MATLABRootSelector), new(*matlabrootselector.MATLABRootSelector)),
 		wire.Bind(new(globalmatlab.MATLABStartingDirSelector), new(*matlabstartingdirselector.MATLABStartingDirSelector)),
+		wire.Bind(new(globalmatlab.Config), new(*config.Config)),
 
 		// MATLAB Root Selector
 		matlabrootselector.New,
@@ -238,11 +479,14 @@ func initializeOrchestrator() (*orchestrator.Orchestrator, error) {
 		matlabservices.New,
 		wire.Bind(new(matlabservices.MATLABLocator), new(*matlablocator.MATLABLocator)),
 		wire.Bind(new(matlabservices.LocalMATLABSessionLauncher), new(*localmatlabsession.Starter)),
+		wire.Bind(new(matlabservices.SharedMATLABSessionAttacher), new(*sharedmatlabsession.Attacher)),
+		wire.Bind(new(matlabservices.RemoteMATLABSessionConnector), new(*remotematlabsession.Connector)),
 
 		// MATLAB Locator
 		matlablocator.New,
 		wire.Bind(new(matlablocator.MATLABRootGetter), new(*matlabroot.Getter)),
 		wire.Bind(new(matlablocator.MATLABVersionGetter), new(*matlabversion.Getter)),
+		wire.Bind(new(matlablocator.MATLABInstallationGetter), new(*matlabinstallation.Getter)),
 
 		// Local MATLAB Session
 		localmatlabsession.NewStarter,
@@ -251,9 +495,19 @@ func initializeOrchestrator() (*orchestrator.Orchestrator, error) {
 		wire.Bind(new(localmatlabsession.MATLABProcessLauncher), new(*processlauncher.MATLABProcessLauncher)),
 		wire.Bind(new(localmatlabsession.Watchdog), new(*watchdogclient.Watchdog)),
 
+		// Shared MATLAB Session
+		sharedmatlabsession.NewAttacher,
+		wire.Bind(new(sharedmatlabsession.SessionDirectoryFactory), new(*directorymanager.DirectoryFactory)),
+
+		// Remote MATLAB Session
+		remotematlabsession.NewConnector,
+		wire.Bind(new(remotematlabsession.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(remotematlabsession.MATLABFiles), new(matlabfiles.MATLABFiles)),
+
 		// Local MATLAB Session Directory Manager
 		directorymanager.NewFactory,
 		wire.Bind(new(directorymanager.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(directorymanager.OSWrapper), new(*oswrapper.OSWrapper)),
 		wire.Bind(new(directorymanager.ApplicationDirectory), new(*directory.Directory)),
 		wire.Bind(new(directorymanager.MATLABFiles), new(matlabfiles.MATLABFiles)),
 
@@ -267,6 +521,7 @@ func initializeOrchestrator() (*orchestrator.Orchestrator, error) {
 		wire.NewSet(
 			// MATLAB Root Getter
 			matlabroot.New,
+			wire.Bind(new(matlabroot.Config), new(*config.Config)),
 			wire.Bind(new(matlabroot.OSLayer), new(*osfacade.OsFacade)),
 			wire.Bind(new(matlabroot.FileLayer), new(*filefacade.FileFacade)),
 
@@ -275,6 +530,11 @@ func initializeOrchestrator() (*orchestrator.Orchestrator, error) {
 			wire.Bind(new(matlabversion.OSLayer), new(*osfacade.OsFacade)),
 			wire.Bind(new(matlabversion.IOLayer), new(*iofacade.IoFacade)),
 
+			// MATLAB Installation Getter
+			matlabinstallation.New,
+			wire.Bind(new(matlabinstallation.OSLayer), new(*osfacade.OsFacade)),
+			wire.Bind(new(matlabinstallation.FileLayer), new(*filefacade.FileFacade)),
+
 			// MATLAB Files Provider
 			matlabfiles.New,
 
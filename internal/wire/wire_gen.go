@@ -25,37 +25,100 @@ import (
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession/processdetails"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession/processlauncher"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/matlablocator"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/matlablocator/matlabinstallation"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/matlablocator/matlabroot"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/matlablocator/matlabversion"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/remotematlabsession"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/sharedmatlabsession"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionpool"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionstore"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/clientroots"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/completion"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/completion/matlabfunctions"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/completion/sessionids"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/completion/testnames"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/elicitation"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/customprompts"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/debugfailingtests"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/reviewcode"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/vectorizeloop"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/writeandtestfunction"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/baseresource/sessionresolver"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/codingguidelines"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/customcodingguidelines"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabdocumentation"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabsessionfigure"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabsessionlog"
+	matlabsessionpool2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabsessionpool"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/matlabsessionworkspace"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server/configurator"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool/approval"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool/audit"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool/debugguard"
 	evalmatlabcode2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/evalmatlabcode"
 	listavailablematlabs2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/listavailablematlabs"
+	resetmatlabstate2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/resetmatlabstate"
+	restartmatlabsession2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/restartmatlabsession"
 	startmatlabsession2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/startmatlabsession"
 	stopmatlabsession2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/stopmatlabsession"
+	analyzematlabdependencies2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/analyzematlabdependencies"
+	benchmarkmatlabcode2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/benchmarkmatlabcode"
 	checkmatlabcode2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/checkmatlabcode"
+	checksimulinkmodel2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/checksimulinkmodel"
+	clearmatlabbreakpoints2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/clearmatlabbreakpoints"
+	controlmatlabdebugger2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/controlmatlabdebugger"
+	debugmatlabcode2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/debugmatlabcode"
 	detectmatlabtoolboxes2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/detectmatlabtoolboxes"
 	evalmatlabcode3 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/evalmatlabcode"
+	listsimulinkblocks2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/listsimulinkblocks"
+	loadsimulinkmodel2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/loadsimulinkmodel"
+	profilematlabcode2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/profilematlabcode"
+	resetmatlabstate3 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/resetmatlabstate"
+	restartmatlabsession3 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/restartmatlabsession"
 	runmatlabfile2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runmatlabfile"
 	runmatlabtestfile2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runmatlabtestfile"
+	setmatlabbreakpoint2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/setmatlabbreakpoint"
+	setsimulinkblockparameters2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/setsimulinkblockparameters"
+	simulatesimulinkmodel2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/simulatesimulinkmodel"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/watchdog"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/watchdog/process"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/facades/filefacade"
 	"github.com/matlab/matlab-mcp-core-server/internal/facades/iofacade"
 	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/analyzematlabdependencies"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/benchmarkmatlabcode"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/checkmatlabcode"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/checksimulinkmodel"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/clearmatlabbreakpoints"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/controlmatlabdebugger"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/debugmatlabcode"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/detectmatlabtoolboxes"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/evalmatlabcode"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/findmatlabfunctions"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getmatlabdocumentation"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/getmatlabfigure"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listavailablematlabs"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listmatlabworkspace"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listsimulinkblocks"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/loadsimulinkmodel"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/profilematlabcode"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/readmatlabsessionlog"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/resetmatlabstate"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/restartmatlabsession"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/runmatlabfile"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/runmatlabtestfile"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/setmatlabbreakpoint"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/setsimulinkblockparameters"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/simulatesimulinkmodel"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/startmatlabsession"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/stopmatlabsession"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/codesafety"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/debugsession"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/pathvalidator"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/simulinkdetector"
 	"github.com/matlab/matlab-mcp-core-server/internal/utils/httpclientfactory"
 	"github.com/matlab/matlab-mcp-core-server/internal/utils/ossignaler"
 	"github.com/matlab/matlab-mcp-core-server/internal/utils/oswrapper"
@@ -85,7 +148,6 @@ func initializeOrchestrator() (*orchestrator.Orchestrator, error) {
 	if err != nil {
 		return nil, err
 	}
-	mcpServer := server.NewMCPSDKServer(configConfig)
 	factory := files.NewFactory(osFacade)
 	directoryDirectory, err := directory.New(configConfig, factory, osFacade)
 	if err != nil {
@@ -96,12 +158,14 @@ func initializeOrchestrator() (*orchestrator.Orchestrator, error) {
 		return nil, err
 	}
 	fileFacade := filefacade.New()
-	getter := matlabroot.New(osFacade, fileFacade)
+	getter := matlabroot.New(configConfig, osFacade, fileFacade)
 	ioFacade := iofacade.New()
 	matlabversionGetter := matlabversion.New(osFacade, ioFacade)
-	matlabLocator := matlablocator.New(getter, matlabversionGetter)
+	matlabinstallationGetter := matlabinstallation.New(osFacade, fileFacade)
+	matlabLocator := matlablocator.New(getter, matlabversionGetter, matlabinstallationGetter)
+	osWrapper := oswrapper.New(osFacade)
 	matlabFiles := matlabfiles.New()
-	directoryFactory := directorymanager.NewFactory(osFacade, directoryDirectory, matlabFiles)
+	directoryFactory := directorymanager.NewFactory(osFacade, osWrapper, directoryDirectory, matlabFiles)
 	processDetails := processdetails.New(osFacade)
 	matlabProcessLauncher := processlauncher.New()
 	processProcess, err := process.New(osFacade, loggerFactory, directoryDirectory)
@@ -111,23 +175,33 @@ func initializeOrchestrator() (*orchestrator.Orchestrator, error) {
 	transportFactory := transport.NewFactory()
 	watchdogWatchdog := watchdog.New(processProcess, transportFactory, loggerFactory)
 	starter := localmatlabsession.NewStarter(directoryFactory, processDetails, matlabProcessLauncher, watchdogWatchdog)
-	matlabServices := matlabservices.New(matlabLocator, starter)
+	attacher := sharedmatlabsession.NewAttacher(directoryFactory)
+	connector := remotematlabsession.NewConnector(osFacade, matlabFiles)
+	matlabServices := matlabservices.New(matlabLocator, starter, attacher, connector)
 	store := matlabsessionstore.New(loggerFactory, lifecycleSignaler)
 	httpClientFactory := httpclientfactory.New()
 	matlabsessionclientFactory := matlabsessionclient.NewFactory(httpClientFactory)
-	matlabManager := matlabmanager.New(matlabServices, store, matlabsessionclientFactory)
+	pool := matlabsessionpool.New(configConfig, loggerFactory, lifecycleSignaler, fileFacade)
+	matlabManager := matlabmanager.New(matlabServices, store, matlabsessionclientFactory, pool)
 	usecase := listavailablematlabs.New(matlabManager)
 	tool := listavailablematlabs2.New(loggerFactory, usecase)
 	startmatlabsessionUsecase := startmatlabsession.New(matlabManager)
 	startmatlabsessionTool := startmatlabsession2.New(loggerFactory, startmatlabsessionUsecase)
 	stopmatlabsessionUsecase := stopmatlabsession.New(matlabManager)
 	stopmatlabsessionTool := stopmatlabsession2.New(loggerFactory, stopmatlabsessionUsecase)
-	pathValidator := pathvalidator.New(osFacade)
-	evalmatlabcodeUsecase := evalmatlabcode.New(pathValidator)
+	clientRoots := clientroots.New(loggerFactory, configConfig)
+	pathValidator := pathvalidator.New(osFacade, fileFacade, configConfig, clientRoots)
+	elicitor := elicitation.New()
+	policy := codesafety.New(configConfig, elicitor)
+	evalmatlabcodeUsecase := evalmatlabcode.New(pathValidator, policy)
 	evalmatlabcodeTool := evalmatlabcode2.New(loggerFactory, evalmatlabcodeUsecase, matlabManager)
+	restartmatlabsessionUsecase := restartmatlabsession.New()
+	restartmatlabsessionTool := restartmatlabsession2.New(loggerFactory, restartmatlabsessionUsecase, matlabManager)
+	resetmatlabstateUsecase := resetmatlabstate.New()
+	resetmatlabstateTool := resetmatlabstate2.New(loggerFactory, resetmatlabstateUsecase, matlabManager)
 	matlabRootSelector := matlabrootselector.New(configConfig, matlabManager)
 	matlabStartingDirSelector := matlabstartingdirselector.New(configConfig, osFacade)
-	globalMATLAB := globalmatlab.New(matlabManager, matlabRootSelector, matlabStartingDirSelector)
+	globalMATLAB := globalmatlab.New(matlabManager, matlabRootSelector, matlabStartingDirSelector, configConfig)
 	tool2 := evalmatlabcode3.New(loggerFactory, evalmatlabcodeUsecase, globalMATLAB)
 	checkmatlabcodeUsecase := checkmatlabcode.New(pathValidator)
 	checkmatlabcodeTool := checkmatlabcode2.New(loggerFactory, checkmatlabcodeUsecase, globalMATLAB)
@@ -137,17 +211,95 @@ func initializeOrchestrator() (*orchestrator.Orchestrator, error) {
 	runmatlabfileTool := runmatlabfile2.New(loggerFactory, runmatlabfileUsecase, globalMATLAB)
 	runmatlabtestfileUsecase := runmatlabtestfile.New(pathValidator)
 	runmatlabtestfileTool := runmatlabtestfile2.New(loggerFactory, runmatlabtestfileUsecase, globalMATLAB)
+	session := debugsession.New()
+	tool3 := restartmatlabsession3.New(loggerFactory, restartmatlabsessionUsecase, globalMATLAB, session)
+	tool4 := resetmatlabstate3.New(loggerFactory, resetmatlabstateUsecase, globalMATLAB)
+	analyzematlabdependenciesUsecase := analyzematlabdependencies.New(pathValidator)
+	analyzematlabdependenciesTool := analyzematlabdependencies2.New(loggerFactory, analyzematlabdependenciesUsecase, globalMATLAB)
+	setmatlabbreakpointUsecase := setmatlabbreakpoint.New(pathValidator, policy)
+	setmatlabbreakpointTool := setmatlabbreakpoint2.New(loggerFactory, setmatlabbreakpointUsecase, globalMATLAB)
+	clearmatlabbreakpointsUsecase := clearmatlabbreakpoints.New(pathValidator)
+	clearmatlabbreakpointsTool := clearmatlabbreakpoints2.New(loggerFactory, clearmatlabbreakpointsUsecase, globalMATLAB)
+	debugmatlabcodeUsecase := debugmatlabcode.New(pathValidator, policy, session)
+	debugmatlabcodeTool := debugmatlabcode2.New(loggerFactory, debugmatlabcodeUsecase, globalMATLAB)
+	controlmatlabdebuggerUsecase := controlmatlabdebugger.New(session)
+	controlmatlabdebuggerTool := controlmatlabdebugger2.New(loggerFactory, controlmatlabdebuggerUsecase, globalMATLAB)
+	profilematlabcodeUsecase := profilematlabcode.New(pathValidator, policy)
+	profilematlabcodeTool := profilematlabcode2.New(loggerFactory, profilematlabcodeUsecase, globalMATLAB)
+	benchmarkmatlabcodeUsecase := benchmarkmatlabcode.New(pathValidator, policy)
+	benchmarkmatlabcodeTool := benchmarkmatlabcode2.New(loggerFactory, benchmarkmatlabcodeUsecase, globalMATLAB)
+	detector := simulinkdetector.New()
+	loadsimulinkmodelUsecase := loadsimulinkmodel.New(pathValidator, detector)
+	loadsimulinkmodelTool := loadsimulinkmodel2.New(loggerFactory, loadsimulinkmodelUsecase, globalMATLAB)
+	listsimulinkblocksUsecase := listsimulinkblocks.New(detector)
+	listsimulinkblocksTool := listsimulinkblocks2.New(loggerFactory, listsimulinkblocksUsecase, globalMATLAB)
+	setsimulinkblockparametersUsecase := setsimulinkblockparameters.New(policy, detector)
+	setsimulinkblockparametersTool := setsimulinkblockparameters2.New(loggerFactory, setsimulinkblockparametersUsecase, globalMATLAB)
+	simulatesimulinkmodelUsecase := simulatesimulinkmodel.New(policy, detector)
+	simulatesimulinkmodelTool := simulatesimulinkmodel2.New(loggerFactory, simulatesimulinkmodelUsecase, globalMATLAB)
+	checksimulinkmodelUsecase := checksimulinkmodel.New(pathValidator, detector)
+	checksimulinkmodelTool := checksimulinkmodel2.New(loggerFactory, checksimulinkmodelUsecase, globalMATLAB)
+	middleware, err := audit.New(configConfig, osFacade, pathValidator, lifecycleSignaler)
+	if err != nil {
+		return nil, err
+	}
+	debugguardMiddleware := debugguard.New(session)
+	approvalMiddleware := approval.New(configConfig, elicitor)
 	resource, err := codingguidelines.New(loggerFactory)
 	if err != nil {
 		return nil, err
 	}
-	configuratorConfigurator := configurator.New(configConfig, tool, startmatlabsessionTool, stopmatlabsessionTool, evalmatlabcodeTool, tool2, checkmatlabcodeTool, detectmatlabtoolboxesTool, runmatlabfileTool, runmatlabtestfileTool, resource)
+	customcodingguidelinesResource := customcodingguidelines.New(configConfig, loggerFactory, osFacade, fileFacade, lifecycleSignaler)
+	getmatlabdocumentationUsecase := getmatlabdocumentation.New()
+	resolver := sessionresolver.New(configConfig, globalMATLAB, matlabManager, session)
+	matlabdocumentationResource, err := matlabdocumentation.New(loggerFactory, getmatlabdocumentationUsecase, resolver)
+	if err != nil {
+		return nil, err
+	}
+	matlabsessionpoolResource, err := matlabsessionpool2.New(loggerFactory, pool)
+	if err != nil {
+		return nil, err
+	}
+	readmatlabsessionlogUsecase := readmatlabsessionlog.New(osFacade)
+	stdoutResource, err := matlabsessionlog.NewStdout(loggerFactory, readmatlabsessionlogUsecase, resolver)
+	if err != nil {
+		return nil, err
+	}
+	stderrResource, err := matlabsessionlog.NewStderr(loggerFactory, readmatlabsessionlogUsecase, resolver)
+	if err != nil {
+		return nil, err
+	}
+	getmatlabfigureUsecase := getmatlabfigure.New()
+	matlabsessionfigureResource, err := matlabsessionfigure.New(loggerFactory, getmatlabfigureUsecase, resolver)
+	if err != nil {
+		return nil, err
+	}
+	listmatlabworkspaceUsecase := listmatlabworkspace.New()
+	matlabsessionworkspaceResource, err := matlabsessionworkspace.New(loggerFactory, listmatlabworkspaceUsecase, resolver)
+	if err != nil {
+		return nil, err
+	}
+	prompt := writeandtestfunction.New(loggerFactory)
+	debugfailingtestsPrompt := debugfailingtests.New(loggerFactory)
+	vectorizeloopPrompt := vectorizeloop.New(loggerFactory)
+	reviewcodePrompt := reviewcode.New(loggerFactory)
+	custompromptsPrompt := customprompts.New(configConfig, loggerFactory, osFacade, fileFacade)
+	configuratorConfigurator := configurator.New(configConfig, tool, startmatlabsessionTool, stopmatlabsessionTool, evalmatlabcodeTool, restartmatlabsessionTool, resetmatlabstateTool, tool2, checkmatlabcodeTool, detectmatlabtoolboxesTool, runmatlabfileTool, runmatlabtestfileTool, tool3, tool4, analyzematlabdependenciesTool, setmatlabbreakpointTool, clearmatlabbreakpointsTool, debugmatlabcodeTool, controlmatlabdebuggerTool, profilematlabcodeTool, benchmarkmatlabcodeTool, loadsimulinkmodelTool, listsimulinkblocksTool, setsimulinkblockparametersTool, simulatesimulinkmodelTool, checksimulinkmodelTool, middleware, debugguardMiddleware, approvalMiddleware, resource, customcodingguidelinesResource, matlabdocumentationResource, matlabsessionpoolResource, stdoutResource, stderrResource, matlabsessionfigureResource, matlabsessionworkspaceResource, prompt, debugfailingtestsPrompt, vectorizeloopPrompt, reviewcodePrompt, custompromptsPrompt)
+	findmatlabfunctionsUsecase := findmatlabfunctions.New()
+	provider := matlabfunctions.New(configConfig, findmatlabfunctionsUsecase, globalMATLAB, session)
+	sessionidsProvider := sessionids.New(store)
+	testnamesProvider := testnames.New(pathValidator, osFacade)
+	completionCompletion := completion.New(loggerFactory, provider, sessionidsProvider, testnamesProvider)
+	mcpServer, err := server.NewMCPSDKServer(configConfig, configuratorConfigurator, clientRoots, completionCompletion)
+	if err != nil {
+		return nil, err
+	}
 	serverServer, err := server.New(mcpServer, loggerFactory, lifecycleSignaler, configuratorConfigurator)
 	if err != nil {
 		return nil, err
 	}
 	osSignaler := ossignaler.New()
-	orchestratorOrchestrator := orchestrator.New(lifecycleSignaler, configConfig, serverServer, watchdogWatchdog, loggerFactory, osSignaler, globalMATLAB, directoryDirectory)
+	orchestratorOrchestrator := orchestrator.New(lifecycleSignaler, configConfig, serverServer, watchdogWatchdog, loggerFactory, osSignaler, globalMATLAB, directoryDirectory, matlabManager)
 	return orchestratorOrchestrator, nil
 }
 
@@ -83,6 +83,52 @@ func (_c *MockOSLayer_Args_Call) RunAndReturn(run func() []string) *MockOSLayer_
 	return _c
 }
 
+// Environ provides a mock function for the type MockOSLayer
+func (_mock *MockOSLayer) Environ() []string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Environ")
+	}
+
+	var r0 []string
+	if returnFunc, ok := ret.Get(0).(func() []string); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	return r0
+}
+
+// MockOSLayer_Environ_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Environ'
+type MockOSLayer_Environ_Call struct {
+	*mock.Call
+}
+
+// Environ is a helper method to define mock.On call
+func (_e *MockOSLayer_Expecter) Environ() *MockOSLayer_Environ_Call {
+	return &MockOSLayer_Environ_Call{Call: _e.mock.On("Environ")}
+}
+
+func (_c *MockOSLayer_Environ_Call) Run(run func()) *MockOSLayer_Environ_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockOSLayer_Environ_Call) Return(strings []string) *MockOSLayer_Environ_Call {
+	_c.Call.Return(strings)
+	return _c
+}
+
+func (_c *MockOSLayer_Environ_Call) RunAndReturn(run func() []string) *MockOSLayer_Environ_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ReadBuildInfo provides a mock function for the type MockOSLayer
 func (_mock *MockOSLayer) ReadBuildInfo() (*debug.BuildInfo, bool) {
 	ret := _mock.Called()
@@ -137,3 +183,118 @@ func (_c *MockOSLayer_ReadBuildInfo_Call) RunAndReturn(run func() (*debug.BuildI
 	_c.Call.Return(run)
 	return _c
 }
+
+// ReadFile provides a mock function for the type MockOSLayer
+func (_mock *MockOSLayer) ReadFile(filePath string) ([]byte, error) {
+	ret := _mock.Called(filePath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadFile")
+	}
+
+	var r0 []byte
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) ([]byte, error)); ok {
+		return returnFunc(filePath)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) []byte); ok {
+		r0 = returnFunc(filePath)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(filePath)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOSLayer_ReadFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadFile'
+type MockOSLayer_ReadFile_Call struct {
+	*mock.Call
+}
+
+// ReadFile is a helper method to define mock.On call
+//   - filePath string
+func (_e *MockOSLayer_Expecter) ReadFile(filePath interface{}) *MockOSLayer_ReadFile_Call {
+	return &MockOSLayer_ReadFile_Call{Call: _e.mock.On("ReadFile", filePath)}
+}
+
+func (_c *MockOSLayer_ReadFile_Call) Run(run func(filePath string)) *MockOSLayer_ReadFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOSLayer_ReadFile_Call) Return(bytes []byte, err error) *MockOSLayer_ReadFile_Call {
+	_c.Call.Return(bytes, err)
+	return _c
+}
+
+func (_c *MockOSLayer_ReadFile_Call) RunAndReturn(run func(filePath string) ([]byte, error)) *MockOSLayer_ReadFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UserConfigDir provides a mock function for the type MockOSLayer
+func (_mock *MockOSLayer) UserConfigDir() (string, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for UserConfigDir")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (string, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOSLayer_UserConfigDir_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UserConfigDir'
+type MockOSLayer_UserConfigDir_Call struct {
+	*mock.Call
+}
+
+// UserConfigDir is a helper method to define mock.On call
+func (_e *MockOSLayer_Expecter) UserConfigDir() *MockOSLayer_UserConfigDir_Call {
+	return &MockOSLayer_UserConfigDir_Call{Call: _e.mock.On("UserConfigDir")}
+}
+
+func (_c *MockOSLayer_UserConfigDir_Call) Run(run func()) *MockOSLayer_UserConfigDir_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockOSLayer_UserConfigDir_Call) Return(s string, err error) *MockOSLayer_UserConfigDir_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockOSLayer_UserConfigDir_Call) RunAndReturn(run func() (string, error)) *MockOSLayer_UserConfigDir_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,77 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockMATLABManager creates a new instance of MockMATLABManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMATLABManager(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMATLABManager {
+	mock := &MockMATLABManager{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockMATLABManager is an autogenerated mock type for the MATLABManager type
+type MockMATLABManager struct {
+	mock.Mock
+}
+
+type MockMATLABManager_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMATLABManager) EXPECT() *MockMATLABManager_Expecter {
+	return &MockMATLABManager_Expecter{mock: &_m.Mock}
+}
+
+// StartSessionPool provides a mock function for the type MockMATLABManager
+func (_mock *MockMATLABManager) StartSessionPool(logger entities.Logger) {
+	_mock.Called(logger)
+	return
+}
+
+// MockMATLABManager_StartSessionPool_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartSessionPool'
+type MockMATLABManager_StartSessionPool_Call struct {
+	*mock.Call
+}
+
+// StartSessionPool is a helper method to define mock.On call
+//   - logger entities.Logger
+func (_e *MockMATLABManager_Expecter) StartSessionPool(logger interface{}) *MockMATLABManager_StartSessionPool_Call {
+	return &MockMATLABManager_StartSessionPool_Call{Call: _e.mock.On("StartSessionPool", logger)}
+}
+
+func (_c *MockMATLABManager_StartSessionPool_Call) Run(run func(logger entities.Logger)) *MockMATLABManager_StartSessionPool_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 entities.Logger
+		if args[0] != nil {
+			arg0 = args[0].(entities.Logger)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMATLABManager_StartSessionPool_Call) Return() *MockMATLABManager_StartSessionPool_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockMATLABManager_StartSessionPool_Call) RunAndReturn(run func(logger entities.Logger)) *MockMATLABManager_StartSessionPool_Call {
+	_c.Run(run)
+	return _c
+}
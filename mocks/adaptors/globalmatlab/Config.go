@@ -0,0 +1,256 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockConfig creates a new instance of MockConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockConfig {
+	mock := &MockConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockConfig is an autogenerated mock type for the Config type
+type MockConfig struct {
+	mock.Mock
+}
+
+type MockConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockConfig) EXPECT() *MockConfig_Expecter {
+	return &MockConfig_Expecter{mock: &_m.Mock}
+}
+
+// RemoteMATLABAPIKeyFile provides a mock function for the type MockConfig
+func (_mock *MockConfig) RemoteMATLABAPIKeyFile() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoteMATLABAPIKeyFile")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockConfig_RemoteMATLABAPIKeyFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoteMATLABAPIKeyFile'
+type MockConfig_RemoteMATLABAPIKeyFile_Call struct {
+	*mock.Call
+}
+
+// RemoteMATLABAPIKeyFile is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) RemoteMATLABAPIKeyFile() *MockConfig_RemoteMATLABAPIKeyFile_Call {
+	return &MockConfig_RemoteMATLABAPIKeyFile_Call{Call: _e.mock.On("RemoteMATLABAPIKeyFile")}
+}
+
+func (_c *MockConfig_RemoteMATLABAPIKeyFile_Call) Run(run func()) *MockConfig_RemoteMATLABAPIKeyFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_RemoteMATLABAPIKeyFile_Call) Return(s string) *MockConfig_RemoteMATLABAPIKeyFile_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockConfig_RemoteMATLABAPIKeyFile_Call) RunAndReturn(run func() string) *MockConfig_RemoteMATLABAPIKeyFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoteMATLABCertificateFile provides a mock function for the type MockConfig
+func (_mock *MockConfig) RemoteMATLABCertificateFile() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoteMATLABCertificateFile")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockConfig_RemoteMATLABCertificateFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoteMATLABCertificateFile'
+type MockConfig_RemoteMATLABCertificateFile_Call struct {
+	*mock.Call
+}
+
+// RemoteMATLABCertificateFile is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) RemoteMATLABCertificateFile() *MockConfig_RemoteMATLABCertificateFile_Call {
+	return &MockConfig_RemoteMATLABCertificateFile_Call{Call: _e.mock.On("RemoteMATLABCertificateFile")}
+}
+
+func (_c *MockConfig_RemoteMATLABCertificateFile_Call) Run(run func()) *MockConfig_RemoteMATLABCertificateFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_RemoteMATLABCertificateFile_Call) Return(s string) *MockConfig_RemoteMATLABCertificateFile_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockConfig_RemoteMATLABCertificateFile_Call) RunAndReturn(run func() string) *MockConfig_RemoteMATLABCertificateFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoteMATLABHost provides a mock function for the type MockConfig
+func (_mock *MockConfig) RemoteMATLABHost() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoteMATLABHost")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockConfig_RemoteMATLABHost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoteMATLABHost'
+type MockConfig_RemoteMATLABHost_Call struct {
+	*mock.Call
+}
+
+// RemoteMATLABHost is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) RemoteMATLABHost() *MockConfig_RemoteMATLABHost_Call {
+	return &MockConfig_RemoteMATLABHost_Call{Call: _e.mock.On("RemoteMATLABHost")}
+}
+
+func (_c *MockConfig_RemoteMATLABHost_Call) Run(run func()) *MockConfig_RemoteMATLABHost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_RemoteMATLABHost_Call) Return(s string) *MockConfig_RemoteMATLABHost_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockConfig_RemoteMATLABHost_Call) RunAndReturn(run func() string) *MockConfig_RemoteMATLABHost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoteMATLABPort provides a mock function for the type MockConfig
+func (_mock *MockConfig) RemoteMATLABPort() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoteMATLABPort")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockConfig_RemoteMATLABPort_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoteMATLABPort'
+type MockConfig_RemoteMATLABPort_Call struct {
+	*mock.Call
+}
+
+// RemoteMATLABPort is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) RemoteMATLABPort() *MockConfig_RemoteMATLABPort_Call {
+	return &MockConfig_RemoteMATLABPort_Call{Call: _e.mock.On("RemoteMATLABPort")}
+}
+
+func (_c *MockConfig_RemoteMATLABPort_Call) Run(run func()) *MockConfig_RemoteMATLABPort_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_RemoteMATLABPort_Call) Return(s string) *MockConfig_RemoteMATLABPort_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockConfig_RemoteMATLABPort_Call) RunAndReturn(run func() string) *MockConfig_RemoteMATLABPort_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SharedMATLABSessionFolder provides a mock function for the type MockConfig
+func (_mock *MockConfig) SharedMATLABSessionFolder() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SharedMATLABSessionFolder")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockConfig_SharedMATLABSessionFolder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SharedMATLABSessionFolder'
+type MockConfig_SharedMATLABSessionFolder_Call struct {
+	*mock.Call
+}
+
+// SharedMATLABSessionFolder is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) SharedMATLABSessionFolder() *MockConfig_SharedMATLABSessionFolder_Call {
+	return &MockConfig_SharedMATLABSessionFolder_Call{Call: _e.mock.On("SharedMATLABSessionFolder")}
+}
+
+func (_c *MockConfig_SharedMATLABSessionFolder_Call) Run(run func()) *MockConfig_SharedMATLABSessionFolder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_SharedMATLABSessionFolder_Call) Return(s string) *MockConfig_SharedMATLABSessionFolder_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockConfig_SharedMATLABSessionFolder_Call) RunAndReturn(run func() string) *MockConfig_SharedMATLABSessionFolder_Call {
+	_c.Call.Return(run)
+	return _c
+}
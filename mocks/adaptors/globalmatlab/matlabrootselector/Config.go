@@ -78,3 +78,47 @@ func (_c *MockConfig_PreferredLocalMATLABRoot_Call) RunAndReturn(run func() stri
 	_c.Call.Return(run)
 	return _c
 }
+
+// PreferredMATLABVersion provides a mock function for the type MockConfig
+func (_mock *MockConfig) PreferredMATLABVersion() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PreferredMATLABVersion")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockConfig_PreferredMATLABVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PreferredMATLABVersion'
+type MockConfig_PreferredMATLABVersion_Call struct {
+	*mock.Call
+}
+
+// PreferredMATLABVersion is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) PreferredMATLABVersion() *MockConfig_PreferredMATLABVersion_Call {
+	return &MockConfig_PreferredMATLABVersion_Call{Call: _e.mock.On("PreferredMATLABVersion")}
+}
+
+func (_c *MockConfig_PreferredMATLABVersion_Call) Run(run func()) *MockConfig_PreferredMATLABVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_PreferredMATLABVersion_Call) Return(s string) *MockConfig_PreferredMATLABVersion_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockConfig_PreferredMATLABVersion_Call) RunAndReturn(run func() string) *MockConfig_PreferredMATLABVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -5,6 +5,8 @@
 package mocks
 
 import (
+	"context"
+
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
@@ -38,6 +40,217 @@ func (_m *MockMATLABServices) EXPECT() *MockMATLABServices_Expecter {
 	return &MockMATLABServices_Expecter{mock: &_m.Mock}
 }
 
+// AttachToSharedMATLABSession provides a mock function for the type MockMATLABServices
+func (_mock *MockMATLABServices) AttachToSharedMATLABSession(logger entities.Logger, request datatypes.SharedSessionDetails) (embeddedconnector.ConnectionDetails, func(killMATLAB bool) error, error) {
+	ret := _mock.Called(logger, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AttachToSharedMATLABSession")
+	}
+
+	var r0 embeddedconnector.ConnectionDetails
+	var r1 func(killMATLAB bool) error
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(entities.Logger, datatypes.SharedSessionDetails) (embeddedconnector.ConnectionDetails, func(killMATLAB bool) error, error)); ok {
+		return returnFunc(logger, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(entities.Logger, datatypes.SharedSessionDetails) embeddedconnector.ConnectionDetails); ok {
+		r0 = returnFunc(logger, request)
+	} else {
+		r0 = ret.Get(0).(embeddedconnector.ConnectionDetails)
+	}
+	if returnFunc, ok := ret.Get(1).(func(entities.Logger, datatypes.SharedSessionDetails) func(killMATLAB bool) error); ok {
+		r1 = returnFunc(logger, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func(killMATLAB bool) error)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(entities.Logger, datatypes.SharedSessionDetails) error); ok {
+		r2 = returnFunc(logger, request)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockMATLABServices_AttachToSharedMATLABSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AttachToSharedMATLABSession'
+type MockMATLABServices_AttachToSharedMATLABSession_Call struct {
+	*mock.Call
+}
+
+// AttachToSharedMATLABSession is a helper method to define mock.On call
+//   - logger entities.Logger
+//   - request datatypes.SharedSessionDetails
+func (_e *MockMATLABServices_Expecter) AttachToSharedMATLABSession(logger interface{}, request interface{}) *MockMATLABServices_AttachToSharedMATLABSession_Call {
+	return &MockMATLABServices_AttachToSharedMATLABSession_Call{Call: _e.mock.On("AttachToSharedMATLABSession", logger, request)}
+}
+
+func (_c *MockMATLABServices_AttachToSharedMATLABSession_Call) Run(run func(logger entities.Logger, request datatypes.SharedSessionDetails)) *MockMATLABServices_AttachToSharedMATLABSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 entities.Logger
+		if args[0] != nil {
+			arg0 = args[0].(entities.Logger)
+		}
+		var arg1 datatypes.SharedSessionDetails
+		if args[1] != nil {
+			arg1 = args[1].(datatypes.SharedSessionDetails)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMATLABServices_AttachToSharedMATLABSession_Call) Return(connectionDetails embeddedconnector.ConnectionDetails, fn func(killMATLAB bool) error, err error) *MockMATLABServices_AttachToSharedMATLABSession_Call {
+	_c.Call.Return(connectionDetails, fn, err)
+	return _c
+}
+
+func (_c *MockMATLABServices_AttachToSharedMATLABSession_Call) RunAndReturn(run func(logger entities.Logger, request datatypes.SharedSessionDetails) (embeddedconnector.ConnectionDetails, func(killMATLAB bool) error, error)) *MockMATLABServices_AttachToSharedMATLABSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConnectToRemoteMATLABSession provides a mock function for the type MockMATLABServices
+func (_mock *MockMATLABServices) ConnectToRemoteMATLABSession(logger entities.Logger, request datatypes.RemoteSessionDetails) (embeddedconnector.ConnectionDetails, func(killMATLAB bool) error, error) {
+	ret := _mock.Called(logger, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConnectToRemoteMATLABSession")
+	}
+
+	var r0 embeddedconnector.ConnectionDetails
+	var r1 func(killMATLAB bool) error
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(entities.Logger, datatypes.RemoteSessionDetails) (embeddedconnector.ConnectionDetails, func(killMATLAB bool) error, error)); ok {
+		return returnFunc(logger, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(entities.Logger, datatypes.RemoteSessionDetails) embeddedconnector.ConnectionDetails); ok {
+		r0 = returnFunc(logger, request)
+	} else {
+		r0 = ret.Get(0).(embeddedconnector.ConnectionDetails)
+	}
+	if returnFunc, ok := ret.Get(1).(func(entities.Logger, datatypes.RemoteSessionDetails) func(killMATLAB bool) error); ok {
+		r1 = returnFunc(logger, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func(killMATLAB bool) error)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(entities.Logger, datatypes.RemoteSessionDetails) error); ok {
+		r2 = returnFunc(logger, request)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockMATLABServices_ConnectToRemoteMATLABSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConnectToRemoteMATLABSession'
+type MockMATLABServices_ConnectToRemoteMATLABSession_Call struct {
+	*mock.Call
+}
+
+// ConnectToRemoteMATLABSession is a helper method to define mock.On call
+//   - logger entities.Logger
+//   - request datatypes.RemoteSessionDetails
+func (_e *MockMATLABServices_Expecter) ConnectToRemoteMATLABSession(logger interface{}, request interface{}) *MockMATLABServices_ConnectToRemoteMATLABSession_Call {
+	return &MockMATLABServices_ConnectToRemoteMATLABSession_Call{Call: _e.mock.On("ConnectToRemoteMATLABSession", logger, request)}
+}
+
+func (_c *MockMATLABServices_ConnectToRemoteMATLABSession_Call) Run(run func(logger entities.Logger, request datatypes.RemoteSessionDetails)) *MockMATLABServices_ConnectToRemoteMATLABSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 entities.Logger
+		if args[0] != nil {
+			arg0 = args[0].(entities.Logger)
+		}
+		var arg1 datatypes.RemoteSessionDetails
+		if args[1] != nil {
+			arg1 = args[1].(datatypes.RemoteSessionDetails)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMATLABServices_ConnectToRemoteMATLABSession_Call) Return(connectionDetails embeddedconnector.ConnectionDetails, fn func(killMATLAB bool) error, err error) *MockMATLABServices_ConnectToRemoteMATLABSession_Call {
+	_c.Call.Return(connectionDetails, fn, err)
+	return _c
+}
+
+func (_c *MockMATLABServices_ConnectToRemoteMATLABSession_Call) RunAndReturn(run func(logger entities.Logger, request datatypes.RemoteSessionDetails) (embeddedconnector.ConnectionDetails, func(killMATLAB bool) error, error)) *MockMATLABServices_ConnectToRemoteMATLABSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InstallMATLABPackage provides a mock function for the type MockMATLABServices
+func (_mock *MockMATLABServices) InstallMATLABPackage(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient) error {
+	ret := _mock.Called(ctx, logger, client)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InstallMATLABPackage")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.MATLABSessionClient) error); ok {
+		r0 = returnFunc(ctx, logger, client)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockMATLABServices_InstallMATLABPackage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InstallMATLABPackage'
+type MockMATLABServices_InstallMATLABPackage_Call struct {
+	*mock.Call
+}
+
+// InstallMATLABPackage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - logger entities.Logger
+//   - client entities.MATLABSessionClient
+func (_e *MockMATLABServices_Expecter) InstallMATLABPackage(ctx interface{}, logger interface{}, client interface{}) *MockMATLABServices_InstallMATLABPackage_Call {
+	return &MockMATLABServices_InstallMATLABPackage_Call{Call: _e.mock.On("InstallMATLABPackage", ctx, logger, client)}
+}
+
+func (_c *MockMATLABServices_InstallMATLABPackage_Call) Run(run func(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient)) *MockMATLABServices_InstallMATLABPackage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		var arg2 entities.MATLABSessionClient
+		if args[2] != nil {
+			arg2 = args[2].(entities.MATLABSessionClient)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMATLABServices_InstallMATLABPackage_Call) Return(err error) *MockMATLABServices_InstallMATLABPackage_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockMATLABServices_InstallMATLABPackage_Call) RunAndReturn(run func(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient) error) *MockMATLABServices_InstallMATLABPackage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListDiscoveredMatlabInfo provides a mock function for the type MockMATLABServices
 func (_mock *MockMATLABServices) ListDiscoveredMatlabInfo(logger entities.Logger) datatypes.ListMatlabInfo {
 	ret := _mock.Called(logger)
@@ -90,7 +303,7 @@ func (_c *MockMATLABServices_ListDiscoveredMatlabInfo_Call) RunAndReturn(run fun
 }
 
 // StartLocalMATLABSession provides a mock function for the type MockMATLABServices
-func (_mock *MockMATLABServices) StartLocalMATLABSession(logger entities.Logger, request datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, func() error, error) {
+func (_mock *MockMATLABServices) StartLocalMATLABSession(logger entities.Logger, request datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, string, func(killMATLAB bool) error, error) {
 	ret := _mock.Called(logger, request)
 
 	if len(ret) == 0 {
@@ -98,9 +311,10 @@ func (_mock *MockMATLABServices) StartLocalMATLABSession(logger entities.Logger,
 	}
 
 	var r0 embeddedconnector.ConnectionDetails
-	var r1 func() error
-	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(entities.Logger, datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, func() error, error)); ok {
+	var r1 string
+	var r2 func(killMATLAB bool) error
+	var r3 error
+	if returnFunc, ok := ret.Get(0).(func(entities.Logger, datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, string, func(killMATLAB bool) error, error)); ok {
 		return returnFunc(logger, request)
 	}
 	if returnFunc, ok := ret.Get(0).(func(entities.Logger, datatypes.LocalSessionDetails) embeddedconnector.ConnectionDetails); ok {
@@ -108,19 +322,24 @@ func (_mock *MockMATLABServices) StartLocalMATLABSession(logger entities.Logger,
 	} else {
 		r0 = ret.Get(0).(embeddedconnector.ConnectionDetails)
 	}
-	if returnFunc, ok := ret.Get(1).(func(entities.Logger, datatypes.LocalSessionDetails) func() error); ok {
+	if returnFunc, ok := ret.Get(1).(func(entities.Logger, datatypes.LocalSessionDetails) string); ok {
 		r1 = returnFunc(logger, request)
 	} else {
-		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(func() error)
-		}
+		r1 = ret.Get(1).(string)
 	}
-	if returnFunc, ok := ret.Get(2).(func(entities.Logger, datatypes.LocalSessionDetails) error); ok {
+	if returnFunc, ok := ret.Get(2).(func(entities.Logger, datatypes.LocalSessionDetails) func(killMATLAB bool) error); ok {
 		r2 = returnFunc(logger, request)
 	} else {
-		r2 = ret.Error(2)
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(func(killMATLAB bool) error)
+		}
 	}
-	return r0, r1, r2
+	if returnFunc, ok := ret.Get(3).(func(entities.Logger, datatypes.LocalSessionDetails) error); ok {
+		r3 = returnFunc(logger, request)
+	} else {
+		r3 = ret.Error(3)
+	}
+	return r0, r1, r2, r3
 }
 
 // MockMATLABServices_StartLocalMATLABSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartLocalMATLABSession'
@@ -153,12 +372,12 @@ func (_c *MockMATLABServices_StartLocalMATLABSession_Call) Run(run func(logger e
 	return _c
 }
 
-func (_c *MockMATLABServices_StartLocalMATLABSession_Call) Return(connectionDetails embeddedconnector.ConnectionDetails, fn func() error, err error) *MockMATLABServices_StartLocalMATLABSession_Call {
-	_c.Call.Return(connectionDetails, fn, err)
+func (_c *MockMATLABServices_StartLocalMATLABSession_Call) Return(connectionDetails embeddedconnector.ConnectionDetails, s string, fn func(killMATLAB bool) error, err error) *MockMATLABServices_StartLocalMATLABSession_Call {
+	_c.Call.Return(connectionDetails, s, fn, err)
 	return _c
 }
 
-func (_c *MockMATLABServices_StartLocalMATLABSession_Call) RunAndReturn(run func(logger entities.Logger, request datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, func() error, error)) *MockMATLABServices_StartLocalMATLABSession_Call {
+func (_c *MockMATLABServices_StartLocalMATLABSession_Call) RunAndReturn(run func(logger entities.Logger, request datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, string, func(killMATLAB bool) error, error)) *MockMATLABServices_StartLocalMATLABSession_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,167 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionpool"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionstore"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockMATLABSessionPool creates a new instance of MockMATLABSessionPool. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMATLABSessionPool(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMATLABSessionPool {
+	mock := &MockMATLABSessionPool{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockMATLABSessionPool is an autogenerated mock type for the MATLABSessionPool type
+type MockMATLABSessionPool struct {
+	mock.Mock
+}
+
+type MockMATLABSessionPool_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMATLABSessionPool) EXPECT() *MockMATLABSessionPool_Expecter {
+	return &MockMATLABSessionPool_Expecter{mock: &_m.Mock}
+}
+
+// Start provides a mock function for the type MockMATLABSessionPool
+func (_mock *MockMATLABSessionPool) Start(logger entities.Logger, starter matlabsessionpool.SessionStarter, sessionDetails []entities.LocalSessionDetails) {
+	_mock.Called(logger, starter, sessionDetails)
+	return
+}
+
+// MockMATLABSessionPool_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type MockMATLABSessionPool_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+//   - logger entities.Logger
+//   - starter matlabsessionpool.SessionStarter
+//   - sessionDetails []entities.LocalSessionDetails
+func (_e *MockMATLABSessionPool_Expecter) Start(logger interface{}, starter interface{}, sessionDetails interface{}) *MockMATLABSessionPool_Start_Call {
+	return &MockMATLABSessionPool_Start_Call{Call: _e.mock.On("Start", logger, starter, sessionDetails)}
+}
+
+func (_c *MockMATLABSessionPool_Start_Call) Run(run func(logger entities.Logger, starter matlabsessionpool.SessionStarter, sessionDetails []entities.LocalSessionDetails)) *MockMATLABSessionPool_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 entities.Logger
+		if args[0] != nil {
+			arg0 = args[0].(entities.Logger)
+		}
+		var arg1 matlabsessionpool.SessionStarter
+		if args[1] != nil {
+			arg1 = args[1].(matlabsessionpool.SessionStarter)
+		}
+		var arg2 []entities.LocalSessionDetails
+		if args[2] != nil {
+			arg2 = args[2].([]entities.LocalSessionDetails)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMATLABSessionPool_Start_Call) Return() *MockMATLABSessionPool_Start_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockMATLABSessionPool_Start_Call) RunAndReturn(run func(logger entities.Logger, starter matlabsessionpool.SessionStarter, sessionDetails []entities.LocalSessionDetails)) *MockMATLABSessionPool_Start_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Take provides a mock function for the type MockMATLABSessionPool
+func (_mock *MockMATLABSessionPool) Take(ctx context.Context, logger entities.Logger, sessionDetails entities.LocalSessionDetails) (matlabsessionstore.MATLABSessionClientWithCleanup, bool) {
+	ret := _mock.Called(ctx, logger, sessionDetails)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Take")
+	}
+
+	var r0 matlabsessionstore.MATLABSessionClientWithCleanup
+	var r1 bool
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.LocalSessionDetails) (matlabsessionstore.MATLABSessionClientWithCleanup, bool)); ok {
+		return returnFunc(ctx, logger, sessionDetails)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.LocalSessionDetails) matlabsessionstore.MATLABSessionClientWithCleanup); ok {
+		r0 = returnFunc(ctx, logger, sessionDetails)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(matlabsessionstore.MATLABSessionClientWithCleanup)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entities.Logger, entities.LocalSessionDetails) bool); ok {
+		r1 = returnFunc(ctx, logger, sessionDetails)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	return r0, r1
+}
+
+// MockMATLABSessionPool_Take_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Take'
+type MockMATLABSessionPool_Take_Call struct {
+	*mock.Call
+}
+
+// Take is a helper method to define mock.On call
+//   - ctx context.Context
+//   - logger entities.Logger
+//   - sessionDetails entities.LocalSessionDetails
+func (_e *MockMATLABSessionPool_Expecter) Take(ctx interface{}, logger interface{}, sessionDetails interface{}) *MockMATLABSessionPool_Take_Call {
+	return &MockMATLABSessionPool_Take_Call{Call: _e.mock.On("Take", ctx, logger, sessionDetails)}
+}
+
+func (_c *MockMATLABSessionPool_Take_Call) Run(run func(ctx context.Context, logger entities.Logger, sessionDetails entities.LocalSessionDetails)) *MockMATLABSessionPool_Take_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		var arg2 entities.LocalSessionDetails
+		if args[2] != nil {
+			arg2 = args[2].(entities.LocalSessionDetails)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMATLABSessionPool_Take_Call) Return(mATLABSessionClientWithCleanup matlabsessionstore.MATLABSessionClientWithCleanup, b bool) *MockMATLABSessionPool_Take_Call {
+	_c.Call.Return(mATLABSessionClientWithCleanup, b)
+	return _c
+}
+
+func (_c *MockMATLABSessionPool_Take_Call) RunAndReturn(run func(ctx context.Context, logger entities.Logger, sessionDetails entities.LocalSessionDetails) (matlabsessionstore.MATLABSessionClientWithCleanup, bool)) *MockMATLABSessionPool_Take_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -189,3 +189,60 @@ func (_c *MockMATLABSessionStore_Remove_Call) RunAndReturn(run func(sessionID en
 	_c.Run(run)
 	return _c
 }
+
+// Replace provides a mock function for the type MockMATLABSessionStore
+func (_mock *MockMATLABSessionStore) Replace(sessionID entities.SessionID, client matlabsessionstore.MATLABSessionClientWithCleanup) error {
+	ret := _mock.Called(sessionID, client)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Replace")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(entities.SessionID, matlabsessionstore.MATLABSessionClientWithCleanup) error); ok {
+		r0 = returnFunc(sessionID, client)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockMATLABSessionStore_Replace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Replace'
+type MockMATLABSessionStore_Replace_Call struct {
+	*mock.Call
+}
+
+// Replace is a helper method to define mock.On call
+//   - sessionID entities.SessionID
+//   - client matlabsessionstore.MATLABSessionClientWithCleanup
+func (_e *MockMATLABSessionStore_Expecter) Replace(sessionID interface{}, client interface{}) *MockMATLABSessionStore_Replace_Call {
+	return &MockMATLABSessionStore_Replace_Call{Call: _e.mock.On("Replace", sessionID, client)}
+}
+
+func (_c *MockMATLABSessionStore_Replace_Call) Run(run func(sessionID entities.SessionID, client matlabsessionstore.MATLABSessionClientWithCleanup)) *MockMATLABSessionStore_Replace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 entities.SessionID
+		if args[0] != nil {
+			arg0 = args[0].(entities.SessionID)
+		}
+		var arg1 matlabsessionstore.MATLABSessionClientWithCleanup
+		if args[1] != nil {
+			arg1 = args[1].(matlabsessionstore.MATLABSessionClientWithCleanup)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMATLABSessionStore_Replace_Call) Return(err error) *MockMATLABSessionStore_Replace_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockMATLABSessionStore_Replace_Call) RunAndReturn(run func(sessionID entities.SessionID, client matlabsessionstore.MATLABSessionClientWithCleanup) error) *MockMATLABSessionStore_Replace_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -39,7 +39,7 @@ func (_m *MockLocalMATLABSessionLauncher) EXPECT() *MockLocalMATLABSessionLaunch
 }
 
 // StartLocalMATLABSession provides a mock function for the type MockLocalMATLABSessionLauncher
-func (_mock *MockLocalMATLABSessionLauncher) StartLocalMATLABSession(logger entities.Logger, request datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, func() error, error) {
+func (_mock *MockLocalMATLABSessionLauncher) StartLocalMATLABSession(logger entities.Logger, request datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, string, func(killMATLAB bool) error, error) {
 	ret := _mock.Called(logger, request)
 
 	if len(ret) == 0 {
@@ -47,9 +47,10 @@ func (_mock *MockLocalMATLABSessionLauncher) StartLocalMATLABSession(logger enti
 	}
 
 	var r0 embeddedconnector.ConnectionDetails
-	var r1 func() error
-	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(entities.Logger, datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, func() error, error)); ok {
+	var r1 string
+	var r2 func(killMATLAB bool) error
+	var r3 error
+	if returnFunc, ok := ret.Get(0).(func(entities.Logger, datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, string, func(killMATLAB bool) error, error)); ok {
 		return returnFunc(logger, request)
 	}
 	if returnFunc, ok := ret.Get(0).(func(entities.Logger, datatypes.LocalSessionDetails) embeddedconnector.ConnectionDetails); ok {
@@ -57,19 +58,24 @@ func (_mock *MockLocalMATLABSessionLauncher) StartLocalMATLABSession(logger enti
 	} else {
 		r0 = ret.Get(0).(embeddedconnector.ConnectionDetails)
 	}
-	if returnFunc, ok := ret.Get(1).(func(entities.Logger, datatypes.LocalSessionDetails) func() error); ok {
+	if returnFunc, ok := ret.Get(1).(func(entities.Logger, datatypes.LocalSessionDetails) string); ok {
 		r1 = returnFunc(logger, request)
 	} else {
-		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(func() error)
-		}
+		r1 = ret.Get(1).(string)
 	}
-	if returnFunc, ok := ret.Get(2).(func(entities.Logger, datatypes.LocalSessionDetails) error); ok {
+	if returnFunc, ok := ret.Get(2).(func(entities.Logger, datatypes.LocalSessionDetails) func(killMATLAB bool) error); ok {
 		r2 = returnFunc(logger, request)
 	} else {
-		r2 = ret.Error(2)
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(func(killMATLAB bool) error)
+		}
+	}
+	if returnFunc, ok := ret.Get(3).(func(entities.Logger, datatypes.LocalSessionDetails) error); ok {
+		r3 = returnFunc(logger, request)
+	} else {
+		r3 = ret.Error(3)
 	}
-	return r0, r1, r2
+	return r0, r1, r2, r3
 }
 
 // MockLocalMATLABSessionLauncher_StartLocalMATLABSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartLocalMATLABSession'
@@ -102,12 +108,12 @@ func (_c *MockLocalMATLABSessionLauncher_StartLocalMATLABSession_Call) Run(run f
 	return _c
 }
 
-func (_c *MockLocalMATLABSessionLauncher_StartLocalMATLABSession_Call) Return(connectionDetails embeddedconnector.ConnectionDetails, fn func() error, err error) *MockLocalMATLABSessionLauncher_StartLocalMATLABSession_Call {
-	_c.Call.Return(connectionDetails, fn, err)
+func (_c *MockLocalMATLABSessionLauncher_StartLocalMATLABSession_Call) Return(connectionDetails embeddedconnector.ConnectionDetails, s string, fn func(killMATLAB bool) error, err error) *MockLocalMATLABSessionLauncher_StartLocalMATLABSession_Call {
+	_c.Call.Return(connectionDetails, s, fn, err)
 	return _c
 }
 
-func (_c *MockLocalMATLABSessionLauncher_StartLocalMATLABSession_Call) RunAndReturn(run func(logger entities.Logger, request datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, func() error, error)) *MockLocalMATLABSessionLauncher_StartLocalMATLABSession_Call {
+func (_c *MockLocalMATLABSessionLauncher_StartLocalMATLABSession_Call) RunAndReturn(run func(logger entities.Logger, request datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, string, func(killMATLAB bool) error, error)) *MockLocalMATLABSessionLauncher_StartLocalMATLABSession_Call {
 	_c.Call.Return(run)
 	return _c
 }
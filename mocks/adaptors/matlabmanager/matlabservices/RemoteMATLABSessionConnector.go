@@ -0,0 +1,178 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockRemoteMATLABSessionConnector creates a new instance of MockRemoteMATLABSessionConnector. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRemoteMATLABSessionConnector(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRemoteMATLABSessionConnector {
+	mock := &MockRemoteMATLABSessionConnector{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRemoteMATLABSessionConnector is an autogenerated mock type for the RemoteMATLABSessionConnector type
+type MockRemoteMATLABSessionConnector struct {
+	mock.Mock
+}
+
+type MockRemoteMATLABSessionConnector_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRemoteMATLABSessionConnector) EXPECT() *MockRemoteMATLABSessionConnector_Expecter {
+	return &MockRemoteMATLABSessionConnector_Expecter{mock: &_m.Mock}
+}
+
+// ConnectToRemoteMATLABSession provides a mock function for the type MockRemoteMATLABSessionConnector
+func (_mock *MockRemoteMATLABSessionConnector) ConnectToRemoteMATLABSession(logger entities.Logger, request datatypes.RemoteSessionDetails) (embeddedconnector.ConnectionDetails, func(killMATLAB bool) error, error) {
+	ret := _mock.Called(logger, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConnectToRemoteMATLABSession")
+	}
+
+	var r0 embeddedconnector.ConnectionDetails
+	var r1 func(killMATLAB bool) error
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(entities.Logger, datatypes.RemoteSessionDetails) (embeddedconnector.ConnectionDetails, func(killMATLAB bool) error, error)); ok {
+		return returnFunc(logger, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(entities.Logger, datatypes.RemoteSessionDetails) embeddedconnector.ConnectionDetails); ok {
+		r0 = returnFunc(logger, request)
+	} else {
+		r0 = ret.Get(0).(embeddedconnector.ConnectionDetails)
+	}
+	if returnFunc, ok := ret.Get(1).(func(entities.Logger, datatypes.RemoteSessionDetails) func(killMATLAB bool) error); ok {
+		r1 = returnFunc(logger, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func(killMATLAB bool) error)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(entities.Logger, datatypes.RemoteSessionDetails) error); ok {
+		r2 = returnFunc(logger, request)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockRemoteMATLABSessionConnector_ConnectToRemoteMATLABSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConnectToRemoteMATLABSession'
+type MockRemoteMATLABSessionConnector_ConnectToRemoteMATLABSession_Call struct {
+	*mock.Call
+}
+
+// ConnectToRemoteMATLABSession is a helper method to define mock.On call
+//   - logger entities.Logger
+//   - request datatypes.RemoteSessionDetails
+func (_e *MockRemoteMATLABSessionConnector_Expecter) ConnectToRemoteMATLABSession(logger interface{}, request interface{}) *MockRemoteMATLABSessionConnector_ConnectToRemoteMATLABSession_Call {
+	return &MockRemoteMATLABSessionConnector_ConnectToRemoteMATLABSession_Call{Call: _e.mock.On("ConnectToRemoteMATLABSession", logger, request)}
+}
+
+func (_c *MockRemoteMATLABSessionConnector_ConnectToRemoteMATLABSession_Call) Run(run func(logger entities.Logger, request datatypes.RemoteSessionDetails)) *MockRemoteMATLABSessionConnector_ConnectToRemoteMATLABSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 entities.Logger
+		if args[0] != nil {
+			arg0 = args[0].(entities.Logger)
+		}
+		var arg1 datatypes.RemoteSessionDetails
+		if args[1] != nil {
+			arg1 = args[1].(datatypes.RemoteSessionDetails)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRemoteMATLABSessionConnector_ConnectToRemoteMATLABSession_Call) Return(connectionDetails embeddedconnector.ConnectionDetails, fn func(killMATLAB bool) error, err error) *MockRemoteMATLABSessionConnector_ConnectToRemoteMATLABSession_Call {
+	_c.Call.Return(connectionDetails, fn, err)
+	return _c
+}
+
+func (_c *MockRemoteMATLABSessionConnector_ConnectToRemoteMATLABSession_Call) RunAndReturn(run func(logger entities.Logger, request datatypes.RemoteSessionDetails) (embeddedconnector.ConnectionDetails, func(killMATLAB bool) error, error)) *MockRemoteMATLABSessionConnector_ConnectToRemoteMATLABSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InstallMATLABPackage provides a mock function for the type MockRemoteMATLABSessionConnector
+func (_mock *MockRemoteMATLABSessionConnector) InstallMATLABPackage(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient) error {
+	ret := _mock.Called(ctx, logger, client)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InstallMATLABPackage")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.MATLABSessionClient) error); ok {
+		r0 = returnFunc(ctx, logger, client)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRemoteMATLABSessionConnector_InstallMATLABPackage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InstallMATLABPackage'
+type MockRemoteMATLABSessionConnector_InstallMATLABPackage_Call struct {
+	*mock.Call
+}
+
+// InstallMATLABPackage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - logger entities.Logger
+//   - client entities.MATLABSessionClient
+func (_e *MockRemoteMATLABSessionConnector_Expecter) InstallMATLABPackage(ctx interface{}, logger interface{}, client interface{}) *MockRemoteMATLABSessionConnector_InstallMATLABPackage_Call {
+	return &MockRemoteMATLABSessionConnector_InstallMATLABPackage_Call{Call: _e.mock.On("InstallMATLABPackage", ctx, logger, client)}
+}
+
+func (_c *MockRemoteMATLABSessionConnector_InstallMATLABPackage_Call) Run(run func(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient)) *MockRemoteMATLABSessionConnector_InstallMATLABPackage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		var arg2 entities.MATLABSessionClient
+		if args[2] != nil {
+			arg2 = args[2].(entities.MATLABSessionClient)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRemoteMATLABSessionConnector_InstallMATLABPackage_Call) Return(err error) *MockRemoteMATLABSessionConnector_InstallMATLABPackage_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRemoteMATLABSessionConnector_InstallMATLABPackage_Call) RunAndReturn(run func(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient) error) *MockRemoteMATLABSessionConnector_InstallMATLABPackage_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,113 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockSharedMATLABSessionAttacher creates a new instance of MockSharedMATLABSessionAttacher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSharedMATLABSessionAttacher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSharedMATLABSessionAttacher {
+	mock := &MockSharedMATLABSessionAttacher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSharedMATLABSessionAttacher is an autogenerated mock type for the SharedMATLABSessionAttacher type
+type MockSharedMATLABSessionAttacher struct {
+	mock.Mock
+}
+
+type MockSharedMATLABSessionAttacher_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSharedMATLABSessionAttacher) EXPECT() *MockSharedMATLABSessionAttacher_Expecter {
+	return &MockSharedMATLABSessionAttacher_Expecter{mock: &_m.Mock}
+}
+
+// AttachToSharedMATLABSession provides a mock function for the type MockSharedMATLABSessionAttacher
+func (_mock *MockSharedMATLABSessionAttacher) AttachToSharedMATLABSession(logger entities.Logger, request datatypes.SharedSessionDetails) (embeddedconnector.ConnectionDetails, func(killMATLAB bool) error, error) {
+	ret := _mock.Called(logger, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AttachToSharedMATLABSession")
+	}
+
+	var r0 embeddedconnector.ConnectionDetails
+	var r1 func(killMATLAB bool) error
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(entities.Logger, datatypes.SharedSessionDetails) (embeddedconnector.ConnectionDetails, func(killMATLAB bool) error, error)); ok {
+		return returnFunc(logger, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(entities.Logger, datatypes.SharedSessionDetails) embeddedconnector.ConnectionDetails); ok {
+		r0 = returnFunc(logger, request)
+	} else {
+		r0 = ret.Get(0).(embeddedconnector.ConnectionDetails)
+	}
+	if returnFunc, ok := ret.Get(1).(func(entities.Logger, datatypes.SharedSessionDetails) func(killMATLAB bool) error); ok {
+		r1 = returnFunc(logger, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func(killMATLAB bool) error)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(entities.Logger, datatypes.SharedSessionDetails) error); ok {
+		r2 = returnFunc(logger, request)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockSharedMATLABSessionAttacher_AttachToSharedMATLABSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AttachToSharedMATLABSession'
+type MockSharedMATLABSessionAttacher_AttachToSharedMATLABSession_Call struct {
+	*mock.Call
+}
+
+// AttachToSharedMATLABSession is a helper method to define mock.On call
+//   - logger entities.Logger
+//   - request datatypes.SharedSessionDetails
+func (_e *MockSharedMATLABSessionAttacher_Expecter) AttachToSharedMATLABSession(logger interface{}, request interface{}) *MockSharedMATLABSessionAttacher_AttachToSharedMATLABSession_Call {
+	return &MockSharedMATLABSessionAttacher_AttachToSharedMATLABSession_Call{Call: _e.mock.On("AttachToSharedMATLABSession", logger, request)}
+}
+
+func (_c *MockSharedMATLABSessionAttacher_AttachToSharedMATLABSession_Call) Run(run func(logger entities.Logger, request datatypes.SharedSessionDetails)) *MockSharedMATLABSessionAttacher_AttachToSharedMATLABSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 entities.Logger
+		if args[0] != nil {
+			arg0 = args[0].(entities.Logger)
+		}
+		var arg1 datatypes.SharedSessionDetails
+		if args[1] != nil {
+			arg1 = args[1].(datatypes.SharedSessionDetails)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSharedMATLABSessionAttacher_AttachToSharedMATLABSession_Call) Return(connectionDetails embeddedconnector.ConnectionDetails, fn func(killMATLAB bool) error, err error) *MockSharedMATLABSessionAttacher_AttachToSharedMATLABSession_Call {
+	_c.Call.Return(connectionDetails, fn, err)
+	return _c
+}
+
+func (_c *MockSharedMATLABSessionAttacher_AttachToSharedMATLABSession_Call) RunAndReturn(run func(logger entities.Logger, request datatypes.SharedSessionDetails) (embeddedconnector.ConnectionDetails, func(killMATLAB bool) error, error)) *MockSharedMATLABSessionAttacher_AttachToSharedMATLABSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -37,7 +37,7 @@ func (_m *MockMATLABProcessLauncher) EXPECT() *MockMATLABProcessLauncher_Expecte
 }
 
 // Launch provides a mock function for the type MockMATLABProcessLauncher
-func (_mock *MockMATLABProcessLauncher) Launch(logger entities.Logger, sessionRoot string, matlabRoot string, workingDir string, args []string, env []string) (int, func(), error) {
+func (_mock *MockMATLABProcessLauncher) Launch(logger entities.Logger, sessionRoot string, matlabRoot string, workingDir string, args []string, env []string) (int, func(killMATLAB bool), error) {
 	ret := _mock.Called(logger, sessionRoot, matlabRoot, workingDir, args, env)
 
 	if len(ret) == 0 {
@@ -45,9 +45,9 @@ func (_mock *MockMATLABProcessLauncher) Launch(logger entities.Logger, sessionRo
 	}
 
 	var r0 int
-	var r1 func()
+	var r1 func(killMATLAB bool)
 	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(entities.Logger, string, string, string, []string, []string) (int, func(), error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(entities.Logger, string, string, string, []string, []string) (int, func(killMATLAB bool), error)); ok {
 		return returnFunc(logger, sessionRoot, matlabRoot, workingDir, args, env)
 	}
 	if returnFunc, ok := ret.Get(0).(func(entities.Logger, string, string, string, []string, []string) int); ok {
@@ -55,11 +55,11 @@ func (_mock *MockMATLABProcessLauncher) Launch(logger entities.Logger, sessionRo
 	} else {
 		r0 = ret.Get(0).(int)
 	}
-	if returnFunc, ok := ret.Get(1).(func(entities.Logger, string, string, string, []string, []string) func()); ok {
+	if returnFunc, ok := ret.Get(1).(func(entities.Logger, string, string, string, []string, []string) func(killMATLAB bool)); ok {
 		r1 = returnFunc(logger, sessionRoot, matlabRoot, workingDir, args, env)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(func())
+			r1 = ret.Get(1).(func(killMATLAB bool))
 		}
 	}
 	if returnFunc, ok := ret.Get(2).(func(entities.Logger, string, string, string, []string, []string) error); ok {
@@ -124,12 +124,12 @@ func (_c *MockMATLABProcessLauncher_Launch_Call) Run(run func(logger entities.Lo
 	return _c
 }
 
-func (_c *MockMATLABProcessLauncher_Launch_Call) Return(n int, fn func(), err error) *MockMATLABProcessLauncher_Launch_Call {
+func (_c *MockMATLABProcessLauncher_Launch_Call) Return(n int, fn func(killMATLAB bool), err error) *MockMATLABProcessLauncher_Launch_Call {
 	_c.Call.Return(n, fn, err)
 	return _c
 }
 
-func (_c *MockMATLABProcessLauncher_Launch_Call) RunAndReturn(run func(logger entities.Logger, sessionRoot string, matlabRoot string, workingDir string, args []string, env []string) (int, func(), error)) *MockMATLABProcessLauncher_Launch_Call {
+func (_c *MockMATLABProcessLauncher_Launch_Call) RunAndReturn(run func(logger entities.Logger, sessionRoot string, matlabRoot string, workingDir string, args []string, env []string) (int, func(killMATLAB bool), error)) *MockMATLABProcessLauncher_Launch_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -35,6 +35,59 @@ func (_m *MockDirectory) EXPECT() *MockDirectory_Expecter {
 	return &MockDirectory_Expecter{mock: &_m.Mock}
 }
 
+// APIKey provides a mock function for the type MockDirectory
+func (_mock *MockDirectory) APIKey() (string, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for APIKey")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (string, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDirectory_APIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'APIKey'
+type MockDirectory_APIKey_Call struct {
+	*mock.Call
+}
+
+// APIKey is a helper method to define mock.On call
+func (_e *MockDirectory_Expecter) APIKey() *MockDirectory_APIKey_Call {
+	return &MockDirectory_APIKey_Call{Call: _e.mock.On("APIKey")}
+}
+
+func (_c *MockDirectory_APIKey_Call) Run(run func()) *MockDirectory_APIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockDirectory_APIKey_Call) Return(s string, err error) *MockDirectory_APIKey_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockDirectory_APIKey_Call) RunAndReturn(run func() (string, error)) *MockDirectory_APIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CertificateFile provides a mock function for the type MockDirectory
 func (_mock *MockDirectory) CertificateFile() string {
 	ret := _mock.Called()
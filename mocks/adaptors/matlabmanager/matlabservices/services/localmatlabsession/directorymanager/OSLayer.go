@@ -95,6 +95,63 @@ func (_c *MockOSLayer_Mkdir_Call) RunAndReturn(run func(name string, perm os.Fil
 	return _c
 }
 
+// MkdirAll provides a mock function for the type MockOSLayer
+func (_mock *MockOSLayer) MkdirAll(path string, perm os.FileMode) error {
+	ret := _mock.Called(path, perm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MkdirAll")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, os.FileMode) error); ok {
+		r0 = returnFunc(path, perm)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockOSLayer_MkdirAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MkdirAll'
+type MockOSLayer_MkdirAll_Call struct {
+	*mock.Call
+}
+
+// MkdirAll is a helper method to define mock.On call
+//   - path string
+//   - perm os.FileMode
+func (_e *MockOSLayer_Expecter) MkdirAll(path interface{}, perm interface{}) *MockOSLayer_MkdirAll_Call {
+	return &MockOSLayer_MkdirAll_Call{Call: _e.mock.On("MkdirAll", path, perm)}
+}
+
+func (_c *MockOSLayer_MkdirAll_Call) Run(run func(path string, perm os.FileMode)) *MockOSLayer_MkdirAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 os.FileMode
+		if args[1] != nil {
+			arg1 = args[1].(os.FileMode)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOSLayer_MkdirAll_Call) Return(err error) *MockOSLayer_MkdirAll_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockOSLayer_MkdirAll_Call) RunAndReturn(run func(path string, perm os.FileMode) error) *MockOSLayer_MkdirAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ReadFile provides a mock function for the type MockOSLayer
 func (_mock *MockOSLayer) ReadFile(filePath string) ([]byte, error) {
 	ret := _mock.Called(filePath)
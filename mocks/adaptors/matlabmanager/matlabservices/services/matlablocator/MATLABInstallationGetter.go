@@ -0,0 +1,97 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockMATLABInstallationGetter creates a new instance of MockMATLABInstallationGetter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMATLABInstallationGetter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMATLABInstallationGetter {
+	mock := &MockMATLABInstallationGetter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockMATLABInstallationGetter is an autogenerated mock type for the MATLABInstallationGetter type
+type MockMATLABInstallationGetter struct {
+	mock.Mock
+}
+
+type MockMATLABInstallationGetter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMATLABInstallationGetter) EXPECT() *MockMATLABInstallationGetter_Expecter {
+	return &MockMATLABInstallationGetter_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function for the type MockMATLABInstallationGetter
+func (_mock *MockMATLABInstallationGetter) Get(matlabRootLocation string) (datatypes.MatlabInstallationInfo, error) {
+	ret := _mock.Called(matlabRootLocation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 datatypes.MatlabInstallationInfo
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (datatypes.MatlabInstallationInfo, error)); ok {
+		return returnFunc(matlabRootLocation)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) datatypes.MatlabInstallationInfo); ok {
+		r0 = returnFunc(matlabRootLocation)
+	} else {
+		r0 = ret.Get(0).(datatypes.MatlabInstallationInfo)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(matlabRootLocation)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMATLABInstallationGetter_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockMATLABInstallationGetter_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - matlabRootLocation string
+func (_e *MockMATLABInstallationGetter_Expecter) Get(matlabRootLocation interface{}) *MockMATLABInstallationGetter_Get_Call {
+	return &MockMATLABInstallationGetter_Get_Call{Call: _e.mock.On("Get", matlabRootLocation)}
+}
+
+func (_c *MockMATLABInstallationGetter_Get_Call) Run(run func(matlabRootLocation string)) *MockMATLABInstallationGetter_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMATLABInstallationGetter_Get_Call) Return(matlabInstallationInfo datatypes.MatlabInstallationInfo, err error) *MockMATLABInstallationGetter_Get_Call {
+	_c.Call.Return(matlabInstallationInfo, err)
+	return _c
+}
+
+func (_c *MockMATLABInstallationGetter_Get_Call) RunAndReturn(run func(matlabRootLocation string) (datatypes.MatlabInstallationInfo, error)) *MockMATLABInstallationGetter_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
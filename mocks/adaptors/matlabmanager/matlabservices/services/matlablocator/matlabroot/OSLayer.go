@@ -148,3 +148,56 @@ func (_c *MockOSLayer_Stat_Call) RunAndReturn(run func(name string) (osfacade.Fi
 	_c.Call.Return(run)
 	return _c
 }
+
+// UserHomeDir provides a mock function for the type MockOSLayer
+func (_mock *MockOSLayer) UserHomeDir() (string, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for UserHomeDir")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (string, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOSLayer_UserHomeDir_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UserHomeDir'
+type MockOSLayer_UserHomeDir_Call struct {
+	*mock.Call
+}
+
+// UserHomeDir is a helper method to define mock.On call
+func (_e *MockOSLayer_Expecter) UserHomeDir() *MockOSLayer_UserHomeDir_Call {
+	return &MockOSLayer_UserHomeDir_Call{Call: _e.mock.On("UserHomeDir")}
+}
+
+func (_c *MockOSLayer_UserHomeDir_Call) Run(run func()) *MockOSLayer_UserHomeDir_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockOSLayer_UserHomeDir_Call) Return(s string, err error) *MockOSLayer_UserHomeDir_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockOSLayer_UserHomeDir_Call) RunAndReturn(run func() (string, error)) *MockOSLayer_UserHomeDir_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,106 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession/directorymanager"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockSessionDirectoryFactory creates a new instance of MockSessionDirectoryFactory. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSessionDirectoryFactory(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSessionDirectoryFactory {
+	mock := &MockSessionDirectoryFactory{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSessionDirectoryFactory is an autogenerated mock type for the SessionDirectoryFactory type
+type MockSessionDirectoryFactory struct {
+	mock.Mock
+}
+
+type MockSessionDirectoryFactory_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSessionDirectoryFactory) EXPECT() *MockSessionDirectoryFactory_Expecter {
+	return &MockSessionDirectoryFactory_Expecter{mock: &_m.Mock}
+}
+
+// Open provides a mock function for the type MockSessionDirectoryFactory
+func (_mock *MockSessionDirectoryFactory) Open(logger entities.Logger, sessionDir string) (directorymanager.Directory, error) {
+	ret := _mock.Called(logger, sessionDir)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Open")
+	}
+
+	var r0 directorymanager.Directory
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(entities.Logger, string) (directorymanager.Directory, error)); ok {
+		return returnFunc(logger, sessionDir)
+	}
+	if returnFunc, ok := ret.Get(0).(func(entities.Logger, string) directorymanager.Directory); ok {
+		r0 = returnFunc(logger, sessionDir)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(directorymanager.Directory)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(entities.Logger, string) error); ok {
+		r1 = returnFunc(logger, sessionDir)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSessionDirectoryFactory_Open_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Open'
+type MockSessionDirectoryFactory_Open_Call struct {
+	*mock.Call
+}
+
+// Open is a helper method to define mock.On call
+//   - logger entities.Logger
+//   - sessionDir string
+func (_e *MockSessionDirectoryFactory_Expecter) Open(logger interface{}, sessionDir interface{}) *MockSessionDirectoryFactory_Open_Call {
+	return &MockSessionDirectoryFactory_Open_Call{Call: _e.mock.On("Open", logger, sessionDir)}
+}
+
+func (_c *MockSessionDirectoryFactory_Open_Call) Run(run func(logger entities.Logger, sessionDir string)) *MockSessionDirectoryFactory_Open_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 entities.Logger
+		if args[0] != nil {
+			arg0 = args[0].(entities.Logger)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionDirectoryFactory_Open_Call) Return(directory directorymanager.Directory, err error) *MockSessionDirectoryFactory_Open_Call {
+	_c.Call.Return(directory, err)
+	return _c
+}
+
+func (_c *MockSessionDirectoryFactory_Open_Call) RunAndReturn(run func(logger entities.Logger, sessionDir string) (directorymanager.Directory, error)) *MockSessionDirectoryFactory_Open_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,80 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockConfig creates a new instance of MockConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockConfig {
+	mock := &MockConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockConfig is an autogenerated mock type for the Config type
+type MockConfig struct {
+	mock.Mock
+}
+
+type MockConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockConfig) EXPECT() *MockConfig_Expecter {
+	return &MockConfig_Expecter{mock: &_m.Mock}
+}
+
+// MATLABSessionPoolSize provides a mock function for the type MockConfig
+func (_mock *MockConfig) MATLABSessionPoolSize() int {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MATLABSessionPoolSize")
+	}
+
+	var r0 int
+	if returnFunc, ok := ret.Get(0).(func() int); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	return r0
+}
+
+// MockConfig_MATLABSessionPoolSize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MATLABSessionPoolSize'
+type MockConfig_MATLABSessionPoolSize_Call struct {
+	*mock.Call
+}
+
+// MATLABSessionPoolSize is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) MATLABSessionPoolSize() *MockConfig_MATLABSessionPoolSize_Call {
+	return &MockConfig_MATLABSessionPoolSize_Call{Call: _e.mock.On("MATLABSessionPoolSize")}
+}
+
+func (_c *MockConfig_MATLABSessionPoolSize_Call) Run(run func()) *MockConfig_MATLABSessionPoolSize_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_MATLABSessionPoolSize_Call) Return(n int) *MockConfig_MATLABSessionPoolSize_Call {
+	_c.Call.Return(n)
+	return _c
+}
+
+func (_c *MockConfig_MATLABSessionPoolSize_Call) RunAndReturn(run func() int) *MockConfig_MATLABSessionPoolSize_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -311,6 +311,96 @@ func (_c *MockMATLABSessionClientWithCleanup_Ping_Call) RunAndReturn(run func(ct
 	return _c
 }
 
+// SessionDetails provides a mock function for the type MockMATLABSessionClientWithCleanup
+func (_mock *MockMATLABSessionClientWithCleanup) SessionDetails() entities.SessionDetails {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SessionDetails")
+	}
+
+	var r0 entities.SessionDetails
+	if returnFunc, ok := ret.Get(0).(func() entities.SessionDetails); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(entities.SessionDetails)
+		}
+	}
+	return r0
+}
+
+// MockMATLABSessionClientWithCleanup_SessionDetails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SessionDetails'
+type MockMATLABSessionClientWithCleanup_SessionDetails_Call struct {
+	*mock.Call
+}
+
+// SessionDetails is a helper method to define mock.On call
+func (_e *MockMATLABSessionClientWithCleanup_Expecter) SessionDetails() *MockMATLABSessionClientWithCleanup_SessionDetails_Call {
+	return &MockMATLABSessionClientWithCleanup_SessionDetails_Call{Call: _e.mock.On("SessionDetails")}
+}
+
+func (_c *MockMATLABSessionClientWithCleanup_SessionDetails_Call) Run(run func()) *MockMATLABSessionClientWithCleanup_SessionDetails_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockMATLABSessionClientWithCleanup_SessionDetails_Call) Return(sessionDetails entities.SessionDetails) *MockMATLABSessionClientWithCleanup_SessionDetails_Call {
+	_c.Call.Return(sessionDetails)
+	return _c
+}
+
+func (_c *MockMATLABSessionClientWithCleanup_SessionDetails_Call) RunAndReturn(run func() entities.SessionDetails) *MockMATLABSessionClientWithCleanup_SessionDetails_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SessionDir provides a mock function for the type MockMATLABSessionClientWithCleanup
+func (_mock *MockMATLABSessionClientWithCleanup) SessionDir() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SessionDir")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockMATLABSessionClientWithCleanup_SessionDir_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SessionDir'
+type MockMATLABSessionClientWithCleanup_SessionDir_Call struct {
+	*mock.Call
+}
+
+// SessionDir is a helper method to define mock.On call
+func (_e *MockMATLABSessionClientWithCleanup_Expecter) SessionDir() *MockMATLABSessionClientWithCleanup_SessionDir_Call {
+	return &MockMATLABSessionClientWithCleanup_SessionDir_Call{Call: _e.mock.On("SessionDir")}
+}
+
+func (_c *MockMATLABSessionClientWithCleanup_SessionDir_Call) Run(run func()) *MockMATLABSessionClientWithCleanup_SessionDir_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockMATLABSessionClientWithCleanup_SessionDir_Call) Return(s string) *MockMATLABSessionClientWithCleanup_SessionDir_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockMATLABSessionClientWithCleanup_SessionDir_Call) RunAndReturn(run func() string) *MockMATLABSessionClientWithCleanup_SessionDir_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StopSession provides a mock function for the type MockMATLABSessionClientWithCleanup
 func (_mock *MockMATLABSessionClientWithCleanup) StopSession(ctx context.Context, sessionLogger entities.Logger) error {
 	ret := _mock.Called(ctx, sessionLogger)
@@ -0,0 +1,119 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockProvider creates a new instance of MockProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProvider {
+	mock := &MockProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProvider is an autogenerated mock type for the Provider type
+type MockProvider struct {
+	mock.Mock
+}
+
+type MockProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProvider) EXPECT() *MockProvider_Expecter {
+	return &MockProvider_Expecter{mock: &_m.Mock}
+}
+
+// Complete provides a mock function for the type MockProvider
+func (_mock *MockProvider) Complete(ctx context.Context, sessionLogger entities.Logger, value string, arguments map[string]string) ([]string, error) {
+	ret := _mock.Called(ctx, sessionLogger, value, arguments)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Complete")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, string, map[string]string) ([]string, error)); ok {
+		return returnFunc(ctx, sessionLogger, value, arguments)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, string, map[string]string) []string); ok {
+		r0 = returnFunc(ctx, sessionLogger, value, arguments)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entities.Logger, string, map[string]string) error); ok {
+		r1 = returnFunc(ctx, sessionLogger, value, arguments)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProvider_Complete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Complete'
+type MockProvider_Complete_Call struct {
+	*mock.Call
+}
+
+// Complete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionLogger entities.Logger
+//   - value string
+//   - arguments map[string]string
+func (_e *MockProvider_Expecter) Complete(ctx interface{}, sessionLogger interface{}, value interface{}, arguments interface{}) *MockProvider_Complete_Call {
+	return &MockProvider_Complete_Call{Call: _e.mock.On("Complete", ctx, sessionLogger, value, arguments)}
+}
+
+func (_c *MockProvider_Complete_Call) Run(run func(ctx context.Context, sessionLogger entities.Logger, value string, arguments map[string]string)) *MockProvider_Complete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 map[string]string
+		if args[3] != nil {
+			arg3 = args[3].(map[string]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProvider_Complete_Call) Return(strings []string, err error) *MockProvider_Complete_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockProvider_Complete_Call) RunAndReturn(run func(ctx context.Context, sessionLogger entities.Logger, value string, arguments map[string]string) ([]string, error)) *MockProvider_Complete_Call {
+	_c.Call.Return(run)
+	return _c
+}
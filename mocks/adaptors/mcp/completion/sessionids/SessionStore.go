@@ -0,0 +1,83 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockSessionStore creates a new instance of MockSessionStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSessionStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSessionStore {
+	mock := &MockSessionStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSessionStore is an autogenerated mock type for the SessionStore type
+type MockSessionStore struct {
+	mock.Mock
+}
+
+type MockSessionStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSessionStore) EXPECT() *MockSessionStore_Expecter {
+	return &MockSessionStore_Expecter{mock: &_m.Mock}
+}
+
+// SessionIDs provides a mock function for the type MockSessionStore
+func (_mock *MockSessionStore) SessionIDs() []entities.SessionID {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SessionIDs")
+	}
+
+	var r0 []entities.SessionID
+	if returnFunc, ok := ret.Get(0).(func() []entities.SessionID); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.SessionID)
+		}
+	}
+	return r0
+}
+
+// MockSessionStore_SessionIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SessionIDs'
+type MockSessionStore_SessionIDs_Call struct {
+	*mock.Call
+}
+
+// SessionIDs is a helper method to define mock.On call
+func (_e *MockSessionStore_Expecter) SessionIDs() *MockSessionStore_SessionIDs_Call {
+	return &MockSessionStore_SessionIDs_Call{Call: _e.mock.On("SessionIDs")}
+}
+
+func (_c *MockSessionStore_SessionIDs_Call) Run(run func()) *MockSessionStore_SessionIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockSessionStore_SessionIDs_Call) Return(sessionIDs []entities.SessionID) *MockSessionStore_SessionIDs_Call {
+	_c.Call.Return(sessionIDs)
+	return _c
+}
+
+func (_c *MockSessionStore_SessionIDs_Call) RunAndReturn(run func() []entities.SessionID) *MockSessionStore_SessionIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
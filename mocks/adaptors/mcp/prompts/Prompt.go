@@ -0,0 +1,77 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockPrompt creates a new instance of MockPrompt. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPrompt(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPrompt {
+	mock := &MockPrompt{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPrompt is an autogenerated mock type for the Prompt type
+type MockPrompt struct {
+	mock.Mock
+}
+
+type MockPrompt_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPrompt) EXPECT() *MockPrompt_Expecter {
+	return &MockPrompt_Expecter{mock: &_m.Mock}
+}
+
+// AddToServer provides a mock function for the type MockPrompt
+func (_mock *MockPrompt) AddToServer(server prompts.Server) {
+	_mock.Called(server)
+	return
+}
+
+// MockPrompt_AddToServer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddToServer'
+type MockPrompt_AddToServer_Call struct {
+	*mock.Call
+}
+
+// AddToServer is a helper method to define mock.On call
+//   - server prompts.Server
+func (_e *MockPrompt_Expecter) AddToServer(server interface{}) *MockPrompt_AddToServer_Call {
+	return &MockPrompt_AddToServer_Call{Call: _e.mock.On("AddToServer", server)}
+}
+
+func (_c *MockPrompt_AddToServer_Call) Run(run func(server prompts.Server)) *MockPrompt_AddToServer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 prompts.Server
+		if args[0] != nil {
+			arg0 = args[0].(prompts.Server)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPrompt_AddToServer_Call) Return() *MockPrompt_AddToServer_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockPrompt_AddToServer_Call) RunAndReturn(run func(server prompts.Server)) *MockPrompt_AddToServer_Call {
+	_c.Run(run)
+	return _c
+}
@@ -0,0 +1,83 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockServer creates a new instance of MockServer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockServer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockServer {
+	mock := &MockServer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockServer is an autogenerated mock type for the Server type
+type MockServer struct {
+	mock.Mock
+}
+
+type MockServer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockServer) EXPECT() *MockServer_Expecter {
+	return &MockServer_Expecter{mock: &_m.Mock}
+}
+
+// AddPrompt provides a mock function for the type MockServer
+func (_mock *MockServer) AddPrompt(prompt *mcp.Prompt, handler mcp.PromptHandler) {
+	_mock.Called(prompt, handler)
+	return
+}
+
+// MockServer_AddPrompt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddPrompt'
+type MockServer_AddPrompt_Call struct {
+	*mock.Call
+}
+
+// AddPrompt is a helper method to define mock.On call
+//   - prompt *mcp.Prompt
+//   - handler mcp.PromptHandler
+func (_e *MockServer_Expecter) AddPrompt(prompt interface{}, handler interface{}) *MockServer_AddPrompt_Call {
+	return &MockServer_AddPrompt_Call{Call: _e.mock.On("AddPrompt", prompt, handler)}
+}
+
+func (_c *MockServer_AddPrompt_Call) Run(run func(prompt *mcp.Prompt, handler mcp.PromptHandler)) *MockServer_AddPrompt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *mcp.Prompt
+		if args[0] != nil {
+			arg0 = args[0].(*mcp.Prompt)
+		}
+		var arg1 mcp.PromptHandler
+		if args[1] != nil {
+			arg1 = args[1].(mcp.PromptHandler)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockServer_AddPrompt_Call) Return() *MockServer_AddPrompt_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockServer_AddPrompt_Call) RunAndReturn(run func(prompt *mcp.Prompt, handler mcp.PromptHandler)) *MockServer_AddPrompt_Call {
+	_c.Run(run)
+	return _c
+}
@@ -81,3 +81,97 @@ func (_c *MockServer_AddResource_Call) RunAndReturn(run func(resource *mcp.Resou
 	_c.Run(run)
 	return _c
 }
+
+// AddResourceTemplate provides a mock function for the type MockServer
+func (_mock *MockServer) AddResourceTemplate(template *mcp.ResourceTemplate, handler mcp.ResourceHandler) {
+	_mock.Called(template, handler)
+	return
+}
+
+// MockServer_AddResourceTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddResourceTemplate'
+type MockServer_AddResourceTemplate_Call struct {
+	*mock.Call
+}
+
+// AddResourceTemplate is a helper method to define mock.On call
+//   - template *mcp.ResourceTemplate
+//   - handler mcp.ResourceHandler
+func (_e *MockServer_Expecter) AddResourceTemplate(template interface{}, handler interface{}) *MockServer_AddResourceTemplate_Call {
+	return &MockServer_AddResourceTemplate_Call{Call: _e.mock.On("AddResourceTemplate", template, handler)}
+}
+
+func (_c *MockServer_AddResourceTemplate_Call) Run(run func(template *mcp.ResourceTemplate, handler mcp.ResourceHandler)) *MockServer_AddResourceTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *mcp.ResourceTemplate
+		if args[0] != nil {
+			arg0 = args[0].(*mcp.ResourceTemplate)
+		}
+		var arg1 mcp.ResourceHandler
+		if args[1] != nil {
+			arg1 = args[1].(mcp.ResourceHandler)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockServer_AddResourceTemplate_Call) Return() *MockServer_AddResourceTemplate_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockServer_AddResourceTemplate_Call) RunAndReturn(run func(template *mcp.ResourceTemplate, handler mcp.ResourceHandler)) *MockServer_AddResourceTemplate_Call {
+	_c.Run(run)
+	return _c
+}
+
+// RemoveResources provides a mock function for the type MockServer
+func (_mock *MockServer) RemoveResources(uris ...string) {
+	if len(uris) > 0 {
+		_mock.Called(uris)
+	} else {
+		_mock.Called()
+	}
+
+	return
+}
+
+// MockServer_RemoveResources_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveResources'
+type MockServer_RemoveResources_Call struct {
+	*mock.Call
+}
+
+// RemoveResources is a helper method to define mock.On call
+//   - uris ...string
+func (_e *MockServer_Expecter) RemoveResources(uris ...interface{}) *MockServer_RemoveResources_Call {
+	return &MockServer_RemoveResources_Call{Call: _e.mock.On("RemoveResources",
+		append([]interface{}{}, uris...)...)}
+}
+
+func (_c *MockServer_RemoveResources_Call) Run(run func(uris ...string)) *MockServer_RemoveResources_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []string
+		var variadicArgs []string
+		if len(args) > 0 {
+			variadicArgs = args[0].([]string)
+		}
+		arg0 = variadicArgs
+		run(
+			arg0...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockServer_RemoveResources_Call) Return() *MockServer_RemoveResources_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockServer_RemoveResources_Call) RunAndReturn(run func(uris ...string)) *MockServer_RemoveResources_Call {
+	_c.Run(run)
+	return _c
+}
@@ -0,0 +1,81 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/debugsession"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockDebugSession creates a new instance of MockDebugSession. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDebugSession(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDebugSession {
+	mock := &MockDebugSession{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockDebugSession is an autogenerated mock type for the DebugSession type
+type MockDebugSession struct {
+	mock.Mock
+}
+
+type MockDebugSession_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDebugSession) EXPECT() *MockDebugSession_Expecter {
+	return &MockDebugSession_Expecter{mock: &_m.Mock}
+}
+
+// State provides a mock function for the type MockDebugSession
+func (_mock *MockDebugSession) State() debugsession.State {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for State")
+	}
+
+	var r0 debugsession.State
+	if returnFunc, ok := ret.Get(0).(func() debugsession.State); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(debugsession.State)
+	}
+	return r0
+}
+
+// MockDebugSession_State_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'State'
+type MockDebugSession_State_Call struct {
+	*mock.Call
+}
+
+// State is a helper method to define mock.On call
+func (_e *MockDebugSession_Expecter) State() *MockDebugSession_State_Call {
+	return &MockDebugSession_State_Call{Call: _e.mock.On("State")}
+}
+
+func (_c *MockDebugSession_State_Call) Run(run func()) *MockDebugSession_State_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockDebugSession_State_Call) Return(state debugsession.State) *MockDebugSession_State_Call {
+	_c.Call.Return(state)
+	return _c
+}
+
+func (_c *MockDebugSession_State_Call) RunAndReturn(run func() debugsession.State) *MockDebugSession_State_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,160 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockGlobalMATLAB creates a new instance of MockGlobalMATLAB. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGlobalMATLAB(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGlobalMATLAB {
+	mock := &MockGlobalMATLAB{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGlobalMATLAB is an autogenerated mock type for the GlobalMATLAB type
+type MockGlobalMATLAB struct {
+	mock.Mock
+}
+
+type MockGlobalMATLAB_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGlobalMATLAB) EXPECT() *MockGlobalMATLAB_Expecter {
+	return &MockGlobalMATLAB_Expecter{mock: &_m.Mock}
+}
+
+// RunningClient provides a mock function for the type MockGlobalMATLAB
+func (_mock *MockGlobalMATLAB) RunningClient(ctx context.Context, logger entities.Logger) (entities.MATLABSessionClient, bool) {
+	ret := _mock.Called(ctx, logger)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunningClient")
+	}
+
+	var r0 entities.MATLABSessionClient
+	var r1 bool
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger) (entities.MATLABSessionClient, bool)); ok {
+		return returnFunc(ctx, logger)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger) entities.MATLABSessionClient); ok {
+		r0 = returnFunc(ctx, logger)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(entities.MATLABSessionClient)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entities.Logger) bool); ok {
+		r1 = returnFunc(ctx, logger)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	return r0, r1
+}
+
+// MockGlobalMATLAB_RunningClient_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunningClient'
+type MockGlobalMATLAB_RunningClient_Call struct {
+	*mock.Call
+}
+
+// RunningClient is a helper method to define mock.On call
+//   - ctx context.Context
+//   - logger entities.Logger
+func (_e *MockGlobalMATLAB_Expecter) RunningClient(ctx interface{}, logger interface{}) *MockGlobalMATLAB_RunningClient_Call {
+	return &MockGlobalMATLAB_RunningClient_Call{Call: _e.mock.On("RunningClient", ctx, logger)}
+}
+
+func (_c *MockGlobalMATLAB_RunningClient_Call) Run(run func(ctx context.Context, logger entities.Logger)) *MockGlobalMATLAB_RunningClient_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGlobalMATLAB_RunningClient_Call) Return(mATLABSessionClient entities.MATLABSessionClient, b bool) *MockGlobalMATLAB_RunningClient_Call {
+	_c.Call.Return(mATLABSessionClient, b)
+	return _c
+}
+
+func (_c *MockGlobalMATLAB_RunningClient_Call) RunAndReturn(run func(ctx context.Context, logger entities.Logger) (entities.MATLABSessionClient, bool)) *MockGlobalMATLAB_RunningClient_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RunningSessionID provides a mock function for the type MockGlobalMATLAB
+func (_mock *MockGlobalMATLAB) RunningSessionID() (entities.SessionID, bool) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunningSessionID")
+	}
+
+	var r0 entities.SessionID
+	var r1 bool
+	if returnFunc, ok := ret.Get(0).(func() (entities.SessionID, bool)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() entities.SessionID); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(entities.SessionID)
+	}
+	if returnFunc, ok := ret.Get(1).(func() bool); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	return r0, r1
+}
+
+// MockGlobalMATLAB_RunningSessionID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunningSessionID'
+type MockGlobalMATLAB_RunningSessionID_Call struct {
+	*mock.Call
+}
+
+// RunningSessionID is a helper method to define mock.On call
+func (_e *MockGlobalMATLAB_Expecter) RunningSessionID() *MockGlobalMATLAB_RunningSessionID_Call {
+	return &MockGlobalMATLAB_RunningSessionID_Call{Call: _e.mock.On("RunningSessionID")}
+}
+
+func (_c *MockGlobalMATLAB_RunningSessionID_Call) Run(run func()) *MockGlobalMATLAB_RunningSessionID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockGlobalMATLAB_RunningSessionID_Call) Return(sessionID entities.SessionID, b bool) *MockGlobalMATLAB_RunningSessionID_Call {
+	_c.Call.Return(sessionID, b)
+	return _c
+}
+
+func (_c *MockGlobalMATLAB_RunningSessionID_Call) RunAndReturn(run func() (entities.SessionID, bool)) *MockGlobalMATLAB_RunningSessionID_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,185 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockMATLABManager creates a new instance of MockMATLABManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMATLABManager(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMATLABManager {
+	mock := &MockMATLABManager{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockMATLABManager is an autogenerated mock type for the MATLABManager type
+type MockMATLABManager struct {
+	mock.Mock
+}
+
+type MockMATLABManager_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMATLABManager) EXPECT() *MockMATLABManager_Expecter {
+	return &MockMATLABManager_Expecter{mock: &_m.Mock}
+}
+
+// GetMATLABSessionClient provides a mock function for the type MockMATLABManager
+func (_mock *MockMATLABManager) GetMATLABSessionClient(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID) (entities.MATLABSessionClient, error) {
+	ret := _mock.Called(ctx, sessionLogger, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMATLABSessionClient")
+	}
+
+	var r0 entities.MATLABSessionClient
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.SessionID) (entities.MATLABSessionClient, error)); ok {
+		return returnFunc(ctx, sessionLogger, sessionID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.SessionID) entities.MATLABSessionClient); ok {
+		r0 = returnFunc(ctx, sessionLogger, sessionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(entities.MATLABSessionClient)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entities.Logger, entities.SessionID) error); ok {
+		r1 = returnFunc(ctx, sessionLogger, sessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMATLABManager_GetMATLABSessionClient_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMATLABSessionClient'
+type MockMATLABManager_GetMATLABSessionClient_Call struct {
+	*mock.Call
+}
+
+// GetMATLABSessionClient is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionLogger entities.Logger
+//   - sessionID entities.SessionID
+func (_e *MockMATLABManager_Expecter) GetMATLABSessionClient(ctx interface{}, sessionLogger interface{}, sessionID interface{}) *MockMATLABManager_GetMATLABSessionClient_Call {
+	return &MockMATLABManager_GetMATLABSessionClient_Call{Call: _e.mock.On("GetMATLABSessionClient", ctx, sessionLogger, sessionID)}
+}
+
+func (_c *MockMATLABManager_GetMATLABSessionClient_Call) Run(run func(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID)) *MockMATLABManager_GetMATLABSessionClient_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		var arg2 entities.SessionID
+		if args[2] != nil {
+			arg2 = args[2].(entities.SessionID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMATLABManager_GetMATLABSessionClient_Call) Return(mATLABSessionClient entities.MATLABSessionClient, err error) *MockMATLABManager_GetMATLABSessionClient_Call {
+	_c.Call.Return(mATLABSessionClient, err)
+	return _c
+}
+
+func (_c *MockMATLABManager_GetMATLABSessionClient_Call) RunAndReturn(run func(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID) (entities.MATLABSessionClient, error)) *MockMATLABManager_GetMATLABSessionClient_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMATLABSessionDirectory provides a mock function for the type MockMATLABManager
+func (_mock *MockMATLABManager) GetMATLABSessionDirectory(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID) (string, error) {
+	ret := _mock.Called(ctx, sessionLogger, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMATLABSessionDirectory")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.SessionID) (string, error)); ok {
+		return returnFunc(ctx, sessionLogger, sessionID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.SessionID) string); ok {
+		r0 = returnFunc(ctx, sessionLogger, sessionID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entities.Logger, entities.SessionID) error); ok {
+		r1 = returnFunc(ctx, sessionLogger, sessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMATLABManager_GetMATLABSessionDirectory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMATLABSessionDirectory'
+type MockMATLABManager_GetMATLABSessionDirectory_Call struct {
+	*mock.Call
+}
+
+// GetMATLABSessionDirectory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionLogger entities.Logger
+//   - sessionID entities.SessionID
+func (_e *MockMATLABManager_Expecter) GetMATLABSessionDirectory(ctx interface{}, sessionLogger interface{}, sessionID interface{}) *MockMATLABManager_GetMATLABSessionDirectory_Call {
+	return &MockMATLABManager_GetMATLABSessionDirectory_Call{Call: _e.mock.On("GetMATLABSessionDirectory", ctx, sessionLogger, sessionID)}
+}
+
+func (_c *MockMATLABManager_GetMATLABSessionDirectory_Call) Run(run func(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID)) *MockMATLABManager_GetMATLABSessionDirectory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		var arg2 entities.SessionID
+		if args[2] != nil {
+			arg2 = args[2].(entities.SessionID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMATLABManager_GetMATLABSessionDirectory_Call) Return(s string, err error) *MockMATLABManager_GetMATLABSessionDirectory_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockMATLABManager_GetMATLABSessionDirectory_Call) RunAndReturn(run func(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID) (string, error)) *MockMATLABManager_GetMATLABSessionDirectory_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,98 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockFileLayer creates a new instance of MockFileLayer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockFileLayer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockFileLayer {
+	mock := &MockFileLayer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockFileLayer is an autogenerated mock type for the FileLayer type
+type MockFileLayer struct {
+	mock.Mock
+}
+
+type MockFileLayer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockFileLayer) EXPECT() *MockFileLayer_Expecter {
+	return &MockFileLayer_Expecter{mock: &_m.Mock}
+}
+
+// Glob provides a mock function for the type MockFileLayer
+func (_mock *MockFileLayer) Glob(pattern string) ([]string, error) {
+	ret := _mock.Called(pattern)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Glob")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) ([]string, error)); ok {
+		return returnFunc(pattern)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = returnFunc(pattern)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(pattern)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFileLayer_Glob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Glob'
+type MockFileLayer_Glob_Call struct {
+	*mock.Call
+}
+
+// Glob is a helper method to define mock.On call
+//   - pattern string
+func (_e *MockFileLayer_Expecter) Glob(pattern interface{}) *MockFileLayer_Glob_Call {
+	return &MockFileLayer_Glob_Call{Call: _e.mock.On("Glob", pattern)}
+}
+
+func (_c *MockFileLayer_Glob_Call) Run(run func(pattern string)) *MockFileLayer_Glob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFileLayer_Glob_Call) Return(strings []string, err error) *MockFileLayer_Glob_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockFileLayer_Glob_Call) RunAndReturn(run func(pattern string) ([]string, error)) *MockFileLayer_Glob_Call {
+	_c.Call.Return(run)
+	return _c
+}
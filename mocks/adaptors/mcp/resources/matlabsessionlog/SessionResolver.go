@@ -0,0 +1,155 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockSessionResolver creates a new instance of MockSessionResolver. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSessionResolver(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSessionResolver {
+	mock := &MockSessionResolver{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSessionResolver is an autogenerated mock type for the SessionResolver type
+type MockSessionResolver struct {
+	mock.Mock
+}
+
+type MockSessionResolver_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSessionResolver) EXPECT() *MockSessionResolver_Expecter {
+	return &MockSessionResolver_Expecter{mock: &_m.Mock}
+}
+
+// SessionDirectory provides a mock function for the type MockSessionResolver
+func (_mock *MockSessionResolver) SessionDirectory(ctx context.Context, logger entities.Logger, arguments map[string]string) (string, error) {
+	ret := _mock.Called(ctx, logger, arguments)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SessionDirectory")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, map[string]string) (string, error)); ok {
+		return returnFunc(ctx, logger, arguments)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, map[string]string) string); ok {
+		r0 = returnFunc(ctx, logger, arguments)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entities.Logger, map[string]string) error); ok {
+		r1 = returnFunc(ctx, logger, arguments)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSessionResolver_SessionDirectory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SessionDirectory'
+type MockSessionResolver_SessionDirectory_Call struct {
+	*mock.Call
+}
+
+// SessionDirectory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - logger entities.Logger
+//   - arguments map[string]string
+func (_e *MockSessionResolver_Expecter) SessionDirectory(ctx interface{}, logger interface{}, arguments interface{}) *MockSessionResolver_SessionDirectory_Call {
+	return &MockSessionResolver_SessionDirectory_Call{Call: _e.mock.On("SessionDirectory", ctx, logger, arguments)}
+}
+
+func (_c *MockSessionResolver_SessionDirectory_Call) Run(run func(ctx context.Context, logger entities.Logger, arguments map[string]string)) *MockSessionResolver_SessionDirectory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		var arg2 map[string]string
+		if args[2] != nil {
+			arg2 = args[2].(map[string]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionResolver_SessionDirectory_Call) Return(s string, err error) *MockSessionResolver_SessionDirectory_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockSessionResolver_SessionDirectory_Call) RunAndReturn(run func(ctx context.Context, logger entities.Logger, arguments map[string]string) (string, error)) *MockSessionResolver_SessionDirectory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UseSingleMATLABSession provides a mock function for the type MockSessionResolver
+func (_mock *MockSessionResolver) UseSingleMATLABSession() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for UseSingleMATLABSession")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockSessionResolver_UseSingleMATLABSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UseSingleMATLABSession'
+type MockSessionResolver_UseSingleMATLABSession_Call struct {
+	*mock.Call
+}
+
+// UseSingleMATLABSession is a helper method to define mock.On call
+func (_e *MockSessionResolver_Expecter) UseSingleMATLABSession() *MockSessionResolver_UseSingleMATLABSession_Call {
+	return &MockSessionResolver_UseSingleMATLABSession_Call{Call: _e.mock.On("UseSingleMATLABSession")}
+}
+
+func (_c *MockSessionResolver_UseSingleMATLABSession_Call) Run(run func()) *MockSessionResolver_UseSingleMATLABSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockSessionResolver_UseSingleMATLABSession_Call) Return(b bool) *MockSessionResolver_UseSingleMATLABSession_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockSessionResolver_UseSingleMATLABSession_Call) RunAndReturn(run func() bool) *MockSessionResolver_UseSingleMATLABSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
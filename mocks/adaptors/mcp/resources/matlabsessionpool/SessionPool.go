@@ -0,0 +1,83 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockSessionPool creates a new instance of MockSessionPool. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSessionPool(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSessionPool {
+	mock := &MockSessionPool{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSessionPool is an autogenerated mock type for the SessionPool type
+type MockSessionPool struct {
+	mock.Mock
+}
+
+type MockSessionPool_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSessionPool) EXPECT() *MockSessionPool_Expecter {
+	return &MockSessionPool_Expecter{mock: &_m.Mock}
+}
+
+// Status provides a mock function for the type MockSessionPool
+func (_mock *MockSessionPool) Status() []entities.MATLABSessionPoolStatus {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Status")
+	}
+
+	var r0 []entities.MATLABSessionPoolStatus
+	if returnFunc, ok := ret.Get(0).(func() []entities.MATLABSessionPoolStatus); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.MATLABSessionPoolStatus)
+		}
+	}
+	return r0
+}
+
+// MockSessionPool_Status_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Status'
+type MockSessionPool_Status_Call struct {
+	*mock.Call
+}
+
+// Status is a helper method to define mock.On call
+func (_e *MockSessionPool_Expecter) Status() *MockSessionPool_Status_Call {
+	return &MockSessionPool_Status_Call{Call: _e.mock.On("Status")}
+}
+
+func (_c *MockSessionPool_Status_Call) Run(run func()) *MockSessionPool_Status_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockSessionPool_Status_Call) Return(mATLABSessionPoolStatuss []entities.MATLABSessionPoolStatus) *MockSessionPool_Status_Call {
+	_c.Call.Return(mATLABSessionPoolStatuss)
+	return _c
+}
+
+func (_c *MockSessionPool_Status_Call) RunAndReturn(run func() []entities.MATLABSessionPoolStatus) *MockSessionPool_Status_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,131 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockClientRootsHandler creates a new instance of MockClientRootsHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockClientRootsHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockClientRootsHandler {
+	mock := &MockClientRootsHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockClientRootsHandler is an autogenerated mock type for the ClientRootsHandler type
+type MockClientRootsHandler struct {
+	mock.Mock
+}
+
+type MockClientRootsHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockClientRootsHandler) EXPECT() *MockClientRootsHandler_Expecter {
+	return &MockClientRootsHandler_Expecter{mock: &_m.Mock}
+}
+
+// HandleInitialized provides a mock function for the type MockClientRootsHandler
+func (_mock *MockClientRootsHandler) HandleInitialized(ctx context.Context, request *mcp.InitializedRequest) {
+	_mock.Called(ctx, request)
+	return
+}
+
+// MockClientRootsHandler_HandleInitialized_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HandleInitialized'
+type MockClientRootsHandler_HandleInitialized_Call struct {
+	*mock.Call
+}
+
+// HandleInitialized is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *mcp.InitializedRequest
+func (_e *MockClientRootsHandler_Expecter) HandleInitialized(ctx interface{}, request interface{}) *MockClientRootsHandler_HandleInitialized_Call {
+	return &MockClientRootsHandler_HandleInitialized_Call{Call: _e.mock.On("HandleInitialized", ctx, request)}
+}
+
+func (_c *MockClientRootsHandler_HandleInitialized_Call) Run(run func(ctx context.Context, request *mcp.InitializedRequest)) *MockClientRootsHandler_HandleInitialized_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *mcp.InitializedRequest
+		if args[1] != nil {
+			arg1 = args[1].(*mcp.InitializedRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientRootsHandler_HandleInitialized_Call) Return() *MockClientRootsHandler_HandleInitialized_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockClientRootsHandler_HandleInitialized_Call) RunAndReturn(run func(ctx context.Context, request *mcp.InitializedRequest)) *MockClientRootsHandler_HandleInitialized_Call {
+	_c.Run(run)
+	return _c
+}
+
+// HandleRootsListChanged provides a mock function for the type MockClientRootsHandler
+func (_mock *MockClientRootsHandler) HandleRootsListChanged(ctx context.Context, request *mcp.RootsListChangedRequest) {
+	_mock.Called(ctx, request)
+	return
+}
+
+// MockClientRootsHandler_HandleRootsListChanged_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HandleRootsListChanged'
+type MockClientRootsHandler_HandleRootsListChanged_Call struct {
+	*mock.Call
+}
+
+// HandleRootsListChanged is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *mcp.RootsListChangedRequest
+func (_e *MockClientRootsHandler_Expecter) HandleRootsListChanged(ctx interface{}, request interface{}) *MockClientRootsHandler_HandleRootsListChanged_Call {
+	return &MockClientRootsHandler_HandleRootsListChanged_Call{Call: _e.mock.On("HandleRootsListChanged", ctx, request)}
+}
+
+func (_c *MockClientRootsHandler_HandleRootsListChanged_Call) Run(run func(ctx context.Context, request *mcp.RootsListChangedRequest)) *MockClientRootsHandler_HandleRootsListChanged_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *mcp.RootsListChangedRequest
+		if args[1] != nil {
+			arg1 = args[1].(*mcp.RootsListChangedRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClientRootsHandler_HandleRootsListChanged_Call) Return() *MockClientRootsHandler_HandleRootsListChanged_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockClientRootsHandler_HandleRootsListChanged_Call) RunAndReturn(run func(ctx context.Context, request *mcp.RootsListChangedRequest)) *MockClientRootsHandler_HandleRootsListChanged_Call {
+	_c.Run(run)
+	return _c
+}
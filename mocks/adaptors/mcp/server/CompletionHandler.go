@@ -0,0 +1,107 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockCompletionHandler creates a new instance of MockCompletionHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCompletionHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCompletionHandler {
+	mock := &MockCompletionHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCompletionHandler is an autogenerated mock type for the CompletionHandler type
+type MockCompletionHandler struct {
+	mock.Mock
+}
+
+type MockCompletionHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCompletionHandler) EXPECT() *MockCompletionHandler_Expecter {
+	return &MockCompletionHandler_Expecter{mock: &_m.Mock}
+}
+
+// HandleCompletion provides a mock function for the type MockCompletionHandler
+func (_mock *MockCompletionHandler) HandleCompletion(ctx context.Context, request *mcp.CompleteRequest) (*mcp.CompleteResult, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HandleCompletion")
+	}
+
+	var r0 *mcp.CompleteResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *mcp.CompleteRequest) (*mcp.CompleteResult, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *mcp.CompleteRequest) *mcp.CompleteResult); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*mcp.CompleteResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *mcp.CompleteRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCompletionHandler_HandleCompletion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HandleCompletion'
+type MockCompletionHandler_HandleCompletion_Call struct {
+	*mock.Call
+}
+
+// HandleCompletion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *mcp.CompleteRequest
+func (_e *MockCompletionHandler_Expecter) HandleCompletion(ctx interface{}, request interface{}) *MockCompletionHandler_HandleCompletion_Call {
+	return &MockCompletionHandler_HandleCompletion_Call{Call: _e.mock.On("HandleCompletion", ctx, request)}
+}
+
+func (_c *MockCompletionHandler_HandleCompletion_Call) Run(run func(ctx context.Context, request *mcp.CompleteRequest)) *MockCompletionHandler_HandleCompletion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *mcp.CompleteRequest
+		if args[1] != nil {
+			arg1 = args[1].(*mcp.CompleteRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCompletionHandler_HandleCompletion_Call) Return(completeResult *mcp.CompleteResult, err error) *MockCompletionHandler_HandleCompletion_Call {
+	_c.Call.Return(completeResult, err)
+	return _c
+}
+
+func (_c *MockCompletionHandler_HandleCompletion_Call) RunAndReturn(run func(ctx context.Context, request *mcp.CompleteRequest) (*mcp.CompleteResult, error)) *MockCompletionHandler_HandleCompletion_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
 	mock "github.com/stretchr/testify/mock"
@@ -37,6 +38,52 @@ func (_m *MockMCPServerConfigurator) EXPECT() *MockMCPServerConfigurator_Expecte
 	return &MockMCPServerConfigurator_Expecter{mock: &_m.Mock}
 }
 
+// GetPromptsToAdd provides a mock function for the type MockMCPServerConfigurator
+func (_mock *MockMCPServerConfigurator) GetPromptsToAdd() []prompts.Prompt {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPromptsToAdd")
+	}
+
+	var r0 []prompts.Prompt
+	if returnFunc, ok := ret.Get(0).(func() []prompts.Prompt); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]prompts.Prompt)
+		}
+	}
+	return r0
+}
+
+// MockMCPServerConfigurator_GetPromptsToAdd_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPromptsToAdd'
+type MockMCPServerConfigurator_GetPromptsToAdd_Call struct {
+	*mock.Call
+}
+
+// GetPromptsToAdd is a helper method to define mock.On call
+func (_e *MockMCPServerConfigurator_Expecter) GetPromptsToAdd() *MockMCPServerConfigurator_GetPromptsToAdd_Call {
+	return &MockMCPServerConfigurator_GetPromptsToAdd_Call{Call: _e.mock.On("GetPromptsToAdd")}
+}
+
+func (_c *MockMCPServerConfigurator_GetPromptsToAdd_Call) Run(run func()) *MockMCPServerConfigurator_GetPromptsToAdd_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockMCPServerConfigurator_GetPromptsToAdd_Call) Return(prompts1 []prompts.Prompt) *MockMCPServerConfigurator_GetPromptsToAdd_Call {
+	_c.Call.Return(prompts1)
+	return _c
+}
+
+func (_c *MockMCPServerConfigurator_GetPromptsToAdd_Call) RunAndReturn(run func() []prompts.Prompt) *MockMCPServerConfigurator_GetPromptsToAdd_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetResourcesToAdd provides a mock function for the type MockMCPServerConfigurator
 func (_mock *MockMCPServerConfigurator) GetResourcesToAdd() []resources.Resource {
 	ret := _mock.Called()
@@ -83,8 +130,54 @@ func (_c *MockMCPServerConfigurator_GetResourcesToAdd_Call) RunAndReturn(run fun
 	return _c
 }
 
+// GetToolMiddlewares provides a mock function for the type MockMCPServerConfigurator
+func (_mock *MockMCPServerConfigurator) GetToolMiddlewares() []tools.Middleware {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetToolMiddlewares")
+	}
+
+	var r0 []tools.Middleware
+	if returnFunc, ok := ret.Get(0).(func() []tools.Middleware); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]tools.Middleware)
+		}
+	}
+	return r0
+}
+
+// MockMCPServerConfigurator_GetToolMiddlewares_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetToolMiddlewares'
+type MockMCPServerConfigurator_GetToolMiddlewares_Call struct {
+	*mock.Call
+}
+
+// GetToolMiddlewares is a helper method to define mock.On call
+func (_e *MockMCPServerConfigurator_Expecter) GetToolMiddlewares() *MockMCPServerConfigurator_GetToolMiddlewares_Call {
+	return &MockMCPServerConfigurator_GetToolMiddlewares_Call{Call: _e.mock.On("GetToolMiddlewares")}
+}
+
+func (_c *MockMCPServerConfigurator_GetToolMiddlewares_Call) Run(run func()) *MockMCPServerConfigurator_GetToolMiddlewares_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockMCPServerConfigurator_GetToolMiddlewares_Call) Return(middlewares []tools.Middleware) *MockMCPServerConfigurator_GetToolMiddlewares_Call {
+	_c.Call.Return(middlewares)
+	return _c
+}
+
+func (_c *MockMCPServerConfigurator_GetToolMiddlewares_Call) RunAndReturn(run func() []tools.Middleware) *MockMCPServerConfigurator_GetToolMiddlewares_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetToolsToAdd provides a mock function for the type MockMCPServerConfigurator
-func (_mock *MockMCPServerConfigurator) GetToolsToAdd() []tools.Tool {
+func (_mock *MockMCPServerConfigurator) GetToolsToAdd() ([]tools.Tool, error) {
 	ret := _mock.Called()
 
 	if len(ret) == 0 {
@@ -92,6 +185,10 @@ func (_mock *MockMCPServerConfigurator) GetToolsToAdd() []tools.Tool {
 	}
 
 	var r0 []tools.Tool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]tools.Tool, error)); ok {
+		return returnFunc()
+	}
 	if returnFunc, ok := ret.Get(0).(func() []tools.Tool); ok {
 		r0 = returnFunc()
 	} else {
@@ -99,7 +196,12 @@ func (_mock *MockMCPServerConfigurator) GetToolsToAdd() []tools.Tool {
 			r0 = ret.Get(0).([]tools.Tool)
 		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // MockMCPServerConfigurator_GetToolsToAdd_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetToolsToAdd'
@@ -119,12 +221,12 @@ func (_c *MockMCPServerConfigurator_GetToolsToAdd_Call) Run(run func()) *MockMCP
 	return _c
 }
 
-func (_c *MockMCPServerConfigurator_GetToolsToAdd_Call) Return(tools1 []tools.Tool) *MockMCPServerConfigurator_GetToolsToAdd_Call {
-	_c.Call.Return(tools1)
+func (_c *MockMCPServerConfigurator_GetToolsToAdd_Call) Return(tools1 []tools.Tool, err error) *MockMCPServerConfigurator_GetToolsToAdd_Call {
+	_c.Call.Return(tools1, err)
 	return _c
 }
 
-func (_c *MockMCPServerConfigurator_GetToolsToAdd_Call) RunAndReturn(run func() []tools.Tool) *MockMCPServerConfigurator_GetToolsToAdd_Call {
+func (_c *MockMCPServerConfigurator_GetToolsToAdd_Call) RunAndReturn(run func() ([]tools.Tool, error)) *MockMCPServerConfigurator_GetToolsToAdd_Call {
 	_c.Call.Return(run)
 	return _c
 }
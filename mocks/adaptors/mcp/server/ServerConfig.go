@@ -35,6 +35,274 @@ func (_m *MockServerConfig) EXPECT() *MockServerConfig_Expecter {
 	return &MockServerConfig_Expecter{mock: &_m.Mock}
 }
 
+// CodingGuidelines provides a mock function for the type MockServerConfig
+func (_mock *MockServerConfig) CodingGuidelines() []string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CodingGuidelines")
+	}
+
+	var r0 []string
+	if returnFunc, ok := ret.Get(0).(func() []string); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	return r0
+}
+
+// MockServerConfig_CodingGuidelines_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CodingGuidelines'
+type MockServerConfig_CodingGuidelines_Call struct {
+	*mock.Call
+}
+
+// CodingGuidelines is a helper method to define mock.On call
+func (_e *MockServerConfig_Expecter) CodingGuidelines() *MockServerConfig_CodingGuidelines_Call {
+	return &MockServerConfig_CodingGuidelines_Call{Call: _e.mock.On("CodingGuidelines")}
+}
+
+func (_c *MockServerConfig_CodingGuidelines_Call) Run(run func()) *MockServerConfig_CodingGuidelines_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockServerConfig_CodingGuidelines_Call) Return(strings []string) *MockServerConfig_CodingGuidelines_Call {
+	_c.Call.Return(strings)
+	return _c
+}
+
+func (_c *MockServerConfig_CodingGuidelines_Call) RunAndReturn(run func() []string) *MockServerConfig_CodingGuidelines_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PromptFiles provides a mock function for the type MockServerConfig
+func (_mock *MockServerConfig) PromptFiles() []string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PromptFiles")
+	}
+
+	var r0 []string
+	if returnFunc, ok := ret.Get(0).(func() []string); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	return r0
+}
+
+// MockServerConfig_PromptFiles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PromptFiles'
+type MockServerConfig_PromptFiles_Call struct {
+	*mock.Call
+}
+
+// PromptFiles is a helper method to define mock.On call
+func (_e *MockServerConfig_Expecter) PromptFiles() *MockServerConfig_PromptFiles_Call {
+	return &MockServerConfig_PromptFiles_Call{Call: _e.mock.On("PromptFiles")}
+}
+
+func (_c *MockServerConfig_PromptFiles_Call) Run(run func()) *MockServerConfig_PromptFiles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockServerConfig_PromptFiles_Call) Return(strings []string) *MockServerConfig_PromptFiles_Call {
+	_c.Call.Return(strings)
+	return _c
+}
+
+func (_c *MockServerConfig_PromptFiles_Call) RunAndReturn(run func() []string) *MockServerConfig_PromptFiles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadOnly provides a mock function for the type MockServerConfig
+func (_mock *MockServerConfig) ReadOnly() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadOnly")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockServerConfig_ReadOnly_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadOnly'
+type MockServerConfig_ReadOnly_Call struct {
+	*mock.Call
+}
+
+// ReadOnly is a helper method to define mock.On call
+func (_e *MockServerConfig_Expecter) ReadOnly() *MockServerConfig_ReadOnly_Call {
+	return &MockServerConfig_ReadOnly_Call{Call: _e.mock.On("ReadOnly")}
+}
+
+func (_c *MockServerConfig_ReadOnly_Call) Run(run func()) *MockServerConfig_ReadOnly_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockServerConfig_ReadOnly_Call) Return(b bool) *MockServerConfig_ReadOnly_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockServerConfig_ReadOnly_Call) RunAndReturn(run func() bool) *MockServerConfig_ReadOnly_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoteMATLABHost provides a mock function for the type MockServerConfig
+func (_mock *MockServerConfig) RemoteMATLABHost() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoteMATLABHost")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockServerConfig_RemoteMATLABHost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoteMATLABHost'
+type MockServerConfig_RemoteMATLABHost_Call struct {
+	*mock.Call
+}
+
+// RemoteMATLABHost is a helper method to define mock.On call
+func (_e *MockServerConfig_Expecter) RemoteMATLABHost() *MockServerConfig_RemoteMATLABHost_Call {
+	return &MockServerConfig_RemoteMATLABHost_Call{Call: _e.mock.On("RemoteMATLABHost")}
+}
+
+func (_c *MockServerConfig_RemoteMATLABHost_Call) Run(run func()) *MockServerConfig_RemoteMATLABHost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockServerConfig_RemoteMATLABHost_Call) Return(s string) *MockServerConfig_RemoteMATLABHost_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockServerConfig_RemoteMATLABHost_Call) RunAndReturn(run func() string) *MockServerConfig_RemoteMATLABHost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplaceCodingGuidelines provides a mock function for the type MockServerConfig
+func (_mock *MockServerConfig) ReplaceCodingGuidelines() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceCodingGuidelines")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockServerConfig_ReplaceCodingGuidelines_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceCodingGuidelines'
+type MockServerConfig_ReplaceCodingGuidelines_Call struct {
+	*mock.Call
+}
+
+// ReplaceCodingGuidelines is a helper method to define mock.On call
+func (_e *MockServerConfig_Expecter) ReplaceCodingGuidelines() *MockServerConfig_ReplaceCodingGuidelines_Call {
+	return &MockServerConfig_ReplaceCodingGuidelines_Call{Call: _e.mock.On("ReplaceCodingGuidelines")}
+}
+
+func (_c *MockServerConfig_ReplaceCodingGuidelines_Call) Run(run func()) *MockServerConfig_ReplaceCodingGuidelines_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockServerConfig_ReplaceCodingGuidelines_Call) Return(b bool) *MockServerConfig_ReplaceCodingGuidelines_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockServerConfig_ReplaceCodingGuidelines_Call) RunAndReturn(run func() bool) *MockServerConfig_ReplaceCodingGuidelines_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UseSingleMATLABSession provides a mock function for the type MockServerConfig
+func (_mock *MockServerConfig) UseSingleMATLABSession() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for UseSingleMATLABSession")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockServerConfig_UseSingleMATLABSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UseSingleMATLABSession'
+type MockServerConfig_UseSingleMATLABSession_Call struct {
+	*mock.Call
+}
+
+// UseSingleMATLABSession is a helper method to define mock.On call
+func (_e *MockServerConfig_Expecter) UseSingleMATLABSession() *MockServerConfig_UseSingleMATLABSession_Call {
+	return &MockServerConfig_UseSingleMATLABSession_Call{Call: _e.mock.On("UseSingleMATLABSession")}
+}
+
+func (_c *MockServerConfig_UseSingleMATLABSession_Call) Run(run func()) *MockServerConfig_UseSingleMATLABSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockServerConfig_UseSingleMATLABSession_Call) Return(b bool) *MockServerConfig_UseSingleMATLABSession_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockServerConfig_UseSingleMATLABSession_Call) RunAndReturn(run func() bool) *MockServerConfig_UseSingleMATLABSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Version provides a mock function for the type MockServerConfig
 func (_mock *MockServerConfig) Version() string {
 	ret := _mock.Called()
@@ -35,6 +35,232 @@ func (_m *MockConfig) EXPECT() *MockConfig_Expecter {
 	return &MockConfig_Expecter{mock: &_m.Mock}
 }
 
+// DisabledTools provides a mock function for the type MockConfig
+func (_mock *MockConfig) DisabledTools() []string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DisabledTools")
+	}
+
+	var r0 []string
+	if returnFunc, ok := ret.Get(0).(func() []string); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	return r0
+}
+
+// MockConfig_DisabledTools_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DisabledTools'
+type MockConfig_DisabledTools_Call struct {
+	*mock.Call
+}
+
+// DisabledTools is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) DisabledTools() *MockConfig_DisabledTools_Call {
+	return &MockConfig_DisabledTools_Call{Call: _e.mock.On("DisabledTools")}
+}
+
+func (_c *MockConfig_DisabledTools_Call) Run(run func()) *MockConfig_DisabledTools_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_DisabledTools_Call) Return(strings []string) *MockConfig_DisabledTools_Call {
+	_c.Call.Return(strings)
+	return _c
+}
+
+func (_c *MockConfig_DisabledTools_Call) RunAndReturn(run func() []string) *MockConfig_DisabledTools_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnabledTools provides a mock function for the type MockConfig
+func (_mock *MockConfig) EnabledTools() []string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnabledTools")
+	}
+
+	var r0 []string
+	if returnFunc, ok := ret.Get(0).(func() []string); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	return r0
+}
+
+// MockConfig_EnabledTools_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnabledTools'
+type MockConfig_EnabledTools_Call struct {
+	*mock.Call
+}
+
+// EnabledTools is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) EnabledTools() *MockConfig_EnabledTools_Call {
+	return &MockConfig_EnabledTools_Call{Call: _e.mock.On("EnabledTools")}
+}
+
+func (_c *MockConfig_EnabledTools_Call) Run(run func()) *MockConfig_EnabledTools_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_EnabledTools_Call) Return(strings []string) *MockConfig_EnabledTools_Call {
+	_c.Call.Return(strings)
+	return _c
+}
+
+func (_c *MockConfig_EnabledTools_Call) RunAndReturn(run func() []string) *MockConfig_EnabledTools_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadOnly provides a mock function for the type MockConfig
+func (_mock *MockConfig) ReadOnly() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadOnly")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockConfig_ReadOnly_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadOnly'
+type MockConfig_ReadOnly_Call struct {
+	*mock.Call
+}
+
+// ReadOnly is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) ReadOnly() *MockConfig_ReadOnly_Call {
+	return &MockConfig_ReadOnly_Call{Call: _e.mock.On("ReadOnly")}
+}
+
+func (_c *MockConfig_ReadOnly_Call) Run(run func()) *MockConfig_ReadOnly_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_ReadOnly_Call) Return(b bool) *MockConfig_ReadOnly_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockConfig_ReadOnly_Call) RunAndReturn(run func() bool) *MockConfig_ReadOnly_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplaceCodingGuidelines provides a mock function for the type MockConfig
+func (_mock *MockConfig) ReplaceCodingGuidelines() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceCodingGuidelines")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockConfig_ReplaceCodingGuidelines_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceCodingGuidelines'
+type MockConfig_ReplaceCodingGuidelines_Call struct {
+	*mock.Call
+}
+
+// ReplaceCodingGuidelines is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) ReplaceCodingGuidelines() *MockConfig_ReplaceCodingGuidelines_Call {
+	return &MockConfig_ReplaceCodingGuidelines_Call{Call: _e.mock.On("ReplaceCodingGuidelines")}
+}
+
+func (_c *MockConfig_ReplaceCodingGuidelines_Call) Run(run func()) *MockConfig_ReplaceCodingGuidelines_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_ReplaceCodingGuidelines_Call) Return(b bool) *MockConfig_ReplaceCodingGuidelines_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockConfig_ReplaceCodingGuidelines_Call) RunAndReturn(run func() bool) *MockConfig_ReplaceCodingGuidelines_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ToolsRequiringApproval provides a mock function for the type MockConfig
+func (_mock *MockConfig) ToolsRequiringApproval() []string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ToolsRequiringApproval")
+	}
+
+	var r0 []string
+	if returnFunc, ok := ret.Get(0).(func() []string); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	return r0
+}
+
+// MockConfig_ToolsRequiringApproval_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ToolsRequiringApproval'
+type MockConfig_ToolsRequiringApproval_Call struct {
+	*mock.Call
+}
+
+// ToolsRequiringApproval is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) ToolsRequiringApproval() *MockConfig_ToolsRequiringApproval_Call {
+	return &MockConfig_ToolsRequiringApproval_Call{Call: _e.mock.On("ToolsRequiringApproval")}
+}
+
+func (_c *MockConfig_ToolsRequiringApproval_Call) Run(run func()) *MockConfig_ToolsRequiringApproval_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_ToolsRequiringApproval_Call) Return(strings []string) *MockConfig_ToolsRequiringApproval_Call {
+	_c.Call.Return(strings)
+	return _c
+}
+
+func (_c *MockConfig_ToolsRequiringApproval_Call) RunAndReturn(run func() []string) *MockConfig_ToolsRequiringApproval_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UseSingleMATLABSession provides a mock function for the type MockConfig
 func (_mock *MockConfig) UseSingleMATLABSession() bool {
 	ret := _mock.Called()
@@ -0,0 +1,120 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockMiddleware creates a new instance of MockMiddleware. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMiddleware(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMiddleware {
+	mock := &MockMiddleware{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockMiddleware is an autogenerated mock type for the Middleware type
+type MockMiddleware struct {
+	mock.Mock
+}
+
+type MockMiddleware_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMiddleware) EXPECT() *MockMiddleware_Expecter {
+	return &MockMiddleware_Expecter{mock: &_m.Mock}
+}
+
+// Handle provides a mock function for the type MockMiddleware
+func (_mock *MockMiddleware) Handle(ctx context.Context, logger entities.Logger, call tools.ToolCall, next tools.ToolCallHandler) (any, error) {
+	ret := _mock.Called(ctx, logger, call, next)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Handle")
+	}
+
+	var r0 any
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, tools.ToolCall, tools.ToolCallHandler) (any, error)); ok {
+		return returnFunc(ctx, logger, call, next)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, tools.ToolCall, tools.ToolCallHandler) any); ok {
+		r0 = returnFunc(ctx, logger, call, next)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(any)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entities.Logger, tools.ToolCall, tools.ToolCallHandler) error); ok {
+		r1 = returnFunc(ctx, logger, call, next)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMiddleware_Handle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Handle'
+type MockMiddleware_Handle_Call struct {
+	*mock.Call
+}
+
+// Handle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - logger entities.Logger
+//   - call tools.ToolCall
+//   - next tools.ToolCallHandler
+func (_e *MockMiddleware_Expecter) Handle(ctx interface{}, logger interface{}, call interface{}, next interface{}) *MockMiddleware_Handle_Call {
+	return &MockMiddleware_Handle_Call{Call: _e.mock.On("Handle", ctx, logger, call, next)}
+}
+
+func (_c *MockMiddleware_Handle_Call) Run(run func(ctx context.Context, logger entities.Logger, call tools.ToolCall, next tools.ToolCallHandler)) *MockMiddleware_Handle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		var arg2 tools.ToolCall
+		if args[2] != nil {
+			arg2 = args[2].(tools.ToolCall)
+		}
+		var arg3 tools.ToolCallHandler
+		if args[3] != nil {
+			arg3 = args[3].(tools.ToolCallHandler)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMiddleware_Handle_Call) Return(v any, err error) *MockMiddleware_Handle_Call {
+	_c.Call.Return(v, err)
+	return _c
+}
+
+func (_c *MockMiddleware_Handle_Call) RunAndReturn(run func(ctx context.Context, logger entities.Logger, call tools.ToolCall, next tools.ToolCallHandler) (any, error)) *MockMiddleware_Handle_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -37,16 +38,22 @@ func (_m *MockTool) EXPECT() *MockTool_Expecter {
 }
 
 // AddToServer provides a mock function for the type MockTool
-func (_mock *MockTool) AddToServer(server *mcp.Server) error {
-	ret := _mock.Called(server)
+func (_mock *MockTool) AddToServer(server *mcp.Server, middlewares ...tools.Middleware) error {
+	var tmpRet mock.Arguments
+	if len(middlewares) > 0 {
+		tmpRet = _mock.Called(server, middlewares)
+	} else {
+		tmpRet = _mock.Called(server)
+	}
+	ret := tmpRet
 
 	if len(ret) == 0 {
 		panic("no return value specified for AddToServer")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*mcp.Server) error); ok {
-		r0 = returnFunc(server)
+	if returnFunc, ok := ret.Get(0).(func(*mcp.Server, ...tools.Middleware) error); ok {
+		r0 = returnFunc(server, middlewares...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -60,18 +67,27 @@ type MockTool_AddToServer_Call struct {
 
 // AddToServer is a helper method to define mock.On call
 //   - server *mcp.Server
-func (_e *MockTool_Expecter) AddToServer(server interface{}) *MockTool_AddToServer_Call {
-	return &MockTool_AddToServer_Call{Call: _e.mock.On("AddToServer", server)}
+//   - middlewares ...tools.Middleware
+func (_e *MockTool_Expecter) AddToServer(server interface{}, middlewares ...interface{}) *MockTool_AddToServer_Call {
+	return &MockTool_AddToServer_Call{Call: _e.mock.On("AddToServer",
+		append([]interface{}{server}, middlewares...)...)}
 }
 
-func (_c *MockTool_AddToServer_Call) Run(run func(server *mcp.Server)) *MockTool_AddToServer_Call {
+func (_c *MockTool_AddToServer_Call) Run(run func(server *mcp.Server, middlewares ...tools.Middleware)) *MockTool_AddToServer_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 *mcp.Server
 		if args[0] != nil {
 			arg0 = args[0].(*mcp.Server)
 		}
+		var arg1 []tools.Middleware
+		var variadicArgs []tools.Middleware
+		if len(args) > 1 {
+			variadicArgs = args[1].([]tools.Middleware)
+		}
+		arg1 = variadicArgs
 		run(
 			arg0,
+			arg1...,
 		)
 	})
 	return _c
@@ -82,7 +98,51 @@ func (_c *MockTool_AddToServer_Call) Return(err error) *MockTool_AddToServer_Cal
 	return _c
 }
 
-func (_c *MockTool_AddToServer_Call) RunAndReturn(run func(server *mcp.Server) error) *MockTool_AddToServer_Call {
+func (_c *MockTool_AddToServer_Call) RunAndReturn(run func(server *mcp.Server, middlewares ...tools.Middleware) error) *MockTool_AddToServer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Name provides a mock function for the type MockTool
+func (_mock *MockTool) Name() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Name")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockTool_Name_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Name'
+type MockTool_Name_Call struct {
+	*mock.Call
+}
+
+// Name is a helper method to define mock.On call
+func (_e *MockTool_Expecter) Name() *MockTool_Name_Call {
+	return &MockTool_Name_Call{Call: _e.mock.On("Name")}
+}
+
+func (_c *MockTool_Name_Call) Run(run func()) *MockTool_Name_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockTool_Name_Call) Return(s string) *MockTool_Name_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockTool_Name_Call) RunAndReturn(run func() string) *MockTool_Name_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -37,16 +38,22 @@ func (_m *MockToolWithStructuredContentOutput[ToolInput, ToolOutput]) EXPECT() *
 }
 
 // AddToServer provides a mock function for the type MockToolWithStructuredContentOutput
-func (_mock *MockToolWithStructuredContentOutput[ToolInput, ToolOutput]) AddToServer(server *mcp.Server) error {
-	ret := _mock.Called(server)
+func (_mock *MockToolWithStructuredContentOutput[ToolInput, ToolOutput]) AddToServer(server *mcp.Server, middlewares ...tools.Middleware) error {
+	var tmpRet mock.Arguments
+	if len(middlewares) > 0 {
+		tmpRet = _mock.Called(server, middlewares)
+	} else {
+		tmpRet = _mock.Called(server)
+	}
+	ret := tmpRet
 
 	if len(ret) == 0 {
 		panic("no return value specified for AddToServer")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*mcp.Server) error); ok {
-		r0 = returnFunc(server)
+	if returnFunc, ok := ret.Get(0).(func(*mcp.Server, ...tools.Middleware) error); ok {
+		r0 = returnFunc(server, middlewares...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -60,18 +67,27 @@ type MockToolWithStructuredContentOutput_AddToServer_Call[ToolInput any, ToolOut
 
 // AddToServer is a helper method to define mock.On call
 //   - server *mcp.Server
-func (_e *MockToolWithStructuredContentOutput_Expecter[ToolInput, ToolOutput]) AddToServer(server interface{}) *MockToolWithStructuredContentOutput_AddToServer_Call[ToolInput, ToolOutput] {
-	return &MockToolWithStructuredContentOutput_AddToServer_Call[ToolInput, ToolOutput]{Call: _e.mock.On("AddToServer", server)}
+//   - middlewares ...tools.Middleware
+func (_e *MockToolWithStructuredContentOutput_Expecter[ToolInput, ToolOutput]) AddToServer(server interface{}, middlewares ...interface{}) *MockToolWithStructuredContentOutput_AddToServer_Call[ToolInput, ToolOutput] {
+	return &MockToolWithStructuredContentOutput_AddToServer_Call[ToolInput, ToolOutput]{Call: _e.mock.On("AddToServer",
+		append([]interface{}{server}, middlewares...)...)}
 }
 
-func (_c *MockToolWithStructuredContentOutput_AddToServer_Call[ToolInput, ToolOutput]) Run(run func(server *mcp.Server)) *MockToolWithStructuredContentOutput_AddToServer_Call[ToolInput, ToolOutput] {
+func (_c *MockToolWithStructuredContentOutput_AddToServer_Call[ToolInput, ToolOutput]) Run(run func(server *mcp.Server, middlewares ...tools.Middleware)) *MockToolWithStructuredContentOutput_AddToServer_Call[ToolInput, ToolOutput] {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 *mcp.Server
 		if args[0] != nil {
 			arg0 = args[0].(*mcp.Server)
 		}
+		var arg1 []tools.Middleware
+		var variadicArgs []tools.Middleware
+		if len(args) > 1 {
+			variadicArgs = args[1].([]tools.Middleware)
+		}
+		arg1 = variadicArgs
 		run(
 			arg0,
+			arg1...,
 		)
 	})
 	return _c
@@ -82,7 +98,7 @@ func (_c *MockToolWithStructuredContentOutput_AddToServer_Call[ToolInput, ToolOu
 	return _c
 }
 
-func (_c *MockToolWithStructuredContentOutput_AddToServer_Call[ToolInput, ToolOutput]) RunAndReturn(run func(server *mcp.Server) error) *MockToolWithStructuredContentOutput_AddToServer_Call[ToolInput, ToolOutput] {
+func (_c *MockToolWithStructuredContentOutput_AddToServer_Call[ToolInput, ToolOutput]) RunAndReturn(run func(server *mcp.Server, middlewares ...tools.Middleware) error) *MockToolWithStructuredContentOutput_AddToServer_Call[ToolInput, ToolOutput] {
 	_c.Call.Return(run)
 	return _c
 }
@@ -132,3 +148,47 @@ func (_c *MockToolWithStructuredContentOutput_Handler_Call[ToolInput, ToolOutput
 	_c.Call.Return(run)
 	return _c
 }
+
+// Name provides a mock function for the type MockToolWithStructuredContentOutput
+func (_mock *MockToolWithStructuredContentOutput[ToolInput, ToolOutput]) Name() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Name")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockToolWithStructuredContentOutput_Name_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Name'
+type MockToolWithStructuredContentOutput_Name_Call[ToolInput any, ToolOutput any] struct {
+	*mock.Call
+}
+
+// Name is a helper method to define mock.On call
+func (_e *MockToolWithStructuredContentOutput_Expecter[ToolInput, ToolOutput]) Name() *MockToolWithStructuredContentOutput_Name_Call[ToolInput, ToolOutput] {
+	return &MockToolWithStructuredContentOutput_Name_Call[ToolInput, ToolOutput]{Call: _e.mock.On("Name")}
+}
+
+func (_c *MockToolWithStructuredContentOutput_Name_Call[ToolInput, ToolOutput]) Run(run func()) *MockToolWithStructuredContentOutput_Name_Call[ToolInput, ToolOutput] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockToolWithStructuredContentOutput_Name_Call[ToolInput, ToolOutput]) Return(s string) *MockToolWithStructuredContentOutput_Name_Call[ToolInput, ToolOutput] {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockToolWithStructuredContentOutput_Name_Call[ToolInput, ToolOutput]) RunAndReturn(run func() string) *MockToolWithStructuredContentOutput_Name_Call[ToolInput, ToolOutput] {
+	_c.Call.Return(run)
+	return _c
+}
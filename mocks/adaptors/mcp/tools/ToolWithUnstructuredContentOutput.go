@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -37,16 +38,22 @@ func (_m *MockToolWithUnstructuredContentOutput[ToolInput]) EXPECT() *MockToolWi
 }
 
 // AddToServer provides a mock function for the type MockToolWithUnstructuredContentOutput
-func (_mock *MockToolWithUnstructuredContentOutput[ToolInput]) AddToServer(server *mcp.Server) error {
-	ret := _mock.Called(server)
+func (_mock *MockToolWithUnstructuredContentOutput[ToolInput]) AddToServer(server *mcp.Server, middlewares ...tools.Middleware) error {
+	var tmpRet mock.Arguments
+	if len(middlewares) > 0 {
+		tmpRet = _mock.Called(server, middlewares)
+	} else {
+		tmpRet = _mock.Called(server)
+	}
+	ret := tmpRet
 
 	if len(ret) == 0 {
 		panic("no return value specified for AddToServer")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*mcp.Server) error); ok {
-		r0 = returnFunc(server)
+	if returnFunc, ok := ret.Get(0).(func(*mcp.Server, ...tools.Middleware) error); ok {
+		r0 = returnFunc(server, middlewares...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -60,18 +67,27 @@ type MockToolWithUnstructuredContentOutput_AddToServer_Call[ToolInput any] struc
 
 // AddToServer is a helper method to define mock.On call
 //   - server *mcp.Server
-func (_e *MockToolWithUnstructuredContentOutput_Expecter[ToolInput]) AddToServer(server interface{}) *MockToolWithUnstructuredContentOutput_AddToServer_Call[ToolInput] {
-	return &MockToolWithUnstructuredContentOutput_AddToServer_Call[ToolInput]{Call: _e.mock.On("AddToServer", server)}
+//   - middlewares ...tools.Middleware
+func (_e *MockToolWithUnstructuredContentOutput_Expecter[ToolInput]) AddToServer(server interface{}, middlewares ...interface{}) *MockToolWithUnstructuredContentOutput_AddToServer_Call[ToolInput] {
+	return &MockToolWithUnstructuredContentOutput_AddToServer_Call[ToolInput]{Call: _e.mock.On("AddToServer",
+		append([]interface{}{server}, middlewares...)...)}
 }
 
-func (_c *MockToolWithUnstructuredContentOutput_AddToServer_Call[ToolInput]) Run(run func(server *mcp.Server)) *MockToolWithUnstructuredContentOutput_AddToServer_Call[ToolInput] {
+func (_c *MockToolWithUnstructuredContentOutput_AddToServer_Call[ToolInput]) Run(run func(server *mcp.Server, middlewares ...tools.Middleware)) *MockToolWithUnstructuredContentOutput_AddToServer_Call[ToolInput] {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 *mcp.Server
 		if args[0] != nil {
 			arg0 = args[0].(*mcp.Server)
 		}
+		var arg1 []tools.Middleware
+		var variadicArgs []tools.Middleware
+		if len(args) > 1 {
+			variadicArgs = args[1].([]tools.Middleware)
+		}
+		arg1 = variadicArgs
 		run(
 			arg0,
+			arg1...,
 		)
 	})
 	return _c
@@ -82,7 +98,7 @@ func (_c *MockToolWithUnstructuredContentOutput_AddToServer_Call[ToolInput]) Ret
 	return _c
 }
 
-func (_c *MockToolWithUnstructuredContentOutput_AddToServer_Call[ToolInput]) RunAndReturn(run func(server *mcp.Server) error) *MockToolWithUnstructuredContentOutput_AddToServer_Call[ToolInput] {
+func (_c *MockToolWithUnstructuredContentOutput_AddToServer_Call[ToolInput]) RunAndReturn(run func(server *mcp.Server, middlewares ...tools.Middleware) error) *MockToolWithUnstructuredContentOutput_AddToServer_Call[ToolInput] {
 	_c.Call.Return(run)
 	return _c
 }
@@ -132,3 +148,47 @@ func (_c *MockToolWithUnstructuredContentOutput_Handler_Call[ToolInput]) RunAndR
 	_c.Call.Return(run)
 	return _c
 }
+
+// Name provides a mock function for the type MockToolWithUnstructuredContentOutput
+func (_mock *MockToolWithUnstructuredContentOutput[ToolInput]) Name() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Name")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockToolWithUnstructuredContentOutput_Name_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Name'
+type MockToolWithUnstructuredContentOutput_Name_Call[ToolInput any] struct {
+	*mock.Call
+}
+
+// Name is a helper method to define mock.On call
+func (_e *MockToolWithUnstructuredContentOutput_Expecter[ToolInput]) Name() *MockToolWithUnstructuredContentOutput_Name_Call[ToolInput] {
+	return &MockToolWithUnstructuredContentOutput_Name_Call[ToolInput]{Call: _e.mock.On("Name")}
+}
+
+func (_c *MockToolWithUnstructuredContentOutput_Name_Call[ToolInput]) Run(run func()) *MockToolWithUnstructuredContentOutput_Name_Call[ToolInput] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockToolWithUnstructuredContentOutput_Name_Call[ToolInput]) Return(s string) *MockToolWithUnstructuredContentOutput_Name_Call[ToolInput] {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockToolWithUnstructuredContentOutput_Name_Call[ToolInput]) RunAndReturn(run func() string) *MockToolWithUnstructuredContentOutput_Name_Call[ToolInput] {
+	_c.Call.Return(run)
+	return _c
+}
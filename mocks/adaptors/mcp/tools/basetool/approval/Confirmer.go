@@ -0,0 +1,111 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockConfirmer creates a new instance of MockConfirmer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockConfirmer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockConfirmer {
+	mock := &MockConfirmer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockConfirmer is an autogenerated mock type for the Confirmer type
+type MockConfirmer struct {
+	mock.Mock
+}
+
+type MockConfirmer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockConfirmer) EXPECT() *MockConfirmer_Expecter {
+	return &MockConfirmer_Expecter{mock: &_m.Mock}
+}
+
+// Confirm provides a mock function for the type MockConfirmer
+func (_mock *MockConfirmer) Confirm(ctx context.Context, sessionLogger entities.Logger, message string) (bool, error) {
+	ret := _mock.Called(ctx, sessionLogger, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Confirm")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, string) (bool, error)); ok {
+		return returnFunc(ctx, sessionLogger, message)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, string) bool); ok {
+		r0 = returnFunc(ctx, sessionLogger, message)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entities.Logger, string) error); ok {
+		r1 = returnFunc(ctx, sessionLogger, message)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockConfirmer_Confirm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Confirm'
+type MockConfirmer_Confirm_Call struct {
+	*mock.Call
+}
+
+// Confirm is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionLogger entities.Logger
+//   - message string
+func (_e *MockConfirmer_Expecter) Confirm(ctx interface{}, sessionLogger interface{}, message interface{}) *MockConfirmer_Confirm_Call {
+	return &MockConfirmer_Confirm_Call{Call: _e.mock.On("Confirm", ctx, sessionLogger, message)}
+}
+
+func (_c *MockConfirmer_Confirm_Call) Run(run func(ctx context.Context, sessionLogger entities.Logger, message string)) *MockConfirmer_Confirm_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConfirmer_Confirm_Call) Return(b bool, err error) *MockConfirmer_Confirm_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockConfirmer_Confirm_Call) RunAndReturn(run func(ctx context.Context, sessionLogger entities.Logger, message string) (bool, error)) *MockConfirmer_Confirm_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,223 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"os"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockOSLayer creates a new instance of MockOSLayer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOSLayer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOSLayer {
+	mock := &MockOSLayer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockOSLayer is an autogenerated mock type for the OSLayer type
+type MockOSLayer struct {
+	mock.Mock
+}
+
+type MockOSLayer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockOSLayer) EXPECT() *MockOSLayer_Expecter {
+	return &MockOSLayer_Expecter{mock: &_m.Mock}
+}
+
+// MkdirAll provides a mock function for the type MockOSLayer
+func (_mock *MockOSLayer) MkdirAll(name string, perm os.FileMode) error {
+	ret := _mock.Called(name, perm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MkdirAll")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, os.FileMode) error); ok {
+		r0 = returnFunc(name, perm)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockOSLayer_MkdirAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MkdirAll'
+type MockOSLayer_MkdirAll_Call struct {
+	*mock.Call
+}
+
+// MkdirAll is a helper method to define mock.On call
+//   - name string
+//   - perm os.FileMode
+func (_e *MockOSLayer_Expecter) MkdirAll(name interface{}, perm interface{}) *MockOSLayer_MkdirAll_Call {
+	return &MockOSLayer_MkdirAll_Call{Call: _e.mock.On("MkdirAll", name, perm)}
+}
+
+func (_c *MockOSLayer_MkdirAll_Call) Run(run func(name string, perm os.FileMode)) *MockOSLayer_MkdirAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 os.FileMode
+		if args[1] != nil {
+			arg1 = args[1].(os.FileMode)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOSLayer_MkdirAll_Call) Return(err error) *MockOSLayer_MkdirAll_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockOSLayer_MkdirAll_Call) RunAndReturn(run func(name string, perm os.FileMode) error) *MockOSLayer_MkdirAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OpenFile provides a mock function for the type MockOSLayer
+func (_mock *MockOSLayer) OpenFile(name string, flag int, perm os.FileMode) (osfacade.File, error) {
+	ret := _mock.Called(name, flag, perm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OpenFile")
+	}
+
+	var r0 osfacade.File
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, int, os.FileMode) (osfacade.File, error)); ok {
+		return returnFunc(name, flag, perm)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, int, os.FileMode) osfacade.File); ok {
+		r0 = returnFunc(name, flag, perm)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(osfacade.File)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, int, os.FileMode) error); ok {
+		r1 = returnFunc(name, flag, perm)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOSLayer_OpenFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OpenFile'
+type MockOSLayer_OpenFile_Call struct {
+	*mock.Call
+}
+
+// OpenFile is a helper method to define mock.On call
+//   - name string
+//   - flag int
+//   - perm os.FileMode
+func (_e *MockOSLayer_Expecter) OpenFile(name interface{}, flag interface{}, perm interface{}) *MockOSLayer_OpenFile_Call {
+	return &MockOSLayer_OpenFile_Call{Call: _e.mock.On("OpenFile", name, flag, perm)}
+}
+
+func (_c *MockOSLayer_OpenFile_Call) Run(run func(name string, flag int, perm os.FileMode)) *MockOSLayer_OpenFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 os.FileMode
+		if args[2] != nil {
+			arg2 = args[2].(os.FileMode)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOSLayer_OpenFile_Call) Return(file osfacade.File, err error) *MockOSLayer_OpenFile_Call {
+	_c.Call.Return(file, err)
+	return _c
+}
+
+func (_c *MockOSLayer_OpenFile_Call) RunAndReturn(run func(name string, flag int, perm os.FileMode) (osfacade.File, error)) *MockOSLayer_OpenFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UserConfigDir provides a mock function for the type MockOSLayer
+func (_mock *MockOSLayer) UserConfigDir() (string, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for UserConfigDir")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (string, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOSLayer_UserConfigDir_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UserConfigDir'
+type MockOSLayer_UserConfigDir_Call struct {
+	*mock.Call
+}
+
+// UserConfigDir is a helper method to define mock.On call
+func (_e *MockOSLayer_Expecter) UserConfigDir() *MockOSLayer_UserConfigDir_Call {
+	return &MockOSLayer_UserConfigDir_Call{Call: _e.mock.On("UserConfigDir")}
+}
+
+func (_c *MockOSLayer_UserConfigDir_Call) Run(run func()) *MockOSLayer_UserConfigDir_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockOSLayer_UserConfigDir_Call) Return(s string, err error) *MockOSLayer_UserConfigDir_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockOSLayer_UserConfigDir_Call) RunAndReturn(run func() (string, error)) *MockOSLayer_UserConfigDir_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,96 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockPathResolver creates a new instance of MockPathResolver. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPathResolver(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPathResolver {
+	mock := &MockPathResolver{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPathResolver is an autogenerated mock type for the PathResolver type
+type MockPathResolver struct {
+	mock.Mock
+}
+
+type MockPathResolver_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPathResolver) EXPECT() *MockPathResolver_Expecter {
+	return &MockPathResolver_Expecter{mock: &_m.Mock}
+}
+
+// ResolvePath provides a mock function for the type MockPathResolver
+func (_mock *MockPathResolver) ResolvePath(filePath string) (string, error) {
+	ret := _mock.Called(filePath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolvePath")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return returnFunc(filePath)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
+		r0 = returnFunc(filePath)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(filePath)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPathResolver_ResolvePath_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolvePath'
+type MockPathResolver_ResolvePath_Call struct {
+	*mock.Call
+}
+
+// ResolvePath is a helper method to define mock.On call
+//   - filePath string
+func (_e *MockPathResolver_Expecter) ResolvePath(filePath interface{}) *MockPathResolver_ResolvePath_Call {
+	return &MockPathResolver_ResolvePath_Call{Call: _e.mock.On("ResolvePath", filePath)}
+}
+
+func (_c *MockPathResolver_ResolvePath_Call) Run(run func(filePath string)) *MockPathResolver_ResolvePath_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPathResolver_ResolvePath_Call) Return(s string, err error) *MockPathResolver_ResolvePath_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockPathResolver_ResolvePath_Call) RunAndReturn(run func(filePath string) (string, error)) *MockPathResolver_ResolvePath_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -105,3 +105,60 @@ func (_c *MockGlobalMATLAB_Client_Call) RunAndReturn(run func(ctx context.Contex
 	_c.Call.Return(run)
 	return _c
 }
+
+// Restart provides a mock function for the type MockGlobalMATLAB
+func (_mock *MockGlobalMATLAB) Restart(ctx context.Context, logger entities.Logger) error {
+	ret := _mock.Called(ctx, logger)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restart")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger) error); ok {
+		r0 = returnFunc(ctx, logger)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockGlobalMATLAB_Restart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restart'
+type MockGlobalMATLAB_Restart_Call struct {
+	*mock.Call
+}
+
+// Restart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - logger entities.Logger
+func (_e *MockGlobalMATLAB_Expecter) Restart(ctx interface{}, logger interface{}) *MockGlobalMATLAB_Restart_Call {
+	return &MockGlobalMATLAB_Restart_Call{Call: _e.mock.On("Restart", ctx, logger)}
+}
+
+func (_c *MockGlobalMATLAB_Restart_Call) Run(run func(ctx context.Context, logger entities.Logger)) *MockGlobalMATLAB_Restart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGlobalMATLAB_Restart_Call) Return(err error) *MockGlobalMATLAB_Restart_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockGlobalMATLAB_Restart_Call) RunAndReturn(run func(ctx context.Context, logger entities.Logger) error) *MockGlobalMATLAB_Restart_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -112,6 +112,78 @@ func (_c *MockMATLABManager_GetMATLABSessionClient_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// GetMATLABSessionDirectory provides a mock function for the type MockMATLABManager
+func (_mock *MockMATLABManager) GetMATLABSessionDirectory(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID) (string, error) {
+	ret := _mock.Called(ctx, sessionLogger, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMATLABSessionDirectory")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.SessionID) (string, error)); ok {
+		return returnFunc(ctx, sessionLogger, sessionID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.SessionID) string); ok {
+		r0 = returnFunc(ctx, sessionLogger, sessionID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entities.Logger, entities.SessionID) error); ok {
+		r1 = returnFunc(ctx, sessionLogger, sessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMATLABManager_GetMATLABSessionDirectory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMATLABSessionDirectory'
+type MockMATLABManager_GetMATLABSessionDirectory_Call struct {
+	*mock.Call
+}
+
+// GetMATLABSessionDirectory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionLogger entities.Logger
+//   - sessionID entities.SessionID
+func (_e *MockMATLABManager_Expecter) GetMATLABSessionDirectory(ctx interface{}, sessionLogger interface{}, sessionID interface{}) *MockMATLABManager_GetMATLABSessionDirectory_Call {
+	return &MockMATLABManager_GetMATLABSessionDirectory_Call{Call: _e.mock.On("GetMATLABSessionDirectory", ctx, sessionLogger, sessionID)}
+}
+
+func (_c *MockMATLABManager_GetMATLABSessionDirectory_Call) Run(run func(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID)) *MockMATLABManager_GetMATLABSessionDirectory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		var arg2 entities.SessionID
+		if args[2] != nil {
+			arg2 = args[2].(entities.SessionID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMATLABManager_GetMATLABSessionDirectory_Call) Return(s string, err error) *MockMATLABManager_GetMATLABSessionDirectory_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockMATLABManager_GetMATLABSessionDirectory_Call) RunAndReturn(run func(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID) (string, error)) *MockMATLABManager_GetMATLABSessionDirectory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListEnvironments provides a mock function for the type MockMATLABManager
 func (_mock *MockMATLABManager) ListEnvironments(ctx context.Context, sessionLogger entities.Logger) []entities.EnvironmentInfo {
 	ret := _mock.Called(ctx, sessionLogger)
@@ -171,6 +243,69 @@ func (_c *MockMATLABManager_ListEnvironments_Call) RunAndReturn(run func(ctx con
 	return _c
 }
 
+// RestartMATLABSession provides a mock function for the type MockMATLABManager
+func (_mock *MockMATLABManager) RestartMATLABSession(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID) error {
+	ret := _mock.Called(ctx, sessionLogger, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestartMATLABSession")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.SessionID) error); ok {
+		r0 = returnFunc(ctx, sessionLogger, sessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockMATLABManager_RestartMATLABSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestartMATLABSession'
+type MockMATLABManager_RestartMATLABSession_Call struct {
+	*mock.Call
+}
+
+// RestartMATLABSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionLogger entities.Logger
+//   - sessionID entities.SessionID
+func (_e *MockMATLABManager_Expecter) RestartMATLABSession(ctx interface{}, sessionLogger interface{}, sessionID interface{}) *MockMATLABManager_RestartMATLABSession_Call {
+	return &MockMATLABManager_RestartMATLABSession_Call{Call: _e.mock.On("RestartMATLABSession", ctx, sessionLogger, sessionID)}
+}
+
+func (_c *MockMATLABManager_RestartMATLABSession_Call) Run(run func(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID)) *MockMATLABManager_RestartMATLABSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		var arg2 entities.SessionID
+		if args[2] != nil {
+			arg2 = args[2].(entities.SessionID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMATLABManager_RestartMATLABSession_Call) Return(err error) *MockMATLABManager_RestartMATLABSession_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockMATLABManager_RestartMATLABSession_Call) RunAndReturn(run func(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID) error) *MockMATLABManager_RestartMATLABSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StartMATLABSession provides a mock function for the type MockMATLABManager
 func (_mock *MockMATLABManager) StartMATLABSession(ctx context.Context, sessionLogger entities.Logger, startRequest entities.SessionDetails) (entities.SessionID, error) {
 	ret := _mock.Called(ctx, sessionLogger, startRequest)
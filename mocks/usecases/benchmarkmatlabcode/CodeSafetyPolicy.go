@@ -0,0 +1,102 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockCodeSafetyPolicy creates a new instance of MockCodeSafetyPolicy. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCodeSafetyPolicy(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCodeSafetyPolicy {
+	mock := &MockCodeSafetyPolicy{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCodeSafetyPolicy is an autogenerated mock type for the CodeSafetyPolicy type
+type MockCodeSafetyPolicy struct {
+	mock.Mock
+}
+
+type MockCodeSafetyPolicy_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCodeSafetyPolicy) EXPECT() *MockCodeSafetyPolicy_Expecter {
+	return &MockCodeSafetyPolicy_Expecter{mock: &_m.Mock}
+}
+
+// Check provides a mock function for the type MockCodeSafetyPolicy
+func (_mock *MockCodeSafetyPolicy) Check(ctx context.Context, sessionLogger entities.Logger, code string) error {
+	ret := _mock.Called(ctx, sessionLogger, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Check")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, string) error); ok {
+		r0 = returnFunc(ctx, sessionLogger, code)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCodeSafetyPolicy_Check_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Check'
+type MockCodeSafetyPolicy_Check_Call struct {
+	*mock.Call
+}
+
+// Check is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionLogger entities.Logger
+//   - code string
+func (_e *MockCodeSafetyPolicy_Expecter) Check(ctx interface{}, sessionLogger interface{}, code interface{}) *MockCodeSafetyPolicy_Check_Call {
+	return &MockCodeSafetyPolicy_Check_Call{Call: _e.mock.On("Check", ctx, sessionLogger, code)}
+}
+
+func (_c *MockCodeSafetyPolicy_Check_Call) Run(run func(ctx context.Context, sessionLogger entities.Logger, code string)) *MockCodeSafetyPolicy_Check_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCodeSafetyPolicy_Check_Call) Return(err error) *MockCodeSafetyPolicy_Check_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCodeSafetyPolicy_Check_Call) RunAndReturn(run func(ctx context.Context, sessionLogger entities.Logger, code string) error) *MockCodeSafetyPolicy_Check_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,102 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockSimulinkDetector creates a new instance of MockSimulinkDetector. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSimulinkDetector(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSimulinkDetector {
+	mock := &MockSimulinkDetector{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSimulinkDetector is an autogenerated mock type for the SimulinkDetector type
+type MockSimulinkDetector struct {
+	mock.Mock
+}
+
+type MockSimulinkDetector_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSimulinkDetector) EXPECT() *MockSimulinkDetector_Expecter {
+	return &MockSimulinkDetector_Expecter{mock: &_m.Mock}
+}
+
+// Check provides a mock function for the type MockSimulinkDetector
+func (_mock *MockSimulinkDetector) Check(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient) error {
+	ret := _mock.Called(ctx, sessionLogger, client)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Check")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.MATLABSessionClient) error); ok {
+		r0 = returnFunc(ctx, sessionLogger, client)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSimulinkDetector_Check_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Check'
+type MockSimulinkDetector_Check_Call struct {
+	*mock.Call
+}
+
+// Check is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionLogger entities.Logger
+//   - client entities.MATLABSessionClient
+func (_e *MockSimulinkDetector_Expecter) Check(ctx interface{}, sessionLogger interface{}, client interface{}) *MockSimulinkDetector_Check_Call {
+	return &MockSimulinkDetector_Check_Call{Call: _e.mock.On("Check", ctx, sessionLogger, client)}
+}
+
+func (_c *MockSimulinkDetector_Check_Call) Run(run func(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient)) *MockSimulinkDetector_Check_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		var arg2 entities.MATLABSessionClient
+		if args[2] != nil {
+			arg2 = args[2].(entities.MATLABSessionClient)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSimulinkDetector_Check_Call) Return(err error) *MockSimulinkDetector_Check_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSimulinkDetector_Check_Call) RunAndReturn(run func(ctx context.Context, sessionLogger entities.Logger, client entities.MATLABSessionClient) error) *MockSimulinkDetector_Check_Call {
+	_c.Call.Return(run)
+	return _c
+}
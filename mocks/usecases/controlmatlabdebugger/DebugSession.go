@@ -0,0 +1,190 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/debugsession"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockDebugSession creates a new instance of MockDebugSession. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDebugSession(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDebugSession {
+	mock := &MockDebugSession{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockDebugSession is an autogenerated mock type for the DebugSession type
+type MockDebugSession struct {
+	mock.Mock
+}
+
+type MockDebugSession_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDebugSession) EXPECT() *MockDebugSession_Expecter {
+	return &MockDebugSession_Expecter{mock: &_m.Mock}
+}
+
+// Resume provides a mock function for the type MockDebugSession
+func (_mock *MockDebugSession) Resume(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, command entities.FEvalRequest) (debugsession.Status, error) {
+	ret := _mock.Called(ctx, logger, client, command)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Resume")
+	}
+
+	var r0 debugsession.Status
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.MATLABSessionClient, entities.FEvalRequest) (debugsession.Status, error)); ok {
+		return returnFunc(ctx, logger, client, command)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.MATLABSessionClient, entities.FEvalRequest) debugsession.Status); ok {
+		r0 = returnFunc(ctx, logger, client, command)
+	} else {
+		r0 = ret.Get(0).(debugsession.Status)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entities.Logger, entities.MATLABSessionClient, entities.FEvalRequest) error); ok {
+		r1 = returnFunc(ctx, logger, client, command)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDebugSession_Resume_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Resume'
+type MockDebugSession_Resume_Call struct {
+	*mock.Call
+}
+
+// Resume is a helper method to define mock.On call
+//   - ctx context.Context
+//   - logger entities.Logger
+//   - client entities.MATLABSessionClient
+//   - command entities.FEvalRequest
+func (_e *MockDebugSession_Expecter) Resume(ctx interface{}, logger interface{}, client interface{}, command interface{}) *MockDebugSession_Resume_Call {
+	return &MockDebugSession_Resume_Call{Call: _e.mock.On("Resume", ctx, logger, client, command)}
+}
+
+func (_c *MockDebugSession_Resume_Call) Run(run func(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, command entities.FEvalRequest)) *MockDebugSession_Resume_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		var arg2 entities.MATLABSessionClient
+		if args[2] != nil {
+			arg2 = args[2].(entities.MATLABSessionClient)
+		}
+		var arg3 entities.FEvalRequest
+		if args[3] != nil {
+			arg3 = args[3].(entities.FEvalRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDebugSession_Resume_Call) Return(status debugsession.Status, err error) *MockDebugSession_Resume_Call {
+	_c.Call.Return(status, err)
+	return _c
+}
+
+func (_c *MockDebugSession_Resume_Call) RunAndReturn(run func(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient, command entities.FEvalRequest) (debugsession.Status, error)) *MockDebugSession_Resume_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Wait provides a mock function for the type MockDebugSession
+func (_mock *MockDebugSession) Wait(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient) (debugsession.Status, error) {
+	ret := _mock.Called(ctx, logger, client)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Wait")
+	}
+
+	var r0 debugsession.Status
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.MATLABSessionClient) (debugsession.Status, error)); ok {
+		return returnFunc(ctx, logger, client)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.MATLABSessionClient) debugsession.Status); ok {
+		r0 = returnFunc(ctx, logger, client)
+	} else {
+		r0 = ret.Get(0).(debugsession.Status)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entities.Logger, entities.MATLABSessionClient) error); ok {
+		r1 = returnFunc(ctx, logger, client)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDebugSession_Wait_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Wait'
+type MockDebugSession_Wait_Call struct {
+	*mock.Call
+}
+
+// Wait is a helper method to define mock.On call
+//   - ctx context.Context
+//   - logger entities.Logger
+//   - client entities.MATLABSessionClient
+func (_e *MockDebugSession_Expecter) Wait(ctx interface{}, logger interface{}, client interface{}) *MockDebugSession_Wait_Call {
+	return &MockDebugSession_Wait_Call{Call: _e.mock.On("Wait", ctx, logger, client)}
+}
+
+func (_c *MockDebugSession_Wait_Call) Run(run func(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient)) *MockDebugSession_Wait_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		var arg2 entities.MATLABSessionClient
+		if args[2] != nil {
+			arg2 = args[2].(entities.MATLABSessionClient)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDebugSession_Wait_Call) Return(status debugsession.Status, err error) *MockDebugSession_Wait_Call {
+	_c.Call.Return(status, err)
+	return _c
+}
+
+func (_c *MockDebugSession_Wait_Call) RunAndReturn(run func(ctx context.Context, logger entities.Logger, client entities.MATLABSessionClient) (debugsession.Status, error)) *MockDebugSession_Wait_Call {
+	_c.Call.Return(run)
+	return _c
+}
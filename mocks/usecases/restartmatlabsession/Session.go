@@ -0,0 +1,164 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockSession creates a new instance of MockSession. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSession(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSession {
+	mock := &MockSession{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSession is an autogenerated mock type for the Session type
+type MockSession struct {
+	mock.Mock
+}
+
+type MockSession_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSession) EXPECT() *MockSession_Expecter {
+	return &MockSession_Expecter{mock: &_m.Mock}
+}
+
+// Client provides a mock function for the type MockSession
+func (_mock *MockSession) Client(ctx context.Context, logger entities.Logger) (entities.MATLABSessionClient, error) {
+	ret := _mock.Called(ctx, logger)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Client")
+	}
+
+	var r0 entities.MATLABSessionClient
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger) (entities.MATLABSessionClient, error)); ok {
+		return returnFunc(ctx, logger)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger) entities.MATLABSessionClient); ok {
+		r0 = returnFunc(ctx, logger)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(entities.MATLABSessionClient)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entities.Logger) error); ok {
+		r1 = returnFunc(ctx, logger)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSession_Client_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Client'
+type MockSession_Client_Call struct {
+	*mock.Call
+}
+
+// Client is a helper method to define mock.On call
+//   - ctx context.Context
+//   - logger entities.Logger
+func (_e *MockSession_Expecter) Client(ctx interface{}, logger interface{}) *MockSession_Client_Call {
+	return &MockSession_Client_Call{Call: _e.mock.On("Client", ctx, logger)}
+}
+
+func (_c *MockSession_Client_Call) Run(run func(ctx context.Context, logger entities.Logger)) *MockSession_Client_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSession_Client_Call) Return(mATLABSessionClient entities.MATLABSessionClient, err error) *MockSession_Client_Call {
+	_c.Call.Return(mATLABSessionClient, err)
+	return _c
+}
+
+func (_c *MockSession_Client_Call) RunAndReturn(run func(ctx context.Context, logger entities.Logger) (entities.MATLABSessionClient, error)) *MockSession_Client_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restart provides a mock function for the type MockSession
+func (_mock *MockSession) Restart(ctx context.Context, logger entities.Logger) error {
+	ret := _mock.Called(ctx, logger)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restart")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger) error); ok {
+		r0 = returnFunc(ctx, logger)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSession_Restart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restart'
+type MockSession_Restart_Call struct {
+	*mock.Call
+}
+
+// Restart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - logger entities.Logger
+func (_e *MockSession_Expecter) Restart(ctx interface{}, logger interface{}) *MockSession_Restart_Call {
+	return &MockSession_Restart_Call{Call: _e.mock.On("Restart", ctx, logger)}
+}
+
+func (_c *MockSession_Restart_Call) Run(run func(ctx context.Context, logger entities.Logger)) *MockSession_Restart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSession_Restart_Call) Return(err error) *MockSession_Restart_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSession_Restart_Call) RunAndReturn(run func(ctx context.Context, logger entities.Logger) error) *MockSession_Restart_Call {
+	_c.Call.Return(run)
+	return _c
+}
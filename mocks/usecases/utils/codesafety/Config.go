@@ -0,0 +1,127 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockConfig creates a new instance of MockConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockConfig {
+	mock := &MockConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockConfig is an autogenerated mock type for the Config type
+type MockConfig struct {
+	mock.Mock
+}
+
+type MockConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockConfig) EXPECT() *MockConfig_Expecter {
+	return &MockConfig_Expecter{mock: &_m.Mock}
+}
+
+// CodeSafetyAction provides a mock function for the type MockConfig
+func (_mock *MockConfig) CodeSafetyAction() entities.CodeSafetyAction {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CodeSafetyAction")
+	}
+
+	var r0 entities.CodeSafetyAction
+	if returnFunc, ok := ret.Get(0).(func() entities.CodeSafetyAction); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(entities.CodeSafetyAction)
+	}
+	return r0
+}
+
+// MockConfig_CodeSafetyAction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CodeSafetyAction'
+type MockConfig_CodeSafetyAction_Call struct {
+	*mock.Call
+}
+
+// CodeSafetyAction is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) CodeSafetyAction() *MockConfig_CodeSafetyAction_Call {
+	return &MockConfig_CodeSafetyAction_Call{Call: _e.mock.On("CodeSafetyAction")}
+}
+
+func (_c *MockConfig_CodeSafetyAction_Call) Run(run func()) *MockConfig_CodeSafetyAction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_CodeSafetyAction_Call) Return(codeSafetyAction entities.CodeSafetyAction) *MockConfig_CodeSafetyAction_Call {
+	_c.Call.Return(codeSafetyAction)
+	return _c
+}
+
+func (_c *MockConfig_CodeSafetyAction_Call) RunAndReturn(run func() entities.CodeSafetyAction) *MockConfig_CodeSafetyAction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CodeSafetyPatterns provides a mock function for the type MockConfig
+func (_mock *MockConfig) CodeSafetyPatterns() []string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CodeSafetyPatterns")
+	}
+
+	var r0 []string
+	if returnFunc, ok := ret.Get(0).(func() []string); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	return r0
+}
+
+// MockConfig_CodeSafetyPatterns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CodeSafetyPatterns'
+type MockConfig_CodeSafetyPatterns_Call struct {
+	*mock.Call
+}
+
+// CodeSafetyPatterns is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) CodeSafetyPatterns() *MockConfig_CodeSafetyPatterns_Call {
+	return &MockConfig_CodeSafetyPatterns_Call{Call: _e.mock.On("CodeSafetyPatterns")}
+}
+
+func (_c *MockConfig_CodeSafetyPatterns_Call) Run(run func()) *MockConfig_CodeSafetyPatterns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_CodeSafetyPatterns_Call) Return(strings []string) *MockConfig_CodeSafetyPatterns_Call {
+	_c.Call.Return(strings)
+	return _c
+}
+
+func (_c *MockConfig_CodeSafetyPatterns_Call) RunAndReturn(run func() []string) *MockConfig_CodeSafetyPatterns_Call {
+	_c.Call.Return(run)
+	return _c
+}
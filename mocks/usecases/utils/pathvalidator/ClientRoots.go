@@ -0,0 +1,91 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockClientRoots creates a new instance of MockClientRoots. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockClientRoots(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockClientRoots {
+	mock := &MockClientRoots{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockClientRoots is an autogenerated mock type for the ClientRoots type
+type MockClientRoots struct {
+	mock.Mock
+}
+
+type MockClientRoots_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockClientRoots) EXPECT() *MockClientRoots_Expecter {
+	return &MockClientRoots_Expecter{mock: &_m.Mock}
+}
+
+// Roots provides a mock function for the type MockClientRoots
+func (_mock *MockClientRoots) Roots() ([]string, bool) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Roots")
+	}
+
+	var r0 []string
+	var r1 bool
+	if returnFunc, ok := ret.Get(0).(func() ([]string, bool)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []string); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() bool); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	return r0, r1
+}
+
+// MockClientRoots_Roots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Roots'
+type MockClientRoots_Roots_Call struct {
+	*mock.Call
+}
+
+// Roots is a helper method to define mock.On call
+func (_e *MockClientRoots_Expecter) Roots() *MockClientRoots_Roots_Call {
+	return &MockClientRoots_Roots_Call{Call: _e.mock.On("Roots")}
+}
+
+func (_c *MockClientRoots_Roots_Call) Run(run func()) *MockClientRoots_Roots_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockClientRoots_Roots_Call) Return(strings []string, b bool) *MockClientRoots_Roots_Call {
+	_c.Call.Return(strings, b)
+	return _c
+}
+
+func (_c *MockClientRoots_Roots_Call) RunAndReturn(run func() ([]string, bool)) *MockClientRoots_Roots_Call {
+	_c.Call.Return(run)
+	return _c
+}
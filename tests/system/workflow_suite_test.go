@@ -53,9 +53,9 @@ func (s *WorkflowTestSuite) TestInteractiveDevelopmentWorkflow() {
 	s.Contains(guidelines, "MATLAB Coding Standards", "should contain coding standards title")
 
 	// Step 2: Feature discovery - check what toolboxes are available
-	info, err := session.DetectToolboxes(ctx)
+	products, err := session.DetectToolboxes(ctx)
 	s.Require().NoError(err, "should detect toolboxes")
-	s.Contains(info, "MATLAB Version:", "should discover MATLAB version")
+	s.Contains(products, "MATLAB", "should discover MATLAB")
 
 	// Step 3: Iterative development with explicit integer math
 	output, err := session.EvaluateCode(ctx, `a = int32(2); b = int32(3);`, s.testDataDir)
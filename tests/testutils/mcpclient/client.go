@@ -186,20 +186,26 @@ func (s *MCPClientSession) RunTestFile(ctx context.Context, scriptPath string) (
 	return s.GetTextContent(result)
 }
 
-// DetectToolboxes detects installed MATLAB toolboxes
-func (s *MCPClientSession) DetectToolboxes(ctx context.Context) (string, error) {
+// DetectToolboxes detects installed MATLAB toolboxes and returns their names
+func (s *MCPClientSession) DetectToolboxes(ctx context.Context) ([]string, error) {
 	result, err := s.CallTool(ctx, "detect_matlab_toolboxes", map[string]any{})
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	var output struct {
-		InstallationInfo string `json:"installation_info"`
+		Products []struct {
+			Name string `json:"name"`
+		} `json:"products"`
 	}
 	err = s.UnmarshalStructuredContent(result, &output)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+	productNames := make([]string, len(output.Products))
+	for i, product := range output.Products {
+		productNames[i] = product.Name
 	}
-	return output.InstallationInfo, nil
+	return productNames, nil
 }
 
 // NewSessionManager creates a new session manager for multi-session workflows